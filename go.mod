@@ -1,11 +1,14 @@
 module github.com/mithrandie/csvq
 
 require (
+	github.com/dsnet/compress v0.0.2-0.20230904184137-39efe44ab707
+	github.com/klauspost/compress v1.17.2
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/mithrandie/go-file/v2 v2.1.0
 	github.com/mithrandie/go-text v1.6.0
 	github.com/mithrandie/readline-csvq v1.3.0
 	github.com/mithrandie/ternary v1.1.1
+	github.com/ulikunitz/xz v0.5.12
 	github.com/urfave/cli/v2 v2.25.1
 	golang.org/x/crypto v0.7.0
 	golang.org/x/sys v0.6.0
@@ -0,0 +1,63 @@
+package action
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	csvqfile "github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/query"
+
+	"github.com/mithrandie/go-file/v2"
+)
+
+func Convert(ctx context.Context, proc *query.Processor, from string, to string, inputFile string, outputFile string) (err error) {
+	if len(inputFile) < 1 || len(outputFile) < 1 {
+		e := parser.NewSyntaxError("file name is empty", parser.Token{})
+		return query.NewSyntaxError(e.(*parser.SyntaxError))
+	}
+
+	if 0 < len(from) {
+		if err = proc.Tx.SetFlag(option.ImportFormatFlag, from); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if err = proc.Tx.SetFormatFlag(to, outputFile); err != nil {
+		return query.NewIncorrectCommandUsageError(err.Error())
+	}
+
+	options := proc.Tx.Flags.ImportOptions.Copy()
+	if len(from) < 1 {
+		options.Format = option.AutoSelect
+	}
+
+	if abs, e := filepath.Abs(outputFile); e == nil {
+		outputFile = abs
+	}
+	if csvqfile.Exists(outputFile) {
+		return query.NewFileAlreadyExistError(parser.Identifier{Literal: outputFile})
+	}
+
+	fp, err := file.Create(outputFile)
+	if err != nil {
+		return query.NewIOError(nil, err.Error())
+	}
+	defer func() {
+		info, e := fp.Stat()
+		if e = fp.Close(); e != nil {
+			proc.LogError(e.Error())
+		}
+		if err != nil || (info != nil && info.Size() < 1) {
+			if e = os.Remove(outputFile); e != nil {
+				proc.LogError(e.Error())
+			}
+		}
+	}()
+
+	if err = query.Convert(ctx, proc.Tx, parser.Identifier{Literal: inputFile}, options, fp); err == query.DataEmpty {
+		err = nil
+	}
+	return err
+}
@@ -0,0 +1,96 @@
+package action
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/file"
+
+	"github.com/mithrandie/csvq/lib/query"
+)
+
+var convertTests = []struct {
+	Name       string
+	From       string
+	To         string
+	InputFile  string
+	OutputFile string
+	Result     string
+	Error      string
+}{
+	{
+		Name:       "Convert",
+		InputFile:  filepath.Join("..", "..", "testdata", "csv", "table1.csv"),
+		OutputFile: GetTestFilePath("convert.jsonl"),
+		Result: "{\"column1\":\"1\",\"column2\":\"str1\"}\n" +
+			"{\"column1\":\"2\",\"column2\":\"str2\"}\n" +
+			"{\"column1\":\"3\",\"column2\":\"str3\"}\n",
+	},
+	{
+		Name:       "Convert with Formats",
+		From:       "jsonl",
+		To:         "tsv",
+		InputFile:  filepath.Join("..", "..", "testdata", "csv", "table7.jsonl"),
+		OutputFile: GetTestFilePath("convert.txt"),
+		Result: "item1\titem2\n" +
+			"value1\t1\n" +
+			"value2\t2\n",
+	},
+	{
+		Name:       "Convert Empty File Name Error",
+		InputFile:  "",
+		OutputFile: GetTestFilePath("convert.csv"),
+		Error:      "file name is empty",
+	},
+	{
+		Name:       "Convert Import Format Error",
+		From:       "error",
+		InputFile:  filepath.Join("..", "..", "testdata", "csv", "table1.csv"),
+		OutputFile: GetTestFilePath("convert.csv"),
+		Error:      "incorrect usage: import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML",
+	},
+	{
+		Name:       "Convert Output File Already Exist Error",
+		InputFile:  filepath.Join("..", "..", "testdata", "csv", "table1.csv"),
+		OutputFile: GetTestFilePath("convert.jsonl"),
+		Error:      "file " + GetTestFilePath("convert.jsonl") + " already exists",
+	},
+	{
+		Name:       "Convert Input File Not Exist Error",
+		InputFile:  GetTestFilePath("notexist.csv"),
+		OutputFile: GetTestFilePath("notexist.jsonl"),
+		Error:      "file " + GetTestFilePath("notexist.csv") + " does not exist",
+	},
+}
+
+func TestConvert(t *testing.T) {
+	tx, _ := query.NewTransaction(context.Background(), file.DefaultWaitTimeout, file.DefaultRetryDelay, query.NewSession())
+	ctx := context.Background()
+
+	for _, v := range convertTests {
+		err := Convert(ctx, query.NewProcessor(tx), v.From, v.To, v.InputFile, v.OutputFile)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result, _ := os.ReadFile(v.OutputFile)
+		if string(result) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(result), v.Result)
+		}
+	}
+
+	if _, err := os.Stat(GetTestFilePath("notexist.jsonl")); err == nil {
+		t.Errorf("output file is not removed on error")
+	}
+}
@@ -95,7 +95,23 @@ func Run() {
 		&cli.StringFlag{
 			Name:    "json-query",
 			Aliases: []string{"j"},
-			Usage:   "`QUERY` for JSON",
+			Usage:   "`QUERY` for JSON and YAML",
+		},
+		&cli.StringFlag{
+			Name:  "xlsx-sheet",
+			Usage: "`SHEET` name or position for XLSX",
+		},
+		&cli.StringFlag{
+			Name:  "xlsx-range",
+			Usage: "cell `RANGE` for XLSX",
+		},
+		&cli.StringFlag{
+			Name:  "xml-row-path",
+			Usage: "`PATH` to the elements of records for XML",
+		},
+		&cli.StringFlag{
+			Name:  "source-path-column",
+			Usage: "`NAME` of the column holding source file paths when loading multiple files",
 		},
 		&cli.StringFlag{
 			Name:    "encoding",
@@ -167,6 +183,38 @@ func Run() {
 			Value:   "BACKSLASH",
 			Usage:   "JSON escape type",
 		},
+		&cli.StringFlag{
+			Name:  "sql-dialect",
+			Value: "ANSI",
+			Usage: "SQL `DIALECT` of statements in SQL format",
+		},
+		&cli.StringFlag{
+			Name:  "sql-table-name",
+			Usage: "table `NAME` of statements in SQL format",
+		},
+		&cli.IntFlag{
+			Name:  "sql-batch-size",
+			Value: option.DefaultSqlBatchSize,
+			Usage: "maximum number of rows in an INSERT statement in SQL format",
+		},
+		&cli.BoolFlag{
+			Name:  "html-document",
+			Usage: "write a standalone HTML document in HTML format",
+		},
+		&cli.StringFlag{
+			Name:  "xml-root-element",
+			Value: option.DefaultXmlRootElement,
+			Usage: "`NAME` of the root element in XML format",
+		},
+		&cli.StringFlag{
+			Name:  "xml-row-element",
+			Value: option.DefaultXmlRowElement,
+			Usage: "`NAME` of the elements of records in XML format",
+		},
+		&cli.BoolFlag{
+			Name:  "xml-attributes",
+			Usage: "write fields as attributes in XML format",
+		},
 		&cli.BoolFlag{
 			Name:    "pretty-print",
 			Aliases: []string{"P"},
@@ -213,6 +261,11 @@ func Run() {
 			Value:   option.GetDefaultNumberOfCPU(),
 			Usage:   "hint for the number of cpu cores to be used",
 		},
+		&cli.IntFlag{
+			Name:  "sort-memory-limit",
+			Value: -1,
+			Usage: "maximum memory in megabytes of buffers to sort records without temporary files",
+		},
 		&cli.BoolFlag{
 			Name:    "stats",
 			Aliases: []string{"x"},
@@ -248,6 +301,34 @@ func Run() {
 				return action.Calc(ctx, proc, expr)
 			}),
 		},
+		{
+			Name:      "convert",
+			Usage:     "Convert a file to another format",
+			ArgsUsage: "INPUT_FILE_PATH OUTPUT_FILE_PATH",
+			Description: "Records are converted chunk by chunk without loading the whole file. " +
+				"The --pretty-print option cannot be used with this subcommand.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "from",
+					Usage: "format of the input file. One of CSV|TSV|JSONL|LTSV",
+				},
+				&cli.StringFlag{
+					Name:  "to",
+					Usage: "format of the output file. One of CSV|TSV|JSONL|LTSV",
+				},
+			},
+			Action: commandAction(func(ctx context.Context, c *cli.Context, proc *query.Processor) error {
+				if 2 != c.NArg() {
+					return query.NewIncorrectCommandUsageError("convert subcommand takes exactly 2 arguments")
+				}
+
+				to := c.String("to")
+				if len(to) < 1 {
+					to = c.String("format")
+				}
+				return action.Convert(ctx, proc, c.String("from"), to, c.Args().Get(0), c.Args().Get(1))
+			}),
+		},
 		{
 			Name:      "syntax",
 			Usage:     "Print syntax",
@@ -427,6 +508,18 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.IsSet("json-query") {
 		_ = tx.SetFlag(option.JsonQueryFlag, c.String("json-query"))
 	}
+	if c.IsSet("xlsx-sheet") {
+		_ = tx.SetFlag(option.XlsxSheetFlag, c.String("xlsx-sheet"))
+	}
+	if c.IsSet("xlsx-range") {
+		_ = tx.SetFlag(option.XlsxRangeFlag, c.String("xlsx-range"))
+	}
+	if c.IsSet("xml-row-path") {
+		_ = tx.SetFlag(option.XmlRowPathFlag, c.String("xml-row-path"))
+	}
+	if c.IsSet("source-path-column") {
+		_ = tx.SetFlag(option.SourcePathColumnFlag, c.String("source-path-column"))
+	}
 	if c.IsSet("encoding") {
 		if err := tx.SetFlag(option.EncodingFlag, c.String("encoding")); err != nil {
 			return query.NewIncorrectCommandUsageError(err.Error())
@@ -478,6 +571,33 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 			return query.NewIncorrectCommandUsageError(err.Error())
 		}
 	}
+	if c.IsSet("sql-dialect") {
+		if err := tx.SetFlag(option.SqlDialectFlag, c.String("sql-dialect")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.IsSet("sql-table-name") {
+		_ = tx.SetFlag(option.SqlTableNameFlag, c.String("sql-table-name"))
+	}
+	if c.IsSet("sql-batch-size") {
+		_ = tx.SetFlag(option.SqlBatchSizeFlag, c.Int64("sql-batch-size"))
+	}
+	if c.IsSet("html-document") {
+		_ = tx.SetFlag(option.HtmlDocumentFlag, c.Bool("html-document"))
+	}
+	if c.IsSet("xml-root-element") {
+		if err := tx.SetFlag(option.XmlRootElementFlag, c.String("xml-root-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.IsSet("xml-row-element") {
+		if err := tx.SetFlag(option.XmlRowElementFlag, c.String("xml-row-element")); err != nil {
+			return query.NewIncorrectCommandUsageError(err.Error())
+		}
+	}
+	if c.IsSet("xml-attributes") {
+		_ = tx.SetFlag(option.XmlAttributesFlag, c.Bool("xml-attributes"))
+	}
 	if c.IsSet("pretty-print") {
 		_ = tx.SetFlag(option.PrettyPrintFlag, c.Bool("pretty-print"))
 	}
@@ -504,6 +624,9 @@ func overwriteFlags(c *cli.Context, tx *query.Transaction) error {
 	if c.IsSet("cpu") {
 		_ = tx.SetFlag(option.CPUFlag, c.Int64("cpu"))
 	}
+	if c.IsSet("sort-memory-limit") {
+		_ = tx.SetFlag(option.SortMemoryLimitFlag, c.Int64("sort-memory-limit"))
+	}
 	if c.IsSet("stats") {
 		_ = tx.SetFlag(option.StatsFlag, c.Bool("stats"))
 	}
@@ -30,9 +30,9 @@ Parameters:
    Timezone
       Local | UTC
    Import Format
-      CSV | TSV | FIXED | JSON | JSONL | LTSV
+      CSV | TSV | FIXED | JSON | JSONL | LTSV | XLSX | PARQUET | XML | YAML
    Export Format
-      CSV | TSV | FIXED | JSON | JSONL | LTSV | GFM | ORG | BOX | TEXT
+      CSV | TSV | FIXED | JSON | JSONL | LTSV | XLSX | PARQUET | XML | YAML | SQL | HTML | GFM | ORG | BOX | TEXT | VERTICAL
    Import Character Encodings
       AUTO | UTF8 | UTF8M | UTF16 | UTF16BE | UTF16LE | UTF16BEM | UTF16LEM | SJIS
    Export Character Encodings
@@ -40,7 +40,9 @@ Parameters:
    Line Break
       CRLF | CR | LF
    JSON Escape Type
-      BACKSLASH | HEX | HEXALL{{end}}{{if .Copyright}}
+      BACKSLASH | HEX | HEXALL
+   SQL Dialect
+      ANSI | MYSQL | POSTGRESQL | SQLITE{{end}}{{if .Copyright}}
 
 Copyright:
    {{.Copyright}}{{end}}
@@ -0,0 +1,142 @@
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+type ArchiveType int
+
+const (
+	NotArchive ArchiveType = iota
+	Zip
+	Tar
+)
+
+const (
+	ZipExt = ".zip"
+	TarExt = ".tar"
+	TgzExt = ".tgz"
+)
+
+var ArchiveExtensions = []string{
+	ZipExt,
+	TarExt,
+	TarExt + GzipExt,
+	TgzExt,
+	TarExt + Bzip2Ext,
+	TarExt + XzExt,
+	TarExt + ZstdExt,
+}
+
+const tarMagicOffset = 257
+
+var (
+	zipMagicNumber      = []byte{'P', 'K', 0x03, 0x04}
+	zipEmptyMagicNumber = []byte{'P', 'K', 0x05, 0x06}
+	tarMagicNumber      = []byte("ustar")
+)
+
+var archiveTypeLiterals = map[ArchiveType]string{
+	NotArchive: "NONE",
+	Zip:        "ZIP",
+	Tar:        "TAR",
+}
+
+func (t ArchiveType) String() string {
+	return archiveTypeLiterals[t]
+}
+
+// DetectArchiveType determines the type of the archive from the magic number at the head of the data.
+func DetectArchiveType(head []byte) ArchiveType {
+	switch {
+	case bytes.HasPrefix(head, zipMagicNumber) || bytes.HasPrefix(head, zipEmptyMagicNumber):
+		return Zip
+	case tarMagicOffset+len(tarMagicNumber) <= len(head) && bytes.Equal(head[tarMagicOffset:tarMagicOffset+len(tarMagicNumber)], tarMagicNumber):
+		return Tar
+	}
+	return NotArchive
+}
+
+// OpenArchiveMember returns a reader of the member in the archive.
+// Tar archives compressed with a supported codec are decompressed on the fly.
+func OpenArchiveMember(fp *os.File, archivePath string, member string) (io.Reader, error) {
+	head := make([]byte, tarMagicOffset+len(tarMagicNumber))
+	n, err := io.ReadFull(fp, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, NewIOError(err.Error())
+	}
+	if _, err = fp.Seek(0, io.SeekStart); err != nil {
+		return nil, NewIOError(err.Error())
+	}
+	head = head[:n]
+
+	if DetectArchiveType(head) == Zip {
+		return openZipMember(fp, archivePath, member)
+	}
+
+	var r io.Reader = fp
+	if compression := DetectCompression(head); compression != NoCompression {
+		if r, err = NewDecompressor(fp, compression); err != nil {
+			return nil, err
+		}
+	}
+	return openTarMember(r, archivePath, member)
+}
+
+func openZipMember(fp *os.File, archivePath string, member string) (io.Reader, error) {
+	info, err := fp.Stat()
+	if err != nil {
+		return nil, NewIOError(err.Error())
+	}
+
+	zr, err := zip.NewReader(fp, info.Size())
+	if err != nil {
+		return nil, NewIOError(fmt.Sprintf("file %s is not a valid archive: %s", archivePath, err.Error()))
+	}
+
+	name := normalizeMemberName(member)
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || normalizeMemberName(f.Name) != name {
+			continue
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return nil, NewIOError(err.Error())
+		}
+		return r, nil
+	}
+	return nil, NewArchiveMemberNotExistError(archivePath, member)
+}
+
+func openTarMember(r io.Reader, archivePath string, member string) (io.Reader, error) {
+	tr := tar.NewReader(r)
+
+	name := normalizeMemberName(member)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, NewIOError(fmt.Sprintf("file %s is not a valid archive: %s", archivePath, err.Error()))
+		}
+
+		if hdr.Typeflag != tar.TypeReg || normalizeMemberName(hdr.Name) != name {
+			continue
+		}
+		return tr, nil
+	}
+	return nil, NewArchiveMemberNotExistError(archivePath, member)
+}
+
+func normalizeMemberName(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(name, "\\", "/")), "/")
+}
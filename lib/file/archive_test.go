@@ -0,0 +1,195 @@
+package file
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var detectArchiveTypeTests = []struct {
+	Head        []byte
+	ArchiveType ArchiveType
+}{
+	{
+		Head:        []byte("column1,column2\n"),
+		ArchiveType: NotArchive,
+	},
+	{
+		Head:        []byte{'P', 'K', 0x03, 0x04, 0x14, 0x00},
+		ArchiveType: Zip,
+	},
+	{
+		Head:        []byte{'P', 'K', 0x05, 0x06, 0x00, 0x00},
+		ArchiveType: Zip,
+	},
+	{
+		Head:        append(make([]byte, 257), []byte("ustar\x0000")...),
+		ArchiveType: Tar,
+	},
+	{
+		Head:        make([]byte, 260),
+		ArchiveType: NotArchive,
+	},
+}
+
+func TestDetectArchiveType(t *testing.T) {
+	for _, v := range detectArchiveTypeTests {
+		result := DetectArchiveType(v.Head)
+		if result != v.ArchiveType {
+			t.Errorf("archive type = %s, want %s for %q", result, v.ArchiveType, v.Head)
+		}
+	}
+}
+
+func createTestZipArchive(fpath string, files map[string]string) error {
+	fp, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fp.Close() }()
+
+	zw := zip.NewWriter(fp)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func createTestTarArchive(fpath string, files map[string]string, compression Compression) error {
+	fp, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fp.Close() }()
+
+	w, err := NewCompressor(fp, compression)
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	for name, content := range files {
+		if err = tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg, Format: tar.FormatUSTAR}); err != nil {
+			return err
+		}
+		if _, err = tw.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+var openArchiveMemberTests = []struct {
+	Name    string
+	Archive string
+	Member  string
+	Result  string
+	Error   string
+}{
+	{
+		Name:    "Zip Archive",
+		Archive: "archive.zip",
+		Member:  "2024/sales.csv",
+		Result:  "column1,column2\n1,str1\n",
+	},
+	{
+		Name:    "Zip Archive with Leading Separator",
+		Archive: "archive.zip",
+		Member:  "/2024\\sales.csv",
+		Result:  "column1,column2\n1,str1\n",
+	},
+	{
+		Name:    "Tar Archive",
+		Archive: "archive.tar",
+		Member:  "items.tsv",
+		Result:  "column3\tcolumn4\n2\tstr22\n",
+	},
+	{
+		Name:    "Gzip Compressed Tar Archive",
+		Archive: "archive.tar.gz",
+		Member:  "./items.tsv",
+		Result:  "column3\tcolumn4\n2\tstr22\n",
+	},
+	{
+		Name:    "Zstd Compressed Tar Archive",
+		Archive: "archive.tar.zst",
+		Member:  "items.tsv",
+		Result:  "column3\tcolumn4\n2\tstr22\n",
+	},
+	{
+		Name:    "Zip Archive Member Not Exist Error",
+		Archive: "archive.zip",
+		Member:  "notexist.csv",
+		Error:   "file notexist.csv does not exist in archive.zip",
+	},
+	{
+		Name:    "Tar Archive Member Not Exist Error",
+		Archive: "archive.tar.gz",
+		Member:  "2024",
+		Error:   "file 2024 does not exist in archive.tar.gz",
+	},
+}
+
+func TestOpenArchiveMember(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"2024/sales.csv": "column1,column2\n1,str1\n",
+		"items.tsv":      "column3\tcolumn4\n2\tstr22\n",
+	}
+	if err := createTestZipArchive(filepath.Join(dir, "archive.zip"), files); err != nil {
+		t.Fatal(err)
+	}
+	if err := createTestTarArchive(filepath.Join(dir, "archive.tar"), files, NoCompression); err != nil {
+		t.Fatal(err)
+	}
+	if err := createTestTarArchive(filepath.Join(dir, "archive.tar.gz"), files, Gzip); err != nil {
+		t.Fatal(err)
+	}
+	if err := createTestTarArchive(filepath.Join(dir, "archive.tar.zst"), files, Zstd); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range openArchiveMemberTests {
+		fp, err := os.Open(filepath.Join(dir, v.Archive))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := OpenArchiveMember(fp, v.Archive, v.Member)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			_ = fp.Close()
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			_ = fp.Close()
+			continue
+		}
+
+		result, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+		} else if string(result) != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, string(result), v.Result)
+		}
+		_ = fp.Close()
+	}
+}
@@ -0,0 +1,134 @@
+package file
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"strings"
+
+	dsbzip2 "github.com/dsnet/compress/bzip2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+type Compression int
+
+const (
+	NoCompression Compression = iota
+	Gzip
+	Bzip2
+	Xz
+	Zstd
+)
+
+const (
+	GzipExt  = ".gz"
+	Bzip2Ext = ".bz2"
+	XzExt    = ".xz"
+	ZstdExt  = ".zst"
+)
+
+var CompressionExtensions = []string{
+	GzipExt,
+	Bzip2Ext,
+	XzExt,
+	ZstdExt,
+}
+
+var compressionLiterals = map[Compression]string{
+	NoCompression: "NONE",
+	Gzip:          "GZIP",
+	Bzip2:         "BZIP2",
+	Xz:            "XZ",
+	Zstd:          "ZSTD",
+}
+
+var compressionMagicNumbers = []struct {
+	Compression Compression
+	Magic       []byte
+}{
+	{Compression: Gzip, Magic: []byte{0x1f, 0x8b}},
+	{Compression: Bzip2, Magic: []byte{'B', 'Z', 'h'}},
+	{Compression: Xz, Magic: []byte{0xfd, '7', 'z', 'X', 'Z', 0x00}},
+	{Compression: Zstd, Magic: []byte{0x28, 0xb5, 0x2f, 0xfd}},
+}
+
+func (c Compression) String() string {
+	return compressionLiterals[c]
+}
+
+// CompressionFromExt returns the codec corresponding to the extension of the file path.
+func CompressionFromExt(fpath string) Compression {
+	switch strings.ToLower(filepath.Ext(fpath)) {
+	case GzipExt:
+		return Gzip
+	case Bzip2Ext:
+		return Bzip2
+	case XzExt:
+		return Xz
+	case ZstdExt:
+		return Zstd
+	}
+	return NoCompression
+}
+
+// TrimCompressionExt removes the extension of a compressed file from the file path.
+func TrimCompressionExt(fpath string) string {
+	if CompressionFromExt(fpath) == NoCompression {
+		return fpath
+	}
+	return fpath[:len(fpath)-len(filepath.Ext(fpath))]
+}
+
+// DetectCompression determines the codec from the magic number at the head of the data.
+func DetectCompression(head []byte) Compression {
+	for _, v := range compressionMagicNumbers {
+		if bytes.HasPrefix(head, v.Magic) {
+			return v.Compression
+		}
+	}
+	return NoCompression
+}
+
+func NewDecompressor(r io.Reader, c Compression) (io.Reader, error) {
+	switch c {
+	case NoCompression:
+		return r, nil
+	case Gzip:
+		return gzip.NewReader(r)
+	case Bzip2:
+		return bzip2.NewReader(r), nil
+	case Xz:
+		return xz.NewReader(r)
+	case Zstd:
+		// Decoding synchronously does not leave goroutines running after reading.
+		return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	}
+	return nil, NewUnsupportedCompressionError(c, "reading")
+}
+
+func NewCompressor(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case NoCompression:
+		return nopWriteCloser{Writer: w}, nil
+	case Gzip:
+		return gzip.NewWriter(w), nil
+	case Bzip2:
+		return dsbzip2.NewWriter(w, nil)
+	case Xz:
+		return xz.NewWriter(w)
+	case Zstd:
+		return zstd.NewWriter(w)
+	}
+	return nil, NewUnsupportedCompressionError(c, "writing")
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
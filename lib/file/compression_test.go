@@ -0,0 +1,130 @@
+package file
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+var compressionFromExtTests = []struct {
+	Path        string
+	Compression Compression
+	Trimmed     string
+}{
+	{
+		Path:        "/path/to/file.csv",
+		Compression: NoCompression,
+		Trimmed:     "/path/to/file.csv",
+	},
+	{
+		Path:        "/path/to/file.csv.gz",
+		Compression: Gzip,
+		Trimmed:     "/path/to/file.csv",
+	},
+	{
+		Path:        "/path/to/file.TSV.BZ2",
+		Compression: Bzip2,
+		Trimmed:     "/path/to/file.TSV",
+	},
+	{
+		Path:        "file.json.xz",
+		Compression: Xz,
+		Trimmed:     "file.json",
+	},
+	{
+		Path:        "file.jsonl.zst",
+		Compression: Zstd,
+		Trimmed:     "file.jsonl",
+	},
+}
+
+func TestCompressionFromExt(t *testing.T) {
+	for _, v := range compressionFromExtTests {
+		result := CompressionFromExt(v.Path)
+		if result != v.Compression {
+			t.Errorf("compression = %s, want %s for %q", result, v.Compression, v.Path)
+		}
+		trimmed := TrimCompressionExt(v.Path)
+		if trimmed != v.Trimmed {
+			t.Errorf("trimmed path = %q, want %q for %q", trimmed, v.Trimmed, v.Path)
+		}
+	}
+}
+
+var detectCompressionTests = []struct {
+	Head        []byte
+	Compression Compression
+}{
+	{
+		Head:        []byte("column1,column2\n"),
+		Compression: NoCompression,
+	},
+	{
+		Head:        []byte{0x1f},
+		Compression: NoCompression,
+	},
+	{
+		Head:        []byte{0x1f, 0x8b, 0x08, 0x00},
+		Compression: Gzip,
+	},
+	{
+		Head:        []byte("BZh91AY&SY"),
+		Compression: Bzip2,
+	},
+	{
+		Head:        []byte{0xfd, '7', 'z', 'X', 'Z', 0x00, 0x00},
+		Compression: Xz,
+	},
+	{
+		Head:        []byte{0x28, 0xb5, 0x2f, 0xfd, 0x04},
+		Compression: Zstd,
+	},
+}
+
+func TestDetectCompression(t *testing.T) {
+	for _, v := range detectCompressionTests {
+		result := DetectCompression(v.Head)
+		if result != v.Compression {
+			t.Errorf("compression = %s, want %s for %v", result, v.Compression, v.Head)
+		}
+	}
+}
+
+func TestCompressor(t *testing.T) {
+	data := "column1,column2\n1,str1\n2,str2\n"
+
+	for _, c := range []Compression{NoCompression, Gzip, Bzip2, Xz, Zstd} {
+		buf := new(bytes.Buffer)
+		w, err := NewCompressor(buf, c)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+		if _, err = w.Write([]byte(data)); err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+		if err = w.Close(); err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+
+		if detected := DetectCompression(buf.Bytes()); detected != c {
+			t.Errorf("%s: detected compression = %s, want %s", c, detected, c)
+		}
+
+		r, err := NewDecompressor(buf, c)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+		result, err := io.ReadAll(r)
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", c, err)
+			continue
+		}
+		if string(result) != data {
+			t.Errorf("%s: result = %q, want %q", c, string(result), data)
+		}
+	}
+}
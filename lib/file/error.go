@@ -68,6 +68,34 @@ func (e AlreadyExistError) Error() string {
 	return e.message
 }
 
+type UnsupportedCompressionError struct {
+	message string
+}
+
+func NewUnsupportedCompressionError(c Compression, operation string) error {
+	return &UnsupportedCompressionError{
+		message: fmt.Sprintf("%s compression is not supported for %s", strings.ToLower(c.String()), operation),
+	}
+}
+
+func (e UnsupportedCompressionError) Error() string {
+	return e.message
+}
+
+type ArchiveMemberNotExistError struct {
+	message string
+}
+
+func NewArchiveMemberNotExistError(archivePath string, member string) error {
+	return &ArchiveMemberNotExistError{
+		message: fmt.Sprintf("file %s does not exist in %s", member, archivePath),
+	}
+}
+
+func (e ArchiveMemberNotExistError) Error() string {
+	return e.message
+}
+
 type LockError struct {
 	message string
 }
@@ -4,12 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/mithrandie/go-text/json"
 
 	"github.com/mithrandie/csvq/lib/value"
-	"github.com/mithrandie/ternary"
 )
 
 func ConvertToValue(structure json.Structure) value.Primary {
@@ -33,6 +31,30 @@ func ConvertToValue(structure json.Structure) value.Primary {
 	return p
 }
 
+// ConvertToStructuredValue converts a JSON structure to a value.
+// Unlike ConvertToValue, JSON arrays and objects are converted to arrays and maps.
+func ConvertToStructuredValue(structure json.Structure) value.Primary {
+	switch structure.(type) {
+	case json.Array:
+		array := structure.(json.Array)
+		values := make([]value.Primary, 0, len(array))
+		for _, v := range array {
+			values = append(values, ConvertToStructuredValue(v))
+		}
+		return value.NewArray(values)
+	case json.Object:
+		obj := structure.(json.Object)
+		m := value.NewMap(obj.Len())
+		obj.Range(func(key string, v json.Structure) bool {
+			m.Set(key, ConvertToStructuredValue(v))
+			return true
+		})
+		return m
+	default:
+		return ConvertToValue(structure)
+	}
+}
+
 func ConvertToArray(array json.Array) []value.Primary {
 	row := make([]value.Primary, 0, len(array))
 	for _, v := range array {
@@ -164,29 +186,5 @@ func addPathValueToRowStructure(parent json.Structure, path ObjectPath, val valu
 }
 
 func ParseValueToStructure(val value.Primary) json.Structure {
-	var s json.Structure
-
-	switch val.(type) {
-	case *value.String:
-		s = json.String(val.(*value.String).Raw())
-	case *value.Integer:
-		s = json.Integer(val.(*value.Integer).Raw())
-	case *value.Float:
-		s = json.Float(val.(*value.Float).Raw())
-	case *value.Boolean:
-		s = json.Boolean(val.(*value.Boolean).Raw())
-	case *value.Ternary:
-		t := val.(*value.Ternary)
-		if t.Ternary() == ternary.UNKNOWN {
-			s = json.Null{}
-		} else {
-			s = json.Boolean(t.Ternary().ParseBool())
-		}
-	case *value.Datetime:
-		s = json.String(val.(*value.Datetime).Format(time.RFC3339Nano))
-	case *value.Null:
-		s = json.Null{}
-	}
-
-	return s
+	return value.ToJsonStructure(val)
 }
@@ -15,7 +15,7 @@ func LoadValue(queryString string, jsontext string) (value.Primary, error) {
 		return nil, err
 	}
 
-	return ConvertToValue(structure), nil
+	return ConvertToStructuredValue(structure), nil
 }
 
 func LoadArray(queryString string, jsontext string) ([]value.Primary, error) {
@@ -20,6 +20,19 @@ var loadValueTests = []struct {
 		Json:   "{\"key\":\"value\"}",
 		Expect: value.NewString("value"),
 	},
+	{
+		Query: "key",
+		Json:  "{\"key\":[1, \"a\", {\"b\":true}]}",
+		Expect: value.NewArray([]value.Primary{
+			value.NewFloat(1),
+			value.NewString("a"),
+			func() value.Primary {
+				m := value.NewMap(1)
+				m.Set("b", value.NewBoolean(true))
+				return m
+			}(),
+		}),
+	},
 	{
 		Query: "'key",
 		Json:  "{\"key\":\"value\"}",
@@ -34,6 +34,10 @@ const (
 	AllowUnevenFieldsFlag        = "ALLOW_UNEVEN_FIELDS"
 	DelimiterPositionsFlag       = "DELIMITER_POSITIONS"
 	JsonQueryFlag                = "JSON_QUERY"
+	XlsxSheetFlag                = "XLSX_SHEET"
+	XlsxRangeFlag                = "XLSX_RANGE"
+	XmlRowPathFlag               = "XML_ROW_PATH"
+	SourcePathColumnFlag         = "SOURCE_PATH_COLUMN"
 	EncodingFlag                 = "ENCODING"
 	NoHeaderFlag                 = "NO_HEADER"
 	WithoutNullFlag              = "WITHOUT_NULL"
@@ -46,6 +50,13 @@ const (
 	LineBreakFlag                = "LINE_BREAK"
 	EncloseAllFlag               = "ENCLOSE_ALL"
 	JsonEscapeFlag               = "JSON_ESCAPE"
+	SqlDialectFlag               = "SQL_DIALECT"
+	SqlTableNameFlag             = "SQL_TABLE_NAME"
+	SqlBatchSizeFlag             = "SQL_BATCH_SIZE"
+	HtmlDocumentFlag             = "HTML_DOCUMENT"
+	XmlRootElementFlag           = "XML_ROOT_ELEMENT"
+	XmlRowElementFlag            = "XML_ROW_ELEMENT"
+	XmlAttributesFlag            = "XML_ATTRIBUTES"
 	PrettyPrintFlag              = "PRETTY_PRINT"
 	ScientificNotationFlag       = "SCIENTIFIC_NOTATION"
 	EastAsianEncodingFlag        = "EAST_ASIAN_ENCODING"
@@ -55,6 +66,7 @@ const (
 	QuietFlag                    = "QUIET"
 	LimitRecursion               = "LIMIT_RECURSION"
 	CPUFlag                      = "CPU"
+	SortMemoryLimitFlag          = "SORT_MEMORY_LIMIT"
 	StatsFlag                    = "STATS"
 )
 
@@ -70,6 +82,10 @@ var FlagList = []string{
 	AllowUnevenFieldsFlag,
 	DelimiterPositionsFlag,
 	JsonQueryFlag,
+	XlsxSheetFlag,
+	XlsxRangeFlag,
+	XmlRowPathFlag,
+	SourcePathColumnFlag,
 	EncodingFlag,
 	NoHeaderFlag,
 	WithoutNullFlag,
@@ -82,6 +98,13 @@ var FlagList = []string{
 	LineBreakFlag,
 	EncloseAllFlag,
 	JsonEscapeFlag,
+	SqlDialectFlag,
+	SqlTableNameFlag,
+	SqlBatchSizeFlag,
+	HtmlDocumentFlag,
+	XmlRootElementFlag,
+	XmlRowElementFlag,
+	XmlAttributesFlag,
 	PrettyPrintFlag,
 	ScientificNotationFlag,
 	EastAsianEncodingFlag,
@@ -91,6 +114,7 @@ var FlagList = []string{
 	QuietFlag,
 	LimitRecursion,
 	CPUFlag,
+	SortMemoryLimitFlag,
 	StatsFlag,
 }
 
@@ -104,23 +128,37 @@ const (
 	JSON
 	JSONL
 	LTSV
+	XLSX
+	PARQUET
+	XML
+	YAML
+	SQL
+	HTML
 	GFM
 	ORG
 	BOX
 	TEXT
+	VERTICAL
 )
 
 var FormatLiteral = map[Format]string{
-	CSV:   "CSV",
-	TSV:   "TSV",
-	FIXED: "FIXED",
-	JSON:  "JSON",
-	JSONL: "JSONL",
-	LTSV:  "LTSV",
-	GFM:   "GFM",
-	ORG:   "ORG",
-	BOX:   "BOX",
-	TEXT:  "TEXT",
+	CSV:      "CSV",
+	TSV:      "TSV",
+	FIXED:    "FIXED",
+	JSON:     "JSON",
+	JSONL:    "JSONL",
+	LTSV:     "LTSV",
+	XLSX:     "XLSX",
+	PARQUET:  "PARQUET",
+	XML:      "XML",
+	YAML:     "YAML",
+	SQL:      "SQL",
+	HTML:     "HTML",
+	GFM:      "GFM",
+	ORG:      "ORG",
+	BOX:      "BOX",
+	TEXT:     "TEXT",
+	VERTICAL: "VERTICAL",
 }
 
 func (f Format) String() string {
@@ -134,6 +172,10 @@ var ImportFormats = []Format{
 	JSON,
 	JSONL,
 	LTSV,
+	XLSX,
+	PARQUET,
+	XML,
+	YAML,
 }
 
 var JsonEscapeTypeLiteral = map[txjson.EscapeType]string{
@@ -146,15 +188,49 @@ func JsonEscapeTypeToString(escapeType txjson.EscapeType) string {
 	return JsonEscapeTypeLiteral[escapeType]
 }
 
+type SqlDialect int
+
+const (
+	ANSI SqlDialect = iota
+	MYSQL
+	POSTGRESQL
+	SQLITE
+)
+
+var SqlDialectLiteral = map[SqlDialect]string{
+	ANSI:       "ANSI",
+	MYSQL:      "MYSQL",
+	POSTGRESQL: "POSTGRESQL",
+	SQLITE:     "SQLITE",
+}
+
+func (d SqlDialect) String() string {
+	return SqlDialectLiteral[d]
+}
+
+const DefaultSqlBatchSize = 100
+
+const (
+	DefaultXmlRootElement = "records"
+	DefaultXmlRowElement  = "record"
+)
+
 const (
 	CsvExt      = ".csv"
 	TsvExt      = ".tsv"
 	JsonExt     = ".json"
 	JsonlExt    = ".jsonl"
 	LtsvExt     = ".ltsv"
+	XlsxExt     = ".xlsx"
+	ParquetExt  = ".parquet"
 	GfmExt      = ".md"
 	OrgExt      = ".org"
+	XmlExt      = ".xml"
+	YamlExt     = ".yaml"
+	YmlExt      = ".yml"
 	SqlExt      = ".sql"
+	HtmlExt     = ".html"
+	HtmExt      = ".htm"
 	CsvqProcExt = ".cql"
 	TextExt     = ".txt"
 )
@@ -166,6 +242,10 @@ type ImportOptions struct {
 	DelimiterPositions []int
 	SingleLine         bool
 	JsonQuery          string
+	XlsxSheet          string
+	XlsxRange          string
+	XmlRowPath         string
+	SourcePathColumn   string
 	Encoding           text.Encoding
 	NoHeader           bool
 	WithoutNull        bool
@@ -191,6 +271,10 @@ func NewImportOptions() ImportOptions {
 		DelimiterPositions: nil,
 		SingleLine:         false,
 		JsonQuery:          "",
+		XlsxSheet:          "",
+		XlsxRange:          "",
+		XmlRowPath:         "",
+		SourcePathColumn:   "",
 		Encoding:           text.AUTO,
 		NoHeader:           false,
 		WithoutNull:        false,
@@ -209,6 +293,13 @@ type ExportOptions struct {
 	EncloseAll           bool
 	JsonEscape           txjson.EscapeType
 	PrettyPrint          bool
+	SqlDialect           SqlDialect
+	SqlTableName         string
+	SqlBatchSize         int
+	HtmlDocument         bool
+	XmlRootElement       string
+	XmlRowElement        string
+	XmlAttributes        bool
 	ScientificNotation   bool
 
 	// For Calculation of String Width
@@ -244,6 +335,13 @@ func NewExportOptions() ExportOptions {
 		EncloseAll:           false,
 		JsonEscape:           txjson.Backslash,
 		PrettyPrint:          false,
+		SqlDialect:           ANSI,
+		SqlTableName:         "",
+		SqlBatchSize:         DefaultSqlBatchSize,
+		HtmlDocument:         false,
+		XmlRootElement:       DefaultXmlRootElement,
+		XmlRowElement:        DefaultXmlRowElement,
+		XmlAttributes:        false,
 		ScientificNotation:   false,
 		EastAsianEncoding:    false,
 		CountDiacriticalSign: false,
@@ -269,10 +367,11 @@ type Flags struct {
 	ExportOptions ExportOptions
 
 	// System Use
-	Quiet          bool
-	LimitRecursion int64
-	CPU            int
-	Stats          bool
+	Quiet           bool
+	LimitRecursion  int64
+	CPU             int
+	SortMemoryLimit int64
+	Stats           bool
 
 	defaultTimeLocation *time.Location
 }
@@ -324,6 +423,7 @@ func NewFlags(env *Environment) (*Flags, error) {
 		Quiet:               false,
 		LimitRecursion:      1000,
 		CPU:                 GetDefaultNumberOfCPU(),
+		SortMemoryLimit:     -1,
 		Stats:               false,
 		defaultTimeLocation: defaultTimeLocation,
 	}, nil
@@ -408,16 +508,16 @@ func (f *Flags) SetWaitTimeout(t float64) {
 func (f *Flags) SetImportFormat(s string) error {
 	fm, _, err := ParseFormat(s, f.ExportOptions.JsonEscape)
 	if err != nil {
-		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV")
+		return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML")
 	}
 
 	switch fm {
-	case CSV, TSV, FIXED, JSON, JSONL, LTSV:
+	case CSV, TSV, FIXED, JSON, JSONL, LTSV, XLSX, PARQUET, XML, YAML:
 		f.ImportOptions.Format = fm
 		return nil
 	}
 
-	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV")
+	return errors.New("import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML")
 }
 
 func (f *Flags) SetDelimiter(s string) error {
@@ -456,6 +556,22 @@ func (f *Flags) SetJsonQuery(s string) {
 	f.ImportOptions.JsonQuery = TrimSpace(s)
 }
 
+func (f *Flags) SetXlsxSheet(s string) {
+	f.ImportOptions.XlsxSheet = TrimSpace(s)
+}
+
+func (f *Flags) SetXlsxRange(s string) {
+	f.ImportOptions.XlsxRange = strings.ToUpper(TrimSpace(s))
+}
+
+func (f *Flags) SetXmlRowPath(s string) {
+	f.ImportOptions.XmlRowPath = TrimSpace(s)
+}
+
+func (f *Flags) SetSourcePathColumn(s string) {
+	f.ImportOptions.SourcePathColumn = TrimSpace(s)
+}
+
 func (f *Flags) SetEncoding(s string) error {
 	if len(s) < 1 {
 		return nil
@@ -500,10 +616,22 @@ func (f *Flags) SetFormat(s string, outfile string, canOutputToPipe bool) error
 			f.ExportOptions.Format = JSONL
 		case LtsvExt:
 			f.ExportOptions.Format = LTSV
+		case XlsxExt:
+			f.ExportOptions.Format = XLSX
+		case ParquetExt:
+			f.ExportOptions.Format = PARQUET
 		case GfmExt:
 			f.ExportOptions.Format = GFM
 		case OrgExt:
 			f.ExportOptions.Format = ORG
+		case XmlExt:
+			f.ExportOptions.Format = XML
+		case YamlExt, YmlExt:
+			f.ExportOptions.Format = YAML
+		case SqlExt:
+			f.ExportOptions.Format = SQL
+		case HtmlExt, HtmExt:
+			f.ExportOptions.Format = HTML
 		default:
 			f.ExportOptions.Format = TEXT
 		}
@@ -592,6 +720,61 @@ func (f *Flags) SetJsonEscape(s string) error {
 	return nil
 }
 
+func (f *Flags) SetSqlDialect(s string) error {
+	dialect, err := ParseSqlDialect(s)
+	if err != nil {
+		return err
+	}
+
+	f.ExportOptions.SqlDialect = dialect
+	return nil
+}
+
+func (f *Flags) SetSqlTableName(s string) {
+	f.ExportOptions.SqlTableName = TrimSpace(s)
+}
+
+func (f *Flags) SetSqlBatchSize(i int64) {
+	if i < 1 {
+		i = 1
+	}
+	f.ExportOptions.SqlBatchSize = int(i)
+}
+
+func (f *Flags) SetHtmlDocument(b bool) {
+	f.ExportOptions.HtmlDocument = b
+}
+
+func (f *Flags) SetXmlRootElement(s string) error {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		s = DefaultXmlRootElement
+	}
+	if !IsXmlName(s) {
+		return errors.New(fmt.Sprintf("xml root element %q is not a valid name", s))
+	}
+
+	f.ExportOptions.XmlRootElement = s
+	return nil
+}
+
+func (f *Flags) SetXmlRowElement(s string) error {
+	s = TrimSpace(s)
+	if len(s) < 1 {
+		s = DefaultXmlRowElement
+	}
+	if !IsXmlName(s) {
+		return errors.New(fmt.Sprintf("xml row element %q is not a valid name", s))
+	}
+
+	f.ExportOptions.XmlRowElement = s
+	return nil
+}
+
+func (f *Flags) SetXmlAttributes(b bool) {
+	f.ExportOptions.XmlAttributes = b
+}
+
 func (f *Flags) SetPrettyPrint(b bool) {
 	f.ExportOptions.PrettyPrint = b
 }
@@ -647,6 +830,13 @@ func (f *Flags) SetCPU(i int) {
 	f.CPU = i
 }
 
+func (f *Flags) SetSortMemoryLimit(i int64) {
+	if i < 0 {
+		i = -1
+	}
+	f.SortMemoryLimit = i
+}
+
 func (f *Flags) SetStats(b bool) {
 	f.Stats = b
 }
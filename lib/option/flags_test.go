@@ -196,7 +196,7 @@ func TestFlags_SetImportFormat(t *testing.T) {
 		t.Errorf("importFormat = %s, expect to set %s for empty string", flags.ImportOptions.Format, JSON)
 	}
 
-	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV"
+	expectErr := "import format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML"
 	err := flags.SetImportFormat("error")
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -299,6 +299,42 @@ func TestFlags_SetJsonQuery(t *testing.T) {
 	}
 }
 
+func TestFlags_SetXlsxSheet(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetXlsxSheet(" Sheet1 ")
+	if flags.ImportOptions.XlsxSheet != "Sheet1" {
+		t.Errorf("xlsx-sheet = %q, expect to set %q", flags.ImportOptions.XlsxSheet, "Sheet1")
+	}
+}
+
+func TestFlags_SetXlsxRange(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetXlsxRange("a1:f200")
+	if flags.ImportOptions.XlsxRange != "A1:F200" {
+		t.Errorf("xlsx-range = %q, expect to set %q", flags.ImportOptions.XlsxRange, "A1:F200")
+	}
+}
+
+func TestFlags_SetXmlRowPath(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetXmlRowPath(" items.item ")
+	if flags.ImportOptions.XmlRowPath != "items.item" {
+		t.Errorf("xml-row-path = %q, expect to set %q", flags.ImportOptions.XmlRowPath, "items.item")
+	}
+}
+
+func TestFlags_SetSourcePathColumn(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetSourcePathColumn(" source_file ")
+	if flags.ImportOptions.SourcePathColumn != "source_file" {
+		t.Errorf("source-path-column = %q, expect to set %q", flags.ImportOptions.SourcePathColumn, "source_file")
+	}
+}
+
 func TestFlags_SetEncoding(t *testing.T) {
 	flags, _ := NewFlags(nil)
 
@@ -377,6 +413,36 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, LTSV, "foo.ltsv")
 	}
 
+	_ = flags.SetFormat("", "foo.xlsx", false)
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, XLSX, "foo.xlsx")
+	}
+
+	_ = flags.SetFormat("", "foo.parquet", false)
+	if flags.ExportOptions.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, PARQUET, "foo.parquet")
+	}
+
+	_ = flags.SetFormat("", "foo.sql", false)
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, SQL, "foo.sql")
+	}
+
+	_ = flags.SetFormat("", "foo.xml", false)
+	if flags.ExportOptions.Format != XML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, XML, "foo.xml")
+	}
+
+	_ = flags.SetFormat("", "foo.yml", false)
+	if flags.ExportOptions.Format != YAML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, YAML, "foo.yml")
+	}
+
+	_ = flags.SetFormat("", "foo.html", false)
+	if flags.ExportOptions.Format != HTML {
+		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, HTML, "foo.html")
+	}
+
 	_ = flags.SetFormat("", "foo.md", false)
 	if flags.ExportOptions.Format != GFM {
 		t.Errorf("format = %s, expect to set %s for empty string with file %q", flags.ExportOptions.Format, GFM, "foo.md")
@@ -417,6 +483,26 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, LTSV, "ltsv")
 	}
 
+	_ = flags.SetFormat("xlsx", "", false)
+	if flags.ExportOptions.Format != XLSX {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, XLSX, "xlsx")
+	}
+
+	_ = flags.SetFormat("parquet", "", false)
+	if flags.ExportOptions.Format != PARQUET {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, PARQUET, "parquet")
+	}
+
+	_ = flags.SetFormat("sql", "", false)
+	if flags.ExportOptions.Format != SQL {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, SQL, "sql")
+	}
+
+	_ = flags.SetFormat("html", "", false)
+	if flags.ExportOptions.Format != HTML {
+		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, HTML, "html")
+	}
+
 	_ = flags.SetFormat("jsonh", "", false)
 	if flags.ExportOptions.Format != JSON {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, JSON, "jsonh")
@@ -453,7 +539,7 @@ func TestFlags_SetFormat(t *testing.T) {
 		t.Errorf("format = %s, expect to set %s for %s", flags.ExportOptions.Format, TEXT, "text")
 	}
 
-	expectErr := "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|BOX|TEXT"
+	expectErr := "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML|SQL|HTML|GFM|ORG|BOX|TEXT|VERTICAL"
 	err := flags.SetFormat("error", "", false)
 	if err == nil {
 		t.Errorf("no error, want error %q for %s", expectErr, "error")
@@ -610,6 +696,99 @@ func TestFlags_SetJsonEscape(t *testing.T) {
 	}
 }
 
+func TestFlags_SetSqlDialect(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	s := "mysql"
+	_ = flags.SetSqlDialect(s)
+	if flags.ExportOptions.SqlDialect != MYSQL {
+		t.Errorf("sql-dialect = %s, expect to set %s", flags.ExportOptions.SqlDialect, MYSQL)
+	}
+
+	s = "postgres"
+	_ = flags.SetSqlDialect(s)
+	if flags.ExportOptions.SqlDialect != POSTGRESQL {
+		t.Errorf("sql-dialect = %s, expect to set %s", flags.ExportOptions.SqlDialect, POSTGRESQL)
+	}
+
+	s = "error"
+	expectErr := "sql dialect must be one of ANSI|MYSQL|POSTGRESQL|SQLITE"
+	err := flags.SetSqlDialect(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetSqlTableName(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	s := " users "
+	flags.SetSqlTableName(s)
+	if flags.ExportOptions.SqlTableName != "users" {
+		t.Errorf("sql-table-name = %q, expect to set %q", flags.ExportOptions.SqlTableName, "users")
+	}
+}
+
+func TestFlags_SetSqlBatchSize(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetSqlBatchSize(500)
+	if flags.ExportOptions.SqlBatchSize != 500 {
+		t.Errorf("sql-batch-size = %d, expect to set %d", flags.ExportOptions.SqlBatchSize, 500)
+	}
+
+	flags.SetSqlBatchSize(0)
+	if flags.ExportOptions.SqlBatchSize != 1 {
+		t.Errorf("sql-batch-size = %d, expect to set %d", flags.ExportOptions.SqlBatchSize, 1)
+	}
+}
+
+func TestFlags_SetXmlRootElement(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	s := " feed "
+	_ = flags.SetXmlRootElement(s)
+	if flags.ExportOptions.XmlRootElement != "feed" {
+		t.Errorf("xml-root-element = %q, expect to set %q", flags.ExportOptions.XmlRootElement, "feed")
+	}
+
+	s = ""
+	_ = flags.SetXmlRootElement(s)
+	if flags.ExportOptions.XmlRootElement != DefaultXmlRootElement {
+		t.Errorf("xml-root-element = %q, expect to set %q", flags.ExportOptions.XmlRootElement, DefaultXmlRootElement)
+	}
+
+	s = "a b"
+	expectErr := "xml root element \"a b\" is not a valid name"
+	err := flags.SetXmlRootElement(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
+func TestFlags_SetXmlRowElement(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	s := "item"
+	_ = flags.SetXmlRowElement(s)
+	if flags.ExportOptions.XmlRowElement != "item" {
+		t.Errorf("xml-row-element = %q, expect to set %q", flags.ExportOptions.XmlRowElement, "item")
+	}
+
+	s = "-item"
+	expectErr := "xml row element \"-item\" is not a valid name"
+	err := flags.SetXmlRowElement(s)
+	if err == nil {
+		t.Errorf("no error, want error %q for %s", expectErr, s)
+	} else if err.Error() != expectErr {
+		t.Errorf("error = %q, want error %q for %s", err.Error(), expectErr, s)
+	}
+}
+
 func TestFlags_SetPrettyPrint(t *testing.T) {
 	flags, _ := NewFlags(nil)
 
@@ -713,6 +892,20 @@ func TestFlags_SetCPU(t *testing.T) {
 	}
 }
 
+func TestFlags_SetSortMemoryLimit(t *testing.T) {
+	flags, _ := NewFlags(nil)
+
+	flags.SetSortMemoryLimit(int64(-100))
+	if flags.SortMemoryLimit != -1 {
+		t.Errorf("sort_memory_limit = %d, expect to set %d", flags.SortMemoryLimit, -1)
+	}
+
+	flags.SetSortMemoryLimit(int64(512))
+	if flags.SortMemoryLimit != 512 {
+		t.Errorf("sort_memory_limit = %d, expect to set %d", flags.SortMemoryLimit, 512)
+	}
+}
+
 func TestFlags_SetStats(t *testing.T) {
 	flags, _ := NewFlags(nil)
 
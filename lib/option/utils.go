@@ -343,6 +343,18 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSONL
 	case "LTSV":
 		fm = LTSV
+	case "XLSX":
+		fm = XLSX
+	case "PARQUET":
+		fm = PARQUET
+	case "XML":
+		fm = XML
+	case "YAML":
+		fm = YAML
+	case "SQL":
+		fm = SQL
+	case "HTML":
+		fm = HTML
 	case "GFM":
 		fm = GFM
 	case "ORG":
@@ -351,6 +363,8 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = BOX
 	case "TEXT":
 		fm = TEXT
+	case "VERTICAL":
+		fm = VERTICAL
 	case "JSONH":
 		fm = JSON
 		et = txjson.HexDigits
@@ -358,7 +372,7 @@ func ParseFormat(s string, et txjson.EscapeType) (Format, txjson.EscapeType, err
 		fm = JSON
 		et = txjson.AllWithHexDigits
 	default:
-		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|BOX|TEXT")
+		return fm, et, errors.New("format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML|SQL|HTML|GFM|ORG|BOX|TEXT|VERTICAL")
 	}
 	return fm, et, nil
 }
@@ -378,6 +392,39 @@ func ParseJsonEscapeType(s string) (txjson.EscapeType, error) {
 	return escape, nil
 }
 
+func ParseSqlDialect(s string) (SqlDialect, error) {
+	var dialect SqlDialect
+	switch strings.ToUpper(s) {
+	case "ANSI":
+		dialect = ANSI
+	case "MYSQL":
+		dialect = MYSQL
+	case "POSTGRESQL", "POSTGRES":
+		dialect = POSTGRESQL
+	case "SQLITE":
+		dialect = SQLITE
+	default:
+		return dialect, errors.New("sql dialect must be one of ANSI|MYSQL|POSTGRESQL|SQLITE")
+	}
+	return dialect, nil
+}
+
+// IsXmlName reports whether s can be used as a name of XML elements and attributes.
+func IsXmlName(s string) bool {
+	if len(s) < 1 {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == ':' || unicode.IsLetter(r):
+		case 0 < i && (r == '-' || r == '.' || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func AppendStrIfNotExist(list []string, elem string) []string {
 	if len(elem) < 1 {
 		return list
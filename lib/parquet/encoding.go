@@ -0,0 +1,462 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var errCorruptedPage = errors.New("corrupted page")
+
+// columnValues holds decoded values of a column.
+// INT32 and INT64 values are stored in ints, FLOAT and DOUBLE values are stored in floats,
+// and INT96, BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY values are stored in bytes.
+type columnValues struct {
+	bools  []bool
+	ints   []int64
+	floats []float64
+	bytes  [][]byte
+}
+
+func (vals *columnValues) len() int {
+	return len(vals.bools) + len(vals.ints) + len(vals.floats) + len(vals.bytes)
+}
+
+func (vals *columnValues) reset() {
+	vals.bools = vals.bools[:0]
+	vals.ints = vals.ints[:0]
+	vals.floats = vals.floats[:0]
+	vals.bytes = vals.bytes[:0]
+}
+
+// appendDictionaryValues appends the values in the dictionary referred by the indices.
+func (vals *columnValues) appendDictionaryValues(dict *columnValues, indices []int32) error {
+	size := dict.len()
+	for _, idx := range indices {
+		if idx < 0 || size <= int(idx) {
+			return errors.New("dictionary index is out of range")
+		}
+	}
+
+	switch {
+	case 0 < len(dict.bools):
+		for _, idx := range indices {
+			vals.bools = append(vals.bools, dict.bools[idx])
+		}
+	case 0 < len(dict.ints):
+		for _, idx := range indices {
+			vals.ints = append(vals.ints, dict.ints[idx])
+		}
+	case 0 < len(dict.floats):
+		for _, idx := range indices {
+			vals.floats = append(vals.floats, dict.floats[idx])
+		}
+	case 0 < len(dict.bytes):
+		for _, idx := range indices {
+			vals.bytes = append(vals.bytes, dict.bytes[idx])
+		}
+	}
+	return nil
+}
+
+func bitWidth(max int) int {
+	return bits.Len(uint(max))
+}
+
+// decodeValues decodes n values in data, and appends them to vals.
+func decodeValues(vals *columnValues, data []byte, physicalType int32, typeLength int, encoding int32, n int) error {
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(vals, data, physicalType, typeLength, n)
+	case encodingRLE:
+		if physicalType != typeBoolean {
+			break
+		}
+		if len(data) < 4 {
+			return errCorruptedPage
+		}
+		l := int(binary.LittleEndian.Uint32(data))
+		if len(data)-4 < l {
+			return errCorruptedPage
+		}
+		levels, err := decodeHybrid(data[4:4+l], 1, n, nil)
+		if err != nil {
+			return err
+		}
+		for _, v := range levels {
+			vals.bools = append(vals.bools, v == 1)
+		}
+		return nil
+	case encodingDeltaBinaryPacked:
+		switch physicalType {
+		case typeInt32, typeInt64:
+			ints, _, err := decodeDeltaBinaryPacked(data, n, physicalType == typeInt32)
+			if err != nil {
+				return err
+			}
+			vals.ints = append(vals.ints, ints...)
+			return nil
+		}
+	case encodingDeltaLengthByteArray:
+		if physicalType == typeByteArray {
+			return decodeDeltaLengthByteArray(vals, data, n)
+		}
+	case encodingDeltaByteArray:
+		switch physicalType {
+		case typeByteArray, typeFixedLenByteArray:
+			return decodeDeltaByteArray(vals, data, n)
+		}
+	case encodingByteStreamSplit:
+		return decodeByteStreamSplit(vals, data, physicalType, typeLength, n)
+	}
+
+	name, ok := encodingNames[encoding]
+	if !ok {
+		name = fmt.Sprintf("%d", encoding)
+	}
+	return errors.New(fmt.Sprintf("unsupported encoding %s", name))
+}
+
+func decodePlain(vals *columnValues, data []byte, physicalType int32, typeLength int, n int) error {
+	switch physicalType {
+	case typeBoolean:
+		if len(data)*8 < n {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.bools = append(vals.bools, data[i/8]>>(uint(i)%8)&1 == 1)
+		}
+	case typeInt32:
+		if len(data) < n*4 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.ints = append(vals.ints, int64(int32(binary.LittleEndian.Uint32(data[i*4:]))))
+		}
+	case typeInt64:
+		if len(data) < n*8 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.ints = append(vals.ints, int64(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case typeInt96:
+		if len(data) < n*12 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.bytes = append(vals.bytes, data[i*12:i*12+12])
+		}
+	case typeFloat:
+		if len(data) < n*4 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.floats = append(vals.floats, float64(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))))
+		}
+	case typeDouble:
+		if len(data) < n*8 {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.floats = append(vals.floats, math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	case typeByteArray:
+		pos := 0
+		for i := 0; i < n; i++ {
+			if len(data) < pos+4 {
+				return errCorruptedPage
+			}
+			l := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if l < 0 || len(data)-pos < l {
+				return errCorruptedPage
+			}
+			vals.bytes = append(vals.bytes, data[pos:pos+l])
+			pos += l
+		}
+	case typeFixedLenByteArray:
+		if typeLength < 0 || len(data) < n*typeLength {
+			return errCorruptedPage
+		}
+		for i := 0; i < n; i++ {
+			vals.bytes = append(vals.bytes, data[i*typeLength:(i+1)*typeLength])
+		}
+	default:
+		return errors.New(fmt.Sprintf("unsupported physical type %d", physicalType))
+	}
+	return nil
+}
+
+// decodeHybrid decodes n values encoded with the RLE/Bit-Packing Hybrid encoding, and appends them to out.
+func decodeHybrid(data []byte, width int, n int, out []int32) ([]int32, error) {
+	if 32 < width {
+		return out, errCorruptedPage
+	}
+	if width == 0 {
+		for i := 0; i < n; i++ {
+			out = append(out, 0)
+		}
+		return out, nil
+	}
+
+	byteWidth := (width + 7) / 8
+	mask := uint64(1)<<uint(width) - 1
+
+	pos := 0
+	remaining := n
+	for 0 < remaining {
+		header, l := binary.Uvarint(data[pos:])
+		if l <= 0 {
+			return out, errCorruptedPage
+		}
+		pos += l
+
+		if header&1 == 0 {
+			count := int(header >> 1)
+			if len(data)-pos < byteWidth || count < 0 {
+				return out, errCorruptedPage
+			}
+			var v uint32
+			for i := 0; i < byteWidth; i++ {
+				v |= uint32(data[pos+i]) << (uint(i) * 8)
+			}
+			pos += byteWidth
+
+			if remaining < count {
+				count = remaining
+			}
+			for i := 0; i < count; i++ {
+				out = append(out, int32(v))
+			}
+			remaining -= count
+		} else {
+			groups := int(header >> 1)
+			size := groups * width
+			if groups < 0 || len(data)-pos < size {
+				return out, errCorruptedPage
+			}
+			count := groups * 8
+			if remaining < count {
+				count = remaining
+			}
+			unpacked := unpackBits(data[pos:pos+size], width, count, mask)
+			for _, v := range unpacked {
+				out = append(out, int32(v))
+			}
+			pos += size
+			remaining -= count
+		}
+	}
+	return out, nil
+}
+
+// unpackBits unpacks n values packed from the least significant bit.
+func unpackBits(data []byte, width int, n int, mask uint64) []uint64 {
+	out := make([]uint64, n)
+	bitPos := 0
+	for i := 0; i < n; i++ {
+		var v uint64
+		read := 0
+		for read < width {
+			b := data[bitPos/8]
+			offset := bitPos % 8
+			take := 8 - offset
+			if width-read < take {
+				take = width - read
+			}
+			v |= uint64(b>>uint(offset)&(1<<uint(take)-1)) << uint(read)
+			read += take
+			bitPos += take
+		}
+		out[i] = v & mask
+	}
+	return out
+}
+
+// decodeDeltaBinaryPacked decodes n integers, and returns them with the number of the consumed bytes.
+func decodeDeltaBinaryPacked(data []byte, n int, is32Bit bool) ([]int64, int, error) {
+	pos := 0
+	readUvarint := func() (uint64, error) {
+		v, l := binary.Uvarint(data[pos:])
+		if l <= 0 {
+			return 0, errCorruptedPage
+		}
+		pos += l
+		return v, nil
+	}
+	readZigzag := func() (int64, error) {
+		v, err := readUvarint()
+		return int64(v>>1) ^ -int64(v&1), err
+	}
+
+	blockSize, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	miniBlocks, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := readUvarint()
+	if err != nil {
+		return nil, 0, err
+	}
+	value, err := readZigzag()
+	if err != nil {
+		return nil, 0, err
+	}
+	if miniBlocks == 0 || blockSize%miniBlocks != 0 || (blockSize/miniBlocks)%8 != 0 || total < uint64(n) {
+		return nil, 0, errCorruptedPage
+	}
+	valuesPerMiniBlock := int(blockSize / miniBlocks)
+
+	out := make([]int64, 0, total)
+	if 0 < total {
+		out = append(out, value)
+	}
+	for uint64(len(out)) < total {
+		minDelta, err := readZigzag()
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(data)-pos < int(miniBlocks) {
+			return nil, 0, errCorruptedPage
+		}
+		widths := data[pos : pos+int(miniBlocks)]
+		pos += int(miniBlocks)
+
+		for _, w := range widths {
+			if total <= uint64(len(out)) {
+				break
+			}
+			if 64 < w {
+				return nil, 0, errCorruptedPage
+			}
+			size := valuesPerMiniBlock * int(w) / 8
+			if len(data)-pos < size {
+				return nil, 0, errCorruptedPage
+			}
+
+			var deltas []uint64
+			if w == 0 {
+				deltas = make([]uint64, valuesPerMiniBlock)
+			} else {
+				mask := uint64(math.MaxUint64)
+				if w < 64 {
+					mask = uint64(1)<<w - 1
+				}
+				deltas = unpackBits(data[pos:pos+size], int(w), valuesPerMiniBlock, mask)
+			}
+			pos += size
+
+			for _, d := range deltas {
+				if total <= uint64(len(out)) {
+					break
+				}
+				if is32Bit {
+					value = int64(int32(value) + int32(minDelta) + int32(d))
+				} else {
+					value = value + minDelta + int64(d)
+				}
+				out = append(out, value)
+			}
+		}
+	}
+
+	if len(out) < n {
+		return nil, 0, errCorruptedPage
+	}
+	return out[:n], pos, nil
+}
+
+func decodeDeltaLengthByteArray(vals *columnValues, data []byte, n int) error {
+	lengths, pos, err := decodeDeltaBinaryPacked(data, n, true)
+	if err != nil {
+		return err
+	}
+	for _, l := range lengths {
+		if l < 0 || int64(len(data)-pos) < l {
+			return errCorruptedPage
+		}
+		vals.bytes = append(vals.bytes, data[pos:pos+int(l)])
+		pos += int(l)
+	}
+	return nil
+}
+
+func decodeDeltaByteArray(vals *columnValues, data []byte, n int) error {
+	prefixLengths, pos, err := decodeDeltaBinaryPacked(data, n, true)
+	if err != nil {
+		return err
+	}
+	suffixes := &columnValues{}
+	if err = decodeDeltaLengthByteArray(suffixes, data[pos:], n); err != nil {
+		return err
+	}
+
+	var prev []byte
+	for i := 0; i < n; i++ {
+		l := prefixLengths[i]
+		if l < 0 || int64(len(prev)) < l {
+			return errCorruptedPage
+		}
+		v := make([]byte, 0, int(l)+len(suffixes.bytes[i]))
+		v = append(v, prev[:l]...)
+		v = append(v, suffixes.bytes[i]...)
+		vals.bytes = append(vals.bytes, v)
+		prev = v
+	}
+	return nil
+}
+
+func decodeByteStreamSplit(vals *columnValues, data []byte, physicalType int32, typeLength int, n int) error {
+	width := 0
+	switch physicalType {
+	case typeInt32, typeFloat:
+		width = 4
+	case typeInt64, typeDouble:
+		width = 8
+	case typeFixedLenByteArray:
+		width = typeLength
+	default:
+		return errors.New("unsupported encoding BYTE_STREAM_SPLIT")
+	}
+	if len(data) < n*width {
+		return errCorruptedPage
+	}
+
+	joined := make([]byte, n*width)
+	for i := 0; i < n; i++ {
+		for k := 0; k < width; k++ {
+			joined[i*width+k] = data[k*n+i]
+		}
+	}
+	return decodePlain(vals, joined, physicalType, typeLength, n)
+}
+
+// appendHybridRuns encodes levels with the RLE/Bit-Packing Hybrid encoding using only RLE runs.
+func appendHybridRuns(buf []byte, levels []int32, width int) []byte {
+	byteWidth := (width + 7) / 8
+
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+
+		header := uint64(j-i) << 1
+		for 0x80 <= header {
+			buf = append(buf, byte(header)|0x80)
+			header >>= 7
+		}
+		buf = append(buf, byte(header))
+		for k := 0; k < byteWidth; k++ {
+			buf = append(buf, byte(uint32(levels[i])>>(uint(k)*8)))
+		}
+		i = j
+	}
+	return buf
+}
@@ -0,0 +1,163 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+var decodeHybridTests = []struct {
+	Data   []byte
+	Width  int
+	N      int
+	Result []int32
+	Error  string
+}{
+	{
+		Data:   []byte{0x06, 0x01, 0x04, 0x00},
+		Width:  1,
+		N:      5,
+		Result: []int32{1, 1, 1, 0, 0},
+	},
+	{
+		// Bit-packed run of 8 values: 0, 1, 2, 3, 4, 5, 6, 7
+		Data:   []byte{0x03, 0x88, 0xc6, 0xfa},
+		Width:  3,
+		N:      6,
+		Result: []int32{0, 1, 2, 3, 4, 5},
+	},
+	{
+		Data:   []byte{0x04, 0x2c, 0x01},
+		Width:  9,
+		N:      2,
+		Result: []int32{300, 300},
+	},
+	{
+		Data:   nil,
+		Width:  0,
+		N:      3,
+		Result: []int32{0, 0, 0},
+	},
+	{
+		Data:  []byte{0x06, 0x01},
+		Width: 1,
+		N:     4,
+		Error: "corrupted page",
+	},
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	for _, v := range decodeHybridTests {
+		result, err := decodeHybrid(v.Data, v.Width, v.N, nil)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %v", err, v.Data)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %v", err.Error(), v.Error, v.Data)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %v", v.Error, v.Data)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %v", result, v.Result, v.Data)
+		}
+	}
+}
+
+func TestAppendHybridRuns(t *testing.T) {
+	levels := []int32{1, 1, 0, 1, 1, 1}
+	data := appendHybridRuns(nil, levels, 1)
+	result, err := decodeHybrid(data, 1, len(levels), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(result, levels) {
+		t.Errorf("result = %v, want %v", result, levels)
+	}
+}
+
+func TestDecodeDeltaBinaryPacked(t *testing.T) {
+	// Block size 128, 4 miniblocks, 5 values, first value 7,
+	// min delta -1, bit widths 2, 0, 0, 0, and deltas 2, 0, 1, 3 added to the min delta.
+	data := []byte{0x80, 0x01, 0x04, 0x05, 0x0e, 0x01, 0x02, 0x00, 0x00, 0x00, 0xd2, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	result, n, err := decodeDeltaBinaryPacked(data, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := []int64{7, 8, 7, 7, 9}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %v, want %v", result, expect)
+	}
+	if n != len(data) {
+		t.Errorf("consumed bytes = %d, want %d", n, len(data))
+	}
+}
+
+func TestDecodeDeltaByteArray(t *testing.T) {
+	// Prefix lengths 0, 3 and suffix lengths 5, 2 encoded with DELTA_BINARY_PACKED, followed by suffixes.
+	data := []byte{
+		0x80, 0x01, 0x04, 0x02, 0x00, 0x06, 0x00, 0x00, 0x00, 0x00,
+		0x80, 0x01, 0x04, 0x02, 0x0a, 0x05, 0x00, 0x00, 0x00, 0x00,
+		'a', 'p', 'p', 'l', 'e', 'l', 'y',
+	}
+	vals := &columnValues{}
+	if err := decodeDeltaByteArray(vals, data, 2); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expect := [][]byte{[]byte("apple"), []byte("apply")}
+	if !reflect.DeepEqual(vals.bytes, expect) {
+		t.Errorf("result = %q, want %q", vals.bytes, expect)
+	}
+}
+
+var decodeSnappyTests = []struct {
+	Data   []byte
+	Result string
+	Error  string
+}{
+	{
+		Data:   []byte{0x05, 0x10, 'h', 'e', 'l', 'l', 'o'},
+		Result: "hello",
+	},
+	{
+		// Literal "ab" and a copy with 1-byte offset 2 and length 6
+		Data:   []byte{0x08, 0x04, 'a', 'b', 0x09, 0x02},
+		Result: "abababab",
+	},
+	{
+		// Literal "xyz" and a copy with 2-byte offset 3 and length 3
+		Data:   []byte{0x06, 0x08, 'x', 'y', 'z', 0x0a, 0x03, 0x00},
+		Result: "xyzxyz",
+	},
+	{
+		Data:  []byte{0x06, 0x10, 'h', 'e', 'l', 'l', 'o'},
+		Error: "corrupted snappy data",
+	},
+	{
+		Data:  []byte{0x04, 0x0d, 0x02},
+		Error: "corrupted snappy data",
+	},
+}
+
+func TestDecodeSnappy(t *testing.T) {
+	for _, v := range decodeSnappyTests {
+		result, err := decodeSnappy(v.Data)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %v", err, v.Data)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %v", err.Error(), v.Error, v.Data)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %v", v.Error, v.Data)
+			continue
+		}
+		if string(result) != v.Result {
+			t.Errorf("result = %q, want %q for %v", result, v.Result, v.Data)
+		}
+	}
+}
@@ -0,0 +1,568 @@
+package parquet
+
+// Physical types
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Field repetition types
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// Converted types that are superseded by logical types
+const (
+	convertedUTF8            = 0
+	convertedMap             = 1
+	convertedMapKeyValue     = 2
+	convertedList            = 3
+	convertedEnum            = 4
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimeMillis      = 7
+	convertedTimeMicros      = 8
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt8            = 15
+	convertedInt16           = 16
+	convertedInt32           = 17
+	convertedInt64           = 18
+	convertedJson            = 19
+	convertedBson            = 20
+	convertedInterval        = 21
+)
+
+// Encodings
+const (
+	encodingPlain                = 0
+	encodingPlainDictionary      = 2
+	encodingRLE                  = 3
+	encodingBitPacked            = 4
+	encodingDeltaBinaryPacked    = 5
+	encodingDeltaLengthByteArray = 6
+	encodingDeltaByteArray       = 7
+	encodingRLEDictionary        = 8
+	encodingByteStreamSplit      = 9
+)
+
+// Compression codecs
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecLZO          = 3
+	codecBrotli       = 4
+	codecLZ4          = 5
+	codecZstd         = 6
+	codecLZ4Raw       = 7
+)
+
+// Page types
+const (
+	pageData       = 0
+	pageIndex      = 1
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// Time units
+const (
+	unitMillis = 1
+	unitMicros = 2
+	unitNanos  = 3
+)
+
+// Logical types. The values are the field IDs in the LogicalType union.
+const (
+	logicalNone      = 0
+	logicalString    = 1
+	logicalMap       = 2
+	logicalList      = 3
+	logicalEnum      = 4
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTime      = 7
+	logicalTimestamp = 8
+	logicalInteger   = 10
+	logicalUnknown   = 11
+	logicalJson      = 12
+	logicalBson      = 13
+	logicalUUID      = 14
+	logicalFloat16   = 15
+)
+
+var codecNames = map[int32]string{
+	codecUncompressed: "UNCOMPRESSED",
+	codecSnappy:       "SNAPPY",
+	codecGzip:         "GZIP",
+	codecLZO:          "LZO",
+	codecBrotli:       "BROTLI",
+	codecLZ4:          "LZ4",
+	codecZstd:         "ZSTD",
+	codecLZ4Raw:       "LZ4_RAW",
+}
+
+var encodingNames = map[int32]string{
+	encodingPlain:                "PLAIN",
+	encodingPlainDictionary:      "PLAIN_DICTIONARY",
+	encodingRLE:                  "RLE",
+	encodingBitPacked:            "BIT_PACKED",
+	encodingDeltaBinaryPacked:    "DELTA_BINARY_PACKED",
+	encodingDeltaLengthByteArray: "DELTA_LENGTH_BYTE_ARRAY",
+	encodingDeltaByteArray:       "DELTA_BYTE_ARRAY",
+	encodingRLEDictionary:        "RLE_DICTIONARY",
+	encodingByteStreamSplit:      "BYTE_STREAM_SPLIT",
+}
+
+type logicalType struct {
+	Type int16
+
+	// Parameters of DECIMAL
+	Scale     int32
+	Precision int32
+
+	// Parameters of TIME and TIMESTAMP
+	IsAdjustedToUTC bool
+	Unit            int16
+
+	// Parameters of INTEGER
+	BitWidth int8
+	IsSigned bool
+}
+
+type schemaElement struct {
+	Type          int32
+	HasType       bool
+	TypeLength    int32
+	Repetition    int32
+	Name          string
+	NumChildren   int32
+	ConvertedType int32
+	HasConverted  bool
+	Scale         int32
+	Precision     int32
+	LogicalType   logicalType
+}
+
+type columnMetaData struct {
+	Type                  int32
+	Encodings             []int32
+	PathInSchema          []string
+	Codec                 int32
+	NumValues             int64
+	TotalUncompressedSize int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	DictionaryPageOffset  int64
+}
+
+type columnChunk struct {
+	FilePath string
+	MetaData columnMetaData
+}
+
+type rowGroup struct {
+	Columns       []columnChunk
+	TotalByteSize int64
+	NumRows       int64
+}
+
+type fileMetaData struct {
+	Version   int32
+	Schema    []schemaElement
+	NumRows   int64
+	RowGroups []rowGroup
+	CreatedBy string
+}
+
+type dataPageHeader struct {
+	NumValues               int32
+	Encoding                int32
+	DefinitionLevelEncoding int32
+	RepetitionLevelEncoding int32
+}
+
+type dictionaryPageHeader struct {
+	NumValues int32
+	Encoding  int32
+}
+
+type dataPageHeaderV2 struct {
+	NumValues                  int32
+	NumNulls                   int32
+	NumRows                    int32
+	Encoding                   int32
+	DefinitionLevelsByteLength int32
+	RepetitionLevelsByteLength int32
+	IsCompressed               bool
+}
+
+type pageHeader struct {
+	Type                 int32
+	UncompressedPageSize int32
+	CompressedPageSize   int32
+	DataPageHeader       dataPageHeader
+	DictionaryPageHeader dictionaryPageHeader
+	DataPageHeaderV2     dataPageHeaderV2
+}
+
+func readFileMetaData(r *thriftReader) (*fileMetaData, error) {
+	m := &fileMetaData{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.Version, err = r.readI32()
+		case id == 2 && typ == thriftList:
+			err = r.readList(func(_ byte) error {
+				e, err := readSchemaElement(r)
+				m.Schema = append(m.Schema, e)
+				return err
+			})
+		case id == 3 && typ == thriftI64:
+			m.NumRows, err = r.readI64()
+		case id == 4 && typ == thriftList:
+			err = r.readList(func(_ byte) error {
+				g, err := readRowGroup(r)
+				m.RowGroups = append(m.RowGroups, g)
+				return err
+			})
+		case id == 6 && typ == thriftBinary:
+			m.CreatedBy, err = r.readString()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return m, err
+}
+
+func readSchemaElement(r *thriftReader) (schemaElement, error) {
+	e := schemaElement{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			e.Type, err = r.readI32()
+			e.HasType = true
+		case id == 2 && typ == thriftI32:
+			e.TypeLength, err = r.readI32()
+		case id == 3 && typ == thriftI32:
+			e.Repetition, err = r.readI32()
+		case id == 4 && typ == thriftBinary:
+			e.Name, err = r.readString()
+		case id == 5 && typ == thriftI32:
+			e.NumChildren, err = r.readI32()
+		case id == 6 && typ == thriftI32:
+			e.ConvertedType, err = r.readI32()
+			e.HasConverted = true
+		case id == 7 && typ == thriftI32:
+			e.Scale, err = r.readI32()
+		case id == 8 && typ == thriftI32:
+			e.Precision, err = r.readI32()
+		case id == 10 && typ == thriftStruct:
+			e.LogicalType, err = readLogicalType(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return e, err
+}
+
+func readLogicalType(r *thriftReader) (logicalType, error) {
+	t := logicalType{}
+	err := r.readStruct(func(id int16, typ byte) error {
+		if typ != thriftStruct {
+			return r.skip(typ)
+		}
+
+		t.Type = id
+		return r.readStruct(func(fid int16, ftyp byte) (err error) {
+			switch id {
+			case logicalDecimal:
+				switch {
+				case fid == 1 && ftyp == thriftI32:
+					t.Scale, err = r.readI32()
+				case fid == 2 && ftyp == thriftI32:
+					t.Precision, err = r.readI32()
+				default:
+					err = r.skip(ftyp)
+				}
+			case logicalTime, logicalTimestamp:
+				switch {
+				case fid == 1 && (ftyp == thriftBooleanTrue || ftyp == thriftBooleanFalse):
+					t.IsAdjustedToUTC, err = r.readBool(ftyp)
+				case fid == 2 && ftyp == thriftStruct:
+					err = r.readStruct(func(uid int16, utyp byte) error {
+						t.Unit = uid
+						return r.skip(utyp)
+					})
+				default:
+					err = r.skip(ftyp)
+				}
+			case logicalInteger:
+				switch {
+				case fid == 1 && ftyp == thriftByte:
+					var b byte
+					b, err = r.readByte()
+					t.BitWidth = int8(b)
+				case fid == 2 && (ftyp == thriftBooleanTrue || ftyp == thriftBooleanFalse):
+					t.IsSigned, err = r.readBool(ftyp)
+				default:
+					err = r.skip(ftyp)
+				}
+			default:
+				err = r.skip(ftyp)
+			}
+			return
+		})
+	})
+	return t, err
+}
+
+func readRowGroup(r *thriftReader) (rowGroup, error) {
+	g := rowGroup{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftList:
+			err = r.readList(func(_ byte) error {
+				c, err := readColumnChunk(r)
+				g.Columns = append(g.Columns, c)
+				return err
+			})
+		case id == 2 && typ == thriftI64:
+			g.TotalByteSize, err = r.readI64()
+		case id == 3 && typ == thriftI64:
+			g.NumRows, err = r.readI64()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return g, err
+}
+
+func readColumnChunk(r *thriftReader) (columnChunk, error) {
+	c := columnChunk{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			c.FilePath, err = r.readString()
+		case id == 3 && typ == thriftStruct:
+			c.MetaData, err = readColumnMetaData(r)
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return c, err
+}
+
+func readColumnMetaData(r *thriftReader) (columnMetaData, error) {
+	m := columnMetaData{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.Type, err = r.readI32()
+		case id == 2 && typ == thriftList:
+			err = r.readList(func(_ byte) error {
+				v, err := r.readI32()
+				m.Encodings = append(m.Encodings, v)
+				return err
+			})
+		case id == 3 && typ == thriftList:
+			err = r.readList(func(_ byte) error {
+				s, err := r.readString()
+				m.PathInSchema = append(m.PathInSchema, s)
+				return err
+			})
+		case id == 4 && typ == thriftI32:
+			m.Codec, err = r.readI32()
+		case id == 5 && typ == thriftI64:
+			m.NumValues, err = r.readI64()
+		case id == 6 && typ == thriftI64:
+			m.TotalUncompressedSize, err = r.readI64()
+		case id == 7 && typ == thriftI64:
+			m.TotalCompressedSize, err = r.readI64()
+		case id == 9 && typ == thriftI64:
+			m.DataPageOffset, err = r.readI64()
+		case id == 11 && typ == thriftI64:
+			m.DictionaryPageOffset, err = r.readI64()
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return m, err
+}
+
+func readPageHeader(r *thriftReader) (*pageHeader, error) {
+	h := &pageHeader{}
+	err := r.readStruct(func(id int16, typ byte) (err error) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.Type, err = r.readI32()
+		case id == 2 && typ == thriftI32:
+			h.UncompressedPageSize, err = r.readI32()
+		case id == 3 && typ == thriftI32:
+			h.CompressedPageSize, err = r.readI32()
+		case id == 5 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.DataPageHeader.NumValues, err = r.readI32()
+				case id == 2 && typ == thriftI32:
+					h.DataPageHeader.Encoding, err = r.readI32()
+				case id == 3 && typ == thriftI32:
+					h.DataPageHeader.DefinitionLevelEncoding, err = r.readI32()
+				case id == 4 && typ == thriftI32:
+					h.DataPageHeader.RepetitionLevelEncoding, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return
+			})
+		case id == 7 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.DictionaryPageHeader.NumValues, err = r.readI32()
+				case id == 2 && typ == thriftI32:
+					h.DictionaryPageHeader.Encoding, err = r.readI32()
+				default:
+					err = r.skip(typ)
+				}
+				return
+			})
+		case id == 8 && typ == thriftStruct:
+			h.DataPageHeaderV2.IsCompressed = true
+			err = r.readStruct(func(id int16, typ byte) (err error) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.DataPageHeaderV2.NumValues, err = r.readI32()
+				case id == 2 && typ == thriftI32:
+					h.DataPageHeaderV2.NumNulls, err = r.readI32()
+				case id == 3 && typ == thriftI32:
+					h.DataPageHeaderV2.NumRows, err = r.readI32()
+				case id == 4 && typ == thriftI32:
+					h.DataPageHeaderV2.Encoding, err = r.readI32()
+				case id == 5 && typ == thriftI32:
+					h.DataPageHeaderV2.DefinitionLevelsByteLength, err = r.readI32()
+				case id == 6 && typ == thriftI32:
+					h.DataPageHeaderV2.RepetitionLevelsByteLength, err = r.readI32()
+				case id == 7 && (typ == thriftBooleanTrue || typ == thriftBooleanFalse):
+					h.DataPageHeaderV2.IsCompressed, err = r.readBool(typ)
+				default:
+					err = r.skip(typ)
+				}
+				return
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return
+	})
+	return h, err
+}
+
+func writeFileMetaData(w *thriftWriter, m *fileMetaData) {
+	w.beginStruct()
+	w.writeI32Field(1, m.Version)
+
+	w.writeListField(2, thriftStruct, len(m.Schema))
+	for _, e := range m.Schema {
+		w.beginStruct()
+		if e.HasType {
+			w.writeI32Field(1, e.Type)
+		}
+		if 0 < e.TypeLength {
+			w.writeI32Field(2, e.TypeLength)
+		}
+		if e.HasType {
+			w.writeI32Field(3, e.Repetition)
+		}
+		w.writeStringField(4, e.Name)
+		if !e.HasType {
+			w.writeI32Field(5, e.NumChildren)
+		}
+		if e.HasConverted {
+			w.writeI32Field(6, e.ConvertedType)
+		}
+		if e.LogicalType.Type != logicalNone {
+			writeLogicalType(w, 10, e.LogicalType)
+		}
+		w.endStruct()
+	}
+
+	w.writeI64Field(3, m.NumRows)
+
+	w.writeListField(4, thriftStruct, len(m.RowGroups))
+	for _, g := range m.RowGroups {
+		w.beginStruct()
+		w.writeListField(1, thriftStruct, len(g.Columns))
+		for _, c := range g.Columns {
+			w.beginStruct()
+			w.writeI64Field(2, c.MetaData.DataPageOffset)
+			w.beginStructField(3)
+			w.writeI32Field(1, c.MetaData.Type)
+			w.writeI32List(2, c.MetaData.Encodings)
+			w.writeStringList(3, c.MetaData.PathInSchema)
+			w.writeI32Field(4, c.MetaData.Codec)
+			w.writeI64Field(5, c.MetaData.NumValues)
+			w.writeI64Field(6, c.MetaData.TotalUncompressedSize)
+			w.writeI64Field(7, c.MetaData.TotalCompressedSize)
+			w.writeI64Field(9, c.MetaData.DataPageOffset)
+			w.endStruct()
+			w.endStruct()
+		}
+		w.writeI64Field(2, g.TotalByteSize)
+		w.writeI64Field(3, g.NumRows)
+		w.endStruct()
+	}
+
+	if 0 < len(m.CreatedBy) {
+		w.writeStringField(6, m.CreatedBy)
+	}
+	w.endStruct()
+}
+
+func writeLogicalType(w *thriftWriter, id int16, t logicalType) {
+	w.beginStructField(id)
+	w.beginStructField(t.Type)
+	switch t.Type {
+	case logicalTimestamp, logicalTime:
+		w.writeBoolField(1, t.IsAdjustedToUTC)
+		w.beginStructField(2)
+		w.beginStructField(t.Unit)
+		w.endStruct()
+		w.endStruct()
+	}
+	w.endStruct()
+	w.endStruct()
+}
+
+func writeDataPageHeader(w *thriftWriter, h *pageHeader) {
+	w.beginStruct()
+	w.writeI32Field(1, h.Type)
+	w.writeI32Field(2, h.UncompressedPageSize)
+	w.writeI32Field(3, h.CompressedPageSize)
+	w.beginStructField(5)
+	w.writeI32Field(1, h.DataPageHeader.NumValues)
+	w.writeI32Field(2, h.DataPageHeader.Encoding)
+	w.writeI32Field(3, h.DataPageHeader.DefinitionLevelEncoding)
+	w.writeI32Field(4, h.DataPageHeader.RepetitionLevelEncoding)
+	w.endStruct()
+	w.endStruct()
+}
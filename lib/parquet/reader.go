@@ -0,0 +1,722 @@
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/go-text/json"
+
+	txjson "github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const julianDayOfEpoch = 2440588
+
+var magicNumber = []byte("PAR1")
+
+type schemaNode struct {
+	element  schemaElement
+	children []*schemaNode
+
+	maxDefinitionLevel int
+	maxRepetitionLevel int
+
+	// Definition levels at which the repeated fields in the path are defined.
+	repeatedDefinitionLevels []int
+}
+
+func (node *schemaNode) isList() bool {
+	return node.element.LogicalType.Type == logicalList ||
+		(node.element.HasConverted && node.element.ConvertedType == convertedList)
+}
+
+func (node *schemaNode) isMap() bool {
+	return node.element.LogicalType.Type == logicalMap ||
+		(node.element.HasConverted && (node.element.ConvertedType == convertedMap || node.element.ConvertedType == convertedMapKeyValue))
+}
+
+type leafColumn struct {
+	name string
+	node *schemaNode
+}
+
+// LoadTable reads records from a parquet file.
+//
+// Only the columns that the function selected returns true are decoded. If selected is nil, all columns are decoded.
+// Nested groups are flattened to dotted column names, and repeated fields are converted to json arrays.
+func LoadTable(r io.ReaderAt, size int64, selected func(column string) bool, loc *time.Location) ([]string, [][]value.Primary, error) {
+	meta, err := readFooter(r, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves, err := buildSchema(meta.Schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := make([]int, 0, len(leaves))
+	for i, leaf := range leaves {
+		if selected == nil || selected(leaf.name) {
+			columns = append(columns, i)
+		}
+	}
+	if len(columns) < 1 && 0 < len(leaves) {
+		columns = append(columns, 0)
+	}
+
+	header := make([]string, 0, len(columns))
+	for _, i := range columns {
+		header = append(header, leaves[i].name)
+	}
+
+	numRows := 0
+	for _, g := range meta.RowGroups {
+		if len(g.Columns) != len(leaves) || g.NumRows < 0 {
+			return nil, nil, errors.New("invalid metadata")
+		}
+		numRows += int(g.NumRows)
+	}
+
+	records := make([][]value.Primary, 0, numRows)
+	for _, g := range meta.RowGroups {
+		offset := len(records)
+		for i := int64(0); i < g.NumRows; i++ {
+			records = append(records, make([]value.Primary, len(columns)))
+		}
+
+		for i, idx := range columns {
+			values, err := readColumnValues(r, size, g.Columns[idx], leaves[idx], loc)
+			if err != nil {
+				return nil, nil, err
+			}
+			if int64(len(values)) != g.NumRows {
+				return nil, nil, errors.New(fmt.Sprintf("column %s has %d rows, expected %d", leaves[idx].name, len(values), g.NumRows))
+			}
+			for j, v := range values {
+				records[offset+j][i] = v
+			}
+		}
+	}
+
+	return header, records, nil
+}
+
+func readFooter(r io.ReaderAt, size int64) (*fileMetaData, error) {
+	if size < int64(len(magicNumber)*2+4) {
+		return nil, errors.New("invalid parquet file")
+	}
+
+	buf := make([]byte, 8)
+	if _, err := r.ReadAt(buf, size-8); err != nil {
+		return nil, err
+	}
+	switch {
+	case bytes.Equal(buf[4:], []byte("PARE")):
+		return nil, errors.New("encrypted parquet file is not supported")
+	case !bytes.Equal(buf[4:], magicNumber):
+		return nil, errors.New("invalid parquet file")
+	}
+
+	metaSize := int64(binary.LittleEndian.Uint32(buf))
+	if size-int64(len(magicNumber)*2+4) < metaSize {
+		return nil, errors.New("invalid parquet file")
+	}
+
+	buf = make([]byte, metaSize)
+	if _, err := r.ReadAt(buf, size-8-metaSize); err != nil {
+		return nil, err
+	}
+	return readFileMetaData(newThriftReader(buf))
+}
+
+func buildSchema(elements []schemaElement) ([]leafColumn, error) {
+	if len(elements) < 1 {
+		return nil, errors.New("invalid metadata")
+	}
+
+	pos := 1
+	var build func(parent *schemaNode, path []*schemaNode) ([]leafColumn, error)
+	build = func(parent *schemaNode, path []*schemaNode) ([]leafColumn, error) {
+		leaves := make([]leafColumn, 0, parent.element.NumChildren)
+
+		for i := int32(0); i < parent.element.NumChildren; i++ {
+			if len(elements) <= pos {
+				return nil, errors.New("invalid metadata")
+			}
+
+			node := &schemaNode{
+				element:                  elements[pos],
+				maxDefinitionLevel:       parent.maxDefinitionLevel,
+				maxRepetitionLevel:       parent.maxRepetitionLevel,
+				repeatedDefinitionLevels: parent.repeatedDefinitionLevels,
+			}
+			pos++
+
+			switch node.element.Repetition {
+			case repetitionOptional:
+				node.maxDefinitionLevel++
+			case repetitionRepeated:
+				node.maxDefinitionLevel++
+				node.maxRepetitionLevel++
+				levels := make([]int, len(parent.repeatedDefinitionLevels), len(parent.repeatedDefinitionLevels)+1)
+				copy(levels, parent.repeatedDefinitionLevels)
+				node.repeatedDefinitionLevels = append(levels, node.maxDefinitionLevel)
+			}
+			parent.children = append(parent.children, node)
+
+			nodePath := append(path[:len(path):len(path)], node)
+			if node.element.HasType {
+				leaves = append(leaves, leafColumn{name: columnName(nodePath), node: node})
+				continue
+			}
+
+			children, err := build(node, nodePath)
+			if err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, children...)
+		}
+
+		return leaves, nil
+	}
+
+	root := &schemaNode{element: elements[0]}
+	leaves, err := build(root, nil)
+	if err == nil && pos != len(elements) {
+		err = errors.New("invalid metadata")
+	}
+	return leaves, err
+}
+
+// columnName returns the dotted name of a leaf column.
+// The names of the groups that only compose lists and maps are omitted.
+func columnName(path []*schemaNode) string {
+	names := make([]string, 0, len(path))
+
+	for i := 0; i < len(path); i++ {
+		node := path[i]
+		names = append(names, node.element.Name)
+
+		if (node.isList() || node.isMap()) && i+1 < len(path) && path[i+1].element.Repetition == repetitionRepeated {
+			i++
+			if node.isList() && len(path[i].children) == 1 && i+1 < len(path) {
+				i++
+			}
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+func decompress(codec int32, data []byte, uncompressedSize int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return decodeSnappy(data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		buf := bytes.NewBuffer(make([]byte, 0, uncompressedSize))
+		if _, err = io.Copy(buf, r); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	name, ok := codecNames[codec]
+	if !ok {
+		name = fmt.Sprintf("%d", codec)
+	}
+	return nil, errors.New(fmt.Sprintf("unsupported compression codec %s", name))
+}
+
+func readColumnValues(r io.ReaderAt, size int64, chunk columnChunk, leaf leafColumn, loc *time.Location) ([]value.Primary, error) {
+	if 0 < len(chunk.FilePath) {
+		return nil, errors.New(fmt.Sprintf("column %s in external file is not supported", leaf.name))
+	}
+
+	meta := chunk.MetaData
+	start := meta.DataPageOffset
+	if 0 < meta.DictionaryPageOffset && meta.DictionaryPageOffset < start {
+		start = meta.DictionaryPageOffset
+	}
+	if start < int64(len(magicNumber)) || meta.TotalCompressedSize < 0 || size < start+meta.TotalCompressedSize {
+		return nil, errors.New("invalid metadata")
+	}
+
+	buf := make([]byte, meta.TotalCompressedSize)
+	if _, err := r.ReadAt(buf, start); err != nil {
+		return nil, err
+	}
+
+	reader := newColumnReader(leaf, loc)
+	if err := reader.readPages(buf, meta.Codec, meta.NumValues); err != nil {
+		return nil, errors.New(fmt.Sprintf("column %s: %s", leaf.name, err.Error()))
+	}
+	return reader.finish(), nil
+}
+
+type columnReader struct {
+	leaf      leafColumn
+	converter func(vals *columnValues, i int) value.Primary
+
+	dictionary *columnValues
+	values     *columnValues
+	indices    []int32
+
+	repetitionLevels []int32
+	definitionLevels []int32
+
+	records []value.Primary
+
+	// States to assemble repeated values.
+	inRow bool
+	row   *listValue
+	lists []*listValue
+}
+
+type listValue struct {
+	elements []interface{}
+}
+
+func (l *listValue) structure() json.Structure {
+	array := make(json.Array, 0, len(l.elements))
+	for _, e := range l.elements {
+		switch e.(type) {
+		case *listValue:
+			array = append(array, e.(*listValue).structure())
+		default:
+			array = append(array, e.(json.Structure))
+		}
+	}
+	return array
+}
+
+func newColumnReader(leaf leafColumn, loc *time.Location) *columnReader {
+	return &columnReader{
+		leaf:      leaf,
+		converter: newConverter(leaf.node.element, loc),
+		values:    &columnValues{},
+	}
+}
+
+func (cr *columnReader) readPages(buf []byte, codec int32, numValues int64) error {
+	element := cr.leaf.node.element
+	pos := 0
+	read := int64(0)
+
+	for read < numValues && pos < len(buf) {
+		tr := newThriftReader(buf[pos:])
+		header, err := readPageHeader(tr)
+		if err != nil {
+			return err
+		}
+		pos += tr.pos
+
+		if header.CompressedPageSize < 0 || len(buf)-pos < int(header.CompressedPageSize) {
+			return errCorruptedPage
+		}
+		page := buf[pos : pos+int(header.CompressedPageSize)]
+		pos += int(header.CompressedPageSize)
+
+		switch header.Type {
+		case pageDictionary:
+			data, err := decompress(codec, page, int(header.UncompressedPageSize))
+			if err != nil {
+				return err
+			}
+			cr.dictionary = &columnValues{}
+			if err = decodePlain(cr.dictionary, data, element.Type, int(element.TypeLength), int(header.DictionaryPageHeader.NumValues)); err != nil {
+				return err
+			}
+		case pageData:
+			data, err := decompress(codec, page, int(header.UncompressedPageSize))
+			if err != nil {
+				return err
+			}
+			n := int(header.DataPageHeader.NumValues)
+
+			if cr.repetitionLevels, data, err = readLevelsV1(data, cr.leaf.node.maxRepetitionLevel, n, header.DataPageHeader.RepetitionLevelEncoding, cr.repetitionLevels[:0]); err != nil {
+				return err
+			}
+			if cr.definitionLevels, data, err = readLevelsV1(data, cr.leaf.node.maxDefinitionLevel, n, header.DataPageHeader.DefinitionLevelEncoding, cr.definitionLevels[:0]); err != nil {
+				return err
+			}
+			if err = cr.readValues(data, header.DataPageHeader.Encoding, n); err != nil {
+				return err
+			}
+			read += int64(n)
+		case pageDataV2:
+			v2 := header.DataPageHeaderV2
+			n := int(v2.NumValues)
+			repLen := int(v2.RepetitionLevelsByteLength)
+			defLen := int(v2.DefinitionLevelsByteLength)
+			if repLen < 0 || defLen < 0 || len(page) < repLen+defLen {
+				return errCorruptedPage
+			}
+
+			if cr.repetitionLevels, err = readLevelsV2(page[:repLen], cr.leaf.node.maxRepetitionLevel, n, cr.repetitionLevels[:0]); err != nil {
+				return err
+			}
+			if cr.definitionLevels, err = readLevelsV2(page[repLen:repLen+defLen], cr.leaf.node.maxDefinitionLevel, n, cr.definitionLevels[:0]); err != nil {
+				return err
+			}
+
+			data := page[repLen+defLen:]
+			if v2.IsCompressed {
+				if data, err = decompress(codec, data, int(header.UncompressedPageSize)-repLen-defLen); err != nil {
+					return err
+				}
+			}
+			if err = cr.readValues(data, v2.Encoding, n); err != nil {
+				return err
+			}
+			read += int64(n)
+		}
+	}
+
+	return nil
+}
+
+func readLevelsV1(data []byte, maxLevel int, n int, encoding int32, out []int32) ([]int32, []byte, error) {
+	if maxLevel < 1 {
+		return out, data, nil
+	}
+	if encoding != encodingRLE {
+		return out, data, errors.New(fmt.Sprintf("unsupported encoding %s for levels", encodingNames[encoding]))
+	}
+
+	if len(data) < 4 {
+		return out, data, errCorruptedPage
+	}
+	l := int(binary.LittleEndian.Uint32(data))
+	if len(data)-4 < l || l < 0 {
+		return out, data, errCorruptedPage
+	}
+	out, err := decodeHybrid(data[4:4+l], bitWidth(maxLevel), n, out)
+	return out, data[4+l:], err
+}
+
+func readLevelsV2(data []byte, maxLevel int, n int, out []int32) ([]int32, error) {
+	if maxLevel < 1 {
+		return out, nil
+	}
+	return decodeHybrid(data, bitWidth(maxLevel), n, out)
+}
+
+func (cr *columnReader) readValues(data []byte, encoding int32, n int) error {
+	element := cr.leaf.node.element
+	maxDef := int32(cr.leaf.node.maxDefinitionLevel)
+
+	count := n
+	if 0 < maxDef {
+		count = 0
+		for _, d := range cr.definitionLevels {
+			if d == maxDef {
+				count++
+			}
+		}
+	}
+
+	cr.values.reset()
+	switch encoding {
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if cr.dictionary == nil {
+			return errors.New("dictionary page is not found")
+		}
+		if 0 < count {
+			if len(data) < 1 {
+				return errCorruptedPage
+			}
+			var err error
+			if cr.indices, err = decodeHybrid(data[1:], int(data[0]), count, cr.indices[:0]); err != nil {
+				return err
+			}
+			if err = cr.values.appendDictionaryValues(cr.dictionary, cr.indices); err != nil {
+				return err
+			}
+		}
+	default:
+		if err := decodeValues(cr.values, data, element.Type, int(element.TypeLength), encoding, count); err != nil {
+			return err
+		}
+	}
+
+	if cr.leaf.node.maxRepetitionLevel < 1 {
+		cr.appendValues(n, maxDef)
+	} else {
+		cr.assembleLists(n, maxDef)
+	}
+	return nil
+}
+
+func (cr *columnReader) appendValues(n int, maxDef int32) {
+	vi := 0
+	for i := 0; i < n; i++ {
+		if 0 < maxDef && cr.definitionLevels[i] < maxDef {
+			cr.records = append(cr.records, value.NewNull())
+			continue
+		}
+		cr.records = append(cr.records, cr.converter(cr.values, vi))
+		vi++
+	}
+}
+
+func (cr *columnReader) assembleLists(n int, maxDef int32) {
+	levels := cr.leaf.node.repeatedDefinitionLevels
+	maxRep := len(levels)
+
+	vi := 0
+	for i := 0; i < n; i++ {
+		rep := int(cr.repetitionLevels[i])
+		def := int(cr.definitionLevels[i])
+
+		depth := rep
+		if rep == 0 {
+			cr.flushRow()
+			cr.inRow = true
+			if def < levels[0]-1 {
+				continue
+			}
+			cr.row = &listValue{}
+			cr.lists = append(cr.lists[:0], cr.row)
+			if def < levels[0] {
+				continue
+			}
+			depth = 1
+		} else {
+			if cr.row == nil || len(cr.lists) < rep {
+				continue
+			}
+			cr.lists = cr.lists[:rep]
+		}
+
+		nested := true
+		for k := depth; k < maxRep; k++ {
+			parent := cr.lists[k-1]
+			if def < levels[k]-1 {
+				parent.elements = append(parent.elements, json.Null{})
+				nested = false
+				break
+			}
+			l := &listValue{}
+			parent.elements = append(parent.elements, l)
+			cr.lists = append(cr.lists, l)
+			if def < levels[k] {
+				nested = false
+				break
+			}
+		}
+		if !nested {
+			continue
+		}
+
+		list := cr.lists[maxRep-1]
+		if def == int(maxDef) {
+			list.elements = append(list.elements, txjson.ParseValueToStructure(cr.converter(cr.values, vi)))
+			vi++
+		} else {
+			list.elements = append(list.elements, json.Null{})
+		}
+	}
+}
+
+func (cr *columnReader) flushRow() {
+	if !cr.inRow {
+		return
+	}
+
+	if cr.row != nil {
+		cr.records = append(cr.records, value.NewString(cr.row.structure().Encode()))
+	} else {
+		cr.records = append(cr.records, value.NewNull())
+	}
+	cr.inRow = false
+	cr.row = nil
+	cr.lists = cr.lists[:0]
+}
+
+func (cr *columnReader) finish() []value.Primary {
+	if 0 < cr.leaf.node.maxRepetitionLevel {
+		cr.flushRow()
+	}
+	return cr.records
+}
+
+func newConverter(e schemaElement, loc *time.Location) func(vals *columnValues, i int) value.Primary {
+	logical := e.LogicalType
+
+	switch e.Type {
+	case typeBoolean:
+		return func(vals *columnValues, i int) value.Primary {
+			return value.NewBoolean(vals.bools[i])
+		}
+	case typeInt32, typeInt64:
+		switch {
+		case logical.Type == logicalDate || (e.HasConverted && e.ConvertedType == convertedDate):
+			return func(vals *columnValues, i int) value.Primary {
+				return value.NewDatetime(time.Date(1970, 1, 1+int(vals.ints[i]), 0, 0, 0, 0, loc))
+			}
+		case logical.Type == logicalTimestamp:
+			return timestampConverter(logical.Unit, logical.IsAdjustedToUTC, loc)
+		case e.HasConverted && e.ConvertedType == convertedTimestampMillis:
+			return timestampConverter(unitMillis, true, loc)
+		case e.HasConverted && e.ConvertedType == convertedTimestampMicros:
+			return timestampConverter(unitMicros, true, loc)
+		case logical.Type == logicalTime:
+			return timeConverter(logical.Unit)
+		case e.HasConverted && e.ConvertedType == convertedTimeMillis:
+			return timeConverter(unitMillis)
+		case e.HasConverted && e.ConvertedType == convertedTimeMicros:
+			return timeConverter(unitMicros)
+		case logical.Type == logicalDecimal || (e.HasConverted && e.ConvertedType == convertedDecimal):
+			scale := decimalScale(e)
+			return func(vals *columnValues, i int) value.Primary {
+				return value.NewFloat(float64(vals.ints[i]) / scale)
+			}
+		case (logical.Type == logicalInteger && !logical.IsSigned) ||
+			(e.HasConverted && (e.ConvertedType == convertedUint8 || e.ConvertedType == convertedUint16 || e.ConvertedType == convertedUint32 || e.ConvertedType == convertedUint64)):
+			if e.Type == typeInt32 {
+				return func(vals *columnValues, i int) value.Primary {
+					return value.NewInteger(int64(uint32(vals.ints[i])))
+				}
+			}
+			return func(vals *columnValues, i int) value.Primary {
+				if vals.ints[i] < 0 {
+					return value.NewFloat(float64(uint64(vals.ints[i])))
+				}
+				return value.NewInteger(vals.ints[i])
+			}
+		}
+		return func(vals *columnValues, i int) value.Primary {
+			return value.NewInteger(vals.ints[i])
+		}
+	case typeInt96:
+		return func(vals *columnValues, i int) value.Primary {
+			b := vals.bytes[i]
+			nanos := int64(binary.LittleEndian.Uint64(b))
+			days := int64(binary.LittleEndian.Uint32(b[8:])) - julianDayOfEpoch
+			return value.NewDatetime(time.Unix(days*86400, nanos).In(loc))
+		}
+	case typeFloat, typeDouble:
+		return func(vals *columnValues, i int) value.Primary {
+			return value.NewFloat(vals.floats[i])
+		}
+	}
+
+	switch {
+	case logical.Type == logicalDecimal || (e.HasConverted && e.ConvertedType == convertedDecimal):
+		scale := decimalScale(e)
+		return func(vals *columnValues, i int) value.Primary {
+			f, _ := new(big.Float).SetInt(bigIntFromBytes(vals.bytes[i])).Float64()
+			return value.NewFloat(f / scale)
+		}
+	case logical.Type == logicalUUID && e.Type == typeFixedLenByteArray && e.TypeLength == 16:
+		return func(vals *columnValues, i int) value.Primary {
+			s := hex.EncodeToString(vals.bytes[i])
+			return value.NewString(s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:])
+		}
+	case logical.Type == logicalFloat16 && e.Type == typeFixedLenByteArray && e.TypeLength == 2:
+		return func(vals *columnValues, i int) value.Primary {
+			return value.NewFloat(float16ToFloat64(binary.LittleEndian.Uint16(vals.bytes[i])))
+		}
+	case e.HasConverted && e.ConvertedType == convertedInterval && e.Type == typeFixedLenByteArray && e.TypeLength == 12:
+		return func(vals *columnValues, i int) value.Primary {
+			b := vals.bytes[i]
+			return value.NewString(fmt.Sprintf("%d months %d days %d milliseconds",
+				binary.LittleEndian.Uint32(b), binary.LittleEndian.Uint32(b[4:]), binary.LittleEndian.Uint32(b[8:])))
+		}
+	}
+	return func(vals *columnValues, i int) value.Primary {
+		return value.NewString(string(vals.bytes[i]))
+	}
+}
+
+func timestampConverter(unit int16, isAdjustedToUTC bool, loc *time.Location) func(vals *columnValues, i int) value.Primary {
+	toTime := func(v int64) time.Time {
+		switch unit {
+		case unitMillis:
+			return time.Unix(v/1e3, v%1e3*1e6)
+		case unitMicros:
+			return time.Unix(v/1e6, v%1e6*1e3)
+		}
+		return time.Unix(0, v)
+	}
+
+	if isAdjustedToUTC {
+		return func(vals *columnValues, i int) value.Primary {
+			return value.NewDatetime(toTime(vals.ints[i]).In(loc))
+		}
+	}
+	return func(vals *columnValues, i int) value.Primary {
+		t := toTime(vals.ints[i]).UTC()
+		return value.NewDatetime(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc))
+	}
+}
+
+func timeConverter(unit int16) func(vals *columnValues, i int) value.Primary {
+	layout := "15:04:05.000000000"
+	nanos := int64(1)
+	switch unit {
+	case unitMillis:
+		layout = "15:04:05.000"
+		nanos = 1e6
+	case unitMicros:
+		layout = "15:04:05.000000"
+		nanos = 1e3
+	}
+
+	return func(vals *columnValues, i int) value.Primary {
+		return value.NewString(time.Unix(0, vals.ints[i]*nanos).UTC().Format(layout))
+	}
+}
+
+func decimalScale(e schemaElement) float64 {
+	scale := e.Scale
+	if e.LogicalType.Type == logicalDecimal {
+		scale = e.LogicalType.Scale
+	}
+	return math.Pow10(int(scale))
+}
+
+// bigIntFromBytes returns the integer represented by the big-endian two's complement bytes.
+func bigIntFromBytes(b []byte) *big.Int {
+	i := new(big.Int).SetBytes(b)
+	if 0 < len(b) && b[0]&0x80 != 0 {
+		i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return i
+}
+
+func float16ToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1.0
+	}
+	exp := int(h>>10) & 0x1f
+	frac := float64(h & 0x03ff)
+
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(frac, -24)
+	case 0x1f:
+		if frac == 0 {
+			return math.Inf(int(sign))
+		}
+		return math.NaN()
+	}
+	return sign * math.Ldexp(frac+1024, exp-25)
+}
@@ -0,0 +1,170 @@
+package parquet
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var testFile = filepath.Join("..", "..", "testdata", "csv", "nested.parquet")
+
+var loadTableTests = []struct {
+	Name     string
+	Selected func(string) bool
+	Location *time.Location
+	Header   []string
+	Records  [][]value.Primary
+	Error    string
+}{
+	{
+		Name:   "LoadTable",
+		Header: []string{"id", "name", "address.city", "address.zip", "created", "birthday", "price", "tags", "score", "legacy_ts"},
+		Records: [][]value.Primary{
+			{
+				value.NewInteger(1),
+				value.NewString("alice"),
+				value.NewString("Tokyo"),
+				value.NewInteger(1000001),
+				value.NewDatetime(time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)),
+				value.NewDatetime(time.Date(1990, 5, 6, 0, 0, 0, 0, time.UTC)),
+				value.NewFloat(12.34),
+				value.NewString("[\"a\",null,\"b\"]"),
+				value.NewFloat(1.5),
+				value.NewDatetime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			},
+			{
+				value.NewInteger(2),
+				value.NewString("bob"),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewNull(),
+				value.NewString("[]"),
+				value.NewNull(),
+				value.NewNull(),
+			},
+			{
+				value.NewInteger(3),
+				value.NewString("alice"),
+				value.NewNull(),
+				value.NewInteger(5),
+				value.NewDatetime(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)),
+				value.NewDatetime(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)),
+				value.NewFloat(-0.5),
+				value.NewNull(),
+				value.NewFloat(2.25),
+				value.NewNull(),
+			},
+		},
+	},
+	{
+		Name: "LoadTable Selected Columns",
+		Selected: func(s string) bool {
+			return s == "address.zip" || s == "created"
+		},
+		Location: time.FixedZone("JST", 9*3600),
+		Header:   []string{"address.zip", "created"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1000001), value.NewDatetime(time.Date(2024, 1, 2, 12, 4, 5, 123456000, time.FixedZone("JST", 9*3600)))},
+			{value.NewNull(), value.NewNull()},
+			{value.NewInteger(5), value.NewDatetime(time.Date(1970, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*3600)))},
+		},
+	},
+	{
+		Name: "LoadTable No Selected Column",
+		Selected: func(s string) bool {
+			return false
+		},
+		Header: []string{"id"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1)},
+			{value.NewInteger(2)},
+			{value.NewInteger(3)},
+		},
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	data, err := os.ReadFile(testFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range loadTableTests {
+		loc := v.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+
+		header, records, err := LoadTable(bytes.NewReader(data), int64(len(data)), v.Selected, loc)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %s, want %s", v.Name, records, v.Records)
+		}
+	}
+}
+
+func TestLoadTable_InvalidFile(t *testing.T) {
+	data := []byte("column1,column2\n1,2\n")
+	_, _, err := LoadTable(bytes.NewReader(data), int64(len(data)), nil, time.UTC)
+	if err == nil || err.Error() != "invalid parquet file" {
+		t.Errorf("error = %v, want %q", err, "invalid parquet file")
+	}
+}
+
+func TestWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	header := []string{"column1", "column2", "column3", "column4", "column5", "column6"}
+	w := NewWriter(buf, header)
+
+	records := [][]value.Primary{
+		{value.NewInteger(1), value.NewString("str1"), value.NewFloat(1.25), value.NewBoolean(true), value.NewDatetime(time.Date(2024, 1, 1, 18, 30, 15, 123456000, time.UTC)), value.NewInteger(1)},
+		{value.NewInteger(-2), value.NewNull(), value.NewInteger(2), value.NewBoolean(false), value.NewNull(), value.NewString("a")},
+		{value.NewNull(), value.NewString(""), value.NewNull(), value.NewBoolean(true), value.NewDatetime(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)), value.NewBoolean(true)},
+	}
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expect := [][]value.Primary{
+		{value.NewInteger(1), value.NewString("str1"), value.NewFloat(1.25), value.NewBoolean(true), value.NewDatetime(time.Date(2024, 1, 1, 18, 30, 15, 123456000, time.UTC)), value.NewString("1")},
+		{value.NewInteger(-2), value.NewNull(), value.NewFloat(2), value.NewBoolean(false), value.NewNull(), value.NewString("a")},
+		{value.NewNull(), value.NewString(""), value.NewNull(), value.NewBoolean(true), value.NewDatetime(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)), value.NewString("true")},
+	}
+
+	resultHeader, resultRecords, err := LoadTable(bytes.NewReader(buf.Bytes()), int64(buf.Len()), nil, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(resultHeader, header) {
+		t.Errorf("header = %q, want %q", resultHeader, header)
+	}
+	if !reflect.DeepEqual(resultRecords, expect) {
+		t.Errorf("records = %s, want %s", resultRecords, expect)
+	}
+}
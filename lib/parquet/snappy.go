@@ -0,0 +1,82 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var errCorruptedSnappyData = errors.New("corrupted snappy data")
+
+// decodeSnappy decodes a block compressed with the Snappy raw format.
+func decodeSnappy(src []byte) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	if n <= 0 || 0xffffffff < length {
+		return nil, errCorruptedSnappyData
+	}
+
+	dst := make([]byte, 0, length)
+	pos := n
+	for pos < len(src) {
+		tag := src[pos]
+		pos++
+
+		var l, offset int
+		switch tag & 0x03 {
+		case 0x00:
+			l = int(tag >> 2)
+			if 60 <= l {
+				size := l - 59
+				if len(src)-pos < size {
+					return nil, errCorruptedSnappyData
+				}
+				l = 0
+				for i := 0; i < size; i++ {
+					l |= int(src[pos+i]) << (uint(i) * 8)
+				}
+				pos += size
+			}
+			l++
+
+			if l <= 0 || len(src)-pos < l || uint64(len(dst)+l) > length {
+				return nil, errCorruptedSnappyData
+			}
+			dst = append(dst, src[pos:pos+l]...)
+			pos += l
+			continue
+		case 0x01:
+			if len(src)-pos < 1 {
+				return nil, errCorruptedSnappyData
+			}
+			l = 4 + int(tag>>2&0x07)
+			offset = int(tag>>5)<<8 | int(src[pos])
+			pos++
+		case 0x02:
+			if len(src)-pos < 2 {
+				return nil, errCorruptedSnappyData
+			}
+			l = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint16(src[pos:]))
+			pos += 2
+		default:
+			if len(src)-pos < 4 {
+				return nil, errCorruptedSnappyData
+			}
+			l = 1 + int(tag>>2)
+			offset = int(binary.LittleEndian.Uint32(src[pos:]))
+			pos += 4
+		}
+
+		if offset <= 0 || len(dst) < offset || uint64(len(dst)+l) > length {
+			return nil, errCorruptedSnappyData
+		}
+		start := len(dst) - offset
+		for i := 0; i < l; i++ {
+			dst = append(dst, dst[start+i])
+		}
+	}
+
+	if uint64(len(dst)) != length {
+		return nil, errCorruptedSnappyData
+	}
+	return dst, nil
+}
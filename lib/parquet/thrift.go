@@ -0,0 +1,350 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Type IDs of the Thrift Compact Protocol.
+const (
+	thriftStop         = 0
+	thriftBooleanTrue  = 1
+	thriftBooleanFalse = 2
+	thriftByte         = 3
+	thriftI16          = 4
+	thriftI32          = 5
+	thriftI64          = 6
+	thriftDouble       = 7
+	thriftBinary       = 8
+	thriftList         = 9
+	thriftSet          = 10
+	thriftMap          = 11
+	thriftStruct       = 12
+)
+
+const thriftMaxNestingDepth = 64
+
+var errInvalidThriftData = errors.New("invalid metadata")
+
+// thriftReader decodes values serialized with the Thrift Compact Protocol.
+type thriftReader struct {
+	buf   []byte
+	pos   int
+	depth int
+}
+
+func newThriftReader(buf []byte) *thriftReader {
+	return &thriftReader{
+		buf: buf,
+	}
+}
+
+func (r *thriftReader) readByte() (byte, error) {
+	if len(r.buf) <= r.pos {
+		return 0, errInvalidThriftData
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) readVarint() (uint64, error) {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errInvalidThriftData
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) readZigzag() (int64, error) {
+	v, err := r.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(v>>1) ^ -int64(v&1), nil
+}
+
+func (r *thriftReader) readI32() (int32, error) {
+	v, err := r.readZigzag()
+	return int32(v), err
+}
+
+func (r *thriftReader) readI64() (int64, error) {
+	return r.readZigzag()
+}
+
+func (r *thriftReader) readDouble() (float64, error) {
+	if len(r.buf) < r.pos+8 {
+		return 0, errInvalidThriftData
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(r.buf[r.pos:]))
+	r.pos += 8
+	return v, nil
+}
+
+func (r *thriftReader) readBinary() ([]byte, error) {
+	l, err := r.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.buf)-r.pos) < l {
+		return nil, errInvalidThriftData
+	}
+	b := r.buf[r.pos : r.pos+int(l)]
+	r.pos += int(l)
+	return b, nil
+}
+
+func (r *thriftReader) readString() (string, error) {
+	b, err := r.readBinary()
+	return string(b), err
+}
+
+// readBool reads a boolean value of a struct field, that is encoded in the type of the field.
+func (r *thriftReader) readBool(typ byte) (bool, error) {
+	switch typ {
+	case thriftBooleanTrue:
+		return true, nil
+	case thriftBooleanFalse:
+		return false, nil
+	}
+	return false, errInvalidThriftData
+}
+
+func (r *thriftReader) readListHeader() (byte, int, error) {
+	b, err := r.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size := int(b >> 4)
+	if size == 15 {
+		l, err := r.readVarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		if uint64(len(r.buf)-r.pos) < l {
+			return 0, 0, errInvalidThriftData
+		}
+		size = int(l)
+	}
+	return b & 0x0f, size, nil
+}
+
+// readList calls fn for each element of a list.
+func (r *thriftReader) readList(fn func(elemType byte) error) error {
+	elemType, size, err := r.readListHeader()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < size; i++ {
+		if err = fn(elemType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStruct calls fn for each field in a struct.
+// The function must read the value of the field, or skip it.
+func (r *thriftReader) readStruct(fn func(id int16, typ byte) error) error {
+	r.depth++
+	if thriftMaxNestingDepth < r.depth {
+		return errInvalidThriftData
+	}
+	defer func() { r.depth-- }()
+
+	var lastId int16
+	for {
+		b, err := r.readByte()
+		if err != nil {
+			return err
+		}
+
+		typ := b & 0x0f
+		if typ == thriftStop {
+			return nil
+		}
+
+		var id int16
+		if delta := int16(b >> 4); delta != 0 {
+			id = lastId + delta
+		} else {
+			v, err := r.readZigzag()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		lastId = id
+
+		if err = fn(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *thriftReader) skip(typ byte) error {
+	var err error
+
+	switch typ {
+	case thriftBooleanTrue, thriftBooleanFalse:
+	case thriftByte:
+		_, err = r.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		_, err = r.readVarint()
+	case thriftDouble:
+		_, err = r.readDouble()
+	case thriftBinary:
+		_, err = r.readBinary()
+	case thriftList, thriftSet:
+		err = r.readList(func(elemType byte) error {
+			if elemType == thriftBooleanTrue || elemType == thriftBooleanFalse {
+				_, err := r.readByte()
+				return err
+			}
+			return r.skip(elemType)
+		})
+	case thriftMap:
+		var size uint64
+		if size, err = r.readVarint(); err != nil || size == 0 {
+			break
+		}
+		if uint64(len(r.buf)-r.pos) < size {
+			return errInvalidThriftData
+		}
+
+		var types byte
+		if types, err = r.readByte(); err != nil {
+			break
+		}
+		for i := uint64(0); i < size; i++ {
+			if err = r.skip(types >> 4); err != nil {
+				break
+			}
+			if err = r.skip(types & 0x0f); err != nil {
+				break
+			}
+		}
+	case thriftStruct:
+		err = r.readStruct(func(_ int16, typ byte) error {
+			return r.skip(typ)
+		})
+	default:
+		err = errInvalidThriftData
+	}
+
+	return err
+}
+
+// thriftWriter encodes values with the Thrift Compact Protocol.
+type thriftWriter struct {
+	buf    []byte
+	lastId []int16
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{
+		buf:    make([]byte, 0, 1024),
+		lastId: []int16{0},
+	}
+}
+
+func (w *thriftWriter) Bytes() []byte {
+	return w.buf
+}
+
+func (w *thriftWriter) writeVarint(v uint64) {
+	for 0x80 <= v {
+		w.buf = append(w.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	w.buf = append(w.buf, byte(v))
+}
+
+func (w *thriftWriter) writeZigzag(v int64) {
+	w.writeVarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) writeFieldHeader(id int16, typ byte) {
+	last := w.lastId[len(w.lastId)-1]
+	if delta := id - last; 0 < delta && delta <= 15 {
+		w.buf = append(w.buf, byte(delta<<4)|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.writeZigzag(int64(id))
+	}
+	w.lastId[len(w.lastId)-1] = id
+}
+
+func (w *thriftWriter) writeI32Field(id int16, v int32) {
+	w.writeFieldHeader(id, thriftI32)
+	w.writeZigzag(int64(v))
+}
+
+func (w *thriftWriter) writeI64Field(id int16, v int64) {
+	w.writeFieldHeader(id, thriftI64)
+	w.writeZigzag(v)
+}
+
+func (w *thriftWriter) writeBoolField(id int16, v bool) {
+	if v {
+		w.writeFieldHeader(id, thriftBooleanTrue)
+	} else {
+		w.writeFieldHeader(id, thriftBooleanFalse)
+	}
+}
+
+func (w *thriftWriter) writeStringField(id int16, s string) {
+	w.writeFieldHeader(id, thriftBinary)
+	w.writeVarint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+func (w *thriftWriter) writeListHeader(elemType byte, size int) {
+	if size < 15 {
+		w.buf = append(w.buf, byte(size<<4)|elemType)
+	} else {
+		w.buf = append(w.buf, 0xf0|elemType)
+		w.writeVarint(uint64(size))
+	}
+}
+
+func (w *thriftWriter) writeListField(id int16, elemType byte, size int) {
+	w.writeFieldHeader(id, thriftList)
+	w.writeListHeader(elemType, size)
+}
+
+func (w *thriftWriter) writeI32List(id int16, list []int32) {
+	w.writeListField(id, thriftI32, len(list))
+	for _, v := range list {
+		w.writeZigzag(int64(v))
+	}
+}
+
+func (w *thriftWriter) writeStringList(id int16, list []string) {
+	w.writeListField(id, thriftBinary, len(list))
+	for _, s := range list {
+		w.writeVarint(uint64(len(s)))
+		w.buf = append(w.buf, s...)
+	}
+}
+
+// beginStructField writes the header of a struct field, and then the fields of the struct must be written
+// followed by endStruct.
+func (w *thriftWriter) beginStructField(id int16) {
+	w.writeFieldHeader(id, thriftStruct)
+	w.beginStruct()
+}
+
+// beginStruct starts a struct that is an element of a list or the top level value.
+func (w *thriftWriter) beginStruct() {
+	w.lastId = append(w.lastId, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.buf = append(w.buf, thriftStop)
+	w.lastId = w.lastId[:len(w.lastId)-1]
+}
@@ -0,0 +1,298 @@
+package parquet
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const (
+	rowGroupSize = 65536
+	createdBy    = "csvq"
+)
+
+type columnKind int
+
+const (
+	kindNull columnKind = iota
+	kindInteger
+	kindFloat
+	kindBoolean
+	kindDatetime
+	kindString
+)
+
+// Writer writes a parquet file.
+// Records are buffered to determine the types of the columns, and the file is written by Close.
+//
+// Integers are written as INT64, floats as DOUBLE, booleans as BOOLEAN, datetimes as INT64 TIMESTAMP in microseconds,
+// and strings as BYTE_ARRAY STRING. If a column contains values of different types, the column is written as strings.
+type Writer struct {
+	w       io.Writer
+	header  []string
+	records [][]value.Primary
+}
+
+func NewWriter(w io.Writer, header []string) *Writer {
+	return &Writer{
+		w:      w,
+		header: header,
+	}
+}
+
+// Write buffers a record. The values are copied, so the caller can reuse the slice.
+func (w *Writer) Write(record []value.Primary) error {
+	r := make([]value.Primary, len(w.header))
+	copy(r, record)
+	w.records = append(w.records, r)
+	return nil
+}
+
+func (w *Writer) Close() error {
+	kinds := make([]columnKind, len(w.header))
+	for i := range w.header {
+		kinds[i] = w.columnKind(i)
+	}
+
+	meta := &fileMetaData{
+		Version:   1,
+		Schema:    make([]schemaElement, 0, len(w.header)+1),
+		NumRows:   int64(len(w.records)),
+		RowGroups: make([]rowGroup, 0, len(w.records)/rowGroupSize+1),
+		CreatedBy: createdBy,
+	}
+	meta.Schema = append(meta.Schema, schemaElement{
+		Name:        "schema",
+		NumChildren: int32(len(w.header)),
+	})
+	for i, name := range w.header {
+		meta.Schema = append(meta.Schema, schemaDefinition(name, kinds[i]))
+	}
+
+	if _, err := w.w.Write(magicNumber); err != nil {
+		return err
+	}
+	offset := int64(len(magicNumber))
+
+	for start := 0; start < len(w.records); start += rowGroupSize {
+		end := start + rowGroupSize
+		if len(w.records) < end {
+			end = len(w.records)
+		}
+
+		group := rowGroup{
+			Columns: make([]columnChunk, 0, len(w.header)),
+			NumRows: int64(end - start),
+		}
+		for i := range w.header {
+			chunk := w.encodeColumnChunk(i, kinds[i], start, end)
+			if _, err := w.w.Write(chunk); err != nil {
+				return err
+			}
+
+			group.Columns = append(group.Columns, columnChunk{
+				MetaData: columnMetaData{
+					Type:                  meta.Schema[i+1].Type,
+					Encodings:             []int32{encodingPlain, encodingRLE},
+					PathInSchema:          []string{w.header[i]},
+					Codec:                 codecUncompressed,
+					NumValues:             int64(end - start),
+					TotalUncompressedSize: int64(len(chunk)),
+					TotalCompressedSize:   int64(len(chunk)),
+					DataPageOffset:        offset,
+				},
+			})
+			group.TotalByteSize += int64(len(chunk))
+			offset += int64(len(chunk))
+		}
+		meta.RowGroups = append(meta.RowGroups, group)
+	}
+
+	tw := newThriftWriter()
+	writeFileMetaData(tw, meta)
+	footer := tw.Bytes()
+	footer = appendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magicNumber...)
+	_, err := w.w.Write(footer)
+	return err
+}
+
+func valueKind(p value.Primary) columnKind {
+	switch p.(type) {
+	case *value.Integer:
+		return kindInteger
+	case *value.Float:
+		return kindFloat
+	case *value.Boolean:
+		return kindBoolean
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+			return kindNull
+		}
+		return kindBoolean
+	case *value.Datetime:
+		return kindDatetime
+	case *value.String, *value.Decimal, *value.Interval, *value.Array, *value.Map:
+		return kindString
+	}
+	return kindNull
+}
+
+func (w *Writer) columnKind(idx int) columnKind {
+	kind := kindNull
+	for _, r := range w.records {
+		k := valueKind(r[idx])
+		switch {
+		case k == kindNull || k == kind:
+		case kind == kindNull:
+			kind = k
+		case (kind == kindInteger && k == kindFloat) || (kind == kindFloat && k == kindInteger):
+			kind = kindFloat
+		default:
+			return kindString
+		}
+	}
+	return kind
+}
+
+func schemaDefinition(name string, kind columnKind) schemaElement {
+	e := schemaElement{
+		HasType:    true,
+		Repetition: repetitionOptional,
+		Name:       name,
+	}
+
+	switch kind {
+	case kindInteger:
+		e.Type = typeInt64
+	case kindFloat:
+		e.Type = typeDouble
+	case kindBoolean:
+		e.Type = typeBoolean
+	case kindDatetime:
+		e.Type = typeInt64
+		e.HasConverted = true
+		e.ConvertedType = convertedTimestampMicros
+		e.LogicalType = logicalType{Type: logicalTimestamp, IsAdjustedToUTC: true, Unit: unitMicros}
+	default:
+		e.Type = typeByteArray
+		e.HasConverted = true
+		e.ConvertedType = convertedUTF8
+		e.LogicalType = logicalType{Type: logicalString}
+	}
+	return e
+}
+
+// encodeColumnChunk encodes values of a column in a data page.
+func (w *Writer) encodeColumnChunk(idx int, kind columnKind, start int, end int) []byte {
+	definitionLevels := make([]int32, 0, end-start)
+	var values []byte
+	var bits byte
+	var numBits int
+
+	for _, r := range w.records[start:end] {
+		p := r[idx]
+		if valueKind(p) == kindNull {
+			definitionLevels = append(definitionLevels, 0)
+			continue
+		}
+		definitionLevels = append(definitionLevels, 1)
+
+		switch kind {
+		case kindInteger:
+			values = appendUint64(values, uint64(p.(*value.Integer).Raw()))
+		case kindFloat:
+			var f float64
+			if i, ok := p.(*value.Integer); ok {
+				f = float64(i.Raw())
+			} else {
+				f = p.(*value.Float).Raw()
+			}
+			values = appendUint64(values, math.Float64bits(f))
+		case kindBoolean:
+			var b bool
+			if t, ok := p.(*value.Ternary); ok {
+				b = t.Ternary() == ternary.TRUE
+			} else {
+				b = p.(*value.Boolean).Raw()
+			}
+			if b {
+				bits |= 1 << uint(numBits)
+			}
+			numBits++
+			if numBits == 8 {
+				values = append(values, bits)
+				bits, numBits = 0, 0
+			}
+		case kindDatetime:
+			t := p.(*value.Datetime).Raw()
+			values = appendUint64(values, uint64(t.Unix()*1e6+int64(t.Nanosecond()/1e3)))
+		default:
+			s := stringValue(p)
+			values = appendUint32(values, uint32(len(s)))
+			values = append(values, s...)
+		}
+	}
+	if 0 < numBits {
+		values = append(values, bits)
+	}
+
+	levels := appendHybridRuns(nil, definitionLevels, 1)
+	body := make([]byte, 0, 4+len(levels)+len(values))
+	body = appendUint32(body, uint32(len(levels)))
+	body = append(body, levels...)
+	body = append(body, values...)
+
+	tw := newThriftWriter()
+	writeDataPageHeader(tw, &pageHeader{
+		Type:                 pageData,
+		UncompressedPageSize: int32(len(body)),
+		CompressedPageSize:   int32(len(body)),
+		DataPageHeader: dataPageHeader{
+			NumValues:               int32(end - start),
+			Encoding:                encodingPlain,
+			DefinitionLevelEncoding: encodingRLE,
+			RepetitionLevelEncoding: encodingRLE,
+		},
+	})
+	return append(tw.Bytes(), body...)
+}
+
+func stringValue(p value.Primary) string {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw()
+	case *value.Integer:
+		return strconv.FormatInt(p.(*value.Integer).Raw(), 10)
+	case *value.Float:
+		return value.Float64ToStr(p.(*value.Float).Raw(), false)
+	case *value.Decimal:
+		return p.(*value.Decimal).String()
+	case *value.Boolean:
+		return strconv.FormatBool(p.(*value.Boolean).Raw())
+	case *value.Ternary:
+		return p.(*value.Ternary).Ternary().String()
+	case *value.Datetime:
+		return p.(*value.Datetime).Format(time.RFC3339Nano)
+	case *value.Interval:
+		return p.(*value.Interval).Format()
+	case *value.Array:
+		return p.(*value.Array).Encode()
+	case *value.Map:
+		return p.(*value.Map).Encode()
+	}
+	return ""
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	return appendUint32(appendUint32(b, uint32(v)), uint32(v>>32))
+}
@@ -143,6 +143,13 @@ func NewDatetimeValue(t time.Time) PrimitiveType {
 	}
 }
 
+func NewIntervalValueFromString(s string) PrimitiveType {
+	return PrimitiveType{
+		Literal: s,
+		Value:   value.NewIntervalFromString(s),
+	}
+}
+
 func NewNullValue() PrimitiveType {
 	return PrimitiveType{
 		Value: value.NewNull(),
@@ -150,6 +157,12 @@ func NewNullValue() PrimitiveType {
 }
 
 func (e PrimitiveType) String() string {
+	if iv, ok := e.Value.(*value.Interval); ok {
+		if 0 < len(e.Literal) {
+			return keyword(INTERVAL) + " " + option.QuoteString(e.Literal)
+		}
+		return keyword(INTERVAL) + " " + iv.String()
+	}
 	if 0 < len(e.Literal) {
 		switch e.Value.(type) {
 		case *value.String, *value.Datetime:
@@ -319,6 +332,7 @@ type SelectEntity struct {
 	WhereClause   QueryExpression
 	GroupByClause QueryExpression
 	HavingClause  QueryExpression
+	QualifyClause QueryExpression
 }
 
 func (e SelectEntity) String() string {
@@ -338,24 +352,35 @@ func (e SelectEntity) String() string {
 	if e.HavingClause != nil {
 		s = append(s, e.HavingClause.String())
 	}
+	if e.QualifyClause != nil {
+		s = append(s, e.QualifyClause.String())
+	}
 	return joinWithSpace(s)
 }
 
 type SelectClause struct {
 	*BaseExpr
-	Distinct Token
-	Fields   []QueryExpression
+	Distinct   Token
+	DistinctOn []QueryExpression
+	Fields     []QueryExpression
 }
 
 func (sc SelectClause) IsDistinct() bool {
 	return sc.Distinct.Token == DISTINCT
 }
 
+func (sc SelectClause) IsDistinctOn() bool {
+	return sc.IsDistinct() && sc.DistinctOn != nil
+}
+
 func (sc SelectClause) String() string {
 	s := []string{keyword(SELECT)}
 	if sc.IsDistinct() {
 		s = append(s, sc.Distinct.String())
 	}
+	if sc.IsDistinctOn() {
+		s = append(s, keyword(ON), putParentheses(listQueryExpressions(sc.DistinctOn)))
+	}
 	s = append(s, listQueryExpressions(sc.Fields))
 	return joinWithSpace(s)
 }
@@ -403,6 +428,19 @@ func (gb GroupByClause) String() string {
 	return joinWithSpace(s)
 }
 
+type GroupingSets struct {
+	*BaseExpr
+	Type  Token
+	Items []QueryExpression
+}
+
+func (e GroupingSets) String() string {
+	if e.Type.Token == GROUPING {
+		return joinWithSpace([]string{keyword(GROUPING), keyword(SETS), putParentheses(listQueryExpressions(e.Items))})
+	}
+	return keyword(e.Type.Token) + putParentheses(listQueryExpressions(e.Items))
+}
+
 type HavingClause struct {
 	*BaseExpr
 	Filter QueryExpression
@@ -413,6 +451,16 @@ func (h HavingClause) String() string {
 	return joinWithSpace(s)
 }
 
+type QualifyClause struct {
+	*BaseExpr
+	Filter QueryExpression
+}
+
+func (e QualifyClause) String() string {
+	s := []string{keyword(QUALIFY), e.Filter.String()}
+	return joinWithSpace(s)
+}
+
 type OrderByClause struct {
 	*BaseExpr
 	Items []QueryExpression
@@ -499,6 +547,15 @@ func (e OffsetClause) String() string {
 	return joinWithSpace(s)
 }
 
+type ReturningClause struct {
+	*BaseExpr
+	Fields []QueryExpression
+}
+
+func (e ReturningClause) String() string {
+	return joinWithSpace([]string{keyword(RETURNING), listQueryExpressions(e.Fields)})
+}
+
 type WithClause struct {
 	*BaseExpr
 	InlineTables []QueryExpression
@@ -562,6 +619,16 @@ func (e TableFunction) String() string {
 	return strings.ToUpper(e.Name) + ConstantDelimiter + putParentheses(listQueryExpressions(e.Args))
 }
 
+type ArchiveMember struct {
+	*BaseExpr
+	Archive Identifier
+	Member  Identifier
+}
+
+func (e ArchiveMember) String() string {
+	return e.Archive.String() + "." + e.Member.String()
+}
+
 type FormatSpecifiedFunction struct {
 	*BaseExpr
 	Type          Token
@@ -798,6 +865,9 @@ func (e Function) String() string {
 			elems = append(elems, e.For.String(), e.Args[2].String())
 		}
 		args = joinWithSpace(elems)
+	} else if strings.EqualFold(e.Name, keyword(EXTRACT)) && !e.From.IsEmpty() {
+		unit := e.Args[0].(PrimitiveType).Literal
+		args = joinWithSpace([]string{strings.ToUpper(unit), e.From.String(), e.Args[1].String()})
 	} else {
 		args = listQueryExpressions(e.Args)
 	}
@@ -809,22 +879,49 @@ type AggregateFunction struct {
 	Name     string
 	Distinct Token
 	Args     []QueryExpression
+	OrderBy  QueryExpression
+	Filter   QueryExpression
 }
 
 func (e AggregateFunction) String() string {
-	s := make([]string, 0)
+	args := make([]string, 0)
 	if !e.Distinct.IsEmpty() {
-		s = append(s, e.Distinct.String())
+		args = append(args, e.Distinct.String())
 	}
-	s = append(s, listQueryExpressions(e.Args))
+	args = append(args, listQueryExpressions(e.Args))
 
-	return strings.ToUpper(e.Name) + "(" + joinWithSpace(s) + ")"
+	s := []string{strings.ToUpper(e.Name) + "(" + joinWithSpace(args) + ")"}
+	if e.OrderBy != nil {
+		s = append(s, keyword(WITHIN), keyword(GROUP), "("+e.OrderBy.String()+")")
+	}
+	if e.Filter != nil {
+		s = append(s, keyword(FILTER), "("+e.Filter.String()+")")
+	}
+	return joinWithSpace(s)
 }
 
 func (e AggregateFunction) IsDistinct() bool {
 	return e.Distinct.Token == DISTINCT
 }
 
+type ArrayValue struct {
+	*BaseExpr
+	Values []QueryExpression
+}
+
+func (e ArrayValue) String() string {
+	return keyword(ARRAY) + "[" + listQueryExpressions(e.Values) + "]"
+}
+
+type Unnest struct {
+	*BaseExpr
+	Expr QueryExpression
+}
+
+func (e Unnest) String() string {
+	return keyword(UNNEST) + putParentheses(e.Expr.String())
+}
+
 type Table struct {
 	*BaseExpr
 	Lateral Token
@@ -876,6 +973,44 @@ func (j Join) String() string {
 	return joinWithSpace(s)
 }
 
+type Pivot struct {
+	*BaseExpr
+	Table     QueryExpression
+	Aggregate QueryExpression
+	Column    QueryExpression
+	Values    []QueryExpression
+}
+
+func (e Pivot) String() string {
+	s := []string{
+		e.Aggregate.String(),
+		keyword(FOR),
+		e.Column.String(),
+		keyword(IN),
+		putParentheses(listQueryExpressions(e.Values)),
+	}
+	return joinWithSpace([]string{e.Table.String(), keyword(PIVOT), putParentheses(joinWithSpace(s))})
+}
+
+type Unpivot struct {
+	*BaseExpr
+	Table   QueryExpression
+	Value   Identifier
+	Name    Identifier
+	Columns []QueryExpression
+}
+
+func (e Unpivot) String() string {
+	s := []string{
+		e.Value.String(),
+		keyword(FOR),
+		e.Name.String(),
+		keyword(IN),
+		putParentheses(listQueryExpressions(e.Columns)),
+	}
+	return joinWithSpace([]string{e.Table.String(), keyword(UNPIVOT), putParentheses(joinWithSpace(s))})
+}
+
 type JoinCondition struct {
 	*BaseExpr
 	On    QueryExpression
@@ -1017,6 +1152,7 @@ type ListFunction struct {
 	Distinct Token
 	Args     []QueryExpression
 	OrderBy  QueryExpression
+	Filter   QueryExpression
 }
 
 func (e ListFunction) String() string {
@@ -1030,6 +1166,9 @@ func (e ListFunction) String() string {
 	if e.OrderBy != nil {
 		s = append(s, keyword(WITHIN), keyword(GROUP), "("+e.OrderBy.String()+")")
 	}
+	if e.Filter != nil {
+		s = append(s, keyword(FILTER), "("+e.Filter.String()+")")
+	}
 	return joinWithSpace(s)
 }
 
@@ -1228,20 +1367,65 @@ type UnsetEnvVar struct {
 
 type InsertQuery struct {
 	*BaseExpr
-	WithClause QueryExpression
-	Table      Table
-	Fields     []QueryExpression
-	ValuesList []QueryExpression
-	Query      QueryExpression
+	WithClause      QueryExpression
+	Table           Table
+	Fields          []QueryExpression
+	ValuesList      []QueryExpression
+	Query           QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e InsertQuery) String() string {
+	s := make([]string, 0, 8)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(INSERT), keyword(INTO), e.Table.String())
+	if e.Fields != nil {
+		s = append(s, putParentheses(listQueryExpressions(e.Fields)))
+	}
+	if e.ValuesList != nil {
+		s = append(s, keyword(VALUES), listQueryExpressions(e.ValuesList))
+	} else {
+		s = append(s, e.Query.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type UpdateQuery struct {
 	*BaseExpr
-	WithClause  QueryExpression
-	Tables      []QueryExpression
-	SetList     []UpdateSet
-	FromClause  QueryExpression
-	WhereClause QueryExpression
+	WithClause      QueryExpression
+	Tables          []QueryExpression
+	SetList         []UpdateSet
+	FromClause      QueryExpression
+	WhereClause     QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e UpdateQuery) String() string {
+	setList := make([]string, len(e.SetList))
+	for i, v := range e.SetList {
+		setList[i] = v.String()
+	}
+
+	s := make([]string, 0, 8)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(UPDATE), listQueryExpressions(e.Tables), keyword(SET), strings.Join(setList, ", "))
+	if e.FromClause != nil {
+		s = append(s, e.FromClause.String())
+	}
+	if e.WhereClause != nil {
+		s = append(s, e.WhereClause.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type UpdateSet struct {
@@ -1250,22 +1434,88 @@ type UpdateSet struct {
 	Value QueryExpression
 }
 
+func (us UpdateSet) String() string {
+	return joinWithSpace([]string{us.Field.String(), "=", us.Value.String()})
+}
+
 type ReplaceQuery struct {
 	*BaseExpr
-	WithClause QueryExpression
-	Table      Table
-	Fields     []QueryExpression
-	Keys       []QueryExpression
-	ValuesList []QueryExpression
-	Query      QueryExpression
+	WithClause      QueryExpression
+	Table           Table
+	Fields          []QueryExpression
+	Keys            []QueryExpression
+	ValuesList      []QueryExpression
+	Query           QueryExpression
+	ReturningClause QueryExpression
 }
 
-type DeleteQuery struct {
+func (e ReplaceQuery) String() string {
+	s := make([]string, 0, 10)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(REPLACE), keyword(INTO), e.Table.String())
+	if e.Fields != nil {
+		s = append(s, putParentheses(listQueryExpressions(e.Fields)))
+	}
+	s = append(s, keyword(USING), putParentheses(listQueryExpressions(e.Keys)))
+	if e.ValuesList != nil {
+		s = append(s, keyword(VALUES), listQueryExpressions(e.ValuesList))
+	} else {
+		s = append(s, e.Query.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
+}
+
+type MergeQuery struct {
 	*BaseExpr
 	WithClause  QueryExpression
-	Tables      []QueryExpression
-	FromClause  FromClause
-	WhereClause QueryExpression
+	Table       Table
+	Source      QueryExpression
+	Condition   QueryExpression
+	WhenClauses []MergeWhen
+}
+
+type MergeWhen struct {
+	*BaseExpr
+	Matched   bool
+	BySource  bool
+	Condition QueryExpression
+	Action    Token
+	SetList   []UpdateSet
+	Fields    []QueryExpression
+	Values    QueryExpression
+}
+
+type DeleteQuery struct {
+	*BaseExpr
+	WithClause      QueryExpression
+	Tables          []QueryExpression
+	FromClause      FromClause
+	WhereClause     QueryExpression
+	ReturningClause QueryExpression
+}
+
+func (e DeleteQuery) String() string {
+	s := make([]string, 0, 6)
+	if e.WithClause != nil {
+		s = append(s, e.WithClause.String())
+	}
+	s = append(s, keyword(DELETE))
+	if e.Tables != nil {
+		s = append(s, listQueryExpressions(e.Tables))
+	}
+	s = append(s, e.FromClause.String())
+	if e.WhereClause != nil {
+		s = append(s, e.WhereClause.String())
+	}
+	if e.ReturningClause != nil {
+		s = append(s, e.ReturningClause.String())
+	}
+	return joinWithSpace(s)
 }
 
 type CreateTable struct {
@@ -1285,10 +1535,28 @@ type AddColumns struct {
 
 type ColumnDefault struct {
 	*BaseExpr
-	Column Identifier
+	Column QueryExpression
 	Value  QueryExpression
 }
 
+type ColumnDefinition struct {
+	*BaseExpr
+	Column  Identifier
+	Type    Identifier
+	NotNull bool
+}
+
+func (e ColumnDefinition) String() string {
+	s := []string{e.Column.String()}
+	if 0 < len(e.Type.Literal) {
+		s = append(s, e.Type.String())
+	}
+	if e.NotNull {
+		s = append(s, keyword(NOT), keyword(NULL))
+	}
+	return joinWithSpace(s)
+}
+
 type ColumnPosition struct {
 	*BaseExpr
 	Position Token
@@ -1420,6 +1688,12 @@ type ShowFields struct {
 	Table QueryExpression
 }
 
+type Explain struct {
+	*BaseExpr
+	Analyze bool
+	Query   SelectQuery
+}
+
 type If struct {
 	*BaseExpr
 	Condition  QueryExpression
@@ -1463,6 +1737,12 @@ type While struct {
 	Statements []Statement
 }
 
+type TryCatch struct {
+	*BaseExpr
+	Try   []Statement
+	Catch []Statement
+}
+
 type WhileInCursor struct {
 	*BaseExpr
 	WithDeclaration bool
@@ -1474,7 +1754,7 @@ type WhileInCursor struct {
 type CursorDeclaration struct {
 	*BaseExpr
 	Cursor    Identifier
-	Query     SelectQuery
+	Query     QueryExpression
 	Statement Identifier
 }
 
@@ -93,6 +93,12 @@ func TestPrimitiveType_String(t *testing.T) {
 		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
 	}
 
+	e = NewIntervalValueFromString("3 days")
+	expect = "INTERVAL '3 days'"
+	if e.String() != expect {
+		t.Errorf("result = %q, want %q for %q ", e.String(), expect, e)
+	}
+
 	e = NewNullValue()
 	expect = "NULL"
 	if e.String() != expect {
@@ -382,6 +388,25 @@ func TestSelectEntity_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = SelectEntity{
+		SelectClause: SelectClause{
+			Fields: []QueryExpression{Field{Object: Identifier{Literal: "column"}}},
+		},
+		FromClause: FromClause{
+			Tables: []QueryExpression{
+				Table{Object: Identifier{Literal: "table"}},
+			},
+		},
+		QualifyClause: QualifyClause{
+			Filter: Identifier{Literal: "column"},
+		},
+	}
+
+	expect = "SELECT column FROM table QUALIFY column"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestSelectClause_IsDistinct(t *testing.T) {
@@ -414,6 +439,22 @@ func TestSelectClause_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = SelectClause{
+		Distinct: Token{Token: DISTINCT, Literal: "distinct"},
+		DistinctOn: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Fields: []QueryExpression{
+			Field{
+				Object: Identifier{Literal: "column1"},
+			},
+		},
+	}
+	expect = "SELECT DISTINCT ON (column1) column1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestIntoClause_String(t *testing.T) {
@@ -469,6 +510,32 @@ func TestGroupByClause_String(t *testing.T) {
 	}
 }
 
+func TestGroupingSets_String(t *testing.T) {
+	e := GroupingSets{
+		Type: Token{Token: ROLLUP, Literal: "rollup"},
+		Items: []QueryExpression{
+			Identifier{Literal: "column1"},
+			Identifier{Literal: "column2"},
+		},
+	}
+	expect := "ROLLUP(column1, column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = GroupingSets{
+		Type: Token{Token: GROUPING, Literal: "grouping"},
+		Items: []QueryExpression{
+			Parentheses{Expr: Identifier{Literal: "column1"}},
+			RowValue{Value: ValueList{}},
+		},
+	}
+	expect = "GROUPING SETS ((column1), ())"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestHavingClause_String(t *testing.T) {
 	e := HavingClause{
 		Filter: Comparison{
@@ -483,6 +550,20 @@ func TestHavingClause_String(t *testing.T) {
 	}
 }
 
+func TestQualifyClause_String(t *testing.T) {
+	e := QualifyClause{
+		Filter: Comparison{
+			LHS:      Identifier{Literal: "column"},
+			Operator: Token{Token: '=', Literal: "="},
+			RHS:      NewIntegerValueFromString("1"),
+		},
+	}
+	expect := "QUALIFY column = 1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestOrderByClause_String(t *testing.T) {
 	e := OrderByClause{
 		Items: []QueryExpression{
@@ -610,6 +691,19 @@ func TestWithClause_String(t *testing.T) {
 	}
 }
 
+func TestReturningClause_String(t *testing.T) {
+	e := ReturningClause{
+		Fields: []QueryExpression{
+			Field{Object: FieldReference{Column: Identifier{Literal: "column1"}}},
+			Field{Object: AllColumns{}},
+		},
+	}
+	expect := "RETURNING column1, *"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestInlineTable_String(t *testing.T) {
 	e := InlineTable{
 		Recursive: Token{Token: RECURSIVE, Literal: "recursive"},
@@ -1083,6 +1177,42 @@ func TestFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = Function{
+		Name: "extract",
+		Args: []QueryExpression{
+			NewStringValue("day"),
+			Identifier{Literal: "column"},
+		},
+		From: Token{Token: FROM, Literal: "from"},
+	}
+	expect = "EXTRACT(DAY FROM column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestArrayValue_String(t *testing.T) {
+	e := ArrayValue{
+		Values: []QueryExpression{
+			NewIntegerValue(1),
+			NewStringValue("a"),
+		},
+	}
+	expect := "ARRAY[1, 'a']"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnnest_String(t *testing.T) {
+	e := Unnest{
+		Expr: FieldReference{Column: Identifier{Literal: "column"}},
+	}
+	expect := "UNNEST(column)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_String(t *testing.T) {
@@ -1097,6 +1227,23 @@ func TestAggregateFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = AggregateFunction{
+		Name: "percentile_cont",
+		Args: []QueryExpression{
+			NewFloatValue(0.9),
+		},
+		OrderBy: OrderByClause{
+			Items: []QueryExpression{Identifier{Literal: "column1"}},
+		},
+		Filter: WhereClause{
+			Filter: Identifier{Literal: "column2"},
+		},
+	}
+	expect = "PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY column1) FILTER (WHERE column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestAggregateFunction_IsDistinct(t *testing.T) {
@@ -1168,6 +1315,41 @@ func TestJoin_String(t *testing.T) {
 	}
 }
 
+func TestPivot_String(t *testing.T) {
+	e := Pivot{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Aggregate: AggregateFunction{
+			Name: "sum",
+			Args: []QueryExpression{FieldReference{Column: Identifier{Literal: "amount"}}},
+		},
+		Column: FieldReference{Column: Identifier{Literal: "month"}},
+		Values: []QueryExpression{
+			Field{Object: NewStringValue("Jan")},
+			Field{Object: NewStringValue("Feb"), As: Token{Token: AS, Literal: "as"}, Alias: Identifier{Literal: "feb"}},
+		},
+	}
+	expect := "table1 PIVOT (SUM(amount) FOR month IN ('Jan', 'Feb' AS feb))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUnpivot_String(t *testing.T) {
+	e := Unpivot{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Value: Identifier{Literal: "value"},
+		Name:  Identifier{Literal: "metric"},
+		Columns: []QueryExpression{
+			FieldReference{Column: Identifier{Literal: "col_a"}},
+			FieldReference{Column: Identifier{Literal: "col_b"}},
+		},
+	}
+	expect := "table1 UNPIVOT (value FOR metric IN (col_a, col_b))"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
 func TestJoinCondition_String(t *testing.T) {
 	e := JoinCondition{
 		On: Comparison{
@@ -1403,6 +1585,20 @@ func TestListFunction_String(t *testing.T) {
 	if e.String() != expect {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
+
+	e = ListFunction{
+		Name: "listagg",
+		Args: []QueryExpression{
+			Identifier{Literal: "column1"},
+		},
+		Filter: WhereClause{
+			Filter: Identifier{Literal: "column2"},
+		},
+	}
+	expect = "LISTAGG(column1) FILTER (WHERE column2)"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
 }
 
 func TestListFunction_IsDistinct(t *testing.T) {
@@ -1651,3 +1847,93 @@ func TestCursorAttrebute_String(t *testing.T) {
 		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
 	}
 }
+
+func TestInsertQuery_String(t *testing.T) {
+	e := InsertQuery{
+		Table:  Table{Object: Identifier{Literal: "table1"}},
+		Fields: []QueryExpression{FieldReference{Column: Identifier{Literal: "column1"}}},
+		ValuesList: []QueryExpression{
+			RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("1")}}},
+			RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("2")}}},
+		},
+		ReturningClause: ReturningClause{
+			Fields: []QueryExpression{Field{Object: AllColumns{}}},
+		},
+	}
+	expect := "INSERT INTO table1 (column1) VALUES (1), (2) RETURNING *"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestUpdateQuery_String(t *testing.T) {
+	e := UpdateQuery{
+		Tables: []QueryExpression{Table{Object: Identifier{Literal: "table1"}}},
+		SetList: []UpdateSet{
+			{Field: FieldReference{Column: Identifier{Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
+			{Field: FieldReference{Column: Identifier{Literal: "column2"}}, Value: NewStringValue("str")},
+		},
+		WhereClause: WhereClause{
+			Filter: NewTernaryValueFromString("true"),
+		},
+		ReturningClause: ReturningClause{
+			Fields: []QueryExpression{Field{Object: FieldReference{Column: Identifier{Literal: "column1"}}}},
+		},
+	}
+	expect := "UPDATE table1 SET column1 = 1, column2 = 'str' WHERE TRUE RETURNING column1"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestReplaceQuery_String(t *testing.T) {
+	e := ReplaceQuery{
+		Table: Table{Object: Identifier{Literal: "table1"}},
+		Keys:  []QueryExpression{FieldReference{Column: Identifier{Literal: "column1"}}},
+		ValuesList: []QueryExpression{
+			RowValue{Value: ValueList{Values: []QueryExpression{NewIntegerValueFromString("1"), NewStringValue("str")}}},
+		},
+	}
+	expect := "REPLACE INTO table1 USING (column1) VALUES (1, 'str')"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestDeleteQuery_String(t *testing.T) {
+	e := DeleteQuery{
+		FromClause: FromClause{
+			Tables: []QueryExpression{Table{Object: Identifier{Literal: "table1"}}},
+		},
+		WhereClause: WhereClause{
+			Filter: NewTernaryValueFromString("true"),
+		},
+		ReturningClause: ReturningClause{
+			Fields: []QueryExpression{Field{Object: AllColumns{}}},
+		},
+	}
+	expect := "DELETE FROM table1 WHERE TRUE RETURNING *"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
+
+func TestColumnDefinition_String(t *testing.T) {
+	e := ColumnDefinition{
+		Column:  Identifier{Literal: "column1"},
+		Type:    Identifier{Literal: "INTEGER"},
+		NotNull: true,
+	}
+	expect := "column1 INTEGER NOT NULL"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+
+	e = ColumnDefinition{
+		Column: Identifier{Literal: "column 1", Quoted: true},
+	}
+	expect = "`column 1`"
+	if e.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", e.String(), expect, e)
+	}
+}
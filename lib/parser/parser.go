@@ -1,4 +1,4 @@
-// Code generated by goyacc -o lib/parser/parser.go -v lib/parser/parser.output lib/parser/parser.y. DO NOT EDIT.
+// Code generated by goyacc -o lib/parser/parser.go -v /tmp/new.out lib/parser/parser.y. DO NOT EDIT.
 
 //line lib/parser/parser.y:2
 package parser
@@ -8,13 +8,14 @@ import __yyfmt__ "fmt"
 //line lib/parser/parser.y:2
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
 
-//line lib/parser/parser.y:12
+//line lib/parser/parser.y:13
 type yySymType struct {
 	yys         int
 	program     []Statement
@@ -42,6 +43,8 @@ type yySymType struct {
 	fetchpos    FetchPosition
 	replaceval  ReplaceValue
 	replacevals []ReplaceValue
+	mergewhen   MergeWhen
+	mergewhens  []MergeWhen
 	token       Token
 	bool        bool
 }
@@ -77,135 +80,159 @@ const AS = 57373
 const DUAL = 57374
 const STDIN = 57375
 const RECURSIVE = 57376
-const CREATE = 57377
-const ADD = 57378
-const DROP = 57379
-const ALTER = 57380
-const TABLE = 57381
-const FIRST = 57382
-const LAST = 57383
-const AFTER = 57384
-const BEFORE = 57385
-const DEFAULT = 57386
-const RENAME = 57387
-const TO = 57388
-const VIEW = 57389
-const ORDER = 57390
-const GROUP = 57391
-const HAVING = 57392
-const BY = 57393
-const ASC = 57394
-const DESC = 57395
-const LIMIT = 57396
-const OFFSET = 57397
-const PERCENT = 57398
-const JOIN = 57399
-const INNER = 57400
-const OUTER = 57401
-const LEFT = 57402
-const RIGHT = 57403
-const FULL = 57404
-const CROSS = 57405
-const ON = 57406
-const USING = 57407
-const NATURAL = 57408
-const LATERAL = 57409
-const UNION = 57410
-const INTERSECT = 57411
-const EXCEPT = 57412
-const ALL = 57413
-const ANY = 57414
-const EXISTS = 57415
-const IN = 57416
-const AND = 57417
-const OR = 57418
-const NOT = 57419
-const BETWEEN = 57420
-const LIKE = 57421
-const IS = 57422
-const NULL = 57423
-const DISTINCT = 57424
-const WITH = 57425
-const RANGE = 57426
-const UNBOUNDED = 57427
-const PRECEDING = 57428
-const FOLLOWING = 57429
-const CURRENT = 57430
-const ROW = 57431
-const CASE = 57432
-const IF = 57433
-const ELSEIF = 57434
-const WHILE = 57435
-const WHEN = 57436
-const THEN = 57437
-const ELSE = 57438
-const DO = 57439
-const END = 57440
-const DECLARE = 57441
-const CURSOR = 57442
-const FOR = 57443
-const FETCH = 57444
-const OPEN = 57445
-const CLOSE = 57446
-const DISPOSE = 57447
-const PREPARE = 57448
-const NEXT = 57449
-const PRIOR = 57450
-const ABSOLUTE = 57451
-const RELATIVE = 57452
-const SEPARATOR = 57453
-const PARTITION = 57454
-const OVER = 57455
-const COMMIT = 57456
-const ROLLBACK = 57457
-const CONTINUE = 57458
-const BREAK = 57459
-const EXIT = 57460
-const ECHO = 57461
-const PRINT = 57462
-const PRINTF = 57463
-const SOURCE = 57464
-const EXECUTE = 57465
-const CHDIR = 57466
-const PWD = 57467
-const RELOAD = 57468
-const REMOVE = 57469
-const SYNTAX = 57470
-const TRIGGER = 57471
-const FUNCTION = 57472
-const AGGREGATE = 57473
-const BEGIN = 57474
-const RETURN = 57475
-const IGNORE = 57476
-const WITHIN = 57477
-const VAR = 57478
-const SHOW = 57479
-const TIES = 57480
-const NULLS = 57481
-const ROWS = 57482
-const ONLY = 57483
-const CSV = 57484
-const JSON = 57485
-const JSONL = 57486
-const FIXED = 57487
-const LTSV = 57488
-const CSV_INLINE = 57489
-const JSON_INLINE = 57490
-const JSON_TABLE = 57491
-const JSON_ROW = 57492
-const SUBSTRING = 57493
-const COUNT = 57494
-const JSON_OBJECT = 57495
-const AGGREGATE_FUNCTION = 57496
-const LIST_FUNCTION = 57497
-const ANALYTIC_FUNCTION = 57498
-const FUNCTION_NTH = 57499
-const FUNCTION_WITH_INS = 57500
-const COMPARISON_OP = 57501
-const STRING_OP = 57502
-const SUBSTITUTION_OP = 57503
-const UMINUS = 57504
-const UPLUS = 57505
+const MERGE = 57377
+const MATCHED = 57378
+const RETURNING = 57379
+const CREATE = 57380
+const ADD = 57381
+const DROP = 57382
+const ALTER = 57383
+const TABLE = 57384
+const FIRST = 57385
+const LAST = 57386
+const AFTER = 57387
+const BEFORE = 57388
+const DEFAULT = 57389
+const RENAME = 57390
+const TO = 57391
+const VIEW = 57392
+const ORDER = 57393
+const GROUP = 57394
+const HAVING = 57395
+const QUALIFY = 57396
+const BY = 57397
+const ASC = 57398
+const DESC = 57399
+const LIMIT = 57400
+const OFFSET = 57401
+const PERCENT = 57402
+const ROLLUP = 57403
+const CUBE = 57404
+const GROUPING = 57405
+const SETS = 57406
+const JOIN = 57407
+const INNER = 57408
+const OUTER = 57409
+const LEFT = 57410
+const RIGHT = 57411
+const FULL = 57412
+const CROSS = 57413
+const ON = 57414
+const USING = 57415
+const NATURAL = 57416
+const LATERAL = 57417
+const PIVOT = 57418
+const UNPIVOT = 57419
+const UNION = 57420
+const INTERSECT = 57421
+const EXCEPT = 57422
+const ALL = 57423
+const ANY = 57424
+const EXISTS = 57425
+const IN = 57426
+const AND = 57427
+const OR = 57428
+const NOT = 57429
+const BETWEEN = 57430
+const LIKE = 57431
+const IS = 57432
+const NULL = 57433
+const DISTINCT = 57434
+const WITH = 57435
+const RANGE = 57436
+const UNBOUNDED = 57437
+const PRECEDING = 57438
+const FOLLOWING = 57439
+const CURRENT = 57440
+const ROW = 57441
+const CASE = 57442
+const IF = 57443
+const ELSEIF = 57444
+const WHILE = 57445
+const WHEN = 57446
+const THEN = 57447
+const ELSE = 57448
+const DO = 57449
+const END = 57450
+const DECLARE = 57451
+const CURSOR = 57452
+const FOR = 57453
+const FETCH = 57454
+const OPEN = 57455
+const CLOSE = 57456
+const DISPOSE = 57457
+const PREPARE = 57458
+const NEXT = 57459
+const PRIOR = 57460
+const ABSOLUTE = 57461
+const RELATIVE = 57462
+const SEPARATOR = 57463
+const PARTITION = 57464
+const OVER = 57465
+const COMMIT = 57466
+const ROLLBACK = 57467
+const CONTINUE = 57468
+const BREAK = 57469
+const EXIT = 57470
+const ECHO = 57471
+const PRINT = 57472
+const PRINTF = 57473
+const SOURCE = 57474
+const EXECUTE = 57475
+const CHDIR = 57476
+const PWD = 57477
+const RELOAD = 57478
+const REMOVE = 57479
+const SYNTAX = 57480
+const TRIGGER = 57481
+const FUNCTION = 57482
+const AGGREGATE = 57483
+const BEGIN = 57484
+const RETURN = 57485
+const TRY = 57486
+const CATCH = 57487
+const IGNORE = 57488
+const WITHIN = 57489
+const FILTER = 57490
+const VAR = 57491
+const SHOW = 57492
+const EXPLAIN = 57493
+const ANALYZE = 57494
+const TIES = 57495
+const NULLS = 57496
+const ROWS = 57497
+const ONLY = 57498
+const CSV = 57499
+const JSON = 57500
+const JSONL = 57501
+const FIXED = 57502
+const LTSV = 57503
+const XLSX = 57504
+const PARQUET = 57505
+const XML = 57506
+const YAML = 57507
+const CSV_INLINE = 57508
+const JSON_INLINE = 57509
+const JSON_TABLE = 57510
+const JSON_ROW = 57511
+const INTERVAL = 57512
+const ARRAY = 57513
+const UNNEST = 57514
+const SUBSTRING = 57515
+const EXTRACT = 57516
+const COUNT = 57517
+const JSON_OBJECT = 57518
+const AGGREGATE_FUNCTION = 57519
+const LIST_FUNCTION = 57520
+const ANALYTIC_FUNCTION = 57521
+const FUNCTION_NTH = 57522
+const FUNCTION_WITH_INS = 57523
+const COMPARISON_OP = 57524
+const STRING_OP = 57525
+const SUBSTITUTION_OP = 57526
+const UMINUS = 57527
+const UPLUS = 57528
+const EMPTY_WITH_CLAUSE = 57529
 
 var yyToknames = [...]string{
 	"$end",
@@ -242,6 +269,9 @@ var yyToknames = [...]string{
 	"DUAL",
 	"STDIN",
 	"RECURSIVE",
+	"MERGE",
+	"MATCHED",
+	"RETURNING",
 	"CREATE",
 	"ADD",
 	"DROP",
@@ -258,12 +288,17 @@ var yyToknames = [...]string{
 	"ORDER",
 	"GROUP",
 	"HAVING",
+	"QUALIFY",
 	"BY",
 	"ASC",
 	"DESC",
 	"LIMIT",
 	"OFFSET",
 	"PERCENT",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
 	"JOIN",
 	"INNER",
 	"OUTER",
@@ -275,6 +310,8 @@ var yyToknames = [...]string{
 	"USING",
 	"NATURAL",
 	"LATERAL",
+	"PIVOT",
+	"UNPIVOT",
 	"UNION",
 	"INTERSECT",
 	"EXCEPT",
@@ -341,10 +378,15 @@ var yyToknames = [...]string{
 	"AGGREGATE",
 	"BEGIN",
 	"RETURN",
+	"TRY",
+	"CATCH",
 	"IGNORE",
 	"WITHIN",
+	"FILTER",
 	"VAR",
 	"SHOW",
+	"EXPLAIN",
+	"ANALYZE",
 	"TIES",
 	"NULLS",
 	"ROWS",
@@ -354,11 +396,19 @@ var yyToknames = [...]string{
 	"JSONL",
 	"FIXED",
 	"LTSV",
+	"XLSX",
+	"PARQUET",
+	"XML",
+	"YAML",
 	"CSV_INLINE",
 	"JSON_INLINE",
 	"JSON_TABLE",
 	"JSON_ROW",
+	"INTERVAL",
+	"ARRAY",
+	"UNNEST",
 	"SUBSTRING",
+	"EXTRACT",
 	"COUNT",
 	"JSON_OBJECT",
 	"AGGREGATE_FUNCTION",
@@ -381,6 +431,9 @@ var yyToknames = [...]string{
 	"'!'",
 	"'('",
 	"')'",
+	"'['",
+	"']'",
+	"EMPTY_WITH_CLAUSE",
 	"','",
 	"'.'",
 }
@@ -391,7 +444,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line lib/parser/parser.y:2837
+//line lib/parser/parser.y:3342
 
 func SetDebugLevel(level int, verbose bool) {
 	yyDebug = level
@@ -406,1428 +459,1981 @@ func Parse(s string, sourceFile string, forPrepared bool, ansiQuotes bool) ([]St
 }
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 0,
 	1, 1,
-	-2, 221,
+	-2, 250,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 21,
-	1, 26,
-	92, 26,
-	94, 26,
-	96, 26,
-	98, 26,
-	164, 26,
-	-2, 240,
-	-1, 27,
-	68, 189,
-	69, 189,
-	70, 189,
-	-2, 201,
-	-1, 35,
-	1, 78,
-	92, 78,
-	94, 78,
-	96, 78,
-	98, 78,
-	164, 78,
-	-2, 253,
-	-1, 61,
-	68, 190,
-	69, 190,
-	70, 190,
-	-2, 245,
-	-1, 123,
-	22, 221,
-	25, 221,
-	27, 221,
+	-1, 22,
+	1, 27,
+	102, 27,
+	104, 27,
+	106, 27,
+	108, 27,
+	187, 27,
+	-2, 270,
+	-1, 29,
+	78, 205,
+	79, 205,
+	80, 205,
+	-2, 230,
+	-1, 38,
+	1, 83,
+	102, 83,
+	104, 83,
+	106, 83,
+	108, 83,
+	187, 83,
+	-2, 284,
+	-1, 65,
+	78, 206,
+	79, 206,
+	80, 206,
+	-2, 275,
+	-1, 151,
+	22, 250,
+	25, 250,
+	27, 250,
 	-2, 1,
-	-1, 137,
-	68, 189,
-	69, 189,
-	70, 189,
-	-2, 201,
-	-1, 177,
-	1, 123,
-	92, 123,
-	94, 123,
-	96, 123,
-	98, 123,
-	164, 123,
-	-2, 234,
-	-1, 178,
-	1, 164,
-	92, 164,
-	94, 164,
-	96, 164,
-	98, 164,
-	164, 164,
-	-2, 240,
-	-1, 183,
-	1, 157,
-	92, 157,
-	94, 157,
-	96, 157,
-	98, 157,
-	164, 157,
-	-2, 240,
-	-1, 184,
-	1, 158,
-	92, 158,
-	94, 158,
-	96, 158,
-	98, 158,
-	164, 158,
-	-2, 240,
-	-1, 185,
-	1, 159,
-	92, 159,
-	94, 159,
-	96, 159,
-	98, 159,
-	164, 159,
-	-2, 240,
-	-1, 186,
-	1, 162,
-	92, 162,
-	94, 162,
-	96, 162,
-	98, 162,
-	164, 162,
-	-2, 234,
-	-1, 187,
-	1, 163,
-	92, 163,
-	94, 163,
-	96, 163,
-	98, 163,
-	164, 163,
-	-2, 240,
-	-1, 190,
-	1, 170,
-	92, 170,
-	94, 170,
-	96, 170,
-	98, 170,
-	164, 170,
-	-2, 234,
-	-1, 191,
+	-1, 165,
+	108, 1,
+	-2, 250,
+	-1, 168,
+	78, 205,
+	79, 205,
+	80, 205,
+	-2, 230,
+	-1, 214,
+	1, 137,
+	102, 137,
+	104, 137,
+	106, 137,
+	108, 137,
+	187, 137,
+	-2, 264,
+	-1, 215,
+	1, 178,
+	102, 178,
+	104, 178,
+	106, 178,
+	108, 178,
+	187, 178,
+	-2, 270,
+	-1, 220,
 	1, 171,
-	92, 171,
-	94, 171,
-	96, 171,
-	98, 171,
-	164, 171,
-	-2, 240,
-	-1, 257,
-	92, 1,
-	96, 1,
-	98, 1,
-	-2, 221,
-	-1, 282,
-	172, 362,
-	-2, 489,
-	-1, 283,
-	172, 363,
-	-2, 490,
-	-1, 284,
-	172, 364,
-	-2, 491,
-	-1, 285,
-	172, 365,
-	-2, 492,
-	-1, 286,
-	172, 366,
-	-2, 493,
-	-1, 297,
-	57, 510,
-	-2, 425,
+	102, 171,
+	104, 171,
+	106, 171,
+	108, 171,
+	187, 171,
+	-2, 270,
+	-1, 221,
+	1, 172,
+	102, 172,
+	104, 172,
+	106, 172,
+	108, 172,
+	187, 172,
+	-2, 270,
+	-1, 222,
+	1, 173,
+	102, 173,
+	104, 173,
+	106, 173,
+	108, 173,
+	187, 173,
+	-2, 270,
+	-1, 223,
+	1, 176,
+	102, 176,
+	104, 176,
+	106, 176,
+	108, 176,
+	187, 176,
+	-2, 264,
+	-1, 224,
+	1, 177,
+	102, 177,
+	104, 177,
+	106, 177,
+	108, 177,
+	187, 177,
+	-2, 270,
+	-1, 227,
+	1, 184,
+	102, 184,
+	104, 184,
+	106, 184,
+	108, 184,
+	187, 184,
+	-2, 264,
+	-1, 228,
+	1, 185,
+	102, 185,
+	104, 185,
+	106, 185,
+	108, 185,
+	187, 185,
+	-2, 270,
+	-1, 302,
+	102, 1,
+	106, 1,
+	108, 1,
+	-2, 250,
+	-1, 328,
+	195, 402,
+	-2, 574,
+	-1, 329,
+	195, 403,
+	-2, 575,
+	-1, 330,
+	195, 404,
+	-2, 576,
+	-1, 331,
+	195, 405,
+	-2, 577,
+	-1, 332,
+	195, 406,
+	-2, 578,
+	-1, 333,
+	195, 407,
+	-2, 579,
 	-1, 334,
-	4, 145,
-	138, 145,
-	139, 145,
-	140, 145,
-	142, 145,
-	143, 145,
-	144, 145,
-	145, 145,
-	146, 145,
-	-2, 240,
+	195, 408,
+	-2, 580,
 	-1, 335,
-	4, 146,
-	138, 146,
-	139, 146,
-	140, 146,
-	142, 146,
-	143, 146,
-	144, 146,
-	145, 146,
-	146, 146,
-	-2, 240,
-	-1, 345,
-	1, 175,
-	92, 175,
-	94, 175,
-	96, 175,
-	98, 175,
-	164, 175,
-	-2, 240,
-	-1, 352,
-	98, 4,
-	-2, 221,
-	-1, 371,
-	74, 0,
-	78, 0,
-	79, 0,
-	80, 0,
-	159, 0,
-	165, 0,
-	-2, 281,
-	-1, 372,
-	74, 0,
-	78, 0,
-	79, 0,
-	80, 0,
-	159, 0,
-	165, 0,
-	-2, 283,
-	-1, 381,
-	74, 0,
-	78, 0,
-	79, 0,
-	80, 0,
-	159, 0,
-	165, 0,
-	-2, 293,
-	-1, 420,
-	98, 1,
-	-2, 221,
-	-1, 427,
-	1, 211,
-	55, 211,
-	83, 211,
-	92, 211,
-	94, 211,
-	96, 211,
-	98, 211,
-	101, 211,
-	141, 211,
-	164, 211,
-	173, 211,
-	-2, 240,
-	-1, 428,
-	1, 216,
-	92, 216,
-	94, 216,
-	96, 216,
-	98, 216,
-	101, 216,
-	102, 216,
-	164, 216,
-	173, 216,
-	-2, 240,
-	-1, 459,
-	68, 190,
-	69, 190,
-	70, 190,
-	-2, 382,
-	-1, 480,
-	1, 80,
-	92, 80,
-	94, 80,
-	96, 80,
-	98, 80,
-	164, 80,
-	-2, 240,
-	-1, 481,
-	1, 81,
-	92, 81,
-	94, 81,
-	96, 81,
-	98, 81,
-	164, 81,
-	-2, 234,
-	-1, 482,
-	1, 82,
-	92, 82,
-	94, 82,
-	96, 82,
-	98, 82,
-	164, 82,
-	-2, 240,
-	-1, 483,
-	1, 83,
-	92, 83,
-	94, 83,
-	96, 83,
-	98, 83,
-	164, 83,
-	-2, 234,
-	-1, 484,
-	1, 150,
-	92, 150,
-	94, 150,
-	96, 150,
-	98, 150,
-	164, 150,
-	-2, 234,
+	195, 409,
+	-2, 581,
+	-1, 336,
+	195, 410,
+	-2, 582,
+	-1, 349,
+	65, 613,
+	-2, 483,
+	-1, 392,
+	84, 270,
+	85, 270,
+	86, 270,
+	87, 270,
+	88, 270,
+	89, 270,
+	90, 270,
+	182, 270,
+	183, 270,
+	188, 270,
+	189, 270,
+	190, 270,
+	191, 270,
+	192, 270,
+	193, 270,
+	-2, 159,
+	-1, 393,
+	84, 270,
+	85, 270,
+	86, 270,
+	87, 270,
+	88, 270,
+	89, 270,
+	90, 270,
+	182, 270,
+	183, 270,
+	188, 270,
+	189, 270,
+	190, 270,
+	191, 270,
+	192, 270,
+	193, 270,
+	-2, 160,
+	-1, 404,
+	1, 191,
+	102, 191,
+	104, 191,
+	106, 191,
+	108, 191,
+	187, 191,
+	-2, 270,
+	-1, 411,
+	108, 4,
+	-2, 250,
+	-1, 431,
+	84, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	182, 0,
+	188, 0,
+	-2, 312,
+	-1, 432,
+	84, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	182, 0,
+	188, 0,
+	-2, 314,
+	-1, 441,
+	84, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	182, 0,
+	188, 0,
+	-2, 324,
 	-1, 485,
-	1, 151,
-	92, 151,
-	94, 151,
-	96, 151,
-	98, 151,
-	164, 151,
-	-2, 240,
-	-1, 486,
-	1, 152,
-	92, 152,
-	94, 152,
-	96, 152,
-	98, 152,
-	164, 152,
-	-2, 234,
-	-1, 487,
-	1, 153,
-	92, 153,
-	94, 153,
-	96, 153,
-	98, 153,
-	164, 153,
-	-2, 240,
-	-1, 490,
-	1, 118,
-	92, 118,
-	94, 118,
-	96, 118,
-	98, 118,
-	164, 118,
-	174, 118,
-	-2, 240,
-	-1, 495,
-	1, 423,
-	92, 423,
-	94, 423,
-	96, 423,
-	98, 423,
-	164, 423,
-	-2, 240,
-	-1, 502,
-	1, 176,
-	92, 176,
-	94, 176,
-	96, 176,
-	98, 176,
-	164, 176,
-	-2, 240,
-	-1, 534,
-	74, 0,
-	78, 0,
-	79, 0,
-	80, 0,
-	159, 0,
-	165, 0,
-	-2, 294,
+	108, 1,
+	-2, 250,
+	-1, 493,
+	1, 240,
+	37, 240,
+	59, 240,
+	93, 240,
+	102, 240,
+	104, 240,
+	106, 240,
+	108, 240,
+	111, 240,
+	156, 240,
+	187, 240,
+	196, 240,
+	-2, 270,
+	-1, 494,
+	1, 245,
+	37, 245,
+	102, 245,
+	104, 245,
+	106, 245,
+	108, 245,
+	111, 245,
+	112, 245,
+	187, 245,
+	196, 245,
+	-2, 270,
+	-1, 529,
+	78, 206,
+	79, 206,
+	80, 206,
+	-2, 427,
+	-1, 558,
+	1, 85,
+	102, 85,
+	104, 85,
+	106, 85,
+	108, 85,
+	187, 85,
+	-2, 270,
+	-1, 559,
+	1, 86,
+	102, 86,
+	104, 86,
+	106, 86,
+	108, 86,
+	187, 86,
+	-2, 264,
 	-1, 560,
-	98, 1,
-	-2, 221,
-	-1, 567,
-	94, 1,
-	96, 1,
-	98, 1,
-	-2, 221,
-	-1, 597,
-	173, 358,
-	174, 358,
-	-2, 234,
-	-1, 615,
-	57, 510,
-	-2, 385,
-	-1, 655,
-	22, 221,
-	25, 221,
-	27, 221,
-	-2, 4,
-	-1, 658,
-	98, 4,
-	-2, 221,
-	-1, 659,
-	98, 4,
-	-2, 221,
+	1, 87,
+	102, 87,
+	104, 87,
+	106, 87,
+	108, 87,
+	187, 87,
+	-2, 270,
+	-1, 561,
+	1, 88,
+	102, 88,
+	104, 88,
+	106, 88,
+	108, 88,
+	187, 88,
+	-2, 264,
+	-1, 562,
+	1, 164,
+	102, 164,
+	104, 164,
+	106, 164,
+	108, 164,
+	187, 164,
+	-2, 264,
+	-1, 563,
+	1, 165,
+	102, 165,
+	104, 165,
+	106, 165,
+	108, 165,
+	187, 165,
+	-2, 270,
+	-1, 564,
+	1, 166,
+	102, 166,
+	104, 166,
+	106, 166,
+	108, 166,
+	187, 166,
+	-2, 264,
+	-1, 565,
+	1, 167,
+	102, 167,
+	104, 167,
+	106, 167,
+	108, 167,
+	187, 167,
+	-2, 270,
+	-1, 568,
+	1, 132,
+	102, 132,
+	104, 132,
+	106, 132,
+	108, 132,
+	187, 132,
+	200, 132,
+	-2, 270,
+	-1, 573,
+	1, 481,
+	102, 481,
+	104, 481,
+	106, 481,
+	108, 481,
+	187, 481,
+	-2, 270,
+	-1, 580,
+	1, 192,
+	102, 192,
+	104, 192,
+	106, 192,
+	108, 192,
+	187, 192,
+	-2, 270,
+	-1, 614,
+	84, 0,
+	88, 0,
+	89, 0,
+	90, 0,
+	182, 0,
+	188, 0,
+	-2, 325,
+	-1, 645,
+	108, 1,
+	-2, 250,
+	-1, 652,
+	104, 1,
+	106, 1,
+	108, 1,
+	-2, 250,
 	-1, 684,
-	173, 263,
-	174, 263,
-	-2, 190,
-	-1, 760,
-	92, 4,
-	96, 4,
-	98, 4,
-	-2, 221,
-	-1, 765,
-	98, 4,
-	-2, 221,
-	-1, 766,
-	98, 4,
-	-2, 221,
-	-1, 792,
-	92, 1,
-	96, 1,
-	98, 1,
-	-2, 221,
-	-1, 829,
-	20, 521,
-	83, 521,
-	172, 521,
-	-2, 87,
-	-1, 837,
-	1, 95,
-	92, 95,
-	94, 95,
-	96, 95,
-	98, 95,
-	164, 95,
-	-2, 234,
-	-1, 838,
-	1, 96,
-	92, 96,
-	94, 96,
-	96, 96,
-	98, 96,
-	164, 96,
-	-2, 240,
-	-1, 842,
-	98, 6,
-	-2, 221,
-	-1, 848,
-	173, 129,
-	174, 129,
-	-2, 240,
-	-1, 853,
-	98, 4,
-	-2, 221,
-	-1, 923,
-	98, 6,
-	-2, 221,
-	-1, 924,
-	98, 6,
-	-2, 221,
-	-1, 928,
-	98, 4,
-	-2, 221,
-	-1, 932,
-	94, 4,
-	96, 4,
-	98, 4,
-	-2, 221,
-	-1, 973,
-	22, 221,
-	25, 221,
-	27, 221,
+	196, 398,
+	200, 398,
+	-2, 264,
+	-1, 705,
+	65, 613,
+	-2, 434,
+	-1, 759,
+	22, 250,
+	25, 250,
+	27, 250,
+	-2, 4,
+	-1, 762,
+	108, 4,
+	-2, 250,
+	-1, 763,
+	108, 4,
+	-2, 250,
+	-1, 764,
+	108, 4,
+	-2, 250,
+	-1, 794,
+	196, 294,
+	200, 294,
+	-2, 206,
+	-1, 890,
+	102, 4,
+	106, 4,
+	108, 4,
+	-2, 250,
+	-1, 896,
+	108, 4,
+	-2, 250,
+	-1, 897,
+	108, 4,
+	-2, 250,
+	-1, 927,
+	102, 1,
+	106, 1,
+	108, 1,
+	-2, 250,
+	-1, 931,
+	108, 1,
+	-2, 250,
+	-1, 977,
+	20, 624,
+	93, 624,
+	195, 624,
+	-2, 92,
+	-1, 988,
+	1, 100,
+	102, 100,
+	104, 100,
+	106, 100,
+	108, 100,
+	187, 100,
+	-2, 264,
+	-1, 989,
+	1, 101,
+	102, 101,
+	104, 101,
+	106, 101,
+	108, 101,
+	187, 101,
+	-2, 270,
+	-1, 993,
+	108, 6,
+	-2, 250,
+	-1, 999,
+	196, 143,
+	200, 143,
+	-2, 270,
+	-1, 1004,
+	108, 4,
+	-2, 250,
+	-1, 1093,
+	108, 6,
+	-2, 250,
+	-1, 1094,
+	108, 6,
+	-2, 250,
+	-1, 1098,
+	108, 4,
+	-2, 250,
+	-1, 1102,
+	104, 4,
+	106, 4,
+	108, 4,
+	-2, 250,
+	-1, 1160,
+	22, 250,
+	25, 250,
+	27, 250,
 	-2, 6,
-	-1, 980,
-	164, 62,
-	-2, 240,
-	-1, 1014,
-	92, 6,
-	96, 6,
-	98, 6,
-	-2, 221,
-	-1, 1017,
-	98, 8,
-	-2, 221,
-	-1, 1024,
-	98, 6,
-	-2, 221,
-	-1, 1027,
-	92, 4,
-	96, 4,
-	98, 4,
-	-2, 221,
-	-1, 1041,
-	98, 6,
-	-2, 221,
-	-1, 1066,
-	98, 6,
-	-2, 221,
-	-1, 1070,
-	94, 6,
-	96, 6,
-	98, 6,
-	-2, 221,
-	-1, 1072,
-	22, 221,
-	25, 221,
-	27, 221,
+	-1, 1163,
+	108, 6,
+	-2, 250,
+	-1, 1168,
+	187, 65,
+	-2, 270,
+	-1, 1216,
+	102, 6,
+	106, 6,
+	108, 6,
+	-2, 250,
+	-1, 1220,
+	108, 8,
+	-2, 250,
+	-1, 1227,
+	108, 6,
+	-2, 250,
+	-1, 1230,
+	102, 4,
+	106, 4,
+	108, 4,
+	-2, 250,
+	-1, 1233,
+	108, 4,
+	-2, 250,
+	-1, 1256,
+	108, 6,
+	-2, 250,
+	-1, 1294,
+	108, 6,
+	-2, 250,
+	-1, 1298,
+	104, 6,
+	106, 6,
+	108, 6,
+	-2, 250,
+	-1, 1301,
+	22, 250,
+	25, 250,
+	27, 250,
 	-2, 8,
-	-1, 1075,
-	98, 8,
-	-2, 221,
-	-1, 1076,
-	98, 8,
-	-2, 221,
-	-1, 1095,
-	92, 8,
-	96, 8,
-	98, 8,
-	-2, 221,
-	-1, 1100,
-	98, 8,
-	-2, 221,
-	-1, 1101,
-	98, 8,
-	-2, 221,
-	-1, 1112,
-	92, 6,
-	96, 6,
-	98, 6,
-	-2, 221,
-	-1, 1117,
-	98, 8,
-	-2, 221,
-	-1, 1132,
-	98, 8,
-	-2, 221,
-	-1, 1136,
-	94, 8,
-	96, 8,
-	98, 8,
-	-2, 221,
-	-1, 1156,
-	92, 8,
-	96, 8,
-	98, 8,
-	-2, 221,
+	-1, 1304,
+	108, 8,
+	-2, 250,
+	-1, 1305,
+	108, 8,
+	-2, 250,
+	-1, 1306,
+	108, 8,
+	-2, 250,
+	-1, 1336,
+	102, 8,
+	106, 8,
+	108, 8,
+	-2, 250,
+	-1, 1342,
+	108, 8,
+	-2, 250,
+	-1, 1343,
+	108, 8,
+	-2, 250,
+	-1, 1360,
+	102, 6,
+	106, 6,
+	108, 6,
+	-2, 250,
+	-1, 1363,
+	108, 6,
+	-2, 250,
+	-1, 1366,
+	108, 8,
+	-2, 250,
+	-1, 1387,
+	108, 8,
+	-2, 250,
+	-1, 1391,
+	104, 8,
+	106, 8,
+	108, 8,
+	-2, 250,
+	-1, 1419,
+	102, 8,
+	106, 8,
+	108, 8,
+	-2, 250,
+	-1, 1422,
+	108, 8,
+	-2, 250,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 4162
+const yyLast = 8168
 
-var yyAct = [...]int{
-	126, 61, 1131, 1096, 1130, 1065, 1104, 1043, 1064, 511,
-	927, 429, 503, 761, 1015, 203, 926, 68, 813, 623,
-	311, 132, 559, 863, 204, 734, 739, 265, 682, 143,
-	643, 939, 301, 862, 626, 510, 26, 646, 292, 605,
-	645, 589, 614, 697, 266, 262, 146, 494, 861, 639,
-	99, 156, 156, 573, 159, 488, 610, 263, 275, 135,
-	740, 444, 558, 364, 509, 25, 449, 448, 296, 367,
-	289, 273, 85, 84, 188, 61, 550, 358, 1, 27,
-	247, 211, 236, 72, 153, 235, 337, 303, 255, 1018,
-	236, 112, 78, 528, 202, 197, 235, 215, 1054, 353,
-	343, 250, 236, 901, 902, 235, 111, 80, 137, 80,
-	903, 904, 887, 888, 753, 754, 80, 517, 1061, 157,
-	122, 165, 710, 711, 61, 1037, 61, 833, 822, 786,
-	134, 21, 181, 452, 80, 453, 454, 455, 447, 751,
-	750, 450, 112, 959, 747, 143, 258, 731, 728, 305,
-	727, 712, 80, 261, 124, 80, 80, 707, 80, 26,
-	80, 905, 653, 294, 884, 295, 650, 103, 580, 270,
-	79, 526, 79, 354, 178, 442, 194, 179, 180, 79,
-	183, 184, 185, 187, 436, 191, 194, 362, 25, 354,
-	317, 1036, 291, 236, 708, 1034, 235, 79, 354, 354,
-	143, 290, 256, 1033, 1032, 196, 112, 201, 452, 342,
-	453, 454, 455, 447, 1030, 79, 450, 354, 79, 79,
-	357, 79, 356, 79, 380, 113, 114, 115, 274, 116,
-	117, 118, 119, 120, 80, 122, 140, 913, 312, 142,
-	315, 139, 1012, 121, 141, 1011, 380, 380, 627, 451,
-	1010, 80, 316, 1009, 21, 1005, 196, 1000, 61, 144,
-	586, 144, 599, 505, 3, 379, 524, 998, 144, 227,
-	226, 228, 229, 230, 378, 997, 113, 114, 115, 137,
-	116, 117, 118, 119, 120, 996, 144, 360, 361, 305,
-	121, 995, 297, 26, 971, 952, 405, 406, 432, 950,
-	1050, 373, 398, 459, 144, 334, 335, 144, 144, 144,
-	144, 150, 379, 80, 79, 949, 938, 925, 433, 889,
-	886, 859, 25, 619, 345, 835, 127, 35, 832, 642,
-	443, 829, 826, 810, 394, 156, 416, 399, 400, 401,
-	113, 114, 115, 803, 116, 117, 118, 119, 120, 785,
-	768, 749, 746, 61, 730, 709, 675, 440, 674, 143,
-	434, 143, 143, 673, 672, 501, 670, 636, 548, 600,
-	515, 547, 295, 546, 633, 439, 541, 380, 539, 553,
-	61, 537, 477, 380, 380, 463, 144, 3, 21, 625,
-	499, 500, 417, 493, 350, 424, 464, 112, 427, 428,
-	197, 551, 473, 587, 1049, 351, 217, 525, 380, 552,
-	552, 552, 227, 226, 228, 229, 230, 349, 103, 961,
-	953, 61, 951, 496, 497, 947, 937, 533, 907, 498,
-	893, 871, 869, 535, 536, 143, 868, 867, 520, 865,
-	520, 520, 305, 839, 523, 782, 530, 529, 780, 779,
-	35, 519, 305, 521, 522, 149, 26, 770, 549, 480,
-	482, 485, 487, 490, 713, 144, 685, 662, 490, 495,
-	544, 622, 585, 495, 495, 479, 143, 502, 143, 601,
-	228, 229, 230, 21, 617, 25, 554, 555, 478, 462,
-	438, 437, 556, 154, 149, 260, 648, 595, 254, 563,
-	244, 290, 243, 465, 242, 604, 241, 652, 593, 295,
-	602, 240, 239, 603, 238, 237, 331, 613, 612, 1072,
-	973, 3, 655, 476, 274, 123, 249, 318, 194, 629,
-	657, 113, 114, 115, 411, 116, 117, 118, 119, 120,
-	574, 699, 591, 329, 1060, 783, 578, 781, 701, 145,
-	798, 21, 684, 679, 1024, 924, 778, 923, 842, 677,
-	877, 61, 570, 875, 259, 630, 776, 864, 61, 596,
-	426, 1101, 624, 575, 680, 663, 632, 634, 777, 154,
-	678, 320, 775, 687, 35, 103, 774, 380, 143, 771,
-	664, 745, 700, 621, 676, 458, 26, 579, 666, 698,
-	669, 1004, 683, 26, 962, 584, 412, 705, 245, 305,
-	305, 475, 686, 425, 246, 704, 225, 305, 1155, 1145,
-	714, 161, 690, 1140, 576, 25, 1139, 143, 718, 571,
-	1134, 1120, 25, 1119, 319, 330, 706, 683, 656, 691,
-	1111, 715, 729, 1087, 716, 1079, 695, 703, 1071, 726,
-	172, 173, 1068, 742, 1026, 1023, 61, 1022, 984, 61,
-	61, 972, 328, 143, 321, 322, 936, 935, 759, 930,
-	725, 763, 764, 856, 160, 732, 855, 791, 689, 35,
-	162, 654, 380, 564, 3, 562, 1133, 1100, 271, 1076,
-	1132, 21, 692, 1075, 624, 1067, 1017, 766, 21, 1066,
-	696, 757, 765, 659, 163, 929, 755, 624, 658, 928,
-	1132, 561, 352, 1117, 310, 560, 248, 170, 171, 174,
-	175, 305, 1066, 305, 305, 305, 1041, 928, 305, 624,
-	853, 560, 784, 809, 422, 420, 1156, 1136, 112, 1112,
-	624, 794, 797, 802, 615, 804, 1095, 35, 811, 805,
-	796, 828, 793, 812, 1070, 816, 1027, 1014, 28, 932,
-	617, 61, 792, 760, 567, 461, 61, 61, 648, 847,
-	823, 808, 648, 851, 257, 1158, 1114, 1097, 857, 858,
-	1029, 490, 1016, 795, 495, 850, 21, 380, 844, 21,
-	21, 845, 846, 61, 762, 418, 264, 841, 1152, 1151,
-	1138, 393, 395, 873, 143, 1137, 873, 402, 403, 404,
-	1093, 991, 990, 872, 591, 878, 876, 934, 933, 305,
-	624, 305, 305, 305, 3, 758, 1159, 143, 26, 874,
-	1133, 3, 1067, 199, 883, 894, 895, 683, 624, 891,
-	882, 143, 929, 61, 830, 831, 561, 1154, 1128, 1110,
-	1057, 896, 1025, 897, 61, 617, 880, 25, 909, 825,
-	910, 790, 314, 1149, 908, 900, 931, 1091, 1105, 1105,
-	1084, 881, 113, 114, 115, 838, 116, 117, 118, 119,
-	120, 472, 988, 848, 199, 380, 693, 35, 911, 1127,
-	143, 21, 1109, 854, 35, 873, 21, 21, 1125, 1126,
-	305, 719, 721, 199, 955, 948, 380, 1153, 1124, 143,
-	1108, 956, 1107, 957, 942, 964, 944, 945, 946, 788,
-	109, 216, 249, 21, 61, 61, 424, 969, 467, 61,
-	1123, 681, 965, 61, 968, 683, 359, 978, 143, 977,
-	975, 986, 1082, 963, 985, 989, 979, 1142, 1103, 1083,
-	1106, 1106, 1085, 376, 1055, 1019, 683, 375, 377, 538,
-	380, 518, 994, 408, 542, 543, 545, 407, 355, 628,
-	410, 409, 209, 21, 61, 383, 382, 873, 890, 999,
-	827, 1007, 35, 338, 21, 35, 35, 1002, 1006, 208,
-	209, 210, 110, 814, 815, 1021, 332, 1020, 611, 821,
-	1001, 724, 594, 1028, 452, 1031, 453, 454, 455, 447,
-	683, 723, 450, 143, 624, 61, 817, 819, 61, 452,
-	615, 453, 454, 455, 609, 61, 608, 1051, 61, 452,
-	268, 453, 454, 267, 268, 993, 941, 143, 380, 607,
-	1058, 269, 61, 606, 870, 445, 136, 940, 974, 744,
-	743, 339, 976, 980, 21, 21, 3, 752, 741, 21,
-	987, 199, 380, 21, 800, 801, 1063, 61, 624, 1080,
-	1074, 61, 152, 61, 1088, 151, 61, 61, 69, 112,
-	1094, 415, 1051, 1098, 1099, 1051, 1051, 35, 683, 214,
-	1086, 983, 35, 35, 627, 860, 61, 735, 736, 737,
-	738, 61, 61, 1115, 21, 1051, 915, 1113, 1121, 1122,
-	1051, 1051, 683, 61, 898, 615, 164, 166, 61, 35,
-	849, 843, 840, 748, 651, 1135, 491, 1051, 287, 272,
-	1143, 293, 148, 61, 1035, 1144, 1146, 61, 199, 147,
-	1147, 435, 1051, 920, 1150, 21, 1051, 1042, 21, 1008,
-	702, 568, 148, 441, 1157, 21, 341, 61, 21, 340,
-	854, 981, 982, 1161, 1160, 336, 1051, 104, 471, 35,
-	107, 5, 21, 107, 104, 103, 207, 492, 1073, 313,
-	35, 468, 469, 213, 71, 70, 155, 915, 915, 1116,
-	470, 1040, 852, 199, 419, 10, 9, 21, 1090, 590,
-	8, 21, 7, 21, 421, 65, 21, 21, 365, 299,
-	298, 1013, 304, 113, 114, 115, 306, 116, 117, 118,
-	119, 120, 276, 281, 920, 920, 21, 1141, 1118, 1102,
-	1081, 21, 21, 1059, 199, 64, 199, 915, 94, 63,
-	62, 67, 59, 21, 66, 1042, 198, 919, 21, 60,
-	35, 35, 1039, 799, 581, 35, 430, 58, 212, 35,
-	86, 577, 1056, 21, 1148, 572, 569, 21, 6, 20,
-	19, 73, 169, 17, 920, 647, 807, 644, 915, 1069,
-	16, 1045, 489, 15, 14, 133, 11, 21, 915, 1118,
-	18, 13, 12, 1046, 916, 1044, 112, 198, 392, 914,
-	35, 506, 504, 4, 1089, 915, 2, 0, 1092, 0,
-	199, 0, 0, 0, 189, 920, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 920, 0, 0, 919, 919,
-	915, 0, 29, 0, 915, 195, 1045, 0, 0, 1045,
-	1045, 35, 920, 0, 35, 0, 199, 0, 233, 234,
-	1129, 35, 0, 0, 35, 0, 0, 0, 0, 1045,
-	0, 138, 251, 252, 1045, 1045, 0, 920, 35, 0,
-	0, 920, 112, 0, 0, 0, 915, 0, 919, 0,
-	0, 1045, 0, 0, 0, 199, 195, 0, 0, 0,
-	0, 0, 133, 35, 0, 0, 1045, 35, 0, 35,
-	1045, 122, 35, 35, 0, 0, 0, 200, 0, 0,
-	189, 0, 0, 920, 0, 0, 0, 0, 0, 919,
-	1045, 199, 35, 0, 0, 0, 0, 35, 35, 919,
-	113, 114, 115, 0, 116, 117, 118, 119, 120, 35,
-	0, 0, 0, 0, 35, 0, 919, 0, 0, 0,
-	0, 0, 0, 958, 112, 347, 0, 0, 200, 35,
-	369, 0, 0, 35, 0, 0, 0, 966, 0, 967,
-	0, 919, 0, 0, 198, 919, 366, 200, 370, 371,
-	372, 457, 374, 35, 0, 381, 0, 384, 385, 386,
-	387, 388, 389, 390, 0, 0, 0, 189, 396, 366,
-	0, 0, 0, 189, 189, 189, 113, 114, 115, 0,
-	116, 117, 118, 119, 120, 413, 0, 919, 0, 0,
-	0, 189, 0, 0, 0, 423, 0, 0, 0, 0,
-	431, 452, 200, 453, 454, 455, 447, 814, 815, 450,
-	0, 0, 0, 0, 0, 0, 112, 0, 0, 0,
-	0, 198, 0, 0, 446, 0, 0, 0, 0, 0,
-	279, 278, 199, 288, 0, 0, 0, 89, 0, 0,
-	0, 0, 0, 0, 0, 280, 0, 189, 0, 474,
-	0, 0, 0, 0, 0, 199, 0, 0, 113, 114,
-	115, 0, 116, 117, 118, 119, 120, 0, 0, 199,
-	112, 0, 158, 0, 0, 189, 588, 167, 168, 0,
-	176, 177, 0, 0, 279, 278, 182, 0, 0, 0,
-	186, 0, 190, 0, 192, 193, 0, 0, 300, 280,
-	0, 0, 0, 0, 0, 200, 532, 0, 534, 0,
-	189, 0, 0, 0, 0, 0, 0, 637, 199, 641,
-	0, 0, 0, 0, 0, 189, 0, 0, 0, 0,
-	189, 189, 189, 616, 0, 0, 0, 199, 0, 0,
-	0, 0, 0, 0, 0, 0, 253, 0, 0, 423,
-	113, 114, 115, 565, 116, 117, 118, 119, 120, 0,
-	0, 200, 0, 200, 200, 0, 199, 0, 189, 540,
-	0, 0, 0, 0, 0, 0, 0, 0, 277, 0,
-	277, 0, 200, 0, 0, 0, 277, 0, 277, 0,
-	277, 0, 0, 198, 0, 0, 0, 0, 323, 324,
-	326, 327, 0, 0, 113, 114, 115, 333, 282, 283,
-	284, 285, 286, 307, 308, 309, 0, 0, 0, 0,
-	0, 0, 223, 232, 231, 222, 221, 224, 220, 198,
-	0, 0, 0, 0, 0, 0, 112, 200, 302, 133,
-	0, 199, 0, 0, 107, 0, 112, 0, 0, 0,
-	0, 363, 0, 368, 0, 0, 0, 366, 0, 0,
-	279, 278, 667, 0, 0, 199, 0, 112, 733, 0,
-	0, 671, 0, 391, 0, 280, 368, 0, 200, 0,
-	200, 279, 278, 80, 0, 0, 0, 0, 688, 0,
-	0, 0, 0, 414, 0, 300, 280, 694, 0, 0,
-	0, 0, 0, 0, 767, 0, 0, 218, 217, 0,
-	277, 431, 0, 219, 227, 226, 228, 229, 230, 0,
-	0, 0, 344, 0, 0, 277, 277, 0, 223, 232,
-	231, 222, 221, 224, 220, 0, 456, 717, 189, 0,
-	277, 0, 0, 460, 0, 0, 79, 0, 0, 0,
-	0, 466, 0, 0, 200, 0, 0, 0, 223, 232,
-	231, 222, 221, 224, 220, 0, 481, 483, 484, 486,
-	113, 114, 115, 0, 116, 117, 118, 119, 120, 277,
-	113, 114, 115, 0, 116, 117, 118, 119, 120, 0,
-	200, 514, 0, 516, 0, 0, 0, 0, 112, 769,
-	392, 113, 114, 115, 0, 282, 283, 284, 285, 286,
-	307, 308, 309, 218, 217, 0, 787, 0, 0, 219,
-	227, 226, 228, 229, 230, 0, 0, 348, 344, 200,
-	0, 0, 0, 112, 0, 302, 0, 0, 0, 806,
-	0, 0, 189, 218, 217, 885, 112, 279, 278, 219,
-	227, 226, 228, 229, 230, 112, 0, 0, 879, 0,
-	0, 300, 280, 0, 0, 200, 0, 0, 906, 279,
-	278, 0, 834, 592, 277, 325, 597, 0, 0, 277,
-	277, 0, 912, 300, 280, 0, 0, 0, 0, 277,
-	0, 0, 0, 423, 0, 618, 899, 460, 0, 620,
-	0, 0, 0, 592, 866, 0, 631, 592, 592, 635,
-	0, 0, 0, 638, 640, 0, 0, 649, 820, 0,
-	0, 0, 223, 232, 231, 222, 221, 224, 220, 0,
-	0, 960, 113, 114, 115, 0, 116, 117, 118, 119,
-	120, 112, 0, 0, 0, 892, 0, 0, 103, 773,
-	970, 0, 0, 0, 0, 660, 661, 0, 0, 112,
-	0, 0, 0, 640, 368, 665, 391, 113, 114, 115,
-	0, 282, 283, 284, 285, 286, 307, 308, 309, 992,
-	113, 114, 115, 0, 116, 117, 118, 119, 120, 113,
-	114, 115, 0, 282, 283, 284, 285, 286, 307, 308,
-	309, 302, 0, 0, 0, 0, 200, 218, 217, 0,
-	954, 0, 0, 219, 227, 226, 228, 229, 230, 189,
-	0, 772, 0, 302, 0, 592, 0, 0, 0, 200,
-	0, 0, 0, 189, 0, 189, 0, 0, 592, 0,
-	0, 0, 0, 200, 0, 0, 277, 277, 0, 133,
-	0, 0, 0, 0, 1038, 0, 0, 0, 0, 0,
-	592, 0, 0, 640, 0, 0, 0, 0, 631, 0,
-	0, 592, 189, 0, 0, 113, 114, 115, 1062, 116,
-	117, 118, 119, 120, 0, 0, 0, 0, 0, 756,
-	0, 0, 200, 113, 114, 115, 0, 116, 117, 118,
-	119, 120, 0, 0, 0, 0, 0, 0, 112, 0,
-	0, 200, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 278, 431, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 300, 280, 223, 232,
-	200, 222, 221, 224, 220, 592, 0, 423, 0, 0,
-	277, 592, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 277, 277, 0, 0, 277, 824, 0, 0, 592,
-	0, 818, 0, 0, 0, 592, 592, 0, 0, 133,
-	0, 836, 837, 0, 0, 640, 0, 0, 112, 81,
-	82, 83, 0, 109, 0, 103, 107, 104, 105, 22,
-	75, 106, 0, 0, 80, 0, 0, 37, 38, 0,
-	0, 0, 0, 0, 30, 200, 0, 122, 0, 31,
-	46, 0, 32, 218, 217, 0, 0, 0, 423, 219,
-	227, 226, 228, 229, 230, 0, 0, 0, 0, 200,
-	0, 0, 113, 114, 115, 0, 282, 283, 284, 285,
-	286, 307, 308, 309, 0, 0, 0, 100, 0, 277,
-	277, 101, 0, 0, 0, 110, 112, 79, 0, 0,
-	0, 631, 0, 0, 1048, 1047, 302, 921, 0, 0,
-	279, 278, 0, 34, 108, 0, 41, 39, 40, 36,
-	42, 0, 0, 0, 300, 280, 0, 0, 44, 45,
-	512, 513, 0, 49, 50, 51, 52, 43, 54, 55,
-	56, 47, 53, 57, 0, 0, 0, 922, 0, 0,
-	33, 48, 113, 114, 115, 0, 116, 117, 118, 119,
-	120, 640, 0, 0, 121, 90, 93, 91, 92, 95,
-	96, 97, 98, 0, 0, 592, 0, 0, 0, 0,
-	87, 88, 0, 0, 0, 102, 74, 0, 0, 0,
-	0, 0, 0, 112, 81, 82, 83, 0, 109, 0,
-	103, 107, 104, 105, 22, 75, 106, 0, 0, 80,
-	0, 0, 37, 38, 0, 0, 0, 0, 0, 30,
-	0, 0, 122, 0, 31, 46, 0, 32, 0, 592,
-	113, 114, 115, 0, 282, 283, 284, 285, 286, 307,
-	308, 309, 0, 0, 0, 0, 0, 0, 0, 0,
+var yyAct = [...]int16{
+	154, 65, 1337, 675, 108, 1386, 1293, 1347, 1244, 1385,
+	1292, 817, 1097, 1217, 162, 792, 716, 495, 1212, 73,
+	1140, 836, 891, 1096, 644, 243, 721, 1015, 311, 11,
+	1017, 175, 960, 867, 589, 1071, 726, 242, 862, 1111,
+	859, 1016, 9, 353, 835, 120, 8, 742, 808, 7,
+	734, 832, 366, 704, 423, 747, 189, 189, 750, 196,
+	321, 175, 312, 572, 344, 749, 581, 566, 659, 166,
+	700, 307, 178, 643, 588, 27, 308, 693, 282, 426,
+	65, 319, 868, 519, 91, 348, 29, 518, 340, 90,
+	250, 292, 77, 186, 635, 254, 587, 26, 1221, 83,
+	368, 241, 121, 395, 512, 622, 276, 417, 1271, 225,
+	295, 300, 275, 276, 1064, 276, 275, 168, 1065, 275,
+	412, 607, 1259, 1066, 1043, 304, 883, 1067, 1044, 596,
+	884, 190, 238, 202, 823, 503, 1283, 522, 824, 523,
+	524, 525, 517, 401, 218, 520, 1203, 515, 516, 982,
+	978, 969, 65, 953, 65, 921, 881, 1, 880, 522,
+	877, 523, 524, 525, 517, 857, 65, 520, 853, 515,
+	516, 852, 825, 820, 306, 356, 757, 175, 85, 754,
+	171, 358, 666, 174, 605, 170, 413, 85, 172, 171,
+	510, 502, 174, 173, 170, 421, 375, 172, 235, 347,
+	625, 281, 112, 127, 1400, 1355, 303, 263, 316, 85,
+	262, 261, 264, 260, 413, 1322, 1319, 346, 1280, 1285,
+	235, 85, 164, 22, 413, 276, 27, 1282, 1241, 439,
+	1248, 275, 175, 175, 1238, 341, 413, 1237, 1236, 1234,
+	27, 821, 1211, 1209, 85, 413, 1208, 152, 26, 85,
+	343, 85, 85, 1133, 85, 85, 85, 85, 1207, 741,
+	1068, 1039, 26, 415, 440, 1202, 672, 956, 438, 215,
+	400, 521, 216, 217, 1266, 220, 221, 222, 224, 276,
+	228, 416, 84, 373, 1197, 275, 1190, 1188, 1187, 709,
+	1186, 440, 440, 1185, 84, 470, 471, 267, 266, 268,
+	269, 270, 237, 65, 240, 258, 257, 365, 1182, 301,
+	1158, 259, 267, 266, 268, 269, 270, 84, 851, 168,
+	1139, 1138, 84, 310, 84, 84, 1124, 84, 84, 84,
+	84, 1122, 1121, 1110, 460, 1095, 1045, 1042, 1011, 986,
+	358, 176, 112, 981, 977, 498, 973, 320, 948, 940,
+	920, 1265, 433, 176, 85, 529, 419, 420, 367, 369,
+	257, 372, 176, 722, 900, 899, 267, 266, 268, 269,
+	270, 1356, 454, 686, 22, 879, 237, 27, 876, 856,
+	464, 465, 466, 822, 176, 638, 231, 746, 22, 785,
+	499, 784, 783, 782, 778, 189, 176, 603, 280, 26,
+	732, 633, 453, 455, 457, 127, 461, 462, 511, 632,
+	631, 636, 65, 467, 468, 469, 624, 623, 175, 176,
+	175, 175, 621, 619, 176, 508, 176, 176, 539, 176,
+	176, 439, 673, 347, 392, 393, 617, 440, 538, 507,
+	65, 613, 482, 440, 440, 409, 410, 615, 616, 408,
+	555, 594, 1206, 526, 182, 579, 1205, 404, 530, 1082,
+	481, 532, 534, 571, 577, 578, 1135, 1125, 1123, 551,
+	1119, 1108, 1070, 440, 637, 637, 637, 634, 1056, 1052,
+	1025, 1023, 1022, 1021, 1019, 1014, 65, 990, 537, 955,
+	574, 575, 238, 954, 500, 687, 917, 915, 914, 550,
+	903, 175, 155, 38, 599, 826, 599, 599, 795, 767,
+	358, 715, 602, 506, 369, 697, 696, 598, 610, 600,
+	601, 608, 358, 609, 557, 22, 187, 720, 556, 176,
+	536, 505, 489, 268, 269, 270, 493, 494, 504, 463,
+	187, 181, 358, 305, 299, 629, 176, 677, 289, 288,
+	540, 287, 286, 285, 175, 284, 175, 280, 279, 278,
+	27, 604, 277, 294, 671, 707, 682, 389, 641, 639,
+	640, 341, 387, 689, 1301, 576, 752, 1160, 759, 151,
+	618, 376, 26, 740, 679, 740, 717, 235, 177, 347,
+	627, 628, 630, 728, 730, 690, 739, 476, 739, 691,
+	738, 761, 738, 737, 703, 737, 745, 756, 702, 558,
+	560, 563, 565, 568, 554, 664, 692, 810, 568, 573,
+	660, 181, 1278, 573, 573, 918, 916, 812, 580, 724,
+	681, 934, 532, 913, 22, 283, 1429, 794, 777, 1422,
+	1416, 793, 789, 648, 787, 1363, 65, 1344, 1233, 1194,
+	931, 1410, 768, 65, 38, 1392, 1304, 912, 290, 661,
+	769, 772, 776, 283, 291, 790, 1299, 788, 38, 1163,
+	1103, 665, 762, 440, 175, 653, 378, 793, 320, 165,
+	809, 1333, 477, 1227, 811, 1178, 1094, 771, 815, 358,
+	816, 1093, 993, 806, 1291, 1031, 775, 1029, 911, 358,
+	358, 829, 910, 909, 827, 904, 388, 358, 22, 209,
+	210, 386, 875, 786, 1018, 662, 656, 492, 800, 717,
+	27, 1239, 175, 797, 1201, 958, 670, 27, 683, 553,
+	491, 265, 855, 717, 112, 1428, 377, 175, 1418, 1404,
+	1403, 1396, 26, 1395, 1389, 1370, 872, 1343, 819, 26,
+	850, 1369, 796, 1368, 1359, 830, 1327, 860, 317, 713,
+	65, 717, 1311, 65, 65, 65, 379, 380, 175, 1309,
+	831, 1300, 1296, 198, 849, 717, 814, 1258, 1229, 1226,
+	1225, 1387, 1172, 207, 208, 211, 212, 1159, 1128, 1107,
+	657, 1106, 440, 1100, 1008, 1007, 919, 1006, 926, 799,
+	758, 649, 647, 801, 490, 38, 1342, 1306, 760, 1305,
+	805, 263, 272, 271, 262, 261, 264, 260, 1220, 897,
+	896, 887, 885, 168, 943, 764, 889, 939, 1388, 893,
+	894, 895, 1387, 197, 1295, 763, 411, 1099, 1294, 199,
+	293, 1098, 950, 947, 1366, 358, 646, 358, 358, 358,
+	645, 1294, 1256, 358, 1098, 1004, 645, 487, 485, 1419,
+	1391, 1360, 1357, 200, 1336, 1298, 677, 1290, 22, 802,
+	1250, 933, 717, 941, 975, 22, 932, 929, 807, 1230,
+	1216, 928, 1102, 951, 942, 927, 946, 890, 959, 652,
+	963, 65, 938, 302, 1421, 349, 707, 65, 65, 1362,
+	752, 998, 1338, 717, 752, 1232, 970, 1218, 1073, 258,
+	257, 979, 980, 930, 38, 259, 267, 266, 268, 269,
+	270, 892, 440, 407, 483, 992, 793, 402, 65, 309,
+	1412, 1411, 65, 1394, 1393, 1334, 1180, 1032, 1179, 995,
+	1001, 175, 1105, 1028, 1104, 1027, 996, 997, 1027, 888,
+	945, 1388, 1295, 1099, 1041, 1013, 1026, 1002, 646, 1030,
+	1424, 1417, 1382, 1009, 1010, 1358, 358, 1274, 358, 358,
+	358, 1228, 1034, 925, 175, 583, 3, 568, 371, 1408,
+	573, 1037, 22, 1331, 1176, 22, 22, 22, 38, 803,
+	1038, 1048, 175, 1377, 65, 1352, 1057, 1058, 1049, 1348,
+	1413, 1348, 27, 1053, 1074, 65, 27, 1050, 1051, 1059,
+	1374, 1060, 1351, 707, 1350, 860, 1077, 923, 1079, 1075,
+	1076, 740, 374, 1063, 26, 1375, 1376, 255, 26, 1078,
+	118, 985, 984, 473, 739, 1155, 1315, 472, 738, 294,
+	440, 737, 1081, 545, 793, 1373, 175, 1114, 1213, 1116,
+	1117, 1118, 1131, 436, 1109, 1130, 1027, 435, 437, 791,
+	1127, 1272, 1222, 358, 1145, 1144, 1253, 1120, 597, 440,
+	414, 1101, 723, 793, 1142, 175, 972, 1137, 1129, 475,
+	474, 1152, 418, 1136, 1151, 1035, 1156, 1153, 1398, 1036,
+	1346, 1349, 1147, 1349, 65, 65, 1213, 989, 1046, 65,
+	443, 442, 1162, 65, 1146, 999, 1148, 248, 1154, 175,
+	974, 1165, 119, 22, 688, 1005, 717, 1167, 1313, 22,
+	22, 1173, 247, 248, 249, 1314, 541, 3, 1316, 1166,
+	961, 962, 396, 522, 440, 523, 524, 525, 793, 390,
+	429, 3, 1196, 701, 65, 968, 1199, 848, 38, 1198,
+	22, 1191, 1200, 489, 22, 38, 1027, 847, 1195, 1189,
+	699, 65, 698, 1210, 65, 1174, 1184, 1192, 314, 1177,
+	1132, 1183, 522, 1113, 523, 524, 525, 517, 961, 962,
+	520, 717, 515, 516, 695, 313, 314, 1223, 315, 833,
+	694, 1149, 522, 1150, 523, 524, 238, 1024, 902, 513,
+	1231, 1235, 515, 516, 167, 1224, 1112, 874, 873, 397,
+	1240, 882, 175, 869, 936, 937, 22, 65, 74, 185,
+	184, 65, 818, 1247, 1243, 1142, 1214, 22, 65, 253,
+	1171, 65, 722, 1252, 65, 522, 1012, 523, 524, 525,
+	517, 949, 175, 520, 1000, 515, 516, 994, 991, 440,
+	528, 878, 717, 793, 755, 1267, 1414, 65, 182, 201,
+	203, 1287, 38, 180, 1286, 38, 38, 38, 1089, 569,
+	179, 338, 522, 337, 523, 524, 525, 517, 3, 1303,
+	520, 440, 515, 516, 318, 793, 183, 1325, 1310, 345,
+	626, 1380, 1320, 1318, 1381, 65, 1317, 1275, 1323, 65,
+	1276, 1401, 65, 1324, 1328, 65, 65, 65, 1288, 1161,
+	1326, 1289, 501, 1279, 1164, 1168, 22, 22, 1242, 813,
+	654, 22, 1175, 180, 509, 22, 399, 398, 1354, 1353,
+	113, 394, 549, 116, 113, 116, 1267, 65, 112, 1267,
+	1267, 1267, 246, 65, 65, 1088, 1361, 570, 546, 547,
+	256, 95, 370, 252, 76, 75, 440, 548, 188, 1365,
+	1378, 65, 1255, 1003, 65, 484, 237, 65, 1089, 1089,
+	677, 1267, 1072, 1379, 10, 676, 486, 1267, 1267, 70,
+	424, 1399, 1245, 22, 355, 351, 22, 67, 65, 191,
+	1402, 359, 65, 38, 204, 205, 1405, 213, 214, 38,
+	38, 1267, 717, 219, 350, 1415, 357, 223, 360, 227,
+	323, 229, 1420, 234, 327, 440, 1397, 705, 1345, 1423,
+	65, 1312, 1267, 65, 1335, 1277, 1267, 1339, 1340, 1341,
+	38, 1427, 69, 126, 38, 1089, 101, 718, 1089, 22,
+	68, 1257, 66, 22, 72, 1088, 1088, 677, 63, 71,
+	22, 64, 935, 22, 1267, 1005, 22, 1267, 667, 1364,
+	496, 3, 62, 251, 663, 1371, 1372, 658, 655, 298,
+	522, 1141, 523, 524, 525, 517, 858, 6, 520, 22,
+	515, 516, 863, 864, 865, 866, 1302, 21, 20, 1390,
+	78, 1089, 206, 18, 751, 748, 38, 17, 567, 16,
+	15, 12, 1089, 19, 14, 13, 1262, 38, 1085, 1260,
+	1406, 1083, 1088, 584, 1409, 1088, 582, 22, 1330, 4,
+	2, 22, 0, 322, 22, 0, 342, 22, 22, 22,
+	0, 1089, 322, 0, 322, 322, 0, 322, 0, 0,
+	0, 0, 1425, 0, 0, 1426, 0, 0, 0, 381,
+	382, 384, 385, 1169, 1170, 0, 0, 31, 391, 22,
+	0, 1367, 0, 0, 0, 22, 22, 0, 1088, 1089,
+	0, 0, 0, 1089, 0, 0, 0, 0, 0, 1088,
+	0, 0, 0, 22, 828, 1257, 22, 0, 169, 22,
+	0, 0, 0, 0, 843, 845, 38, 38, 0, 0,
+	0, 38, 0, 0, 422, 38, 427, 0, 1088, 0,
+	22, 1407, 0, 0, 22, 620, 0, 0, 233, 0,
+	1215, 3, 0, 1219, 0, 0, 0, 451, 3, 0,
+	458, 427, 0, 0, 0, 1089, 0, 233, 1089, 0,
+	0, 0, 22, 0, 1367, 22, 1088, 0, 0, 0,
+	1088, 0, 479, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 38, 0, 0, 38, 0, 0, 0,
+	322, 0, 0, 0, 0, 0, 1254, 0, 263, 272,
+	271, 262, 261, 264, 260, 0, 0, 1273, 0, 322,
+	322, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 322, 0, 0, 0,
+	0, 233, 1088, 0, 0, 1088, 1297, 0, 0, 38,
+	0, 542, 544, 38, 0, 0, 0, 0, 0, 0,
+	38, 0, 0, 38, 233, 0, 38, 0, 559, 561,
+	562, 564, 0, 964, 966, 0, 0, 0, 705, 0,
+	30, 322, 0, 0, 1329, 0, 0, 0, 1332, 38,
+	0, 0, 0, 0, 593, 0, 595, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 258, 257, 0, 0,
+	0, 0, 259, 267, 266, 268, 269, 270, 0, 233,
+	233, 0, 0, 0, 402, 0, 0, 38, 0, 0,
+	0, 38, 0, 0, 38, 0, 0, 38, 38, 38,
+	0, 232, 0, 0, 0, 0, 5, 0, 0, 0,
+	1383, 0, 0, 1384, 0, 0, 0, 0, 0, 0,
+	232, 263, 272, 271, 262, 261, 264, 260, 0, 38,
+	0, 0, 0, 0, 0, 38, 38, 0, 0, 0,
+	0, 0, 0, 678, 322, 680, 0, 684, 0, 0,
+	0, 322, 342, 38, 1061, 705, 38, 0, 0, 38,
+	0, 0, 0, 322, 0, 0, 0, 230, 0, 708,
+	0, 0, 0, 710, 0, 711, 0, 712, 0, 0,
+	38, 0, 678, 322, 38, 719, 239, 0, 727, 678,
+	678, 731, 0, 3, 232, 735, 743, 3, 0, 753,
+	0, 0, 233, 263, 272, 271, 262, 261, 264, 260,
+	0, 0, 38, 0, 0, 38, 0, 232, 0, 258,
+	257, 0, 0, 0, 0, 259, 267, 266, 268, 269,
+	270, 0, 0, 0, 0, 0, 0, 1033, 765, 766,
+	0, 0, 0, 0, 0, 0, 743, 427, 770, 451,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1084,
+	239, 0, 0, 0, 129, 233, 0, 233, 233, 0,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	85, 0, 0, 239, 0, 263, 272, 233, 262, 261,
+	264, 260, 0, 128, 0, 160, 147, 125, 0, 0,
+	0, 258, 257, 0, 0, 0, 0, 259, 267, 266,
+	268, 269, 270, 0, 124, 678, 0, 0, 0, 642,
+	0, 146, 145, 192, 144, 0, 0, 0, 0, 678,
+	322, 0, 0, 0, 0, 0, 122, 123, 403, 842,
+	322, 322, 0, 0, 0, 0, 0, 0, 233, 0,
+	0, 0, 0, 84, 0, 0, 0, 678, 0, 1084,
+	1084, 0, 727, 0, 0, 0, 0, 727, 0, 870,
+	0, 678, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 258, 257, 0, 0, 0, 0, 259,
+	267, 266, 268, 269, 270, 232, 0, 886, 0, 0,
+	0, 233, 0, 233, 149, 150, 0, 0, 143, 0,
+	0, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 1084, 0, 0, 1084,
+	193, 195, 148, 0, 194, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 272, 271, 262, 261,
+	264, 260, 0, 0, 0, 176, 0, 0, 0, 0,
+	427, 239, 678, 0, 0, 0, 0, 342, 678, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	232, 0, 1084, 0, 233, 0, 1261, 0, 0, 322,
+	322, 0, 0, 1084, 322, 971, 0, 0, 0, 678,
+	0, 0, 0, 0, 0, 163, 0, 678, 678, 0,
+	0, 0, 0, 0, 0, 987, 988, 0, 0, 743,
+	0, 233, 1084, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 232, 427, 258, 257, 0, 239, 0, 0, 259,
+	267, 266, 268, 269, 270, 0, 0, 0, 0, 236,
+	1084, 402, 0, 0, 1084, 0, 0, 1261, 0, 233,
+	1261, 1261, 1261, 273, 274, 263, 272, 271, 262, 261,
+	264, 260, 0, 0, 233, 0, 0, 0, 0, 0,
+	296, 297, 0, 0, 736, 0, 736, 0, 0, 678,
+	1054, 0, 1261, 0, 0, 0, 0, 674, 1261, 1261,
+	322, 322, 0, 0, 0, 233, 0, 0, 0, 0,
+	727, 0, 0, 0, 727, 0, 1084, 0, 0, 1084,
+	0, 0, 1261, 236, 0, 0, 0, 0, 0, 163,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1261, 0, 0, 0, 1261, 0, 0,
+	733, 226, 744, 0, 0, 0, 263, 272, 271, 262,
+	261, 264, 260, 258, 257, 0, 0, 232, 0, 259,
+	267, 266, 268, 269, 270, 1261, 0, 1193, 1261, 0,
+	0, 0, 0, 906, 0, 0, 0, 0, 743, 0,
+	0, 0, 0, 0, 0, 263, 272, 271, 262, 261,
+	264, 260, 678, 0, 232, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 272, 271, 262, 261,
+	264, 260, 0, 0, 425, 0, 0, 430, 431, 432,
+	0, 434, 0, 239, 441, 0, 444, 445, 446, 447,
+	448, 449, 450, 0, 0, 0, 226, 456, 226, 425,
+	226, 226, 232, 0, 258, 257, 0, 226, 226, 226,
+	259, 267, 266, 268, 269, 270, 0, 678, 905, 478,
+	239, 0, 0, 0, 0, 226, 0, 0, 233, 488,
+	0, 0, 0, 0, 0, 497, 0, 0, 0, 0,
+	0, 0, 0, 258, 257, 0, 0, 0, 232, 259,
+	267, 266, 268, 269, 270, 0, 0, 1115, 0, 0,
+	0, 233, 0, 258, 257, 514, 0, 0, 861, 259,
+	267, 266, 268, 269, 270, 0, 0, 924, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 678, 0,
+	0, 0, 129, 226, 452, 0, 552, 0, 0, 0,
+	0, 0, 0, 1269, 1270, 0, 263, 272, 271, 262,
+	261, 264, 260, 0, 898, 0, 0, 0, 0, 0,
+	0, 0, 226, 160, 147, 125, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 192, 144, 1307, 1308, 612, 0, 614, 0, 226,
+	0, 0, 233, 0, 122, 123, 1321, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 226, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 0, 0,
+	0, 0, 0, 488, 258, 257, 678, 650, 0, 0,
+	259, 267, 266, 268, 269, 270, 0, 0, 908, 0,
+	0, 232, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 233, 149, 150, 0, 0, 143, 0, 678, 161,
+	131, 130, 132, 133, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 232, 0, 0, 714, 193, 195,
+	148, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 736, 0, 0, 0, 0, 0, 0, 428,
+	0, 0, 0, 678, 0, 0, 0, 1040, 0, 0,
+	0, 129, 263, 272, 271, 262, 261, 264, 260, 233,
+	0, 0, 0, 0, 0, 0, 163, 85, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 741, 0, 0,
+	1069, 0, 160, 147, 125, 425, 232, 0, 226, 233,
+	0, 773, 0, 0, 0, 0, 0, 0, 1080, 0,
+	779, 124, 780, 0, 0, 0, 781, 0, 146, 145,
+	192, 144, 0, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 798, 122, 123, 0, 0, 0, 0, 0,
+	0, 804, 263, 272, 271, 262, 261, 264, 260, 0,
+	84, 0, 0, 0, 0, 0, 497, 0, 0, 232,
+	258, 257, 1134, 0, 0, 0, 259, 267, 266, 268,
+	269, 270, 0, 0, 907, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 834, 837, 841, 0, 0, 0,
+	0, 1157, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 149, 150, 0, 0, 143, 0, 0, 161, 131,
+	130, 132, 133, 0, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 0, 0, 1181, 0, 193, 195, 148,
+	0, 194, 0, 0, 0, 0, 0, 0, 0, 0,
+	258, 257, 668, 669, 0, 0, 259, 267, 266, 268,
+	269, 270, 176, 0, 854, 0, 0, 0, 0, 0,
+	239, 0, 232, 0, 901, 0, 0, 0, 0, 0,
+	263, 272, 271, 262, 261, 264, 260, 0, 0, 0,
+	0, 0, 0, 0, 0, 922, 0, 0, 0, 0,
+	0, 0, 232, 0, 0, 0, 0, 0, 1284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 425, 0,
+	0, 944, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 952, 0, 0, 0, 0, 1249, 0,
+	0, 0, 0, 0, 129, 86, 87, 88, 0, 118,
+	0, 112, 116, 113, 114, 0, 80, 115, 976, 0,
+	85, 263, 272, 271, 262, 261, 264, 260, 1281, 983,
+	157, 0, 0, 128, 0, 160, 147, 125, 258, 257,
+	0, 0, 0, 0, 259, 267, 266, 268, 269, 270,
+	0, 0, 488, 0, 124, 0, 0, 0, 0, 0,
+	425, 146, 145, 99, 144, 0, 1020, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 123, 0, 0,
+	0, 0, 0, 109, 0, 0, 0, 110, 0, 0,
+	0, 119, 0, 84, 0, 0, 0, 0, 0, 0,
+	159, 156, 0, 0, 0, 0, 0, 0, 0, 1047,
+	117, 0, 0, 837, 226, 226, 0, 0, 0, 258,
+	257, 1055, 0, 0, 0, 259, 267, 266, 268, 269,
+	270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 150, 0, 0, 143, 158,
+	0, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 0, 0, 127,
+	89, 100, 148, 96, 97, 103, 98, 102, 104, 105,
+	106, 107, 263, 272, 271, 262, 261, 264, 260, 93,
+	94, 129, 0, 1126, 111, 79, 1204, 0, 0, 0,
+	0, 0, 1073, 0, 226, 325, 324, 0, 339, 0,
+	0, 0, 837, 263, 272, 271, 262, 261, 264, 260,
+	326, 0, 160, 147, 125, 226, 0, 226, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	957, 124, 0, 0, 0, 0, 0, 163, 146, 145,
+	192, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 226, 0, 0, 0, 0, 0, 0,
+	258, 257, 0, 0, 0, 0, 259, 267, 266, 268,
+	269, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 236, 0, 0, 0, 0, 0, 0,
+	0, 258, 257, 0, 0, 0, 0, 259, 267, 266,
+	268, 269, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 149, 150, 0, 0, 143, 0, 0, 161, 131,
+	130, 132, 133, 497, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 0, 0, 0, 0, 193, 195, 148,
+	0, 194, 0, 837, 0, 1246, 0, 0, 0, 0,
+	0, 0, 0, 1251, 0, 0, 0, 0, 488, 129,
+	86, 87, 88, 0, 118, 0, 112, 116, 113, 114,
+	23, 80, 115, 0, 0, 85, 0, 0, 40, 41,
+	0, 0, 0, 0, 0, 32, 0, 0, 128, 0,
+	33, 147, 125, 34, 49, 0, 35, 0, 0, 0,
+	0, 0, 0, 0, 163, 0, 0, 0, 0, 124,
+	0, 0, 0, 0, 0, 0, 146, 145, 99, 144,
+	0, 0, 0, 1246, 0, 0, 0, 0, 0, 0,
+	0, 122, 123, 0, 0, 0, 0, 0, 109, 0,
+	0, 0, 110, 0, 0, 0, 119, 0, 84, 0,
+	0, 0, 0, 0, 0, 1264, 1263, 0, 1091, 0,
+	0, 0, 0, 0, 37, 117, 0, 44, 42, 43,
+	39, 45, 0, 0, 0, 0, 0, 0, 488, 47,
+	48, 591, 592, 0, 52, 53, 54, 55, 46, 57,
+	58, 59, 50, 56, 61, 0, 0, 1268, 1092, 149,
+	150, 0, 0, 143, 36, 51, 60, 131, 130, 132,
+	133, 0, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 0, 0, 0, 127, 89, 100, 148, 96, 97,
+	103, 98, 102, 104, 105, 106, 107, 263, 272, 271,
+	262, 261, 264, 260, 93, 94, 0, 0, 0, 111,
+	79, 129, 86, 87, 88, 0, 118, 483, 112, 116,
+	113, 114, 23, 80, 115, 0, 0, 85, 0, 0,
+	40, 41, 0, 0, 0, 0, 0, 32, 0, 0,
+	128, 0, 33, 147, 125, 34, 49, 0, 35, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 124, 0, 0, 0, 0, 0, 0, 146, 145,
+	99, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 123, 0, 0, 0, 0, 0,
+	109, 0, 0, 0, 110, 258, 257, 0, 119, 0,
+	84, 259, 267, 266, 268, 269, 270, 586, 585, 0,
+	81, 0, 0, 0, 0, 0, 37, 117, 0, 44,
+	42, 43, 39, 45, 0, 0, 0, 0, 0, 0,
+	0, 47, 48, 591, 592, 82, 52, 53, 54, 55,
+	46, 57, 58, 59, 50, 56, 61, 0, 0, 590,
+	0, 149, 150, 0, 0, 143, 36, 51, 60, 131,
+	130, 132, 133, 0, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 0, 0, 0, 127, 89, 100, 148,
+	96, 97, 103, 98, 102, 104, 105, 106, 107, 263,
+	272, 271, 262, 261, 264, 260, 93, 94, 0, 0,
+	0, 111, 79, 129, 86, 87, 88, 0, 118, 0,
+	112, 116, 113, 114, 23, 80, 115, 0, 0, 85,
+	0, 0, 40, 41, 0, 0, 0, 0, 0, 32,
+	0, 0, 128, 0, 33, 147, 125, 34, 49, 0,
+	35, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 0, 0, 0,
+	146, 145, 99, 144, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 122, 123, 0, 0, 0,
+	0, 0, 109, 0, 0, 0, 110, 258, 257, 0,
+	119, 0, 84, 259, 267, 266, 268, 269, 270, 1087,
+	1086, 0, 1091, 0, 0, 0, 0, 0, 37, 117,
+	0, 44, 42, 43, 39, 45, 0, 0, 0, 0,
+	0, 0, 0, 47, 48, 0, 0, 0, 52, 53,
+	54, 55, 46, 57, 58, 59, 50, 56, 61, 0,
+	0, 1090, 1092, 149, 150, 0, 0, 143, 36, 51,
+	60, 131, 130, 132, 133, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 0, 0, 0, 127, 89,
+	100, 148, 96, 97, 103, 98, 102, 104, 105, 106,
+	107, 263, 774, 271, 262, 261, 264, 260, 93, 94,
+	0, 0, 0, 111, 79, 129, 86, 87, 88, 0,
+	118, 0, 112, 116, 113, 114, 23, 80, 115, 0,
+	0, 85, 0, 0, 40, 41, 0, 0, 0, 0,
+	0, 32, 0, 0, 128, 0, 33, 147, 125, 34,
+	49, 0, 35, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 0,
+	0, 0, 146, 145, 99, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 123, 0,
+	0, 0, 0, 0, 109, 0, 0, 0, 110, 258,
+	257, 0, 119, 0, 84, 259, 267, 266, 268, 269,
+	270, 25, 24, 0, 81, 0, 0, 0, 0, 0,
+	37, 117, 0, 44, 42, 43, 39, 45, 0, 0,
+	0, 0, 0, 0, 0, 47, 48, 0, 0, 82,
+	52, 53, 54, 55, 46, 57, 58, 59, 50, 56,
+	61, 0, 0, 28, 0, 149, 150, 0, 0, 143,
+	36, 51, 60, 131, 130, 132, 133, 0, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 0, 0, 0,
+	127, 89, 100, 148, 96, 97, 103, 98, 102, 104,
+	105, 106, 107, 263, 611, 271, 262, 261, 264, 260,
+	93, 94, 0, 0, 0, 111, 79, 129, 86, 87,
+	88, 0, 118, 0, 112, 116, 113, 114, 0, 80,
+	115, 263, 272, 271, 262, 261, 264, 260, 0, 0,
+	0, 0, 0, 157, 0, 0, 128, 0, 160, 147,
+	125, 0, 651, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 146, 145, 99, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 122,
+	123, 0, 0, 606, 0, 0, 109, 0, 0, 0,
+	110, 258, 257, 0, 119, 0, 0, 259, 267, 266,
+	268, 269, 270, 159, 156, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 0, 0, 0, 0, 0, 258,
+	257, 0, 0, 0, 0, 259, 267, 266, 268, 269,
+	270, 0, 0, 0, 0, 0, 263, 272, 271, 262,
+	261, 264, 260, 0, 0, 0, 0, 149, 150, 0,
+	0, 143, 158, 0, 161, 131, 130, 132, 133, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 0,
+	0, 0, 127, 89, 100, 148, 96, 97, 103, 98,
+	102, 104, 105, 106, 107, 0, 0, 0, 0, 0,
+	0, 0, 93, 94, 428, 0, 0, 111, 79, 459,
+	129, 86, 87, 88, 0, 118, 0, 112, 116, 113,
+	114, 0, 80, 115, 325, 324, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 685,
+	0, 160, 147, 125, 258, 257, 0, 0, 0, 0,
+	259, 267, 266, 268, 269, 270, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 146, 145, 99,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 123, 0, 0, 0, 0, 0, 109,
+	0, 0, 0, 110, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 159, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 0, 0, 143, 158, 0, 161, 131, 130,
+	132, 133, 129, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 0, 0, 0, 127, 89, 100, 148, 96,
+	97, 103, 98, 102, 104, 105, 106, 107, 0, 533,
+	0, 0, 0, 160, 147, 93, 94, 0, 0, 0,
+	111, 79, 129, 86, 87, 88, 0, 118, 0, 112,
+	116, 113, 114, 0, 80, 115, 0, 0, 85, 146,
+	145, 192, 144, 0, 0, 0, 0, 0, 157, 0,
+	0, 128, 0, 160, 147, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 99, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 123, 0, 0, 0, 0,
+	0, 109, 0, 0, 0, 110, 0, 0, 0, 119,
+	0, 84, 0, 0, 0, 0, 0, 0, 159, 156,
+	0, 0, 149, 150, 0, 0, 143, 0, 117, 161,
+	131, 130, 132, 133, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 0, 0, 0, 0, 193, 195,
+	148, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 143, 158, 0, 161,
+	131, 130, 132, 133, 129, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 0, 0, 0, 127, 89, 100,
+	148, 96, 97, 103, 98, 102, 104, 105, 106, 107,
+	0, 535, 0, 0, 0, 160, 147, 93, 94, 0,
+	0, 0, 111, 79, 129, 86, 87, 88, 0, 118,
+	0, 112, 116, 113, 114, 0, 80, 115, 0, 0,
+	0, 146, 145, 192, 144, 0, 0, 0, 0, 0,
+	157, 0, 0, 128, 0, 160, 147, 125, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 146, 145, 99, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 123, 0, 0,
+	0, 0, 0, 109, 0, 0, 0, 110, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 0, 0, 0,
+	159, 156, 0, 0, 149, 150, 0, 0, 143, 245,
+	117, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 0, 0, 0,
+	193, 195, 148, 0, 194, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 150, 0, 0, 143, 244,
+	0, 161, 131, 130, 132, 133, 129, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 0, 0, 127,
+	89, 100, 148, 96, 97, 103, 98, 102, 104, 105,
+	106, 107, 0, 531, 0, 0, 0, 160, 147, 93,
+	94, 0, 0, 0, 111, 79, 129, 86, 87, 88,
+	0, 118, 0, 112, 116, 113, 114, 0, 80, 115,
+	0, 0, 0, 146, 145, 192, 144, 0, 0, 0,
+	0, 0, 157, 0, 0, 128, 0, 160, 147, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 146, 145, 99, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 123,
+	0, 0, 0, 0, 0, 109, 0, 0, 0, 110,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 156, 0, 0, 149, 150, 0, 0,
+	143, 0, 117, 161, 131, 130, 132, 133, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 0, 0,
+	0, 0, 193, 195, 148, 0, 194, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 150, 0, 0,
+	143, 158, 0, 161, 131, 130, 132, 133, 129, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 0, 0,
+	0, 127, 89, 100, 148, 96, 97, 103, 98, 102,
+	104, 105, 106, 107, 0, 527, 0, 0, 0, 160,
+	147, 93, 94, 428, 0, 0, 111, 79, 129, 86,
+	87, 88, 0, 118, 0, 112, 116, 113, 114, 0,
+	80, 115, 0, 0, 0, 146, 145, 192, 144, 0,
+	0, 0, 0, 0, 157, 0, 0, 128, 0, 160,
+	147, 125, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
+	0, 0, 0, 0, 0, 146, 145, 99, 144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 123, 0, 0, 0, 0, 0, 109, 0, 0,
+	0, 110, 0, 0, 0, 119, 374, 0, 0, 0,
+	0, 0, 0, 0, 159, 156, 0, 0, 149, 150,
+	0, 0, 143, 0, 117, 161, 131, 130, 132, 133,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	0, 0, 0, 0, 193, 195, 148, 0, 194, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 149, 150,
+	0, 0, 143, 158, 0, 161, 131, 130, 132, 133,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	0, 0, 0, 127, 89, 100, 148, 96, 97, 103,
+	98, 102, 104, 105, 106, 107, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 0, 0, 0, 111, 79,
+	129, 86, 87, 88, 0, 118, 0, 112, 116, 113,
+	114, 0, 80, 115, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 157, 0, 0, 128,
+	0, 160, 147, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 146, 145, 99,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 123, 0, 0, 0, 0, 0, 109,
+	0, 0, 0, 110, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 159, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 117, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 0, 0, 143, 158, 0, 161, 131, 130,
+	132, 133, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 0, 0, 0, 127, 89, 100, 148, 96,
+	97, 103, 98, 102, 104, 105, 106, 107, 0, 0,
+	0, 0, 0, 0, 0, 93, 94, 0, 0, 0,
+	111, 79, 129, 86, 87, 88, 0, 118, 0, 112,
+	116, 113, 114, 0, 80, 115, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 157, 0,
+	0, 128, 0, 160, 147, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 99, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 123, 0, 0, 0, 0,
+	0, 109, 0, 0, 0, 110, 0, 0, 0, 119,
+	0, 0, 0, 0, 0, 0, 0, 0, 159, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 143, 158, 0, 161,
+	131, 130, 132, 133, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 0, 0, 0, 127, 89, 100,
+	148, 96, 97, 103, 98, 102, 104, 105, 106, 107,
+	0, 0, 0, 0, 0, 0, 0, 93, 94, 0,
+	0, 0, 111, 153, 129, 86, 87, 88, 0, 118,
+	0, 112, 116, 113, 114, 0, 80, 115, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 0, 0, 128, 0, 160, 147, 125, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 838, 839, 840, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 123, 0, 0,
+	0, 0, 0, 109, 0, 0, 0, 110, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 0, 0, 0,
+	159, 156, 0, 0, 0, 0, 0, 0, 0, 0,
+	117, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 150, 0, 0, 143, 158,
+	0, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 0, 0, 127,
+	89, 100, 148, 96, 97, 103, 98, 102, 104, 105,
+	106, 107, 0, 0, 0, 0, 0, 0, 0, 93,
+	94, 0, 0, 0, 111, 1143, 129, 86, 87, 88,
+	0, 118, 0, 112, 116, 113, 114, 0, 80, 115,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 157, 0, 0, 128, 0, 160, 147, 125,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 838, 839, 840, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 123,
+	0, 0, 0, 0, 0, 109, 0, 0, 0, 110,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 117, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 100, 0, 302, 0, 101, 0, 0, 112,
-	110, 0, 79, 0, 0, 0, 0, 0, 0, 508,
-	507, 0, 76, 279, 278, 0, 1052, 1053, 34, 108,
-	0, 41, 39, 40, 36, 42, 0, 0, 280, 0,
-	0, 0, 0, 44, 45, 512, 513, 77, 49, 50,
-	51, 52, 43, 54, 55, 56, 47, 53, 57, 0,
-	0, 0, 1077, 1078, 0, 33, 48, 113, 114, 115,
-	0, 116, 117, 118, 119, 120, 0, 0, 0, 121,
-	90, 93, 91, 92, 95, 96, 97, 98, 0, 0,
-	0, 0, 0, 0, 0, 87, 88, 0, 0, 0,
-	102, 74, 112, 81, 82, 83, 0, 109, 0, 103,
-	107, 104, 105, 22, 75, 106, 0, 0, 80, 0,
-	0, 37, 38, 0, 0, 0, 0, 0, 30, 0,
-	0, 122, 0, 31, 46, 0, 32, 0, 0, 0,
-	0, 0, 0, 113, 114, 115, 0, 282, 283, 284,
-	285, 286, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 100, 0, 0, 0, 101, 0, 0, 0, 110,
-	0, 79, 0, 0, 0, 0, 0, 0, 918, 917,
-	0, 921, 0, 0, 0, 0, 0, 34, 108, 0,
-	41, 39, 40, 36, 42, 0, 0, 0, 0, 0,
-	0, 0, 44, 45, 0, 0, 0, 49, 50, 51,
-	52, 43, 54, 55, 56, 47, 53, 57, 0, 0,
-	0, 922, 0, 0, 33, 48, 113, 114, 115, 0,
-	116, 117, 118, 119, 120, 0, 0, 0, 121, 90,
-	93, 91, 92, 95, 96, 97, 98, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 0, 0, 0, 102,
-	74, 112, 81, 82, 83, 0, 109, 0, 103, 107,
-	104, 105, 22, 75, 106, 0, 0, 80, 0, 0,
-	37, 38, 0, 0, 0, 0, 0, 30, 0, 0,
-	122, 0, 31, 46, 0, 32, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 150, 0, 0,
+	143, 158, 0, 161, 131, 130, 132, 133, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 0, 0,
+	0, 127, 89, 100, 148, 96, 97, 103, 98, 102,
+	104, 105, 106, 107, 0, 0, 0, 0, 0, 0,
+	0, 93, 94, 0, 0, 0, 111, 79, 129, 86,
+	405, 88, 0, 118, 0, 112, 116, 113, 114, 0,
+	80, 115, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 157, 0, 0, 128, 0, 160,
+	147, 125, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
+	0, 129, 0, 0, 0, 146, 145, 99, 144, 0,
+	0, 0, 0, 0, 0, 325, 324, 85, 0, 0,
+	122, 123, 0, 0, 0, 0, 0, 109, 0, 352,
+	326, 110, 160, 147, 125, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 159, 156, 0, 0, 0, 0,
+	0, 124, 0, 0, 117, 0, 0, 0, 146, 145,
+	192, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 149, 150,
+	84, 0, 143, 158, 0, 161, 131, 130, 132, 133,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	0, 0, 0, 127, 89, 100, 148, 96, 97, 103,
+	98, 102, 104, 105, 106, 107, 0, 0, 0, 0,
+	0, 0, 0, 93, 94, 0, 0, 0, 111, 79,
+	0, 149, 150, 0, 0, 143, 0, 0, 161, 131,
+	130, 132, 133, 0, 328, 329, 330, 331, 332, 333,
+	334, 335, 336, 362, 363, 364, 129, 193, 195, 361,
+	0, 194, 0, 0, 0, 0, 0, 0, 0, 0,
+	325, 324, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 354, 0, 352, 326, 0, 160, 147, 125,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 0, 124, 0, 0, 0,
+	0, 0, 0, 146, 145, 192, 144, 0, 325, 324,
+	0, 0, 0, 0, 0, 0, 0, 706, 122, 123,
+	0, 0, 352, 326, 0, 160, 147, 125, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 146, 145, 192, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1062, 122, 123, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	100, 0, 0, 0, 101, 0, 0, 0, 110, 0,
-	79, 0, 0, 0, 0, 0, 0, 24, 23, 0,
-	76, 0, 0, 0, 0, 0, 34, 108, 0, 41,
-	39, 40, 36, 42, 0, 0, 0, 0, 0, 0,
-	0, 44, 45, 0, 0, 77, 49, 50, 51, 52,
-	43, 54, 55, 56, 47, 53, 57, 0, 0, 0,
-	0, 0, 0, 33, 48, 113, 114, 115, 0, 116,
-	117, 118, 119, 120, 0, 0, 0, 121, 90, 93,
-	91, 92, 95, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 0, 87, 88, 0, 0, 0, 102, 74,
-	112, 81, 82, 83, 0, 109, 0, 103, 107, 104,
-	105, 0, 75, 106, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 0, 122,
-	223, 232, 231, 222, 221, 224, 220, 0, 0, 0,
-	0, 112, 81, 82, 83, 0, 109, 0, 103, 107,
-	104, 105, 0, 75, 106, 279, 278, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 100,
-	598, 0, 0, 101, 0, 0, 0, 110, 0, 0,
-	0, 0, 0, 0, 0, 0, 131, 128, 0, 0,
-	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 150, 0, 0,
+	143, 0, 0, 161, 131, 130, 132, 133, 0, 328,
+	329, 330, 331, 332, 333, 334, 335, 336, 362, 363,
+	364, 0, 193, 195, 361, 0, 194, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	100, 0, 0, 0, 101, 218, 217, 0, 110, 0,
-	0, 219, 227, 226, 228, 229, 230, 131, 128, 0,
-	557, 0, 130, 0, 113, 114, 115, 108, 116, 117,
-	118, 119, 120, 0, 0, 0, 121, 90, 93, 91,
-	92, 95, 96, 97, 98, 223, 232, 231, 222, 221,
-	224, 220, 87, 88, 369, 0, 0, 102, 74, 397,
-	0, 0, 0, 130, 0, 113, 114, 115, 0, 116,
-	117, 118, 119, 120, 0, 0, 0, 121, 90, 93,
-	91, 92, 95, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 0, 87, 88, 0, 0, 0, 102, 74,
-	112, 81, 82, 83, 0, 109, 0, 103, 107, 104,
-	105, 0, 75, 106, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 0, 0, 122,
-	218, 217, 0, 0, 0, 0, 219, 227, 226, 228,
-	229, 230, 0, 0, 0, 344, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 150, 0, 354, 143, 0,
+	0, 161, 131, 130, 132, 133, 0, 328, 329, 330,
+	331, 332, 333, 334, 335, 336, 362, 363, 364, 129,
+	193, 195, 361, 0, 194, 0, 0, 0, 0, 0,
+	0, 0, 0, 325, 324, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 354, 0, 352, 326, 0,
+	160, 147, 125, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 0, 124,
+	0, 0, 0, 0, 0, 0, 146, 145, 192, 144,
+	0, 325, 324, 0, 0, 0, 0, 0, 0, 0,
+	967, 122, 123, 0, 0, 352, 326, 0, 160, 147,
+	125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 146, 145, 192, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 965, 122,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
+	150, 0, 0, 143, 0, 0, 161, 131, 130, 132,
+	133, 0, 328, 329, 330, 331, 332, 333, 334, 335,
+	336, 362, 363, 364, 0, 193, 195, 361, 0, 194,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 100,
-	0, 0, 0, 101, 0, 0, 0, 110, 0, 79,
-	0, 0, 0, 0, 0, 0, 131, 128, 0, 0,
-	0, 0, 112, 81, 82, 83, 108, 109, 0, 103,
-	107, 104, 105, 0, 75, 106, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
-	0, 122, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 130, 0, 113, 114, 115, 0, 116, 117,
-	118, 119, 120, 0, 0, 0, 121, 90, 93, 91,
-	92, 95, 96, 97, 98, 0, 0, 0, 0, 0,
-	0, 100, 87, 88, 0, 101, 0, 102, 74, 110,
-	0, 0, 0, 0, 0, 0, 0, 0, 131, 128,
-	0, 0, 0, 0, 0, 0, 0, 206, 108, 112,
-	81, 82, 83, 0, 109, 0, 103, 107, 104, 105,
-	0, 75, 106, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 0, 0, 122, 0,
-	0, 0, 0, 0, 205, 0, 113, 114, 115, 0,
-	116, 117, 118, 119, 120, 0, 0, 0, 121, 90,
-	93, 91, 92, 95, 96, 97, 98, 0, 0, 0,
-	0, 0, 0, 0, 87, 88, 0, 0, 100, 102,
-	74, 0, 101, 0, 0, 0, 110, 0, 0, 0,
-	0, 0, 0, 0, 0, 131, 128, 0, 0, 0,
-	0, 112, 81, 82, 83, 108, 109, 0, 103, 107,
-	104, 105, 0, 75, 106, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 0, 0,
-	122, 0, 223, 232, 231, 222, 221, 224, 220, 0,
-	0, 130, 0, 113, 114, 115, 0, 116, 117, 118,
-	119, 120, 0, 0, 0, 121, 90, 93, 91, 92,
-	95, 96, 97, 98, 0, 0, 0, 0, 0, 0,
-	100, 87, 88, 369, 101, 0, 102, 74, 110, 216,
-	0, 0, 0, 0, 0, 0, 0, 131, 128, 0,
-	0, 0, 0, 112, 81, 82, 83, 108, 109, 0,
-	103, 107, 104, 105, 0, 75, 106, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 218, 217, 129,
-	0, 0, 122, 219, 227, 226, 228, 229, 230, 0,
-	0, 1003, 0, 130, 0, 113, 114, 115, 0, 116,
-	117, 118, 119, 120, 0, 0, 0, 121, 90, 93,
-	91, 92, 95, 96, 97, 98, 0, 0, 0, 0,
-	0, 0, 100, 87, 88, 0, 101, 0, 102, 74,
-	110, 0, 0, 0, 0, 0, 0, 0, 0, 131,
-	128, 0, 0, 0, 0, 112, 81, 82, 83, 108,
-	109, 0, 103, 107, 104, 105, 0, 75, 106, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 150, 0,
+	354, 143, 0, 0, 161, 131, 130, 132, 133, 0,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 362,
+	363, 364, 129, 193, 195, 361, 0, 194, 0, 0,
+	0, 0, 0, 0, 0, 0, 325, 324, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 354, 0,
+	352, 326, 0, 160, 147, 125, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 0, 0, 122, 0, 223, 232, 231, 222,
-	221, 224, 220, 0, 0, 130, 0, 113, 114, 115,
-	0, 116, 117, 118, 119, 120, 112, 0, 0, 121,
-	90, 93, 91, 92, 95, 96, 97, 98, 0, 0,
-	279, 278, 0, 0, 100, 87, 88, 0, 101, 0,
-	102, 74, 110, 0, 300, 280, 0, 0, 0, 0,
-	0, 131, 128, 0, 0, 0, 0, 112, 81, 346,
-	83, 108, 109, 0, 103, 107, 104, 105, 0, 75,
-	106, 0, 0, 0, 0, 0, 0, 0, 0, 722,
-	0, 218, 217, 129, 0, 0, 122, 219, 227, 226,
-	228, 229, 230, 0, 0, 943, 0, 130, 0, 113,
-	114, 115, 0, 116, 117, 118, 119, 120, 112, 0,
-	0, 121, 90, 93, 91, 92, 95, 96, 97, 98,
-	0, 0, 279, 278, 0, 0, 100, 87, 88, 0,
-	101, 0, 102, 125, 110, 0, 300, 280, 0, 0,
-	0, 0, 0, 131, 128, 0, 0, 0, 0, 0,
-	113, 114, 115, 108, 282, 283, 284, 285, 286, 307,
-	308, 309, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 720, 223, 232, 231, 222, 221, 224, 220, 0,
-	0, 0, 0, 0, 302, 0, 0, 0, 0, 130,
-	0, 113, 114, 115, 0, 116, 117, 118, 119, 120,
-	0, 0, 0, 121, 90, 93, 91, 92, 95, 96,
-	97, 98, 582, 583, 0, 0, 0, 0, 0, 87,
-	88, 0, 0, 0, 102, 74, 0, 0, 0, 0,
-	0, 0, 0, 0, 223, 232, 231, 222, 221, 224,
-	220, 0, 113, 114, 115, 0, 282, 283, 284, 285,
-	286, 307, 308, 309, 0, 0, 0, 218, 217, 527,
-	0, 0, 0, 219, 227, 226, 228, 229, 230, 0,
-	0, 789, 0, 0, 0, 0, 302, 223, 232, 231,
-	222, 221, 224, 220, 0, 0, 0, 0, 0, 223,
-	232, 231, 222, 221, 224, 220, 0, 418, 0, 0,
-	0, 0, 223, 232, 231, 222, 221, 224, 220, 0,
-	566, 0, 0, 0, 0, 0, 0, 0, 223, 218,
-	217, 222, 221, 224, 220, 219, 227, 226, 228, 229,
-	230, 223, 232, 231, 222, 221, 224, 220, 0, 0,
-	0, 0, 0, 223, 668, 231, 222, 221, 224, 220,
-	0, 0, 0, 0, 0, 223, 531, 231, 222, 221,
-	224, 220, 218, 217, 0, 0, 0, 0, 219, 227,
-	226, 228, 229, 230, 218, 217, 0, 0, 0, 0,
-	219, 227, 226, 228, 229, 230, 0, 218, 217, 0,
-	0, 0, 0, 219, 227, 226, 228, 229, 230, 0,
-	0, 0, 0, 218, 217, 0, 0, 0, 0, 219,
-	227, 226, 228, 229, 230, 0, 218, 217, 0, 0,
-	0, 0, 219, 227, 226, 228, 229, 230, 218, 217,
-	0, 0, 0, 0, 219, 227, 226, 228, 229, 230,
-	218, 217, 0, 0, 0, 0, 219, 227, 226, 228,
-	229, 230,
+	129, 0, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 192, 144, 0, 325, 324, 0, 0, 0, 0,
+	0, 0, 0, 846, 122, 123, 0, 0, 352, 326,
+	0, 160, 147, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 146, 145, 192,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 844, 122, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 143, 0, 0, 161,
+	131, 130, 132, 133, 0, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 362, 363, 364, 0, 193, 195,
+	361, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 0, 354, 143, 0, 0, 161, 131, 130,
+	132, 133, 0, 328, 329, 330, 331, 332, 333, 334,
+	335, 336, 362, 363, 364, 129, 193, 195, 361, 0,
+	194, 0, 0, 0, 0, 0, 0, 0, 0, 325,
+	324, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 354, 0, 352, 326, 0, 160, 147, 125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 0, 124, 0, 0, 0, 0,
+	0, 0, 146, 145, 192, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 122, 123, 128,
+	0, 160, 147, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 146, 145, 192,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 149, 150, 0, 0, 143,
+	0, 0, 161, 131, 130, 132, 133, 0, 328, 329,
+	330, 331, 332, 333, 334, 335, 336, 362, 363, 364,
+	0, 193, 195, 361, 0, 194, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 129, 0, 143, 0, 354, 161, 131, 130,
+	132, 133, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 0, 0, 0, 0, 193, 195, 148, 0,
+	194, 0, 0, 160, 147, 125, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 729, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 192, 144, 0, 325, 324, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 123, 0, 0, 0, 326,
+	0, 160, 147, 125, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 146, 145, 192,
+	144, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 143, 0, 0, 161,
+	131, 130, 132, 133, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 0, 0, 0, 0, 193, 195,
+	148, 0, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 150, 0, 725, 143, 0, 0, 161, 131, 130,
+	132, 133, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 129, 0, 0, 0, 193, 195, 148, 0,
+	194, 0, 0, 0, 0, 0, 325, 324, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 326, 0, 160, 147, 125, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 146,
+	145, 192, 144, 0, 0, 128, 0, 160, 147, 125,
+	0, 0, 0, 0, 122, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 146, 145, 192, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 150, 0, 0, 143, 0, 0, 161,
+	131, 130, 132, 133, 0, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 0, 0, 0, 129, 193, 195,
+	148, 0, 194, 0, 0, 0, 149, 150, 0, 0,
+	143, 0, 0, 161, 131, 130, 132, 133, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 160, 147,
+	125, 129, 193, 195, 148, 0, 194, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 146, 145, 192, 144, 543, 0,
+	0, 0, 160, 147, 125, 0, 0, 0, 0, 122,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	871, 124, 0, 0, 0, 0, 0, 0, 146, 145,
+	192, 144, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 150, 0,
+	0, 143, 0, 0, 161, 131, 130, 132, 133, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 0,
+	0, 0, 0, 193, 195, 148, 0, 194, 0, 0,
+	0, 149, 150, 0, 129, 143, 480, 0, 161, 131,
+	130, 132, 133, 0, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 0, 0, 0, 0, 193, 195, 148,
+	0, 194, 0, 0, 0, 160, 147, 125, 129, 0,
+	452, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 146, 145, 192, 144, 0, 0, 0, 0, 160,
+	147, 125, 0, 0, 129, 0, 122, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
+	0, 0, 0, 0, 0, 146, 145, 192, 144, 0,
+	0, 0, 0, 383, 0, 160, 147, 125, 0, 0,
+	122, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 146, 145, 192, 144, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 150, 122, 123, 143, 0,
+	0, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 0, 0, 0,
+	193, 195, 148, 0, 194, 0, 0, 0, 149, 150,
+	0, 0, 143, 0, 0, 161, 131, 130, 132, 133,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	0, 0, 0, 0, 193, 195, 148, 0, 194, 0,
+	0, 0, 0, 0, 149, 150, 0, 0, 143, 0,
+	0, 161, 131, 130, 132, 133, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 0, 129, 0, 0,
+	193, 195, 148, 0, 194, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 160, 147,
+	125, 129, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 146, 145, 192, 144, 0, 0,
+	0, 0, 160, 147, 125, 0, 0, 129, 0, 122,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 124, 0, 0, 0, 0, 0, 0, 146, 145,
+	192, 144, 0, 0, 0, 0, 0, 0, 160, 147,
+	125, 0, 0, 122, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 146, 145, 192, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 150, 122,
+	123, 143, 0, 0, 161, 131, 130, 132, 133, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 0,
+	0, 0, 0, 193, 195, 148, 0, 194, 0, 0,
+	0, 149, 150, 0, 0, 143, 0, 0, 161, 131,
+	130, 132, 133, 0, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 0, 0, 0, 0, 193, 195, 148,
+	0, 194, 0, 0, 0, 0, 0, 149, 150, 0,
+	0, 143, 0, 0, 161, 131, 130, 132, 133, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 0,
+	0, 0, 0, 193, 195, 148, 0, 194,
 }
 
-var yyPact = [...]int{
-	2827, -1000, 361, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3671, 3579, -1000, -1000, 998, 214, 1111,
-	283, 1036, 1033, 407, 2067, -1000, 574, 1161, 1154, 2085,
-	2085, 610, 2085, 3579, -1000, -1000, 3579, 3579, 1762, 3579,
-	3579, 3579, 3579, 3579, 3579, -1000, 2085, 2085, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 367, -1000,
-	-1000, -1000, -1000, -1000, 3206, -1000, 3298, 1170, 921, 1055,
-	839, -1000, -1000, -1000, -1000, -1000, 3967, 3579, 3579, -90,
-	343, 342, 340, 339, -1000, 334, 332, 330, 328, 449,
-	137, 3579, 3579, -1000, -1000, -1000, -1000, -1000, 2085, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 326, -87, 2827, 679, 3206, -1000, -1000, 323, 322,
-	321, 3579, 702, 3967, -1000, 979, 990, 998, 1111, 1101,
-	2565, 1100, 1542, -1000, 136, 1131, 1105, 1164, 2392, 3579,
-	2565, 771, 2565, -1000, 839, 16, 366, -1000, 534, -1000,
-	2085, 1972, 2085, 2085, 497, 470, -1000, 931, -1000, 2085,
-	-1000, -1000, -1000, -1000, 3579, 3579, 1144, 21, 918, 1005,
-	1138, -1000, 1135, -1000, -1000, 35, -90, -1000, -1000, 3081,
-	-90, -1000, -1000, 3763, 3579, 1784, 244, 221, 232, 293,
-	1131, 615, 25, 894, 1164, 321, -1000, -1000, 865, 865,
-	865, -1000, 13, 2085, -1000, 3395, -1000, 3579, 3579, 3579,
-	845, 3579, 879, 93, 3579, 904, 3579, 3579, 3579, 3579,
-	3579, 3579, 3579, -1000, -1000, 1924, 3487, 3579, 2996, 839,
-	839, 839, 3579, 3579, 3579, 93, 93, 889, 899, -1000,
-	-1000, 3954, -1000, 454, 3579, 1075, -1000, 2827, 221, 219,
-	3579, 701, 639, 638, 3579, 512, 468, 3579, 3579, 3579,
-	979, 1131, 2565, 1118, 10, -1000, -1000, -1000, -1000, 319,
-	-1000, 318, -1000, -1000, -1000, -1000, -1000, 2565, 2392, 1132,
-	1, 1105, 996, 3579, -1000, -1, -1000, 75, 1450, -1000,
-	-1000, -1000, 1793, -1000, -1000, 734, 317, -1000, -1000, -1000,
-	212, -1000, 331, 2085, 851, 1145, 3579, 1164, 3579, 510,
-	351, 316, 303, -1000, -1000, -1000, -1000, -1000, 3579, 3579,
-	3579, 3579, 3579, 1098, -1000, -1000, 1172, 3579, 3579, 1158,
-	1158, 2565, 3579, 3579, 3579, -1000, 3579, 3967, -1000, -1000,
-	-1000, -1000, 2489, 2085, 1164, 2085, 43, 887, 293, -1000,
-	293, 293, 1055, 235, -1000, -3, 3938, -1000, -82, -1000,
-	103, 246, 246, 911, 3991, 3579, 93, 3579, -1000, 3206,
-	-1000, 246, 93, 93, 312, 312, -1000, -1000, -1000, 2194,
-	3954, -1000, -1000, 208, 3579, 205, 1678, -1000, 203, 3579,
-	3395, 3579, 200, 198, 195, -1000, -1000, 93, 229, 229,
-	229, 845, -1000, 2956, -1000, -1000, 619, -1000, 3579, 587,
-	2827, 585, 3579, 3925, 669, 1129, 522, 484, 457, -1000,
-	-6, 3870, 504, 1105, 231, 1368, 2565, 3579, 3037, 197,
-	1105, 2392, 1772, 996, 993, 988, 3967, 969, 967, 939,
-	961, 1596, -1000, -1000, -1000, -1000, -1000, 2085, 150, 734,
-	-1000, 2085, 3579, -1000, 299, 1368, 217, 896, 393, 202,
-	1368, 2085, 194, -1000, 3967, 138, 2085, 136, 156, 2085,
-	-1000, -90, -1000, -90, -90, -1000, -90, -1000, -1000, -8,
-	1093, 1164, -1000, -1000, -1000, -12, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 583, 358, -1000, -1000, 3671, 3579, -1000,
-	-1000, -1000, -1000, -1000, 611, -1000, 606, 2085, 2085, 903,
-	-1000, -1000, 903, -1000, 295, 2085, 3395, 2085, 1292, -1000,
-	-1000, 3579, 3979, -1000, 246, -1000, -1000, 487, 193, -1000,
-	3579, -1000, 191, 190, 185, 183, 481, 446, 440, 856,
-	-1000, 140, -1000, 294, -1000, -1000, 509, 3579, 580, 635,
-	2827, 3579, 796, -1000, -1000, 3967, 3579, 2827, -1000, 3579,
-	-1000, -1000, 458, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	3579, 409, -1000, -1000, 1128, 996, 93, 87, -1000, 1131,
-	-17, 29, -79, -1000, 182, -51, -23, -90, -87, 292,
-	1368, -1000, 1105, -1000, 993, -1000, 3579, 3579, 3814, 3722,
-	954, -1000, 944, 939, -1000, 946, 137, -24, -1000, -1000,
-	-1000, -26, 1368, 181, -27, 2085, 136, -1000, -1000, 1057,
-	2085, 1014, -1000, 1368, 1004, 1003, 478, -1000, -1000, 179,
-	-30, -1000, 1092, 178, -34, -1000, -1000, -35, 1013, -59,
-	3579, 2085, -1000, 3579, 732, 2489, 668, 700, 2489, 2489,
-	605, 600, 136, 177, -1000, -1000, -1000, 3954, 3579, 285,
-	476, 1978, 473, 469, 453, 443, 277, 276, 408, 273,
-	406, 93, 176, -45, -1000, 3579, -1000, 835, 3808, 770,
-	579, -1000, 667, -1000, 3913, 689, 484, 975, -1000, 412,
-	-1000, 1024, -1000, 993, -1000, 170, 1105, 1368, 3579, -1000,
-	-1000, 3579, 1772, 1368, 160, -1000, 998, 3967, -1000, 971,
-	137, 1473, 137, 2234, 1981, 942, -46, 1596, 3579, 159,
-	915, 1368, 158, -1000, -1000, -1000, -1000, 1368, 1368, 155,
-	-47, 3579, 152, 2085, 3579, 271, 1091, 2085, 426, 1090,
-	1164, 1164, 3579, 1089, 1164, -1000, -1000, -1000, -1000, -1000,
-	2489, 634, 3579, 578, 575, 2489, 2489, 148, 1064, 3954,
-	455, 267, -1000, 3579, 265, 264, 260, 995, 259, 455,
-	455, 450, 455, 447, -1000, -1000, 93, 1814, -1000, -1000,
-	-1000, 765, 2827, -1000, -1000, 3579, 458, -1000, -1000, -1000,
-	-1000, -1000, 998, 135, -1000, -1000, 3967, 147, -61, 146,
-	913, 979, -1000, -1000, 3579, 258, 929, 1473, 137, 971,
-	137, 1959, 1596, -1000, -70, -63, 132, 256, -1000, 1063,
-	-1000, -1000, 1057, 2085, 3967, -1000, -1000, -90, -1000, 455,
-	136, -1000, 2658, 425, -1000, -1000, -1000, 1013, -1000, 423,
-	144, 613, 571, 2489, 664, 725, 724, 569, 568, -1000,
-	254, 143, -1000, 999, 985, 455, 3632, 455, 455, 455,
-	253, 455, 142, 998, 126, 250, 122, 248, -1000, 3579,
-	-1000, 754, -1000, 979, 93, -1000, -1000, -1000, 3579, 114,
-	247, 503, 3967, 2085, -1000, -1000, 929, -1000, 971, 137,
-	-1000, -1000, 3579, -1000, 3579, 93, -1000, 1368, 136, -1000,
-	-1000, 121, -1000, 563, 356, -1000, -1000, 3671, 3579, -1000,
-	-1000, 3298, 3579, 2658, 2658, 1060, 560, 631, 2489, 3579,
-	792, -1000, 2489, -1000, -1000, 719, 718, 136, -1000, -1000,
-	984, 3579, 118, -1000, 112, 102, 94, 998, 84, -1000,
-	-1000, 455, -1000, 455, 3448, -1000, 500, -1000, 82, 93,
-	-1000, 1368, 1127, 80, -1000, -1000, 77, 72, -1000, 69,
-	-1000, -1000, -1000, 2658, 662, 688, 599, 15, 881, 1164,
-	-1000, 559, 557, 422, 761, 556, -1000, 661, -1000, 686,
-	-1000, -1000, 41, 3579, -1000, -1000, -1000, -1000, -1000, 31,
-	-1000, 30, 22, -1000, 1112, -1000, -1000, 18, -1000, -1000,
-	-1000, -1000, 96, -1000, 2658, 630, 3579, 2314, 2085, 2085,
-	24, 880, -1000, -1000, 2658, -1000, 759, 2489, -1000, 3579,
-	-1000, 404, -1000, -1000, -1000, -1000, 89, 93, -1000, 603,
-	554, 2658, 659, 550, 355, -1000, -1000, 3671, 3579, -1000,
-	-1000, -1000, 596, 592, 2085, 2085, 547, -1000, 750, -1000,
-	864, 93, -1000, -1000, 545, 626, 2658, 3579, 777, -1000,
-	2658, 717, 2314, 651, 683, 2314, 2314, 590, 474, -1000,
-	-1000, -1000, 863, 826, 824, 803, -1000, 758, 542, -1000,
-	644, -1000, 682, -1000, -1000, 2314, 617, 3579, 535, 533,
-	2314, 2314, 855, 822, -1000, 812, 800, -1000, -1000, -1000,
-	-1000, 757, 2658, -1000, 3579, 594, 532, 2314, 642, 712,
-	707, 528, 525, 862, -1000, -1000, -1000, -1000, -1000, 740,
-	521, 614, 2314, 3579, 773, -1000, 2314, -1000, -1000, 706,
-	705, -1000, 820, -1000, -1000, 756, 520, -1000, 641, -1000,
-	681, -1000, -1000, -1000, -1000, 735, 2314, -1000, 3579, -1000,
-	738, -1000,
+var yyPact = [...]int16{
+	3981, -32768, 392, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, 5518, 5326, -32768, -32768, 535, 1153,
+	158, 1242, 426, 1258, 1178, 1177, 331, 7957, -32768, 723,
+	1321, 1317, 7993, 7993, 666, 7993, 5326, -32768, -32768, 5326,
+	5326, 7923, 5326, 5326, 5326, 5326, 5326, 5326, -32768, 7993,
+	234, 7993, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, 403, -32768, -32768, -32768, -32768, -32768, 4558,
+	-32768, 4750, 1336, 1044, 1195, 935, -32768, -32768, -32768, 1345,
+	-32768, -32768, 3695, 5326, 5326, -89, 367, 364, 363, 362,
+	4, 487, 360, 358, 357, 356, 354, 353, 476, 351,
+	5326, 5326, -32768, -32768, -32768, -32768, -32768, 7993, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 349, -90, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 3981, 788, 4558, -32768, -32768, 348, 346, 345, 5326,
+	-32768, -32768, 825, 3695, -32768, 3981, 1127, 1133, 1153, 1242,
+	1256, 7348, 1245, 1243, 3217, -32768, 235, 1302, 1263, 1327,
+	6951, 5326, 7348, 7348, 877, 7348, -32768, 930, -4, 397,
+	-32768, 626, -32768, -32768, -32768, -32768, -32768, 7993, 7760, 7993,
+	7993, 523, 518, -32768, 1066, -32768, 7993, -32768, -32768, -32768,
+	-32768, 5326, 5326, 1310, 30, 1059, 1160, 1306, -32768, 1305,
+	-32768, -32768, 70, -89, -32768, -32768, 2071, -89, -32768, -32768,
+	-32768, 235, 334, 1302, 6094, 5326, 727, 253, 249, 250,
+	729, 36, 986, 1327, 345, -32768, -32768, 1001, 1001, 1001,
+	-32768, -5, 7993, -32768, 4942, 1068, -32768, 5326, 5326, 5326,
+	952, 5326, 969, 34, 5326, 1019, 5326, 5326, 5326, 5326,
+	5326, 5326, 5326, -32768, -32768, 7724, 5134, 5326, 5326, 4173,
+	5326, 5326, -32768, 344, 930, 930, 930, 5326, 5326, 5326,
+	34, 34, 949, 998, -32768, -32768, 123, -32768, 507, 5326,
+	7690, -32768, 3981, 249, 246, 5326, 820, 752, 751, 5326,
+	696, 619, 605, 5326, 5326, 5326, 1127, 1302, 7348, 1289,
+	-9, -32768, -66, -32768, -32768, 343, -32768, 336, -32768, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, -32768, 7348, 7348, 6951,
+	1303, -10, -32768, 1263, 1147, 5326, -32768, -14, -32768, 71,
+	5094, -32768, -32768, -32768, 6147, 4902, -32768, -32768, 4518, 4710,
+	335, 293, -32768, -32768, -32768, 242, -32768, 355, 1053, 7547,
+	7993, 956, 1309, 5326, -32768, 1327, 5326, 618, 419, 333,
+	329, -32768, -32768, -32768, -32768, -32768, 5326, 5326, 5326, 5326,
+	5326, 1241, -32768, -32768, 1342, 5326, 5326, 1323, 1323, 7348,
+	5326, 5326, 5326, -32768, -32768, 5326, 3695, -32768, -32768, -32768,
+	-32768, 3597, 7993, 1327, 7993, 45, 984, 334, -32768, 334,
+	334, 1195, 366, -32768, -16, 4222, -32768, -80, -32768, 326,
+	108, 177, 177, 1021, 4079, 5326, 34, 5326, -32768, 4558,
+	-32768, 177, 34, 34, 342, 342, -32768, -32768, -32768, 1911,
+	123, -32768, -32768, 240, 5326, 227, 1594, 226, 84, -32768,
+	221, 220, 2, 1264, 5326, 4942, 5326, 214, 213, 205,
+	-32768, -32768, 34, 216, 216, 216, 952, -32768, 1829, -32768,
+	-32768, 744, -32768, 5326, 694, 3981, 693, 5326, 4107, 784,
+	531, 1298, 673, 560, 516, -32768, -18, 2886, 615, 1263,
+	237, 7382, 7348, 7993, 5326, 4366, 300, 1041, 1263, 6951,
+	7186, 1147, 1137, 1129, 3695, 321, 320, 1097, 1095, 1076,
+	1067, 6312, -32768, -32768, -32768, -32768, -32768, 7993, 93, 4518,
+	-32768, 7993, -32768, 7993, -32768, 7993, 5326, 5326, -32768, 316,
+	7382, 6951, -32768, 7993, 332, 989, 7138, 6996, 7382, 7993,
+	204, -32768, 3695, 2757, 7993, 229, 191, 7993, -32768, -89,
+	-32768, -89, -89, -32768, -89, -32768, -32768, -21, 1223, 1327,
+	-32768, -32768, -32768, -24, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, 692, 391, -32768, -32768, 5518, 5326, -32768, -32768, -32768,
+	528, -32768, -32768, 728, -32768, 718, 7993, 7993, 1028, -32768,
+	-32768, 1028, -32768, 314, 7993, 4942, 7993, 2558, 5326, -32768,
+	-32768, 5326, 3887, -32768, 177, -32768, -32768, 515, 198, -32768,
+	5326, -32768, 5326, -32768, -32768, -32768, 5326, 197, 196, 195,
+	193, 590, 521, 519, 974, -32768, 236, -32768, 313, -32768,
+	-32768, 639, 5326, 691, 750, 3981, 5326, 889, -32768, -32768,
+	3695, 5326, 3981, 551, -32768, 5326, -32768, -32768, 524, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768, 5326, 473, -32768, -32768,
+	1297, 1147, 34, 1970, 1185, 1302, -27, 53, -85, -32768,
+	-32768, 187, -62, -28, -89, -90, 310, 7382, 6951, 1185,
+	1263, -32768, 1137, 1135, 5326, 5902, 5326, 7993, 6786, 6738,
+	1092, -32768, 1082, 1076, -32768, 1206, 146, -29, -32768, -32768,
+	-32768, -32768, -32768, -32, 2758, 7382, 183, -35, 1404, -32768,
+	7993, 235, -32768, -32768, 1439, 7993, 1166, 7513, -32768, 7382,
+	1159, 1158, 589, -32768, -32768, -32768, 167, -32768, -32768, -32768,
+	-32768, 1230, 182, -40, -32768, -32768, 1220, 179, -42, -32768,
+	-32768, -44, 1164, -70, 5326, 7993, -32768, 5326, 846, 3597,
+	782, 817, 3597, 3597, 3597, 713, 712, 235, 169, -32768,
+	-32768, -32768, 168, 123, 5326, -32768, 1146, 305, 582, 2292,
+	2678, 2492, 580, 579, 575, 510, 303, 302, 472, 301,
+	471, 34, 154, -45, -32768, 5326, -32768, 923, 2351, 872,
+	690, -32768, 780, -32768, 3503, 809, 505, 560, 1109, -32768,
+	478, -32768, 1171, -32768, 1137, 1185, 153, -32768, 4942, 1263,
+	7382, 5326, -32768, -32768, 5326, 7186, 7382, 152, 1169, -32768,
+	1185, 1135, -32768, 5326, 3695, -32768, -47, 3695, 298, 294,
+	203, 3159, 614, 1126, 146, 1106, 146, 6573, 6525, 1080,
+	-49, 293, 6312, 5326, -32768, 150, 1037, 7382, 5326, 148,
+	-50, -32768, -32768, -32768, -32768, 7382, 7382, 147, -51, 5326,
+	945, 940, 143, 7993, 5326, 292, 1217, 7993, 550, 1216,
+	1327, 1327, 5326, 1213, 1327, -32768, -32768, -32768, -32768, -32768,
+	3597, 749, 5326, 689, 687, 686, 3597, 3597, 142, 1205,
+	4942, 123, 290, 592, 289, -32768, 5326, -32768, -32768, 288,
+	287, 286, 1145, 285, 592, 592, 574, 592, 572, -32768,
+	-32768, 34, 1747, -32768, -32768, -32768, 871, 3981, -32768, -32768,
+	5326, 3981, 524, -32768, -32768, -32768, -32768, -32768, 1135, -32768,
+	232, -32768, 1185, -32768, 3695, 141, -72, 140, 1025, 5326,
+	-32768, 1153, 3695, 5902, 5326, 5326, 284, 7382, 7993, -32768,
+	-32768, 5326, 283, 1058, 1106, 146, 1126, 146, 6360, 6312,
+	-32768, -82, -73, 231, 277, -32768, 3128, 1201, 7993, -32768,
+	-32768, 1439, 7993, 3695, 938, -32768, -32768, -32768, -89, -32768,
+	592, 229, -32768, 3789, 549, -32768, -32768, -32768, 1164, -32768,
+	544, 139, 735, 685, 3597, 777, 526, 841, 839, 683,
+	681, -32768, 276, -32768, 1153, 137, -32768, 1155, 1118, 592,
+	2331, 592, 592, 592, 275, 592, 136, 1153, 135, 273,
+	130, 272, -32768, 5326, -32768, 856, 680, -32768, 1153, 34,
+	1185, -32768, -32768, -32768, 5326, 224, 271, 3128, 1127, -32768,
+	125, 124, 5710, 981, 980, 3695, 7993, -32768, -32768, 1058,
+	-32768, 1126, 146, -32768, -32768, 5326, -32768, 5326, 34, 1185,
+	7382, -32768, 804, 999, 235, -32768, -32768, -32768, -32768, 114,
+	-32768, -32768, 679, 390, -32768, -32768, 5518, 5326, -32768, -32768,
+	525, 4750, 5326, 3789, 3789, 1199, 674, 748, 3597, 5326,
+	884, -32768, 3597, 543, -32768, -32768, 835, 833, 235, 112,
+	-32768, -32768, 1116, 5326, 97, -32768, 94, 92, 91, 1153,
+	90, -32768, -32768, 592, -32768, 592, 2201, -32768, 504, 1127,
+	1185, -32768, 88, 34, 1185, 7382, -32768, 613, -32768, -32768,
+	69, -54, -32768, 3030, 261, 257, 62, -32768, -32768, 50,
+	47, 1185, -32768, 46, -32768, 963, 1190, -32768, -32768, -32768,
+	3789, 775, 803, 3789, 711, 14, 978, 1327, -32768, 672,
+	671, 541, 870, 670, -32768, 774, -32768, 801, 503, -32768,
+	-32768, 43, -32768, 5326, -32768, -32768, -32768, -32768, -32768, 42,
+	-32768, 41, 38, -32768, -32768, 610, -32768, -32768, 1185, -32768,
+	32, 1296, -32768, 5710, -32768, 5326, 7382, -32768, -32768, -32768,
+	-32768, 201, 765, 5326, 1011, -32768, 3789, 746, 5326, 669,
+	3405, 7993, 7993, 24, 977, -32768, -32768, 3789, -32768, 866,
+	3597, -32768, 5326, 3597, -32768, 467, -32768, -32768, -32768, 1291,
+	-32768, 189, -32768, -32768, 31, -64, 2967, 23, 34, 1185,
+	1286, 3695, 762, 562, 732, 664, 3789, 760, 522, 663,
+	387, -32768, -32768, 5518, 5326, -32768, -32768, -32768, 512, 702,
+	700, 7993, 7993, 661, -32768, 851, 654, -32768, 1030, -32768,
+	34, 1185, 20, 5326, 7993, 19, 1185, -32768, 1280, -32768,
+	1260, 963, 648, 745, 3789, 5326, 883, -32768, 3789, 539,
+	832, 3405, 759, 798, 3405, 3405, 3405, 699, 640, -32768,
+	-32768, 502, -32768, 995, 918, 916, 896, 1185, -32768, -32768,
+	-32768, -32768, -32768, -32768, 7382, 176, 757, 864, 646, -32768,
+	756, -32768, 795, 500, -32768, -32768, 3405, 738, 5326, 645,
+	643, 637, 3405, 3405, -32768, 960, 914, -32768, 929, 894,
+	-32768, -32768, -32768, -32768, -32768, 34, 7382, 1269, -32768, 861,
+	3789, -32768, 5326, 3789, 726, 636, 3405, 755, 511, 831,
+	830, 635, 633, 993, -32768, -32768, -32768, -32768, -32768, 8,
+	1278, -32768, -32768, 850, 632, 631, 675, 3405, 5326, 879,
+	-32768, 3405, 509, -32768, -32768, 828, 827, -32768, 903, -32768,
+	1227, 7382, -32768, 495, 860, 630, -32768, 754, -32768, 790,
+	494, -32768, -32768, -32768, 34, -32768, -32768, -32768, 859, 3405,
+	-32768, 5326, 3405, -32768, -32768, 849, 627, -32768, 491, -32768,
 }
 
-var yyPgo = [...]int{
-	0, 78, 12, 237, 7, 263, 9, 1306, 64, 24,
-	35, 1303, 1302, 1301, 1299, 404, 300, 1295, 1294, 1293,
-	1292, 1291, 1290, 1286, 60, 26, 25, 1284, 1283, 1282,
-	55, 1280, 37, 1277, 1275, 40, 30, 1273, 1272, 1271,
-	1270, 1269, 1171, 1268, 79, 92, 1332, 549, 46, 38,
-	61, 39, 31, 27, 43, 1266, 1265, 53, 1261, 44,
-	758, 1258, 81, 1257, 73, 72, 106, 1260, 130, 69,
-	50, 28, 11, 1256, 1254, 1253, 0, 1249, 76, 1244,
-	1242, 1241, 564, 1240, 1239, 1238, 1235, 33, 48, 23,
-	1233, 1230, 6, 1229, 1227, 58, 1223, 1222, 1216, 1212,
-	87, 70, 71, 1210, 32, 42, 292, 1209, 18, 1208,
-	1205, 21, 57, 1204, 19, 20, 47, 68, 49, 63,
-	1202, 1200, 1199, 41, 1196, 1195, 22, 62, 10, 16,
-	5, 8, 2, 4, 45, 1194, 13, 1192, 14, 1191,
-	3, 1189, 1567, 17, 15, 326, 1186, 84, 1078, 1185,
-	1184, 83, 97, 80, 67, 56, 66, 77, 1183, 34,
-	616, 1179,
+var yyPgo = [...]int16{
+	0, 157, 66, 459, 122, 975, 34, 1520, 96, 25,
+	74, 1519, 1516, 1513, 1511, 351, 274, 1509, 1508, 1506,
+	1505, 1504, 1503, 1501, 36, 40, 82, 33, 38, 1500,
+	1499, 1498, 67, 1497, 58, 1495, 1494, 65, 55, 1493,
+	1492, 1490, 1488, 1487, 1816, 1477, 11, 50, 86, 99,
+	1557, 588, 72, 64, 104, 21, 44, 1471, 20, 77,
+	51, 39, 28, 48, 1468, 1467, 68, 1464, 62, 1750,
+	1463, 90, 1462, 89, 84, 45, 2189, 222, 79, 4,
+	15, 17, 1460, 1458, 1452, 0, 1451, 94, 1449, 1448,
+	1444, 125, 1442, 1440, 1436, 78, 1433, 1432, 41, 27,
+	30, 1425, 1421, 7, 1418, 1416, 60, 1414, 1410, 1408,
+	1406, 175, 88, 81, 1404, 43, 1391, 1387, 53, 895,
+	1385, 1384, 1382, 8, 32, 1380, 1379, 14, 76, 1376,
+	16, 52, 63, 85, 47, 54, 49, 46, 1375, 3,
+	42, 1374, 100, 18, 1372, 35, 29, 24, 73, 12,
+	23, 6, 10, 5, 9, 71, 1365, 22, 1363, 13,
+	1362, 2, 1359, 1351, 102, 19, 37, 502, 1358, 93,
+	1218, 1355, 1354, 92, 95, 91, 87, 70, 83, 107,
+	1353, 26, 731, 1352,
 }
 
-var yyR1 = [...]int{
+var yyR1 = [...]uint8{
 	0, 1, 1, 1, 2, 2, 3, 3, 4, 4,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 5, 6, 6,
-	7, 7, 8, 8, 8, 8, 8, 9, 9, 10,
-	10, 12, 12, 11, 11, 11, 11, 11, 13, 13,
-	13, 13, 13, 13, 14, 14, 15, 15, 15, 15,
-	15, 16, 16, 17, 17, 18, 18, 18, 18, 18,
-	19, 19, 19, 19, 19, 19, 20, 20, 20, 20,
-	21, 21, 21, 21, 21, 22, 22, 23, 23, 23,
-	23, 23, 23, 23, 23, 23, 23, 24, 24, 25,
-	25, 26, 26, 26, 26, 26, 27, 27, 27, 27,
-	27, 27, 27, 28, 28, 28, 28, 28, 29, 29,
-	30, 30, 31, 31, 31, 31, 32, 33, 33, 34,
-	35, 35, 36, 36, 36, 37, 37, 37, 37, 37,
-	38, 38, 38, 38, 38, 38, 38, 39, 39, 39,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 40, 40, 40, 40, 40, 40, 40,
-	40, 40, 40, 40, 41, 41, 41, 42, 42, 42,
-	42, 43, 43, 43, 43, 44, 44, 44, 44, 45,
-	45, 46, 47, 48, 48, 49, 49, 50, 50, 51,
-	51, 52, 52, 53, 53, 53, 54, 54, 54, 55,
-	55, 56, 56, 57, 57, 57, 58, 58, 58, 59,
-	59, 60, 60, 61, 61, 62, 62, 63, 63, 63,
-	63, 63, 64, 65, 66, 66, 66, 66, 66, 67,
-	67, 67, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
-	68, 69, 70, 70, 70, 71, 71, 72, 72, 73,
-	73, 74, 74, 74, 75, 75, 76, 77, 78, 78,
-	78, 79, 79, 79, 79, 79, 79, 79, 79, 79,
-	79, 79, 79, 79, 79, 79, 79, 79, 79, 79,
-	80, 80, 80, 80, 80, 80, 80, 81, 81, 81,
-	81, 82, 82, 83, 83, 83, 83, 83, 83, 83,
-	83, 84, 84, 84, 84, 84, 84, 85, 85, 86,
-	86, 86, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 87, 88, 88, 89, 89, 90, 90, 91, 91,
-	91, 92, 92, 92, 93, 93, 94, 94, 95, 95,
-	95, 95, 96, 96, 96, 96, 96, 98, 98, 98,
-	97, 97, 97, 97, 99, 99, 99, 99, 100, 100,
-	103, 103, 104, 104, 104, 105, 105, 105, 105, 106,
-	106, 106, 106, 106, 106, 106, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 108, 108, 109, 109,
-	109, 109, 110, 111, 111, 112, 112, 113, 113, 114,
-	114, 115, 115, 116, 116, 117, 117, 101, 101, 102,
-	102, 118, 118, 119, 119, 120, 120, 120, 120, 121,
-	122, 123, 123, 124, 124, 124, 124, 124, 124, 124,
-	124, 125, 125, 126, 126, 127, 127, 128, 128, 129,
-	129, 130, 130, 131, 131, 132, 132, 133, 133, 134,
-	134, 135, 135, 136, 136, 137, 137, 138, 138, 139,
-	139, 140, 140, 141, 141, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 143, 144, 144, 145, 146, 146,
-	147, 147, 148, 149, 150, 151, 152, 152, 153, 153,
-	154, 154, 155, 155, 156, 156, 156, 157, 157, 158,
-	158, 159, 159, 160, 160, 161, 161,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 6,
+	6, 7, 7, 8, 8, 8, 8, 8, 9, 9,
+	10, 10, 12, 12, 11, 11, 11, 11, 11, 11,
+	13, 13, 13, 13, 13, 13, 13, 14, 14, 15,
+	15, 15, 15, 15, 16, 16, 17, 17, 18, 18,
+	18, 18, 18, 18, 19, 19, 19, 19, 19, 19,
+	19, 20, 20, 20, 20, 21, 21, 21, 21, 21,
+	22, 22, 23, 23, 23, 23, 23, 23, 23, 23,
+	23, 23, 24, 24, 24, 24, 25, 25, 26, 26,
+	27, 27, 28, 28, 28, 28, 28, 29, 29, 29,
+	29, 29, 29, 29, 29, 30, 30, 30, 30, 30,
+	30, 30, 31, 31, 32, 32, 33, 33, 33, 33,
+	34, 35, 35, 36, 37, 37, 38, 38, 38, 39,
+	39, 39, 39, 39, 40, 40, 40, 40, 40, 40,
+	40, 41, 41, 41, 42, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
+	43, 43, 43, 44, 44, 44, 44, 45, 45, 45,
+	45, 48, 48, 48, 48, 49, 49, 50, 50, 51,
+	52, 52, 53, 53, 54, 54, 55, 55, 55, 55,
+	56, 56, 57, 57, 58, 58, 59, 59, 60, 60,
+	61, 61, 62, 62, 62, 63, 63, 63, 64, 64,
+	65, 65, 66, 66, 66, 67, 67, 67, 68, 68,
+	69, 69, 70, 70, 71, 71, 72, 72, 72, 72,
+	72, 72, 73, 74, 75, 75, 75, 75, 75, 76,
+	76, 76, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 77, 77, 77, 77,
+	77, 77, 78, 79, 79, 79, 80, 80, 81, 81,
+	82, 82, 83, 83, 83, 84, 84, 85, 86, 87,
+	87, 87, 88, 88, 88, 88, 88, 88, 88, 88,
+	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
+	88, 89, 89, 89, 89, 89, 89, 89, 90, 90,
+	90, 90, 91, 91, 117, 92, 92, 92, 92, 92,
+	92, 92, 92, 92, 92, 92, 93, 93, 93, 94,
+	94, 94, 94, 94, 94, 94, 96, 96, 95, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
+	97, 98, 99, 99, 100, 100, 101, 101, 102, 102,
+	102, 103, 103, 103, 104, 104, 105, 105, 106, 106,
+	106, 106, 107, 107, 107, 107, 107, 107, 107, 107,
+	107, 109, 109, 109, 108, 108, 108, 108, 110, 110,
+	110, 110, 111, 111, 111, 114, 114, 115, 115, 115,
+	115, 115, 115, 116, 118, 118, 118, 118, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 121, 121,
+	122, 122, 123, 123, 120, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 124, 124, 125, 125, 125, 125,
+	126, 127, 127, 128, 128, 129, 129, 130, 130, 131,
+	131, 132, 132, 133, 133, 112, 112, 113, 113, 134,
+	134, 135, 135, 136, 136, 136, 136, 137, 138, 139,
+	139, 140, 140, 140, 140, 140, 140, 140, 140, 141,
+	141, 142, 142, 142, 143, 143, 144, 144, 144, 144,
+	144, 144, 145, 145, 146, 146, 46, 46, 47, 47,
+	47, 47, 147, 147, 148, 148, 149, 149, 150, 150,
+	151, 151, 152, 152, 153, 153, 154, 154, 155, 155,
+	156, 156, 157, 157, 158, 158, 159, 159, 160, 160,
+	161, 161, 162, 162, 163, 163, 163, 163, 163, 164,
+	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
+	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
+	164, 164, 164, 164, 164, 164, 164, 165, 166, 166,
+	167, 168, 168, 169, 169, 170, 171, 172, 173, 174,
+	174, 175, 175, 176, 176, 177, 177, 178, 178, 178,
+	179, 179, 180, 180, 181, 181, 182, 182, 183, 183,
 }
 
-var yyR2 = [...]int{
+var yyR2 = [...]int8{
 	0, 0, 1, 3, 0, 3, 0, 3, 0, 3,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 6, 8, 8, 9, 9, 1, 1, 1,
-	2, 1, 1, 7, 8, 6, 1, 1, 7, 8,
-	6, 1, 1, 1, 1, 1, 6, 8, 8, 9,
-	9, 1, 2, 1, 1, 7, 8, 6, 1, 1,
-	7, 8, 6, 1, 1, 1, 2, 2, 1, 2,
-	4, 4, 4, 4, 2, 1, 1, 7, 9, 6,
-	6, 8, 5, 7, 7, 7, 7, 1, 3, 1,
-	3, 0, 1, 1, 2, 2, 5, 5, 2, 4,
-	2, 3, 5, 6, 8, 5, 3, 3, 1, 3,
-	1, 3, 4, 2, 4, 3, 1, 1, 3, 3,
-	1, 3, 1, 1, 3, 9, 10, 10, 12, 3,
-	0, 1, 1, 1, 1, 2, 2, 5, 6, 3,
-	4, 4, 4, 4, 4, 4, 2, 2, 2, 2,
-	4, 4, 2, 2, 2, 4, 1, 2, 2, 4,
-	2, 2, 1, 2, 2, 3, 4, 3, 5, 4,
-	6, 8, 10, 9, 11, 5, 4, 4, 4, 1,
-	1, 3, 2, 0, 2, 0, 2, 0, 3, 0,
-	2, 0, 3, 1, 6, 5, 0, 1, 2, 1,
-	1, 0, 1, 1, 1, 1, 0, 1, 1, 0,
-	3, 0, 2, 6, 9, 1, 3, 1, 1, 1,
+	1, 1, 1, 6, 8, 8, 9, 9, 1, 1,
+	1, 2, 1, 1, 7, 8, 6, 1, 1, 10,
+	7, 8, 6, 1, 1, 1, 10, 1, 1, 6,
+	8, 8, 9, 9, 1, 2, 1, 1, 7, 8,
+	6, 1, 1, 10, 7, 8, 6, 1, 1, 1,
+	10, 2, 2, 1, 2, 4, 4, 4, 4, 2,
+	1, 1, 7, 9, 6, 6, 8, 5, 7, 7,
+	7, 7, 1, 2, 3, 4, 1, 3, 1, 3,
+	1, 3, 0, 1, 1, 2, 2, 5, 5, 5,
+	2, 4, 2, 3, 5, 6, 8, 5, 8, 5,
+	3, 3, 1, 3, 1, 3, 4, 2, 4, 3,
+	1, 1, 3, 3, 1, 3, 1, 1, 3, 9,
+	10, 10, 12, 3, 0, 1, 1, 1, 1, 2,
+	2, 5, 6, 3, 4, 4, 4, 4, 4, 4,
+	2, 2, 2, 2, 4, 4, 2, 2, 2, 4,
+	1, 2, 2, 4, 2, 2, 1, 2, 2, 3,
+	2, 3, 4, 3, 5, 4, 6, 9, 11, 10,
+	12, 6, 4, 4, 4, 1, 1, 3, 7, 2,
+	0, 2, 0, 2, 0, 3, 1, 4, 4, 5,
+	1, 3, 1, 2, 1, 3, 0, 2, 0, 2,
+	0, 3, 1, 6, 5, 0, 1, 2, 1, 1,
+	0, 1, 1, 1, 1, 0, 1, 1, 0, 3,
+	0, 2, 6, 9, 1, 3, 1, 1, 1, 2,
 	1, 1, 1, 1, 1, 3, 3, 3, 3, 1,
 	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 3, 1, 6, 1, 3, 1, 3, 2,
-	4, 0, 1, 1, 1, 1, 3, 3, 3, 1,
-	6, 3, 3, 3, 3, 4, 4, 5, 6, 6,
-	3, 4, 4, 3, 4, 4, 4, 4, 4, 2,
-	3, 3, 3, 3, 3, 2, 2, 3, 3, 2,
-	2, 0, 1, 4, 4, 6, 8, 3, 4, 4,
-	4, 5, 5, 5, 5, 5, 1, 5, 10, 8,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 1, 3, 1, 6, 1, 3, 1, 3,
+	2, 4, 0, 1, 1, 1, 1, 3, 3, 3,
+	1, 6, 3, 3, 3, 3, 4, 4, 5, 6,
+	6, 3, 4, 4, 3, 4, 4, 4, 4, 4,
+	2, 3, 3, 3, 3, 3, 2, 2, 3, 3,
+	2, 2, 0, 1, 4, 4, 4, 6, 8, 4,
+	6, 3, 4, 4, 4, 4, 1, 2, 5, 5,
+	5, 5, 5, 5, 9, 1, 5, 10, 5, 8,
 	9, 9, 9, 9, 9, 9, 8, 8, 10, 8,
 	10, 2, 1, 5, 0, 3, 2, 5, 2, 2,
 	2, 2, 2, 2, 2, 1, 2, 1, 1, 1,
 	4, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	4, 6, 6, 8, 6, 8, 6, 8, 1, 1,
-	1, 1, 1, 2, 3, 1, 2, 3, 4, 1,
-	2, 3, 1, 1, 1, 3, 4, 5, 6, 5,
-	6, 5, 6, 7, 6, 7, 2, 4, 1, 3,
-	1, 3, 5, 0, 1, 4, 5, 0, 2, 1,
+	1, 1, 1, 1, 4, 6, 6, 8, 6, 8,
+	6, 8, 1, 3, 1, 1, 1, 1, 2, 3,
+	1, 2, 3, 4, 1, 2, 3, 4, 1, 2,
+	3, 1, 1, 1, 3, 1, 2, 3, 11, 11,
+	1, 3, 1, 3, 4, 5, 6, 5, 6, 5,
+	6, 7, 6, 7, 2, 4, 1, 3, 1, 3,
+	5, 0, 1, 4, 5, 0, 2, 1, 3, 1,
 	3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-	3, 1, 3, 1, 3, 6, 9, 5, 8, 7,
-	3, 1, 3, 10, 13, 9, 12, 9, 12, 8,
-	11, 5, 6, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 4, 5, 0, 2, 4,
-	5, 0, 2, 4, 5, 0, 2, 4, 5, 0,
-	2, 4, 5, 0, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 3, 1, 3,
-	1, 3, 1, 1, 1, 1, 0, 1, 0, 1,
-	0, 1, 0, 1, 1, 1, 1, 0, 1, 0,
-	1, 0, 1, 1, 1, 0, 3,
+	3, 1, 3, 7, 10, 6, 9, 8, 3, 1,
+	3, 11, 14, 10, 13, 10, 13, 9, 12, 9,
+	8, 1, 2, 3, 0, 2, 7, 5, 8, 11,
+	10, 8, 1, 2, 6, 7, 0, 2, 1, 1,
+	1, 1, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 4, 5, 0, 2, 4, 5,
+	0, 2, 4, 5, 0, 2, 4, 5, 0, 2,
+	4, 5, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 1, 3, 1, 3, 1, 1, 1, 1, 0,
+	1, 0, 1, 0, 1, 0, 1, 1, 1, 1,
+	0, 1, 0, 1, 0, 1, 1, 1, 0, 3,
 }
 
-var yyChk = [...]int{
-	-1000, -1, -7, -5, -11, -42, -43, -120, -121, -124,
-	-125, -23, -20, -21, -27, -28, -31, -37, -22, -40,
-	-41, -68, 15, 91, 90, -8, -10, -44, -60, -46,
-	30, 35, 38, 136, 99, -145, 105, 23, 24, 103,
-	104, 102, 106, 123, 114, 115, 36, 127, 137, 119,
-	120, 121, 122, 128, 124, 125, 126, 129, -63, -80,
-	-77, -76, -83, -84, -86, -110, -79, -81, -143, -148,
-	-149, -150, -151, -39, 172, 16, 93, 118, -45, 83,
-	20, 5, 6, 7, -64, -65, -67, 166, 167, -142,
-	151, 153, 154, 152, -85, 155, 156, 157, 158, -70,
-	73, 77, 171, 11, 13, 14, 17, 12, 100, 9,
-	81, -66, 4, 138, 139, 140, 142, 143, 144, 145,
-	146, 150, 33, 164, -68, 172, -76, -145, 91, 30,
-	136, 90, -111, -67, -68, -52, 48, -44, -46, 27,
-	22, 30, 25, -76, 172, -47, -48, 28, 21, 172,
-	28, 39, 39, -147, 172, -146, -143, -147, -142, -143,
-	100, 47, 106, 130, -148, -151, -148, -142, -142, -38,
-	107, 108, 40, 41, 109, 110, -142, -142, -68, -68,
-	-68, -151, -142, -68, -68, -68, -142, -68, -115, -67,
-	-142, -68, -142, -142, 161, -67, -68, -115, -42, -60,
-	-46, -68, -143, -144, -9, 136, 99, 6, 68, 69,
-	70, -62, -61, -158, 34, -152, 82, 160, 159, 165,
-	80, 78, 77, 74, 79, -160, 167, 166, 168, 169,
-	170, 76, 75, -67, -67, 175, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 159, 165, -153, -160, 77,
-	-76, -67, -67, -142, 172, 175, -1, 95, -115, -82,
-	172, -111, -134, -112, 94, -53, -59, 54, 55, 51,
-	-52, -47, 28, -102, -100, -95, -97, -142, 19, 18,
-	33, -96, 142, 143, 144, 145, 146, 28, 21, -101,
-	-95, -48, -49, 26, -144, -143, -117, -106, -103, -107,
-	32, -104, 172, -100, -99, -76, -98, 147, 148, 149,
-	-82, -115, -100, -161, 91, -100, -152, 174, 161, 100,
-	47, 130, 131, -142, -142, 33, -142, -142, 165, 46,
-	165, 46, 65, -142, -68, -68, 21, 65, 65, 46,
-	21, 21, 174, 65, 174, -68, 6, -67, 173, 173,
-	173, 173, 97, 74, 174, 74, -143, -144, -157, 71,
-	-157, -157, 174, -142, -119, -109, -67, -69, -142, 168,
-	-67, -67, -67, -153, -67, 78, 74, 79, -70, 172,
-	-76, -67, 72, 71, -67, -67, -67, -67, -67, -67,
-	-67, -142, 6, -82, -152, -82, -67, 173, -119, -152,
-	-152, -152, -82, -82, -82, -70, -70, 78, 74, 72,
-	71, 80, 152, -67, -142, 6, -1, 173, 94, -135,
-	96, -113, 96, -67, -68, 101, 102, -68, -68, -72,
-	-73, -67, -53, -48, -100, 23, 174, 172, 172, -100,
-	-117, 21, 174, -49, -50, 49, -67, 63, -154, -156,
-	66, 174, 58, 60, 61, 62, -142, 31, -106, -76,
-	-142, 31, 172, 173, 65, 172, -142, 77, 36, 37,
-	45, 23, -82, -147, -67, 101, 172, 31, 172, 172,
-	-68, -142, -68, -142, -142, -68, -142, -68, -30, -29,
-	-68, 28, 5, -30, -116, -68, -151, -151, -100, -116,
-	-116, -115, -68, -2, -12, -5, -13, 91, 90, -8,
-	-10, -6, 116, 117, -142, -144, -142, 74, 74, -45,
-	-44, -45, -45, -62, 31, 172, 174, 31, 175, -64,
-	-65, 75, -67, -70, -67, -70, -70, 173, -82, 173,
-	21, 173, -82, -82, -69, -82, 173, 173, 173, -70,
-	-78, 172, -76, 150, -78, -78, -153, 174, -127, -126,
-	96, 92, 98, -1, 98, -67, 95, 95, 22, -55,
-	40, 107, -56, -57, 56, 89, 140, -58, 89, 140,
-	174, -74, 52, 53, 101, -49, 29, 172, -42, -123,
-	-122, -66, -142, -102, -82, -95, -68, -142, 33, 65,
-	172, -49, -117, -101, -50, -51, 50, 51, 57, 57,
-	-155, 59, -154, -156, -105, -106, 67, -104, -142, 173,
-	-142, -68, 172, -114, -66, 172, -159, 31, 73, -24,
-	172, -142, -66, 172, -66, -142, 173, -42, -142, -118,
-	-142, -42, 173, -36, -33, -35, -32, -34, -143, -142,
-	174, 31, -144, 174, 98, 164, -68, -111, 97, 97,
-	-142, -142, 172, -118, -119, -142, -69, -67, 75, 113,
-	173, -67, 173, 173, 173, 173, 113, 113, 134, 113,
-	134, 75, -71, -70, -76, 172, 103, 74, -67, 98,
-	-127, -1, -68, 90, -67, -1, -68, -54, 141, 83,
-	-72, 139, 22, -50, -71, -114, -48, 174, 165, 173,
-	173, 174, 174, 172, -114, -49, -51, -67, -115, -106,
-	67, -106, 67, 57, 57, -155, -104, 174, 174, -114,
-	173, 174, -118, -42, -26, 40, 41, 42, 43, -25,
-	-24, 44, -114, 46, 46, 113, 173, 174, 31, 173,
-	174, 174, 44, 173, 174, -30, -142, -116, 93, -2,
-	95, -136, 94, -2, -2, 97, 97, -42, 173, -67,
-	172, 113, 173, 101, 113, 113, 113, 135, 113, 172,
-	172, 139, 172, 139, -70, 173, 174, -67, 84, 173,
-	91, 98, 95, -112, -134, 94, -57, -59, 138, -75,
-	40, 41, -51, 173, -49, -123, -67, -82, -95, -114,
-	173, -52, -104, -108, 64, 65, -104, -106, 67, -106,
-	67, 57, 174, -105, -142, -68, 173, 65, -114, 173,
-	-66, -66, 173, 174, -67, 173, -142, -142, -68, 172,
-	31, -118, 132, 31, -32, -35, -35, -143, -68, 31,
-	-36, -2, -137, 96, -68, 98, 98, -2, -2, 173,
-	31, -88, -87, -89, 112, 172, -67, 172, 172, 172,
-	49, 172, -87, -89, -88, 113, -87, 113, -71, 174,
-	91, -1, -54, -52, 29, -42, 173, 173, 174, 173,
-	65, -53, -67, 172, -108, -108, -104, -104, -106, 67,
-	-105, 173, 174, 173, 174, 29, -42, 172, -159, -26,
-	-25, -88, -42, -3, -14, -5, -18, 91, 90, -15,
-	-16, 93, 133, 132, 132, 173, -129, -128, 96, 92,
-	98, -2, 95, 93, 93, 98, 98, 172, 173, -52,
-	48, 51, -88, 173, -88, -88, -88, 172, -87, 173,
-	173, 172, 173, 172, -67, -126, -53, -71, -82, 29,
-	-42, 172, 101, -118, -108, -104, -82, -82, -71, -114,
-	-42, 173, 98, 164, -68, -111, -68, -143, -144, -9,
-	-68, -3, -3, 31, 98, -129, -2, -68, 90, -2,
-	93, 93, -42, 51, -115, 173, 173, 173, 173, -52,
-	173, -88, -87, 173, 101, 173, -71, -114, 22, 173,
-	173, 173, 173, -3, 95, -138, 94, 97, 74, 74,
-	-143, -144, 98, 98, 132, 91, 98, 95, -136, 94,
-	173, -72, 173, 173, 173, 22, 173, 29, -42, -3,
-	-139, 96, -68, -4, -17, -5, -19, 91, 90, -15,
-	-16, -6, -142, -142, 74, 74, -3, 91, -2, -90,
-	140, 29, -42, -71, -131, -130, 96, 92, 98, -3,
-	95, 98, 164, -68, -111, 97, 97, -142, -142, 98,
-	-128, -91, 78, 85, 6, 88, -71, 98, -131, -3,
-	-68, 90, -3, 93, -4, 95, -140, 94, -4, -4,
-	97, 97, -93, 85, -92, 6, 88, 86, 86, 89,
-	91, 98, 95, -138, 94, -4, -141, 96, -68, 98,
-	98, -4, -4, 75, 86, 86, 87, 89, 91, -3,
-	-133, -132, 96, 92, 98, -4, 95, 93, 93, 98,
-	98, -94, 85, -92, -130, 98, -133, -4, -68, 90,
-	-4, 93, 93, 87, 91, 98, 95, -140, 94, 91,
-	-4, -132,
+var yyChk = [...]int16{
+	-32768, -1, -7, -5, -11, -44, -45, -136, -137, -140,
+	-141, -146, -23, -20, -21, -29, -30, -33, -39, -22,
+	-42, -43, -77, 15, 101, 100, -8, -10, 142, -48,
+	-69, -50, 30, 35, 38, 41, 149, 109, -167, 115,
+	23, 24, 113, 114, 112, 116, 133, 124, 125, 39,
+	137, 150, 129, 130, 131, 132, 138, 134, 135, 136,
+	151, 139, -72, -89, -86, -85, -92, -117, -93, -97,
+	-126, -88, -90, -165, -170, -171, -172, -173, -41, 195,
+	16, 103, 128, -49, 93, 20, 5, 6, 7, 170,
+	-73, -74, -76, 189, 190, -163, 173, 174, 176, 63,
+	171, -94, 177, 175, 178, 179, 180, 181, -79, 83,
+	87, 194, 11, 13, 14, 17, 12, 110, 9, 91,
+	-75, -164, 76, 77, 54, 37, -96, 169, 33, 4,
+	153, 152, 154, 155, 157, 158, 159, 160, 161, 162,
+	163, 164, 165, 148, 64, 62, 61, 36, 172, 144,
+	145, 187, -77, 195, -85, -167, 101, 30, 149, 100,
+	35, 151, -127, -76, -77, 144, -61, 51, -48, -50,
+	27, 22, 30, 35, 25, -85, 195, -51, -52, 28,
+	21, 195, 28, 28, 42, 42, -169, 195, -168, -165,
+	-169, -163, 63, 170, 174, 171, -165, 110, 50, 116,
+	140, -170, -173, -170, -163, -163, -40, 117, 118, 43,
+	44, 119, 120, -163, -163, -77, -77, -77, -173, -163,
+	-77, -77, -77, -163, -77, -131, -76, -163, -77, -163,
+	-44, 152, -69, -50, -163, 184, -76, -77, -131, -44,
+	-77, -165, -166, -9, 149, 109, 6, 78, 79, 80,
+	-71, -70, -180, 34, -174, 92, 5, 183, 182, 188,
+	90, 88, 87, 84, 89, -182, 190, 189, 191, 192,
+	193, 86, 85, -76, -76, 201, 195, 195, 195, 195,
+	195, 197, -95, 148, 195, 195, 195, 195, 195, 195,
+	182, 188, -175, -182, 87, -85, -76, -76, -163, 195,
+	201, -1, 105, -131, -91, 195, -127, -155, -128, 104,
+	-1, -62, -68, 58, 59, 55, -61, -51, 28, -113,
+	-111, -106, -163, -108, 19, 18, 33, -107, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 28, 28, 21,
+	-112, -106, -163, -52, -53, 26, -166, -165, -133, -119,
+	-114, -120, 32, -115, 195, -121, -111, -110, -85, -116,
+	-109, 172, 166, 167, 168, -91, -131, -111, -142, -111,
+	-183, 101, -111, -174, 92, 200, 184, 110, 50, 140,
+	141, -163, -163, 33, -163, -163, 188, 49, 188, 49,
+	73, -163, -77, -77, 21, 73, 73, 49, 21, 21,
+	200, 73, 200, -44, -77, 6, -76, 196, 196, 196,
+	196, 107, 84, 200, 84, -165, -166, -179, 81, -179,
+	-179, 200, -163, -135, -125, -76, -78, -163, 191, 72,
+	-76, -76, -76, -175, -76, 88, 84, 89, -79, 195,
+	-85, -76, 82, 81, -76, -76, -76, -76, -76, -76,
+	-76, -163, 6, -91, -174, -91, -76, -91, -163, 196,
+	-135, -91, -91, 195, -174, -174, -174, -91, -91, -91,
+	-79, -79, 88, 84, 82, 81, 90, 175, -76, -163,
+	6, -1, 196, 104, -156, 106, -129, 106, -76, -77,
+	108, 111, 112, -77, -77, -81, -82, -76, -62, -52,
+	-111, 23, 200, 201, 195, 195, -111, -142, -133, 21,
+	200, -53, -54, 52, -76, 76, 77, 71, -176, -178,
+	74, 200, 66, 68, 69, 70, -164, 31, -119, -85,
+	-164, 31, -164, 31, -164, 31, 195, 195, 196, 73,
+	195, 73, -163, 31, -163, 87, 39, 40, 48, 23,
+	-91, -169, -76, 111, 195, 31, 195, 195, -77, -163,
+	-77, -163, -163, -77, -163, -77, -32, -31, -77, 28,
+	5, -32, -132, -77, -173, -173, -111, -132, -132, -131,
+	-77, -2, -12, -5, -13, 101, 100, -8, -10, -6,
+	142, 126, 127, -163, -166, -163, 84, 84, -49, -48,
+	-49, -49, -71, 31, 195, 200, 31, 201, 195, -73,
+	-74, 85, -76, -79, -76, -79, -79, 196, -91, 196,
+	21, 196, 21, 196, 196, 198, 26, -91, -91, -78,
+	-91, 196, 196, 196, -79, -87, 195, -85, 169, -87,
+	-87, -175, 200, -148, -147, 106, 102, 108, -1, 108,
+	-76, 105, 105, 144, 22, -64, 43, 117, -65, -66,
+	60, 99, 155, -67, 99, 155, 200, -83, 56, 57,
+	111, -53, 29, 195, -44, -139, -138, -75, -163, -113,
+	-163, -91, -106, -77, -163, 33, 73, 195, 73, -53,
+	-133, -112, -54, -59, 53, 55, 195, 195, 65, 65,
+	-177, 67, -176, -178, -118, -119, 75, -115, -163, 196,
+	-163, -163, -163, -77, -76, 195, -130, -75, -119, -163,
+	195, -181, 31, 83, -26, 195, -24, -163, -75, 195,
+	-75, -163, 196, -44, -47, -163, -69, -136, -137, -140,
+	-146, 30, -134, -163, -44, -47, 196, -38, -35, -37,
+	-34, -36, -165, -163, 200, 31, -166, 200, 108, 187,
+	-77, -127, 144, 107, 107, -163, -163, 195, -134, -135,
+	-163, -78, -131, -76, 85, -95, 147, 123, 196, -76,
+	-76, -76, 196, 196, 196, 196, 123, 123, 146, 123,
+	146, 85, -80, -79, -85, 195, 113, 84, -76, 108,
+	-148, -1, -77, 100, -76, -1, 142, -77, -63, 156,
+	93, -81, 154, 22, -54, -80, -130, -46, 37, -52,
+	200, 188, 196, 196, 200, 200, 195, -130, -119, -46,
+	-53, -59, -60, 54, -76, -56, -55, -76, 61, 62,
+	63, -76, -163, -119, 75, -119, 75, 65, 65, -177,
+	-115, 172, 200, 200, 196, -130, 196, 200, 72, -25,
+	-24, -44, -28, 43, 44, 45, 46, -27, -26, 47,
+	-163, 87, -130, 49, 49, 123, 196, 200, 31, 196,
+	200, 200, 47, 196, 200, -32, -163, -132, 103, -2,
+	105, -157, 104, -2, -2, -2, 107, 107, -44, 196,
+	196, -76, 52, 195, 123, 196, 111, 196, 196, 123,
+	123, 123, 147, 123, 195, 195, 154, 195, 154, -79,
+	196, 200, -76, 94, 196, 101, 108, 105, -128, -155,
+	104, 145, -66, -68, 153, -84, 43, 44, -59, -46,
+	196, -135, -53, -139, -76, -91, -106, -130, 196, 72,
+	-46, -60, -76, 200, 195, 195, 64, 111, 111, -115,
+	-124, 72, 73, -115, -119, 75, -119, 75, 65, 200,
+	-118, -163, -77, 196, 73, -130, -76, 196, 200, -75,
+	-75, 196, 200, -76, 87, 91, 196, -163, -163, -77,
+	195, 31, -134, 142, 31, -34, -37, -37, -165, -77,
+	31, -38, -2, -158, 106, -77, 108, 108, 108, -2,
+	-2, 196, 31, -135, 195, -99, -98, -100, 122, 195,
+	-76, 195, 195, 195, 52, 195, -98, -100, -99, 123,
+	-98, 123, -80, 200, 101, -1, -1, -63, -60, 29,
+	-44, -46, 196, 196, 200, 196, 73, -76, -61, -56,
+	-131, -131, 195, -75, -163, -76, 195, -124, -124, -115,
+	-115, -119, 75, -118, 196, 200, 196, 200, 29, -44,
+	195, -145, -144, 104, -181, -25, -28, -27, 91, -99,
+	-44, -47, -3, -14, -5, -18, 101, 100, -15, -16,
+	142, 103, 143, 142, 142, 196, -150, -149, 106, 102,
+	108, -2, 105, 144, 103, 103, 108, 108, 195, -61,
+	196, -61, 51, 55, -99, 196, -99, -99, -99, 195,
+	-98, 196, 196, 195, 196, 195, -76, -147, 108, -61,
+	-80, -46, -91, 29, -44, 195, -145, -62, 196, 196,
+	-58, -57, -55, 195, 84, 84, -134, -124, -115, -91,
+	-91, -80, -46, -130, -145, 36, 87, -44, 196, 108,
+	187, -77, -127, 144, -77, -165, -166, -9, -77, -3,
+	-3, 31, 108, -150, -2, -77, 100, -2, 142, 103,
+	103, -44, 196, 55, -131, 196, 196, 196, 196, -61,
+	196, -99, -98, 196, 145, -62, -46, 196, -80, -46,
+	-130, 111, 196, 200, 196, 195, 195, 196, 196, 196,
+	-46, 196, -143, 85, 36, -3, 105, -159, 104, -3,
+	107, 84, 84, -165, -166, 108, 108, 142, 101, 108,
+	105, -157, 104, 145, 196, -81, 196, 196, 196, 111,
+	-46, 196, 22, -58, -123, -122, -76, -130, 29, -44,
+	105, -76, -143, 55, -3, -160, 106, -77, 108, -4,
+	-17, -5, -19, 101, 100, -15, -16, -6, 142, -163,
+	-163, 84, 84, -3, 101, -2, -2, -101, 155, 22,
+	29, -44, 196, 200, 31, 196, -80, -46, 22, 25,
+	105, 132, -152, -151, 106, 102, 108, -3, 105, 144,
+	108, 187, -77, -127, 144, 107, 107, -163, -163, 108,
+	-149, 108, -102, 88, 95, 6, 98, -80, -46, 196,
+	-123, -163, 196, -46, 23, 27, -143, 108, -152, -3,
+	-77, 100, -3, 142, 103, -4, 105, -161, 104, -4,
+	-4, -4, 107, 107, 145, -104, 95, -103, 6, 98,
+	96, 96, 99, -46, -139, 29, 195, 105, 101, 108,
+	105, -159, 104, 145, -4, -162, 106, -77, 108, 108,
+	108, -4, -4, 85, 96, 96, 97, 99, -79, -130,
+	22, 25, 101, -3, -3, -154, -153, 106, 102, 108,
+	-4, 105, 144, 103, 103, 108, 108, -105, 95, -103,
+	196, 23, -151, 108, 108, -154, -4, -77, 100, -4,
+	142, 103, 103, 97, 29, -139, 145, 101, 108, 105,
+	-161, 104, 145, -79, 101, -4, -4, -153, 108, 145,
 }
 
-var yyDef = [...]int{
-	-2, -2, 2, 30, 31, 10, 11, 12, 13, 14,
+var yyDef = [...]int16{
+	-2, -2, 2, 31, 32, 10, 11, 12, 13, 14,
 	15, 16, 17, 18, 19, 20, 21, 22, 23, 24,
-	25, -2, 27, 0, 413, 46, 47, -2, 0, 193,
-	0, 0, 0, 0, 0, -2, 0, 0, 0, 0,
-	0, 140, 0, 0, 85, 86, 0, 0, 0, 0,
-	0, 0, 0, 166, 0, 172, 0, 0, 242, 243,
-	244, -2, 246, 247, 248, 249, 250, 251, 252, 254,
-	255, 256, 257, 258, 0, 260, 0, 39, 0, 519,
-	506, 227, 228, 229, 230, 231, 0, 0, 0, 234,
-	0, 0, 0, 0, 326, 0, 0, 0, 0, 508,
-	0, 0, 0, 494, 502, 503, 504, 505, 0, 232,
-	233, 239, 485, 486, 487, 488, 489, 490, 491, 492,
-	493, 0, 0, -2, 240, 311, 245, 253, 0, 0,
-	0, 413, 0, 414, 240, 219, 0, -2, 193, 0,
-	0, 0, 0, 190, 0, 193, 195, 0, 0, 311,
-	0, 525, 0, 76, 506, 500, 498, 77, 0, 79,
-	0, 0, 0, 0, 0, 0, 84, 108, 110, 0,
-	141, 142, 143, 144, 0, 0, 0, -2, -2, 240,
-	240, 156, 168, -2, -2, -2, -2, -2, 167, 421,
-	-2, -2, 173, 174, 0, 0, 240, 0, 0, 0,
-	193, 240, 252, 0, 0, 37, 38, 40, 517, 517,
-	517, 222, 225, 0, 520, 0, 507, 0, 523, 524,
-	508, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 305, 306, 0, 311, 311, 0, 506,
-	506, 506, 311, 311, 311, 523, 524, 0, 0, 509,
-	299, 309, 310, 0, 0, 0, 3, -2, 0, 0,
-	311, 0, 471, 417, 0, 177, 203, 0, 0, 0,
-	219, 193, 0, 0, 429, 378, 379, 358, 359, 0,
-	361, 0, -2, -2, -2, -2, -2, 0, 0, 0,
-	427, 195, 197, 0, 192, 495, 194, -2, 389, 392,
-	393, 394, 0, 380, 381, 382, 0, 367, 368, 369,
-	0, 312, 0, 0, 0, 0, 311, 0, 0, 0,
-	0, 0, 0, 111, 116, 117, 125, 139, 0, 0,
-	0, 0, 0, 0, -2, -2, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, -2, 228, 497, 241, 259,
-	262, 276, -2, 0, 0, 0, 0, 0, 0, 518,
-	0, 0, 519, 0, 191, 433, 408, 410, 234, 261,
-	277, -2, -2, 0, 0, 0, 0, 0, 290, 0,
-	263, -2, 0, 0, 300, 301, 302, 303, 304, 307,
-	308, 235, 237, 0, 311, 0, 421, 317, 0, 311,
-	311, 311, 0, 0, 0, 282, 284, 0, 0, 0,
-	0, 508, 149, 0, 236, 238, 455, 319, 0, 0,
-	-2, 0, 0, 0, 240, 0, 0, -2, -2, 202,
-	267, 271, 179, 195, 0, 0, 0, 311, 0, 0,
-	195, 0, 0, 197, 199, 0, 196, 0, 0, 512,
-	510, 0, 511, 514, 515, 516, 390, 0, 510, -2,
-	383, 0, 0, 320, 0, 0, 521, 0, 0, 0,
-	0, 0, 0, 501, 499, 0, 0, 0, 0, 0,
-	-2, -2, -2, -2, -2, -2, -2, -2, 109, 120,
-	-2, 0, 122, 124, 165, -2, 154, 155, 169, 160,
-	161, 422, -2, 0, 0, 41, 42, 0, 413, 51,
-	52, 53, 28, 29, 0, 496, 0, 0, 0, 186,
-	189, 187, 188, 226, 0, 0, 0, 0, 0, 285,
-	286, 0, 0, 291, -2, 295, 297, 313, 0, 314,
-	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
-	292, 0, 279, 0, 296, 298, 0, 0, 0, 455,
-	-2, 0, 0, 472, 412, 418, 0, -2, 178, 0,
-	209, 210, 206, 212, 213, 214, 215, 220, 217, 218,
-	0, 269, 272, 273, 0, 197, 0, 0, 437, 193,
-	441, 0, 234, 430, 0, 0, 240, -2, 361, 0,
-	0, 451, 195, 428, 199, 185, 0, 0, 0, 0,
-	0, 513, 0, 512, 426, -2, 0, 394, 391, 395,
-	384, 240, 0, 0, 419, 0, 0, 522, 526, 101,
-	0, 97, 92, 0, 0, 0, 323, 106, 107, 0,
-	431, 115, 0, 0, 132, 133, 127, 130, 126, 0,
-	0, 0, 112, 0, 0, -2, 240, 0, -2, -2,
-	0, 0, 0, 0, 434, 409, 411, 287, 0, 0,
-	321, 0, 322, 324, 325, 327, 0, 0, 0, 0,
-	0, 0, 0, 265, -2, 0, 147, 0, 0, 0,
-	0, 456, 240, 45, 415, 469, 240, 219, 207, 0,
-	268, 0, 180, 199, 435, 0, 195, 0, 0, 360,
-	370, 311, 0, 0, 0, 452, 201, 200, 198, 396,
-	0, 510, 0, 0, 0, 0, 386, 0, 0, 0,
-	0, 0, 0, 89, 90, 102, 103, 0, 0, 0,
-	99, 0, 0, 0, 0, 0, 113, 0, 0, 0,
-	0, 0, 0, 0, 0, 121, 119, 424, 32, 5,
-	-2, 475, 0, 0, 0, -2, -2, 0, 0, 288,
-	344, 0, 315, 0, 0, 0, 0, 0, 0, 344,
-	344, 0, 344, 0, 289, 278, 0, 0, 148, 264,
-	43, 0, -2, 416, 470, 0, 206, 205, 208, 270,
-	274, 275, 201, 0, 439, 442, 440, 0, 0, 0,
-	0, 219, 401, 397, 0, 0, 0, 510, 0, 399,
-	0, 0, 0, 387, 234, 240, 0, 0, 420, -2,
-	104, 105, 101, 0, 98, 93, 94, -2, -2, 344,
-	0, 432, -2, 0, 128, 134, 131, 0, -2, 0,
-	0, 459, 0, -2, 240, 0, 0, 0, 0, 223,
-	0, 0, 342, 201, 0, 344, 0, 344, 344, 344,
-	0, 344, 0, 201, 0, 0, 0, 0, 266, 0,
-	44, 453, 204, 219, 0, 438, 371, 372, 311, 0,
-	0, 181, 406, 0, 402, 398, 0, 404, 400, 0,
-	388, 374, 311, 376, 311, 0, 449, 0, 0, 91,
-	100, 0, 114, 0, 0, 54, 55, 0, 413, 68,
-	69, 0, 61, -2, -2, 0, 0, 459, -2, 0,
-	0, 476, -2, 33, 34, 0, 0, 0, 329, 341,
-	0, 0, 0, 316, 0, 0, 0, 201, 0, 336,
-	337, 344, 339, 344, 0, 454, 183, 436, 0, 0,
-	445, 0, 0, 0, 403, 405, 0, 0, 447, 0,
-	88, 332, 135, -2, 240, 0, 240, 252, 0, 0,
-	-2, 0, 0, 0, 0, 0, 460, 240, 50, 473,
-	35, 36, 0, 0, 345, 330, 331, 333, 334, 0,
-	335, 0, 0, 280, 0, 373, 443, 0, 182, 407,
-	375, 377, 0, 7, -2, 479, 0, -2, 0, 0,
-	0, 0, 136, 137, -2, 48, 0, -2, 474, 0,
-	224, 202, 328, 338, 340, 184, 0, 0, 450, 463,
-	0, -2, 240, 0, 0, 63, 64, 0, 413, 73,
-	74, 75, 0, 0, 0, 0, 0, 49, 457, 343,
-	0, 0, 446, 448, 0, 463, -2, 0, 0, 480,
-	-2, 0, -2, 240, 0, -2, -2, 0, 0, 138,
-	458, 346, 0, 0, 0, 0, 444, 0, 0, 464,
-	240, 67, 477, 56, 9, -2, 483, 0, 0, 0,
-	-2, -2, 0, 0, 355, 0, 0, 348, 349, 350,
-	65, 0, -2, 478, 0, 467, 0, -2, 240, 0,
-	0, 0, 0, 0, 354, 351, 352, 353, 66, 461,
-	0, 467, -2, 0, 0, 484, -2, 57, 58, 0,
-	0, 347, 0, 357, 462, 0, 0, 468, 240, 72,
-	481, 59, 60, 356, 70, 0, -2, 482, 0, 71,
-	465, 466,
+	25, 26, -2, 28, 0, 471, 47, 48, 0, -2,
+	0, 210, 0, 588, 0, 0, 0, 0, -2, 0,
+	0, 0, 0, 0, 154, 0, 0, 90, 91, 0,
+	0, 0, 0, 0, 0, 0, 180, 0, 186, 0,
+	596, 0, 272, 273, 274, -2, 276, 277, 278, 279,
+	280, 281, 282, 283, 285, 286, 287, 288, 289, 0,
+	291, 0, 40, 0, 622, 609, 256, 257, 258, 590,
+	260, 261, 0, 0, 0, 264, 0, 591, 0, 587,
+	592, 356, 0, 0, 0, 0, 0, 0, 611, 0,
+	0, 0, 597, 605, 606, 607, 608, 0, 262, 263,
+	269, 564, 565, 566, 567, 568, 365, 0, 0, 569,
+	570, 571, 572, 573, 574, 575, 576, 577, 578, 579,
+	580, 581, 582, 583, 584, 585, 586, 589, 593, 594,
+	595, -2, 270, 342, 275, 284, 0, 0, 0, 471,
+	588, 596, 0, 472, 270, -2, 248, 0, -2, 210,
+	0, 0, 0, 0, 0, 206, 0, 210, 212, 0,
+	0, 342, 0, 0, 628, 0, 81, 609, 603, 601,
+	82, 0, 587, 590, 591, 592, 84, 0, 0, 0,
+	0, 0, 0, 89, 120, 122, 0, 155, 156, 157,
+	158, 0, 0, 0, -2, -2, 270, 270, 170, 182,
+	-2, -2, -2, -2, -2, 181, 479, -2, -2, 187,
+	188, 0, 0, 210, 190, 0, 0, 270, 0, 0,
+	270, 283, 0, 0, 38, 39, 41, 620, 620, 620,
+	251, 254, 0, 623, 0, 610, 259, 0, 626, 627,
+	611, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 336, 337, 0, 342, 342, 342, 0,
+	342, 342, 357, 0, 609, 609, 609, 342, 342, 342,
+	626, 627, 0, 0, 612, 330, 340, 341, 0, 0,
+	0, 3, -2, 0, 0, 342, 0, 550, 475, 0,
+	0, 193, 232, 0, 0, 0, 248, 210, 0, 0,
+	487, 422, 398, 424, 399, 0, 401, 0, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, 0, 0, 0,
+	0, 485, 398, 212, 214, 0, 209, 598, 211, -2,
+	438, 441, 442, 443, 0, 445, 425, 426, 427, 430,
+	0, 593, 411, 412, 413, 0, 343, 0, 0, 511,
+	0, 0, 0, 342, 610, 0, 0, 0, 0, 0,
+	0, 123, 130, 131, 139, 153, 0, 0, 0, 0,
+	0, 0, -2, -2, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, -2, 257, 600, 271, 290, 293,
+	307, -2, 0, 0, 0, 0, 0, 0, 621, 0,
+	0, 622, 0, 207, 491, 466, 468, 264, 292, 0,
+	308, -2, -2, 0, 0, 0, 0, 0, 321, 0,
+	294, -2, 0, 0, 331, 332, 333, 334, 335, 338,
+	339, 265, 267, 0, 342, 0, 479, 0, 264, 351,
+	0, 0, 0, 0, 342, 342, 342, 0, 0, 0,
+	313, 315, 0, 0, 0, 0, 611, 163, 0, 266,
+	268, 534, 353, 0, 0, -2, 0, 0, 0, 270,
+	0, 0, 0, -2, -2, 231, 298, 302, 195, 212,
+	0, 0, 0, 0, 342, 0, 0, 0, 212, 0,
+	0, 214, 226, 0, 213, 0, 0, 0, 0, 615,
+	613, 0, 614, 617, 618, 619, 439, 0, 613, -2,
+	446, 0, 428, 0, 431, 0, 0, 0, 354, 0,
+	0, 0, 512, 0, 624, 0, 0, 0, 0, 0,
+	0, 604, 602, 250, 0, 250, 0, 0, -2, -2,
+	-2, -2, -2, -2, -2, -2, 121, 134, -2, 0,
+	136, 138, 179, -2, 168, 169, 183, 174, 175, 480,
+	-2, 0, 0, 42, 43, 0, 471, 53, 54, 55,
+	0, 29, 30, 0, 599, 0, 0, 0, 202, 205,
+	203, 204, 255, 0, 0, 0, 0, 0, 0, 316,
+	317, 0, 0, 322, -2, 326, 328, 345, 0, 346,
+	0, 349, 0, 352, 355, 344, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 323, 0, 310, 0, 327,
+	329, 0, 0, 0, 534, -2, 0, 0, 551, 470,
+	476, 0, -2, 0, 194, 0, 238, 239, 235, 241,
+	242, 243, 244, 249, 246, 247, 0, 300, 303, 304,
+	0, 214, 0, 0, 526, 210, 499, 0, 264, 488,
+	423, 0, 0, 270, -2, 401, 0, 0, 0, 526,
+	212, 486, 226, 228, 0, 0, 0, 0, 0, 0,
+	0, 616, 0, 615, 484, -2, 0, 443, 440, 444,
+	447, 429, 432, 270, 0, 0, 0, 477, 613, 513,
+	0, 0, 625, 629, 112, 0, 108, 102, 97, 0,
+	0, 0, 361, 117, 118, 119, 0, 528, 529, 530,
+	531, 0, 0, 489, 127, 129, 0, 0, 146, 147,
+	141, 144, 140, 0, 0, 0, 124, 0, 0, -2,
+	270, 0, -2, -2, -2, 0, 0, 0, 0, 492,
+	467, 469, 0, 318, 0, 358, 0, 0, 359, 0,
+	0, 0, 360, 362, 363, 366, 0, 0, 0, 0,
+	0, 0, 0, 296, -2, 0, 161, 0, 0, 0,
+	0, 535, 270, 46, 473, 548, 0, 270, 248, 236,
+	0, 299, 0, 196, 226, 526, 0, 495, 0, 212,
+	0, 0, 400, 414, 342, 0, 0, 0, 613, 524,
+	526, 228, 201, 0, 227, 215, 220, 216, 586, 585,
+	587, 0, 0, 454, 0, 613, 0, 0, 0, 0,
+	435, 0, 0, 0, 433, 0, 0, 0, 0, 0,
+	106, 94, 95, 113, 114, 0, 0, 0, 110, 0,
+	103, 0, 0, 0, 0, 0, 125, 0, 0, 0,
+	0, 0, 0, 0, 0, 135, 133, 482, 33, 5,
+	-2, 554, 0, 0, 0, 0, -2, -2, 0, 0,
+	0, 319, 0, 384, 0, 347, 0, 350, 368, 0,
+	0, 0, 0, 0, 384, 384, 0, 384, 0, 320,
+	309, 0, 0, 162, 295, 44, 0, -2, 474, 549,
+	0, -2, 235, 234, 237, 301, 305, 306, 228, 493,
+	0, 527, 526, 500, 498, 0, 0, 0, 0, 0,
+	525, 230, 229, 0, 0, 0, 0, 0, 0, 459,
+	455, 0, 0, 0, 613, 0, 457, 0, 0, 0,
+	436, 264, 270, 0, 0, 478, 0, -2, 0, 115,
+	116, 112, 0, 109, 0, 104, 98, 99, -2, -2,
+	384, 250, 490, -2, 0, 142, 148, 145, 0, -2,
+	0, 0, 538, 0, -2, 270, 0, 0, 0, 0,
+	0, 252, 0, 208, 230, 0, 382, 230, 0, 384,
+	0, 384, 384, 384, 0, 384, 0, 230, 0, 0,
+	0, 0, 297, 0, 45, 532, 0, 233, 230, 0,
+	526, 497, 415, 416, 342, 0, 0, 0, 248, 221,
+	0, 0, 0, 0, 0, 464, 0, 460, 456, 0,
+	462, 458, 0, 437, 418, 342, 420, 342, 0, 526,
+	0, 510, 522, 0, 0, 107, 96, 111, 105, 0,
+	126, 128, 0, 0, 57, 58, 0, 471, 71, 72,
+	0, 0, 64, -2, -2, 0, 0, 538, -2, 0,
+	0, 555, -2, 0, 34, 35, 0, 0, 0, 0,
+	369, 381, 0, 0, 0, 348, 0, 0, 0, 230,
+	0, 376, 377, 384, 379, 384, 0, 533, 0, 248,
+	526, 496, 0, 0, 526, 0, 509, 197, 217, 218,
+	0, 224, 222, 0, 0, 0, 0, 461, 463, 0,
+	0, 526, 507, 0, 523, 514, 0, 93, 372, 149,
+	-2, 270, 0, -2, 270, 283, 0, 0, -2, 0,
+	0, 0, 0, 0, 539, 270, 52, 552, 0, 36,
+	37, 0, 364, 0, 385, 370, 371, 373, 374, 0,
+	375, 0, 0, 311, 49, 199, 494, 417, 526, 503,
+	0, 0, 219, 0, 223, 0, 0, 465, 419, 421,
+	505, 0, 0, 0, 514, 7, -2, 558, 0, 0,
+	-2, 0, 0, 0, 0, 150, 151, -2, 50, 0,
+	-2, 553, 0, -2, 253, 231, 367, 378, 380, 0,
+	501, 0, 198, 225, 0, 452, 450, 0, 0, 526,
+	0, 515, 0, 0, 542, 0, -2, 270, 0, 0,
+	0, 66, 67, 0, 471, 77, 78, 79, 0, 0,
+	0, 0, 0, 0, 51, 536, 0, 383, 0, 200,
+	0, 526, 0, 0, 0, 0, 526, 508, 0, 517,
+	0, 514, 0, 542, -2, 0, 0, 559, -2, 0,
+	0, -2, 270, 0, -2, -2, -2, 0, 0, 152,
+	537, 0, 386, 0, 0, 0, 0, 526, 504, 448,
+	453, 451, 449, 506, 0, 0, 0, 0, 0, 543,
+	270, 70, 556, 0, 59, 9, -2, 562, 0, 0,
+	0, 0, -2, -2, 56, 0, 0, 395, 0, 0,
+	388, 389, 390, 502, 516, 0, 0, 0, 68, 0,
+	-2, 557, 0, -2, 546, 0, -2, 270, 0, 0,
+	0, 0, 0, 0, 394, 391, 392, 393, 518, 0,
+	0, 521, 69, 540, 0, 0, 546, -2, 0, 0,
+	563, -2, 0, 60, 61, 0, 0, 387, 0, 397,
+	0, 0, 541, 0, 0, 0, 547, 270, 76, 560,
+	0, 62, 63, 396, 0, 520, 73, 74, 0, -2,
+	561, 0, -2, 519, 75, 544, 0, 545, 0, 80,
 }
 
-var yyTok1 = [...]int{
+var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 171, 3, 3, 3, 170, 3, 3,
-	172, 173, 168, 167, 174, 166, 175, 169, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 164,
-	3, 165,
+	3, 3, 3, 194, 3, 3, 3, 193, 3, 3,
+	195, 196, 191, 190, 200, 189, 201, 192, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 187,
+	3, 188, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 197, 3, 198,
 }
 
-var yyTok2 = [...]int{
+var yyTok2 = [...]uint8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -1844,10 +2450,12 @@ var yyTok2 = [...]int{
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
 	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
-	162, 163,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 199,
 }
 
-var yyTok3 = [...]int{
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -1890,7 +2498,7 @@ func yyNewParser() yyParser {
 	return &yyParserImpl{}
 }
 
-const yyFlag = -1000
+const yyFlag = -32768
 
 func yyTokname(c int) string {
 	if c >= 1 && c-1 < len(yyToknames) {
@@ -1929,9 +2537,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1941,13 +2549,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1978,30 +2586,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -2056,7 +2664,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -2067,8 +2675,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -2081,7 +2689,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -2090,18 +2698,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -2123,10 +2731,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -2162,7 +2770,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -2173,16 +2781,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -2190,1066 +2798,1162 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:254
+//line lib/parser/parser.y:288
 		{
 			yyVAL.program = nil
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:259
+//line lib/parser/parser.y:293
 		{
 			yyVAL.program = []Statement{yyDollar[1].statement}
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:264
+//line lib/parser/parser.y:298
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 			yylex.(*Lexer).program = yyVAL.program
 		}
 	case 4:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:271
+//line lib/parser/parser.y:305
 		{
 			yyVAL.program = nil
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:275
+//line lib/parser/parser.y:309
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 6:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:281
+//line lib/parser/parser.y:315
 		{
 			yyVAL.program = nil
 		}
 	case 7:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:285
+//line lib/parser/parser.y:319
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 8:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:291
+//line lib/parser/parser.y:325
 		{
 			yyVAL.program = nil
 		}
 	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:295
+//line lib/parser/parser.y:329
 		{
 			yyVAL.program = append([]Statement{yyDollar[1].statement}, yyDollar[3].program...)
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:301
+//line lib/parser/parser.y:335
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:305
+//line lib/parser/parser.y:339
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:309
+//line lib/parser/parser.y:343
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:313
+//line lib/parser/parser.y:347
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:317
+//line lib/parser/parser.y:351
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:321
+//line lib/parser/parser.y:355
 		{
 			yyVAL.statement = yyDollar[1].expression
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:325
+//line lib/parser/parser.y:359
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = yyDollar[1].expression
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:329
+//line lib/parser/parser.y:363
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:333
+//line lib/parser/parser.y:367
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:337
+//line lib/parser/parser.y:371
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:341
+//line lib/parser/parser.y:375
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:345
+//line lib/parser/parser.y:379
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:349
+//line lib/parser/parser.y:383
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:353
+//line lib/parser/parser.y:387
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:357
+//line lib/parser/parser.y:391
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:361
+//line lib/parser/parser.y:395
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:365
+//line lib/parser/parser.y:399
 		{
-			yyVAL.statement = yyDollar[1].queryexpr
+			yyVAL.statement = yyDollar[1].statement
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:369
+//line lib/parser/parser.y:403
 		{
-			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
+			yyVAL.statement = yyDollar[1].queryexpr
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:375
+//line lib/parser/parser.y:407
 		{
-			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
+			yyVAL.statement = ExternalCommand{BaseExpr: NewBaseExpr(yyDollar[1].token), Command: yyDollar[1].token.Literal}
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:379
+//line lib/parser/parser.y:413
 		{
 			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:385
+//line lib/parser/parser.y:417
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.statement = FlowControl{Token: yyDollar[1].token.Token}
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:389
+//line lib/parser/parser.y:423
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 32:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:427
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:395
+//line lib/parser/parser.y:433
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:399
+//line lib/parser/parser.y:437
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:403
+//line lib/parser/parser.y:441
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:407
+//line lib/parser/parser.y:445
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:411
+//line lib/parser/parser.y:449
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:417
+//line lib/parser/parser.y:455
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:421
+//line lib/parser/parser.y:459
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:427
+//line lib/parser/parser.y:465
 		{
 			yyVAL.statement = Exit{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:431
+//line lib/parser/parser.y:469
 		{
 			yyVAL.statement = Exit{Code: value.NewIntegerFromString(yyDollar[2].token.Literal)}
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:437
+//line lib/parser/parser.y:475
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:441
+//line lib/parser/parser.y:479
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:447
+//line lib/parser/parser.y:485
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:451
+//line lib/parser/parser.y:489
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:455
+//line lib/parser/parser.y:493
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:459
+//line lib/parser/parser.y:497
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:463
+//line lib/parser/parser.y:501
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 48:
+	case 49:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:505
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:469
+//line lib/parser/parser.y:511
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 49:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:473
+//line lib/parser/parser.y:515
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 50:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:477
+//line lib/parser/parser.y:519
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 51:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:481
+//line lib/parser/parser.y:523
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 52:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:485
+//line lib/parser/parser.y:527
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 53:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:489
+//line lib/parser/parser.y:531
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 54:
+	case 56:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:535
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:495
+//line lib/parser/parser.y:541
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 55:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:499
+//line lib/parser/parser.y:545
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:505
+//line lib/parser/parser.y:551
 		{
 			yyVAL.statement = While{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}
 		}
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:509
+//line lib/parser/parser.y:555
 		{
 			yyVAL.statement = WhileInCursor{Variables: []Variable{yyDollar[2].variable}, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:513
+//line lib/parser/parser.y:559
 		{
 			yyVAL.statement = WhileInCursor{Variables: yyDollar[2].variables, Cursor: yyDollar[4].identifier, Statements: yyDollar[6].program}
 		}
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:517
+//line lib/parser/parser.y:563
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: []Variable{yyDollar[3].variable}, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:521
+//line lib/parser/parser.y:567
 		{
 			yyVAL.statement = WhileInCursor{WithDeclaration: true, Variables: yyDollar[3].variables, Cursor: yyDollar[5].identifier, Statements: yyDollar[7].program}
 		}
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:527
+//line lib/parser/parser.y:573
 		{
 			yyVAL.statement = Return{Value: NewNullValue()}
 		}
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:531
+//line lib/parser/parser.y:577
 		{
 			yyVAL.statement = Return{Value: yyDollar[2].queryexpr}
 		}
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:537
+//line lib/parser/parser.y:583
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:541
+//line lib/parser/parser.y:587
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:547
+//line lib/parser/parser.y:593
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:551
+//line lib/parser/parser.y:597
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:555
+//line lib/parser/parser.y:601
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:559
+//line lib/parser/parser.y:605
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:563
+//line lib/parser/parser.y:609
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 70:
+	case 73:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:613
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:569
+//line lib/parser/parser.y:619
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, Else: yyDollar[5].elseexpr}
 		}
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:573
+//line lib/parser/parser.y:623
 		{
 			yyVAL.statement = If{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program, ElseIf: yyDollar[5].elseif, Else: yyDollar[6].elseexpr}
 		}
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:577
+//line lib/parser/parser.y:627
 		{
 			yyVAL.statement = Case{Value: yyDollar[2].queryexpr, When: yyDollar[3].casewhen, Else: yyDollar[4].caseelse}
 		}
-	case 73:
+	case 77:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:581
+//line lib/parser/parser.y:631
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 74:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:585
+//line lib/parser/parser.y:635
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 75:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:589
+//line lib/parser/parser.y:639
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 76:
+	case 80:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:643
+		{
+			yyVAL.statement = TryCatch{BaseExpr: NewBaseExpr(yyDollar[1].token), Try: yyDollar[3].program, Catch: yyDollar[8].program}
+		}
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:595
+//line lib/parser/parser.y:649
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 77:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:599
+//line lib/parser/parser.y:653
 		{
 			yyVAL.statement = VariableDeclaration{Assignments: yyDollar[2].varassigns}
 		}
-	case 78:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:603
+//line lib/parser/parser.y:657
 		{
 			yyVAL.statement = yyDollar[1].queryexpr
 		}
-	case 79:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:607
+//line lib/parser/parser.y:661
 		{
 			yyVAL.statement = DisposeVariable{Variable: yyDollar[2].variable}
 		}
-	case 80:
+	case 85:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:613
+//line lib/parser/parser.y:667
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 81:
+	case 86:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:617
+//line lib/parser/parser.y:671
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 82:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:621
+//line lib/parser/parser.y:675
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].queryexpr}
 		}
-	case 83:
+	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:625
+//line lib/parser/parser.y:679
 		{
 			yyVAL.statement = SetEnvVar{EnvVar: yyDollar[2].envvar, Value: yyDollar[4].identifier}
 		}
-	case 84:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:629
+//line lib/parser/parser.y:683
 		{
 			yyVAL.statement = UnsetEnvVar{EnvVar: yyDollar[2].envvar}
 		}
-	case 85:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:635
+//line lib/parser/parser.y:689
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 86:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:639
+//line lib/parser/parser.y:693
 		{
 			yyVAL.statement = TransactionControl{BaseExpr: NewBaseExpr(yyDollar[1].token), Token: yyDollar[1].token.Token}
 		}
-	case 87:
+	case 92:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:645
+//line lib/parser/parser.y:699
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[4].identifier, Fields: yyDollar[6].queryexprs, IfNotExists: yyDollar[3].bool}
 		}
-	case 88:
+	case 93:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:649
+//line lib/parser/parser.y:703
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[4].identifier, Fields: yyDollar[6].queryexprs, Query: yyDollar[9].queryexpr, IfNotExists: yyDollar[3].bool}
 		}
-	case 89:
+	case 94:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:653
+//line lib/parser/parser.y:707
 		{
 			yyVAL.statement = CreateTable{Table: yyDollar[4].identifier, Query: yyDollar[6].queryexpr, IfNotExists: yyDollar[3].bool}
 		}
-	case 90:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:657
+//line lib/parser/parser.y:711
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: []ColumnDefault{yyDollar[5].columndef}, Position: yyDollar[6].expression}
 		}
-	case 91:
+	case 96:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:661
+//line lib/parser/parser.y:715
 		{
 			yyVAL.statement = AddColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].columndefs, Position: yyDollar[8].expression}
 		}
-	case 92:
+	case 97:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:665
+//line lib/parser/parser.y:719
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: []QueryExpression{yyDollar[5].queryexpr}}
 		}
-	case 93:
+	case 98:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:669
+//line lib/parser/parser.y:723
 		{
 			yyVAL.statement = DropColumns{Table: yyDollar[3].queryexpr, Columns: yyDollar[6].queryexprs}
 		}
-	case 94:
+	case 99:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:673
+//line lib/parser/parser.y:727
 		{
 			yyVAL.statement = RenameColumn{Table: yyDollar[3].queryexpr, Old: yyDollar[5].queryexpr, New: yyDollar[7].identifier}
 		}
-	case 95:
+	case 100:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:677
+//line lib/parser/parser.y:731
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].identifier}
 		}
-	case 96:
+	case 101:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:681
+//line lib/parser/parser.y:735
 		{
 			yyVAL.statement = SetTableAttribute{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].queryexpr, Attribute: yyDollar[5].identifier, Value: yyDollar[7].queryexpr}
 		}
-	case 97:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:687
+//line lib/parser/parser.y:741
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier}
+			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 98:
+	case 103:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:745
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier}
+		}
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:691
+//line lib/parser/parser.y:749
 		{
-			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].identifier, Value: yyDollar[3].queryexpr}
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, NotNull: true}
 		}
-	case 99:
+	case 105:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:753
+		{
+			yyVAL.queryexpr = ColumnDefinition{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier, Type: yyDollar[2].identifier, NotNull: true}
+		}
+	case 106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:759
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:763
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:769
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].queryexpr}
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:773
+		{
+			yyVAL.columndef = ColumnDefault{Column: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
+		}
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:697
+//line lib/parser/parser.y:779
 		{
 			yyVAL.columndefs = []ColumnDefault{yyDollar[1].columndef}
 		}
-	case 100:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:701
+//line lib/parser/parser.y:783
 		{
 			yyVAL.columndefs = append([]ColumnDefault{yyDollar[1].columndef}, yyDollar[3].columndefs...)
 		}
-	case 101:
+	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:707
+//line lib/parser/parser.y:789
 		{
 			yyVAL.expression = nil
 		}
-	case 102:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:711
+//line lib/parser/parser.y:793
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 103:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:715
+//line lib/parser/parser.y:797
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token}
 		}
-	case 104:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:719
+//line lib/parser/parser.y:801
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 105:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:723
+//line lib/parser/parser.y:805
 		{
 			yyVAL.expression = ColumnPosition{Position: yyDollar[1].token, Column: yyDollar[2].queryexpr}
 		}
-	case 106:
+	case 117:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:729
+//line lib/parser/parser.y:811
 		{
-			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 107:
+	case 118:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:815
+		{
+			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
+		}
+	case 119:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:733
+//line lib/parser/parser.y:819
 		{
 			yyVAL.statement = CursorDeclaration{Cursor: yyDollar[2].identifier, Statement: yyDollar[5].identifier}
 		}
-	case 108:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:737
+//line lib/parser/parser.y:823
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 109:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:741
+//line lib/parser/parser.y:827
 		{
 			yyVAL.statement = OpenCursor{Cursor: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 110:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:745
+//line lib/parser/parser.y:831
 		{
 			yyVAL.statement = CloseCursor{Cursor: yyDollar[2].identifier}
 		}
-	case 111:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:749
+//line lib/parser/parser.y:835
 		{
 			yyVAL.statement = DisposeCursor{Cursor: yyDollar[3].identifier}
 		}
-	case 112:
+	case 124:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:753
+//line lib/parser/parser.y:839
 		{
 			yyVAL.statement = FetchCursor{Position: yyDollar[2].fetchpos, Cursor: yyDollar[3].identifier, Variables: yyDollar[5].variables}
 		}
-	case 113:
+	case 125:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:759
+//line lib/parser/parser.y:845
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs}
 		}
-	case 114:
+	case 126:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:763
+//line lib/parser/parser.y:849
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
 		}
-	case 115:
+	case 127:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:767
+//line lib/parser/parser.y:853
 		{
 			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
 		}
-	case 116:
+	case 128:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lib/parser/parser.y:857
+		{
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Fields: yyDollar[5].queryexprs, Query: yyDollar[8].queryexpr}
+		}
+	case 129:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:861
+		{
+			yyVAL.statement = ViewDeclaration{View: yyDollar[2].identifier, Query: yyDollar[5].queryexpr}
+		}
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:771
+//line lib/parser/parser.y:865
 		{
 			yyVAL.statement = DisposeView{View: yyDollar[3].identifier}
 		}
-	case 117:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:775
+//line lib/parser/parser.y:869
 		{
 			yyVAL.statement = DisposeView{View: Stdin{BaseExpr: NewBaseExpr(yyDollar[3].token)}}
 		}
-	case 118:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:781
+//line lib/parser/parser.y:875
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr}
 		}
-	case 119:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:785
+//line lib/parser/parser.y:879
 		{
 			yyVAL.replaceval = ReplaceValue{Value: yyDollar[1].queryexpr, Name: yyDollar[3].identifier}
 		}
-	case 120:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:791
+//line lib/parser/parser.y:885
 		{
 			yyVAL.replacevals = []ReplaceValue{yyDollar[1].replaceval}
 		}
-	case 121:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:795
+//line lib/parser/parser.y:889
 		{
 			yyVAL.replacevals = append([]ReplaceValue{yyDollar[1].replaceval}, yyDollar[3].replacevals...)
 		}
-	case 122:
+	case 136:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:801
+//line lib/parser/parser.y:895
 		{
 			yyVAL.statement = StatementPreparation{Name: yyDollar[2].identifier, Statement: value.NewString(yyDollar[4].token.Literal)}
 		}
-	case 123:
+	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:805
+//line lib/parser/parser.y:899
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier}
 		}
-	case 124:
+	case 138:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:809
+//line lib/parser/parser.y:903
 		{
 			yyVAL.statement = ExecuteStatement{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[2].identifier, Values: yyDollar[4].replacevals}
 		}
-	case 125:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:813
+//line lib/parser/parser.y:907
 		{
 			yyVAL.statement = DisposeStatement{Name: yyDollar[3].identifier}
 		}
-	case 126:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:819
+//line lib/parser/parser.y:913
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 127:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:825
+//line lib/parser/parser.y:919
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 128:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:829
+//line lib/parser/parser.y:923
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassign)
 		}
-	case 129:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:835
+//line lib/parser/parser.y:929
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 130:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:841
+//line lib/parser/parser.y:935
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 131:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:845
+//line lib/parser/parser.y:939
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 132:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:851
+//line lib/parser/parser.y:945
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 133:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:855
+//line lib/parser/parser.y:949
 		{
 			yyVAL.varassigns = yyDollar[1].varassigns
 		}
-	case 134:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:859
+//line lib/parser/parser.y:953
 		{
 			yyVAL.varassigns = append(yyDollar[1].varassigns, yyDollar[3].varassigns...)
 		}
-	case 135:
+	case 149:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:865
+//line lib/parser/parser.y:959
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Statements: yyDollar[8].program}
 		}
-	case 136:
+	case 150:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:869
+//line lib/parser/parser.y:963
 		{
 			yyVAL.statement = FunctionDeclaration{Name: yyDollar[2].identifier, Parameters: yyDollar[5].varassigns, Statements: yyDollar[9].program}
 		}
-	case 137:
+	case 151:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:873
+//line lib/parser/parser.y:967
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Statements: yyDollar[9].program}
 		}
-	case 138:
+	case 152:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line lib/parser/parser.y:877
+//line lib/parser/parser.y:971
 		{
 			yyVAL.statement = AggregateDeclaration{Name: yyDollar[2].identifier, Cursor: yyDollar[5].identifier, Parameters: yyDollar[7].varassigns, Statements: yyDollar[11].program}
 		}
-	case 139:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:881
+//line lib/parser/parser.y:975
 		{
 			yyVAL.statement = DisposeFunction{Name: yyDollar[3].identifier}
 		}
-	case 140:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:887
+//line lib/parser/parser.y:981
 		{
 			yyVAL.fetchpos = FetchPosition{}
 		}
-	case 141:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:891
+//line lib/parser/parser.y:985
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 142:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:895
+//line lib/parser/parser.y:989
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 143:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:899
+//line lib/parser/parser.y:993
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 144:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:903
+//line lib/parser/parser.y:997
 		{
 			yyVAL.fetchpos = FetchPosition{Position: yyDollar[1].token}
 		}
-	case 145:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:907
+//line lib/parser/parser.y:1001
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 146:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:911
+//line lib/parser/parser.y:1005
 		{
 			yyVAL.fetchpos = FetchPosition{BaseExpr: NewBaseExpr(yyDollar[1].token), Position: yyDollar[1].token, Number: yyDollar[2].queryexpr}
 		}
-	case 147:
+	case 161:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:917
+//line lib/parser/parser.y:1011
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[5].token}
 		}
-	case 148:
+	case 162:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:921
+//line lib/parser/parser.y:1015
 		{
 			yyVAL.queryexpr = CursorStatus{Cursor: yyDollar[2].identifier, Negation: yyDollar[4].token, Type: yyDollar[6].token}
 		}
-	case 149:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:925
+//line lib/parser/parser.y:1019
 		{
 			yyVAL.queryexpr = CursorAttrebute{Cursor: yyDollar[2].identifier, Attrebute: yyDollar[3].token}
 		}
-	case 150:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:931
+//line lib/parser/parser.y:1025
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 151:
+	case 165:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:935
+//line lib/parser/parser.y:1029
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 152:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:939
+//line lib/parser/parser.y:1033
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].identifier}
 		}
-	case 153:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:943
+//line lib/parser/parser.y:1037
 		{
 			yyVAL.statement = SetFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag, Value: yyDollar[4].queryexpr}
 		}
-	case 154:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:947
+//line lib/parser/parser.y:1041
 		{
 			yyVAL.statement = AddFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 155:
+	case 169:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:951
+//line lib/parser/parser.y:1045
 		{
 			yyVAL.statement = RemoveFlagElement{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[4].flag, Value: yyDollar[2].queryexpr}
 		}
-	case 156:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:955
+//line lib/parser/parser.y:1049
 		{
 			yyVAL.statement = ShowFlag{BaseExpr: NewBaseExpr(yyDollar[1].token), Flag: yyDollar[2].flag}
 		}
-	case 157:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:959
+//line lib/parser/parser.y:1053
 		{
 			yyVAL.statement = Echo{Value: yyDollar[2].queryexpr}
 		}
-	case 158:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:963
+//line lib/parser/parser.y:1057
 		{
 			yyVAL.statement = Print{Value: yyDollar[2].queryexpr}
 		}
-	case 159:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:967
+//line lib/parser/parser.y:1061
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr}
 		}
-	case 160:
+	case 174:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:971
+//line lib/parser/parser.y:1065
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 161:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:975
+//line lib/parser/parser.y:1069
 		{
 			yyVAL.statement = Printf{BaseExpr: NewBaseExpr(yyDollar[1].token), Format: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 162:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:979
+//line lib/parser/parser.y:1073
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].identifier}
 		}
-	case 163:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:983
+//line lib/parser/parser.y:1077
 		{
 			yyVAL.statement = Source{BaseExpr: NewBaseExpr(yyDollar[1].token), FilePath: yyDollar[2].queryexpr}
 		}
-	case 164:
+	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:987
+//line lib/parser/parser.y:1081
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr}
 		}
-	case 165:
+	case 179:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:991
+//line lib/parser/parser.y:1085
 		{
 			yyVAL.statement = Execute{BaseExpr: NewBaseExpr(yyDollar[1].token), Statements: yyDollar[2].queryexpr, Values: yyDollar[4].queryexprs}
 		}
-	case 166:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:995
+//line lib/parser/parser.y:1089
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 167:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:999
+//line lib/parser/parser.y:1093
 		{
 			yyVAL.statement = Syntax{BaseExpr: NewBaseExpr(yyDollar[1].token), Keywords: yyDollar[2].queryexprs}
 		}
-	case 168:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1003
+//line lib/parser/parser.y:1097
 		{
 			yyVAL.statement = ShowObjects{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 169:
+	case 183:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1007
+//line lib/parser/parser.y:1101
 		{
 			yyVAL.statement = ShowFields{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier, Table: yyDollar[4].queryexpr}
 		}
-	case 170:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1011
+//line lib/parser/parser.y:1105
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].identifier}
 		}
-	case 171:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1015
+//line lib/parser/parser.y:1109
 		{
 			yyVAL.statement = Chdir{BaseExpr: NewBaseExpr(yyDollar[1].token), DirPath: yyDollar[2].queryexpr}
 		}
-	case 172:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1019
+//line lib/parser/parser.y:1113
 		{
 			yyVAL.statement = Pwd{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 173:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1023
+//line lib/parser/parser.y:1117
 		{
 			yyVAL.statement = Reload{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[2].identifier}
 		}
-	case 174:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1029
+//line lib/parser/parser.y:1121
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:1125
+		{
+			yyVAL.statement = Explain{BaseExpr: NewBaseExpr(yyDollar[1].token), Analyze: true, Query: yyDollar[3].queryexpr.(SelectQuery)}
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:1131
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier}
 		}
-	case 175:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1033
+//line lib/parser/parser.y:1135
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[3].queryexpr}
 		}
-	case 176:
+	case 192:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1037
+//line lib/parser/parser.y:1139
 		{
 			yyVAL.statement = Trigger{BaseExpr: NewBaseExpr(yyDollar[1].token), Event: yyDollar[2].identifier, Message: yyDollar[4].queryexpr, Code: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 177:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1043
+//line lib/parser/parser.y:1145
 		{
 			yyVAL.queryexpr = SelectQuery{
 				SelectEntity:  yyDollar[1].queryexpr,
@@ -3257,9 +3961,9 @@ yydefault:
 				LimitClause:   yyDollar[3].queryexpr,
 			}
 		}
-	case 178:
+	case 194:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1051
+//line lib/parser/parser.y:1153
 		{
 			yyVAL.queryexpr = SelectQuery{
 				SelectEntity:  yyDollar[1].queryexpr,
@@ -3268,9 +3972,9 @@ yydefault:
 				Context:       yyDollar[5].token,
 			}
 		}
-	case 179:
+	case 195:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1060
+//line lib/parser/parser.y:1162
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3279,9 +3983,9 @@ yydefault:
 				LimitClause:   yyDollar[4].queryexpr,
 			}
 		}
-	case 180:
+	case 196:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1069
+//line lib/parser/parser.y:1171
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause:    yyDollar[1].queryexpr,
@@ -3291,9 +3995,9 @@ yydefault:
 				Context:       yyDollar[6].token,
 			}
 		}
-	case 181:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1081
+	case 197:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lib/parser/parser.y:1183
 		{
 			yyVAL.queryexpr = SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3303,14 +4007,15 @@ yydefault:
 					WhereClause:   yyDollar[4].queryexpr,
 					GroupByClause: yyDollar[5].queryexpr,
 					HavingClause:  yyDollar[6].queryexpr,
+					QualifyClause: yyDollar[7].queryexpr,
 				},
-				OrderByClause: yyDollar[7].queryexpr,
-				LimitClause:   yyDollar[8].queryexpr,
+				OrderByClause: yyDollar[8].queryexpr,
+				LimitClause:   yyDollar[9].queryexpr,
 			}
 		}
-	case 182:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:1096
+	case 198:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lib/parser/parser.y:1199
 		{
 			yyVAL.queryexpr = SelectQuery{
 				SelectEntity: SelectEntity{
@@ -3320,15 +4025,16 @@ yydefault:
 					WhereClause:   yyDollar[4].queryexpr,
 					GroupByClause: yyDollar[5].queryexpr,
 					HavingClause:  yyDollar[6].queryexpr,
+					QualifyClause: yyDollar[7].queryexpr,
 				},
-				OrderByClause: yyDollar[7].queryexpr,
-				LimitClause:   yyDollar[8].queryexpr,
-				Context:       yyDollar[10].token,
+				OrderByClause: yyDollar[8].queryexpr,
+				LimitClause:   yyDollar[9].queryexpr,
+				Context:       yyDollar[11].token,
 			}
 		}
-	case 183:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1112
+	case 199:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:1216
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3339,14 +4045,15 @@ yydefault:
 					WhereClause:   yyDollar[5].queryexpr,
 					GroupByClause: yyDollar[6].queryexpr,
 					HavingClause:  yyDollar[7].queryexpr,
+					QualifyClause: yyDollar[8].queryexpr,
 				},
-				OrderByClause: yyDollar[8].queryexpr,
-				LimitClause:   yyDollar[9].queryexpr,
+				OrderByClause: yyDollar[9].queryexpr,
+				LimitClause:   yyDollar[10].queryexpr,
 			}
 		}
-	case 184:
-		yyDollar = yyS[yypt-11 : yypt+1]
-//line lib/parser/parser.y:1128
+	case 200:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line lib/parser/parser.y:1233
 		{
 			yyVAL.queryexpr = SelectQuery{
 				WithClause: yyDollar[1].queryexpr,
@@ -3357,15 +4064,16 @@ yydefault:
 					WhereClause:   yyDollar[5].queryexpr,
 					GroupByClause: yyDollar[6].queryexpr,
 					HavingClause:  yyDollar[7].queryexpr,
+					QualifyClause: yyDollar[8].queryexpr,
 				},
-				OrderByClause: yyDollar[8].queryexpr,
-				LimitClause:   yyDollar[9].queryexpr,
-				Context:       yyDollar[11].token,
+				OrderByClause: yyDollar[9].queryexpr,
+				LimitClause:   yyDollar[10].queryexpr,
+				Context:       yyDollar[12].token,
 			}
 		}
-	case 185:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1147
+	case 201:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lib/parser/parser.y:1253
 		{
 			yyVAL.queryexpr = SelectEntity{
 				SelectClause:  yyDollar[1].queryexpr,
@@ -3373,11 +4081,12 @@ yydefault:
 				WhereClause:   yyDollar[3].queryexpr,
 				GroupByClause: yyDollar[4].queryexpr,
 				HavingClause:  yyDollar[5].queryexpr,
+				QualifyClause: yyDollar[6].queryexpr,
 			}
 		}
-	case 186:
+	case 202:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1157
+//line lib/parser/parser.y:1264
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3386,9 +4095,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 187:
+	case 203:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1166
+//line lib/parser/parser.y:1273
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3397,9 +4106,9 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 188:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1175
+//line lib/parser/parser.y:1282
 		{
 			yyVAL.queryexpr = SelectSet{
 				LHS:      yyDollar[1].queryexpr,
@@ -3408,93 +4117,171 @@ yydefault:
 				RHS:      yyDollar[4].queryexpr,
 			}
 		}
-	case 189:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1186
+//line lib/parser/parser.y:1293
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 190:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1190
+//line lib/parser/parser.y:1297
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 191:
+	case 207:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1196
+//line lib/parser/parser.y:1303
 		{
 			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Distinct: yyDollar[2].token, Fields: yyDollar[3].queryexprs}
 		}
-	case 192:
+	case 208:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lib/parser/parser.y:1307
+		{
+			yyVAL.queryexpr = SelectClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Distinct: yyDollar[2].token, DistinctOn: yyDollar[5].queryexprs, Fields: yyDollar[7].queryexprs}
+		}
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1202
+//line lib/parser/parser.y:1313
 		{
 			yyVAL.queryexpr = IntoClause{Variables: yyDollar[2].variables}
 		}
-	case 193:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1208
+//line lib/parser/parser.y:1319
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 194:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1212
+//line lib/parser/parser.y:1323
 		{
 			yyVAL.queryexpr = FromClause{Tables: yyDollar[2].queryexprs}
 		}
-	case 195:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1218
+//line lib/parser/parser.y:1329
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 196:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1222
+//line lib/parser/parser.y:1333
 		{
 			yyVAL.queryexpr = WhereClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 197:
+	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1228
+//line lib/parser/parser.y:1339
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 198:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1232
+//line lib/parser/parser.y:1343
 		{
 			yyVAL.queryexpr = GroupByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 199:
+	case 216:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:1349
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 217:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:1353
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Items: yyDollar[3].queryexprs}
+		}
+	case 218:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:1357
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Items: yyDollar[3].queryexprs}
+		}
+	case 219:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:1361
+		{
+			yyVAL.queryexpr = GroupingSets{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Items: yyDollar[4].queryexprs}
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:1367
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:1371
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 222:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:1377
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:1381
+		{
+			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{}}
+		}
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:1387
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:1391
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 226:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1238
+//line lib/parser/parser.y:1397
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 200:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1242
+//line lib/parser/parser.y:1401
 		{
 			yyVAL.queryexpr = HavingClause{Filter: yyDollar[2].queryexpr}
 		}
-	case 201:
+	case 228:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1248
+//line lib/parser/parser.y:1407
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 202:
+	case 229:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:1411
+		{
+			yyVAL.queryexpr = QualifyClause{Filter: yyDollar[2].queryexpr}
+		}
+	case 230:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lib/parser/parser.y:1417
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1252
+//line lib/parser/parser.y:1421
 		{
 			yyVAL.queryexpr = OrderByClause{Items: yyDollar[3].queryexprs}
 		}
-	case 203:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1258
+//line lib/parser/parser.y:1427
 		{
 			if yyDollar[1].queryexpr == nil {
 				yyVAL.queryexpr = yyDollar[1].queryexpr
@@ -3502,9 +4289,9 @@ yydefault:
 				yyVAL.queryexpr = LimitClause{BaseExpr: yyDollar[1].queryexpr.(OffsetClause).BaseExpr, OffsetClause: yyDollar[1].queryexpr}
 			}
 		}
-	case 204:
+	case 233:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1266
+//line lib/parser/parser.y:1435
 		{
 			var base *BaseExpr
 			if yyDollar[1].queryexpr == nil {
@@ -3514,147 +4301,147 @@ yydefault:
 			}
 			yyVAL.queryexpr = LimitClause{BaseExpr: base, Type: yyDollar[2].token, Position: yyDollar[3].token, Value: yyDollar[4].queryexpr, Unit: yyDollar[5].token, Restriction: yyDollar[6].token, OffsetClause: yyDollar[1].queryexpr}
 		}
-	case 205:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1276
+//line lib/parser/parser.y:1445
 		{
 			yyVAL.queryexpr = LimitClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token, Restriction: yyDollar[4].token, OffsetClause: yyDollar[5].queryexpr}
 		}
-	case 206:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1282
+//line lib/parser/parser.y:1451
 		{
 			yyVAL.token = Token{}
 		}
-	case 207:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1286
+//line lib/parser/parser.y:1455
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 208:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1290
+//line lib/parser/parser.y:1459
 		{
 			yyVAL.token = yyDollar[2].token
 		}
-	case 209:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1296
+//line lib/parser/parser.y:1465
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 210:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1300
+//line lib/parser/parser.y:1469
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 211:
+	case 240:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1306
+//line lib/parser/parser.y:1475
 		{
 			yyVAL.token = Token{}
 		}
-	case 212:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1310
+//line lib/parser/parser.y:1479
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 213:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1316
+//line lib/parser/parser.y:1485
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 214:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1320
+//line lib/parser/parser.y:1489
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 215:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1324
+//line lib/parser/parser.y:1493
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 216:
+	case 245:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1330
+//line lib/parser/parser.y:1499
 		{
 			yyVAL.token = Token{}
 		}
-	case 217:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1334
+//line lib/parser/parser.y:1503
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 218:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1338
+//line lib/parser/parser.y:1507
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 219:
+	case 248:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1344
+//line lib/parser/parser.y:1513
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 220:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1348
+//line lib/parser/parser.y:1517
 		{
 			yyVAL.queryexpr = OffsetClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: yyDollar[2].queryexpr, Unit: yyDollar[3].token}
 		}
-	case 221:
+	case 250:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1354
+//line lib/parser/parser.y:1523
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 222:
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1358
+//line lib/parser/parser.y:1527
 		{
 			yyVAL.queryexpr = WithClause{InlineTables: yyDollar[2].queryexprs}
 		}
-	case 223:
+	case 252:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1364
+//line lib/parser/parser.y:1533
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Query: yyDollar[5].queryexpr.(SelectQuery)}
 		}
-	case 224:
+	case 253:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1368
+//line lib/parser/parser.y:1537
 		{
 			yyVAL.queryexpr = InlineTable{Recursive: yyDollar[1].token, Name: yyDollar[2].identifier, Fields: yyDollar[4].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
 		}
-	case 225:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1374
+//line lib/parser/parser.y:1543
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 226:
+	case 255:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1378
+//line lib/parser/parser.y:1547
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 227:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1384
+//line lib/parser/parser.y:1553
 		{
 			yyVAL.queryexpr = NewStringValue(yyDollar[1].token.Literal)
 		}
-	case 228:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1388
+//line lib/parser/parser.y:1557
 		{
 			i, err := strconv.ParseInt(yyDollar[1].token.Literal, 10, 64)
 			if err != nil {
@@ -3665,195 +4452,210 @@ yydefault:
 				yyVAL.queryexpr = iv
 			}
 		}
-	case 229:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1399
+//line lib/parser/parser.y:1568
 		{
 			yyVAL.queryexpr = NewFloatValueFromString(yyDollar[1].token.Literal)
 		}
-	case 230:
+	case 259:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:1572
+		{
+			if _, ok := value.StrToInterval(yyDollar[2].token.Literal); !ok && yylex.(*Lexer).err == nil {
+				yylex.(*Lexer).err = NewSyntaxError(fmt.Sprintf("invalid interval %q", yyDollar[2].token.Literal), yyDollar[2].token)
+			}
+			yyVAL.queryexpr = NewIntervalValueFromString(yyDollar[2].token.Literal)
+		}
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1403
+//line lib/parser/parser.y:1579
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 231:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1407
+//line lib/parser/parser.y:1583
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 232:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1413
+//line lib/parser/parser.y:1589
 		{
 			yyVAL.queryexpr = NewTernaryValueFromString(yyDollar[1].token.Literal)
 		}
-	case 233:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1419
+//line lib/parser/parser.y:1595
 		{
 			yyVAL.queryexpr = NewNullValue()
 		}
-	case 234:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1425
+//line lib/parser/parser.y:1601
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, Column: yyDollar[1].identifier}
 		}
-	case 235:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1429
+//line lib/parser/parser.y:1605
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].identifier}
 		}
-	case 236:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1433
+//line lib/parser/parser.y:1609
 		{
 			yyVAL.queryexpr = FieldReference{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Column: yyDollar[3].identifier}
 		}
-	case 237:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1437
+//line lib/parser/parser.y:1613
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 238:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1441
+//line lib/parser/parser.y:1617
 		{
 			yyVAL.queryexpr = ColumnNumber{BaseExpr: NewBaseExpr(yyDollar[1].token), View: Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal}, Number: value.NewIntegerFromString(yyDollar[3].token.Literal)}
 		}
-	case 239:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1447
+//line lib/parser/parser.y:1623
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 240:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1451
+//line lib/parser/parser.y:1627
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 241:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1455
+//line lib/parser/parser.y:1631
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 242:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1461
+//line lib/parser/parser.y:1637
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 243:
+	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1465
+//line lib/parser/parser.y:1641
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 244:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1469
+//line lib/parser/parser.y:1645
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 245:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1473
+//line lib/parser/parser.y:1649
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 246:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1477
+//line lib/parser/parser.y:1653
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 247:
+	case 277:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1481
+//line lib/parser/parser.y:1657
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 248:
+	case 278:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1485
+//line lib/parser/parser.y:1661
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 249:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1489
+//line lib/parser/parser.y:1665
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 250:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1493
+//line lib/parser/parser.y:1669
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 251:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1497
+//line lib/parser/parser.y:1673
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 252:
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:1677
+		{
+			yyVAL.queryexpr = yyDollar[1].queryexpr
+		}
+	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1501
+//line lib/parser/parser.y:1681
 		{
 			yyVAL.queryexpr = yyDollar[1].variable
 		}
-	case 253:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1505
+//line lib/parser/parser.y:1685
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 254:
+	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1509
+//line lib/parser/parser.y:1689
 		{
 			yyVAL.queryexpr = yyDollar[1].envvar
 		}
-	case 255:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1513
+//line lib/parser/parser.y:1693
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 256:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1517
+//line lib/parser/parser.y:1697
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 257:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1521
+//line lib/parser/parser.y:1701
 		{
 			yyVAL.queryexpr = yyDollar[1].flag
 		}
-	case 258:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1525
+//line lib/parser/parser.y:1705
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 259:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1529
+//line lib/parser/parser.y:1709
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 260:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1533
+//line lib/parser/parser.y:1713
 		{
 			name := ""
 			if yyDollar[1].token.Literal[0] == ':' {
@@ -3861,105 +4663,105 @@ yydefault:
 			}
 			yyVAL.queryexpr = Placeholder{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Ordinal: yyDollar[1].token.HolderOrdinal, Name: name}
 		}
-	case 261:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1543
+//line lib/parser/parser.y:1723
 		{
 			yyVAL.queryexpr = AllColumns{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 262:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1549
+//line lib/parser/parser.y:1729
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: ValueList{Values: yyDollar[2].queryexprs}}
 		}
-	case 263:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1553
+//line lib/parser/parser.y:1733
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: yyDollar[1].queryexpr.GetBaseExpr(), Value: yyDollar[1].queryexpr}
 		}
-	case 264:
+	case 295:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1557
+//line lib/parser/parser.y:1737
 		{
 			yyVAL.queryexpr = RowValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Value: JsonQuery{JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}}
 		}
-	case 265:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1563
+//line lib/parser/parser.y:1743
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 266:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1567
+//line lib/parser/parser.y:1747
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 267:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1573
+//line lib/parser/parser.y:1753
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 268:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1577
+//line lib/parser/parser.y:1757
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 269:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1583
+//line lib/parser/parser.y:1763
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token}
 		}
-	case 270:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1587
+//line lib/parser/parser.y:1767
 		{
 			yyVAL.queryexpr = OrderItem{Value: yyDollar[1].queryexpr, Direction: yyDollar[2].token, NullsPosition: yyDollar[4].token}
 		}
-	case 271:
+	case 302:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1593
+//line lib/parser/parser.y:1773
 		{
 			yyVAL.token = Token{}
 		}
-	case 272:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1597
+//line lib/parser/parser.y:1777
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 273:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1601
+//line lib/parser/parser.y:1781
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 274:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1607
+//line lib/parser/parser.y:1787
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 275:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1611
+//line lib/parser/parser.y:1791
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 276:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1617
+//line lib/parser/parser.y:1797
 		{
 			yyVAL.queryexpr = Subquery{BaseExpr: NewBaseExpr(yyDollar[1].token), Query: yyDollar[2].queryexpr.(SelectQuery)}
 		}
-	case 277:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1623
+//line lib/parser/parser.y:1803
 		{
 			var item1 []QueryExpression
 			var item2 []QueryExpression
@@ -3980,1382 +4782,1821 @@ yydefault:
 
 			yyVAL.queryexpr = Concat{Items: append(item1, item2...)}
 		}
-	case 278:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1646
+//line lib/parser/parser.y:1826
 		{
 			yyVAL.queryexpr = RowValueList{RowValues: yyDollar[2].queryexprs}
 		}
-	case 279:
+	case 310:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1650
+//line lib/parser/parser.y:1830
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 280:
+	case 311:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1654
+//line lib/parser/parser.y:1834
 		{
 			yyVAL.queryexpr = JsonQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), JsonQuery: yyDollar[1].token, Query: yyDollar[3].queryexpr, JsonText: yyDollar[5].queryexpr}
 		}
-	case 281:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1660
+//line lib/parser/parser.y:1840
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 282:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1664
+//line lib/parser/parser.y:1844
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 283:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1668
+//line lib/parser/parser.y:1848
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 284:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1672
+//line lib/parser/parser.y:1852
 		{
 			yyVAL.queryexpr = Comparison{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 285:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1676
+//line lib/parser/parser.y:1856
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 286:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1680
+//line lib/parser/parser.y:1860
 		{
 			yyVAL.queryexpr = Is{LHS: yyDollar[1].queryexpr, RHS: yyDollar[4].queryexpr, Negation: yyDollar[3].token}
 		}
-	case 287:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1684
+//line lib/parser/parser.y:1864
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[3].queryexpr, High: yyDollar[5].queryexpr}
 		}
-	case 288:
+	case 319:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1688
+//line lib/parser/parser.y:1868
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 289:
+	case 320:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1692
+//line lib/parser/parser.y:1872
 		{
 			yyVAL.queryexpr = Between{LHS: yyDollar[1].queryexpr, Low: yyDollar[4].queryexpr, High: yyDollar[6].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 290:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1696
+//line lib/parser/parser.y:1876
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[3].queryexpr}
 		}
-	case 291:
+	case 322:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1700
+//line lib/parser/parser.y:1880
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 292:
+	case 323:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1704
+//line lib/parser/parser.y:1884
 		{
 			yyVAL.queryexpr = In{LHS: yyDollar[1].queryexpr, Values: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 293:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1708
+//line lib/parser/parser.y:1888
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[3].queryexpr}
 		}
-	case 294:
+	case 325:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1712
+//line lib/parser/parser.y:1892
 		{
 			yyVAL.queryexpr = Like{LHS: yyDollar[1].queryexpr, Pattern: yyDollar[4].queryexpr, Negation: yyDollar[2].token}
 		}
-	case 295:
+	case 326:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1716
+//line lib/parser/parser.y:1896
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 296:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1720
+//line lib/parser/parser.y:1900
 		{
 			yyVAL.queryexpr = Any{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 297:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1724
+//line lib/parser/parser.y:1904
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 298:
+	case 329:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1728
+//line lib/parser/parser.y:1908
 		{
 			yyVAL.queryexpr = All{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, Values: yyDollar[4].queryexpr}
 		}
-	case 299:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1732
+//line lib/parser/parser.y:1912
 		{
 			yyVAL.queryexpr = Exists{Query: yyDollar[2].queryexpr.(Subquery)}
 		}
-	case 300:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1738
+//line lib/parser/parser.y:1918
 		{
 			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 301:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1742
+//line lib/parser/parser.y:1922
 		{
 			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 302:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1746
+//line lib/parser/parser.y:1926
 		{
 			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 303:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1750
+//line lib/parser/parser.y:1930
 		{
 			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 304:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1754
+//line lib/parser/parser.y:1934
 		{
 			yyVAL.queryexpr = Arithmetic{BaseExpr: NewBaseExpr(yyDollar[2].token), LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 305:
+	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1758
+//line lib/parser/parser.y:1938
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 306:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1762
+//line lib/parser/parser.y:1942
 		{
 			yyVAL.queryexpr = UnaryArithmetic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 307:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1768
+//line lib/parser/parser.y:1948
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 308:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1772
+//line lib/parser/parser.y:1952
 		{
 			yyVAL.queryexpr = Logic{LHS: yyDollar[1].queryexpr, Operator: yyDollar[2].token, RHS: yyDollar[3].queryexpr}
 		}
-	case 309:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1776
+//line lib/parser/parser.y:1956
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 310:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1780
+//line lib/parser/parser.y:1960
 		{
 			yyVAL.queryexpr = UnaryLogic{Operand: yyDollar[2].queryexpr, Operator: yyDollar[1].token}
 		}
-	case 311:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1786
+//line lib/parser/parser.y:1966
 		{
 			yyVAL.queryexprs = nil
 		}
-	case 312:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1790
+//line lib/parser/parser.y:1970
 		{
 			yyVAL.queryexprs = yyDollar[1].queryexprs
 		}
-	case 313:
+	case 344:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:1976
+		{
+			yyVAL.queryexpr = ArrayValue{BaseExpr: NewBaseExpr(yyDollar[1].token), Values: yyDollar[3].queryexprs}
+		}
+	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1796
+//line lib/parser/parser.y:1982
 		{
 			yyVAL.queryexpr = Function{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 314:
+	case 346:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1800
+//line lib/parser/parser.y:1986
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 315:
+	case 347:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:1804
+//line lib/parser/parser.y:1990
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr}, From: yyDollar[4].token}
 		}
-	case 316:
+	case 348:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1808
+//line lib/parser/parser.y:1994
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{yyDollar[3].queryexpr, yyDollar[5].queryexpr, yyDollar[7].queryexpr}, From: yyDollar[4].token, For: yyDollar[6].token}
 		}
-	case 317:
+	case 349:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:1998
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
+		}
+	case 350:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lib/parser/parser.y:2002
+		{
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: []QueryExpression{NewStringValue(yyDollar[3].identifier.Literal), yyDollar[5].queryexpr}, From: yyDollar[4].token}
+		}
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1812
+//line lib/parser/parser.y:2006
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 318:
+	case 352:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1816
+//line lib/parser/parser.y:2010
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 319:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1820
+//line lib/parser/parser.y:2014
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 320:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:1824
+//line lib/parser/parser.y:2018
 		{
 			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 321:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1831
+	case 355:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:2022
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = Function{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 322:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1835
+	case 356:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2029
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 323:
+	case 357:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2033
+		{
+			switch f := yyDollar[1].queryexpr.(type) {
+			case AggregateFunction:
+				f.Filter = yyDollar[2].queryexpr
+				yyVAL.queryexpr = f
+			case ListFunction:
+				f.Filter = yyDollar[2].queryexpr
+				yyVAL.queryexpr = f
+			}
+		}
+	case 358:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1839
+//line lib/parser/parser.y:2044
 		{
-			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, Filter: yyDollar[5].queryexpr}
 		}
-	case 324:
+	case 359:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1843
+//line lib/parser/parser.y:2050
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+		}
+	case 360:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:2054
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 325:
+	case 361:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:2058
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+		}
+	case 362:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1847
+//line lib/parser/parser.y:2062
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
+		}
+	case 363:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:2066
 		{
 			yyVAL.queryexpr = AggregateFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}}
 		}
-	case 326:
+	case 364:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lib/parser/parser.y:2070
+		{
+			yyVAL.queryexpr = AggregateFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, OrderBy: yyDollar[8].queryexpr}
+		}
+	case 365:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1851
+//line lib/parser/parser.y:2074
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 327:
+	case 366:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1857
+//line lib/parser/parser.y:2080
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs}
 		}
-	case 328:
+	case 367:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:1861
+//line lib/parser/parser.y:2084
 		{
 			yyVAL.queryexpr = ListFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, OrderBy: yyDollar[9].queryexpr}
 		}
-	case 329:
+	case 368:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line lib/parser/parser.y:2090
+		{
+			yyVAL.queryexpr = WhereClause{Filter: yyDollar[4].queryexpr}
+		}
+	case 369:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1867
+//line lib/parser/parser.y:2096
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 330:
+	case 370:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1871
+//line lib/parser/parser.y:2100
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: yyDollar[1].identifier.BaseExpr, Name: yyDollar[1].identifier.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 331:
+	case 371:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1875
+//line lib/parser/parser.y:2104
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 332:
+	case 372:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1879
+//line lib/parser/parser.y:2108
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 333:
+	case 373:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1883
+//line lib/parser/parser.y:2112
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 334:
+	case 374:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1887
+//line lib/parser/parser.y:2116
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: []QueryExpression{yyDollar[4].queryexpr}, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 335:
+	case 375:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:1891
+//line lib/parser/parser.y:2120
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Distinct: yyDollar[3].token, Args: yyDollar[4].queryexprs, AnalyticClause: yyDollar[8].queryexpr.(AnalyticClause)}
 		}
-	case 336:
+	case 376:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1895
+//line lib/parser/parser.y:2124
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 337:
+	case 377:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1899
+//line lib/parser/parser.y:2128
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 338:
+	case 378:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:1903
+//line lib/parser/parser.y:2132
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 339:
+	case 379:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:1907
+//line lib/parser/parser.y:2136
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, AnalyticClause: yyDollar[7].queryexpr.(AnalyticClause)}
 		}
-	case 340:
+	case 380:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:1911
+//line lib/parser/parser.y:2140
 		{
 			yyVAL.queryexpr = AnalyticFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs, IgnoreType: yyDollar[6].token, AnalyticClause: yyDollar[9].queryexpr.(AnalyticClause)}
 		}
-	case 341:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1917
+//line lib/parser/parser.y:2146
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: yyDollar[2].queryexpr}
 		}
-	case 342:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1923
+//line lib/parser/parser.y:2152
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 343:
+	case 383:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1927
+//line lib/parser/parser.y:2156
 		{
 			yyVAL.queryexpr = AnalyticClause{PartitionClause: yyDollar[1].queryexpr, OrderByClause: OrderByClause{Items: yyDollar[4].queryexprs}, WindowingClause: yyDollar[5].queryexpr}
 		}
-	case 344:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:1933
+//line lib/parser/parser.y:2162
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 345:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:1937
+//line lib/parser/parser.y:2166
 		{
 			yyVAL.queryexpr = PartitionClause{Values: yyDollar[3].queryexprs}
 		}
-	case 346:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1943
+//line lib/parser/parser.y:2172
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[2].queryexpr}
 		}
-	case 347:
+	case 387:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:1947
+//line lib/parser/parser.y:2176
 		{
 			yyVAL.queryexpr = WindowingClause{FrameLow: yyDollar[3].queryexpr, FrameHigh: yyDollar[5].queryexpr}
 		}
-	case 348:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1953
+//line lib/parser/parser.y:2182
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 349:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1957
+//line lib/parser/parser.y:2186
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 350:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1962
+//line lib/parser/parser.y:2191
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 351:
+	case 391:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1968
+//line lib/parser/parser.y:2197
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 352:
+	case 392:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1973
+//line lib/parser/parser.y:2202
 		{
 			i, _ := strconv.Atoi(yyDollar[1].token.Literal)
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Offset: i}
 		}
-	case 353:
+	case 393:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1978
+//line lib/parser/parser.y:2207
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[1].token}
 		}
-	case 354:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1984
+//line lib/parser/parser.y:2213
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 355:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1988
+//line lib/parser/parser.y:2217
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 356:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:1994
+//line lib/parser/parser.y:2223
 		{
 			yyVAL.queryexpr = WindowFramePosition{Direction: yyDollar[2].token, Unbounded: yyDollar[1].token}
 		}
-	case 357:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:1998
+//line lib/parser/parser.y:2227
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 358:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2004
+//line lib/parser/parser.y:2233
 		{
 			yyVAL.queryexpr = yyDollar[1].identifier
 		}
-	case 359:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2008
+//line lib/parser/parser.y:2237
 		{
 			yyVAL.queryexpr = Url{BaseExpr: NewBaseExpr(yyDollar[1].token), Raw: yyDollar[1].token.Literal}
 		}
-	case 360:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2012
+//line lib/parser/parser.y:2241
 		{
 			yyVAL.queryexpr = TableFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Args: yyDollar[3].queryexprs}
 		}
-	case 361:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2016
+//line lib/parser/parser.y:2245
 		{
 			yyVAL.queryexpr = Stdin{BaseExpr: NewBaseExpr(yyDollar[1].token)}
 		}
-	case 362:
+	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2022
+//line lib/parser/parser.y:2251
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 363:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2026
+//line lib/parser/parser.y:2255
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 364:
+	case 404:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2030
+//line lib/parser/parser.y:2259
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 365:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2034
+//line lib/parser/parser.y:2263
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 366:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2038
+//line lib/parser/parser.y:2267
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 367:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2044
+//line lib/parser/parser.y:2271
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 368:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2048
+//line lib/parser/parser.y:2275
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 369:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2052
+//line lib/parser/parser.y:2279
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 370:
+	case 410:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2283
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 411:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2289
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 412:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2293
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 413:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2297
+		{
+			yyVAL.token = yyDollar[1].token
+		}
+	case 414:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2058
+//line lib/parser/parser.y:2303
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: nil}
 		}
-	case 371:
+	case 415:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2062
+//line lib/parser/parser.y:2307
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, Path: yyDollar[3].queryexpr, Args: yyDollar[5].queryexprs}
 		}
-	case 372:
+	case 416:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2066
+//line lib/parser/parser.y:2311
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 373:
+	case 417:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:2070
+//line lib/parser/parser.y:2315
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 374:
+	case 418:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2076
+//line lib/parser/parser.y:2321
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: nil}
 		}
-	case 375:
+	case 419:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:2080
+//line lib/parser/parser.y:2325
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].identifier, Args: yyDollar[7].queryexprs}
 		}
-	case 376:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2084
+//line lib/parser/parser.y:2329
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: nil}
 		}
-	case 377:
+	case 421:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:2088
+//line lib/parser/parser.y:2333
 		{
 			yyVAL.queryexpr = FormatSpecifiedFunction{BaseExpr: NewBaseExpr(yyDollar[1].token), Type: yyDollar[1].token, FormatElement: yyDollar[3].queryexpr, Path: yyDollar[5].queryexpr, Args: yyDollar[7].queryexprs}
 		}
-	case 378:
+	case 422:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2094
+//line lib/parser/parser.y:2339
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 379:
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2343
+		{
+			yyVAL.queryexpr = ArchiveMember{BaseExpr: yyDollar[1].identifier.BaseExpr, Archive: yyDollar[1].identifier, Member: yyDollar[3].identifier}
+		}
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2098
+//line lib/parser/parser.y:2347
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 380:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2104
+//line lib/parser/parser.y:2353
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 381:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2108
+//line lib/parser/parser.y:2357
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 382:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2114
+//line lib/parser/parser.y:2363
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 383:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2118
+//line lib/parser/parser.y:2367
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 384:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2122
+//line lib/parser/parser.y:2371
 		{
 			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 385:
+	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2128
+//line lib/parser/parser.y:2375
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 431:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2379
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 432:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2383
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 433:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line lib/parser/parser.y:2389
+		{
+			yyVAL.queryexpr = Unnest{BaseExpr: NewBaseExpr(yyDollar[1].token), Expr: yyDollar[3].queryexpr}
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2395
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 386:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2132
+//line lib/parser/parser.y:2399
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = []QueryExpression{yyDollar[2].table}
 		}
-	case 387:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2138
+//line lib/parser/parser.y:2405
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].table}, yyDollar[3].queryexprs...)
 		}
-	case 388:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2142
+//line lib/parser/parser.y:2409
 		{
 			yyDollar[2].table.Lateral = yyDollar[1].token
 			yyDollar[2].table.BaseExpr = NewBaseExpr(yyDollar[1].token)
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[2].table}, yyDollar[4].queryexprs...)
 		}
-	case 389:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2150
+//line lib/parser/parser.y:2417
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 390:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2154
+//line lib/parser/parser.y:2421
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
 		}
-	case 391:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2158
+//line lib/parser/parser.y:2425
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 392:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2162
+//line lib/parser/parser.y:2429
 		{
 			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 393:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2166
+//line lib/parser/parser.y:2433
 		{
 			yyVAL.queryexpr = Table{Object: Dual{}}
 		}
-	case 394:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2170
+//line lib/parser/parser.y:2437
 		{
 			yyVAL.queryexpr = yyDollar[1].table
 		}
-	case 395:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2174
+//line lib/parser/parser.y:2441
 		{
 			yyVAL.queryexpr = Parentheses{Expr: yyDollar[2].queryexpr}
 		}
-	case 396:
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2445
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr}
+		}
+	case 446:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2449
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 447:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2453
+		{
+			yyVAL.queryexpr = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 448:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lib/parser/parser.y:2459
+		{
+			yyVAL.queryexpr = Pivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Aggregate: yyDollar[4].queryexpr, Column: yyDollar[6].queryexpr, Values: yyDollar[9].queryexprs}
+		}
+	case 449:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lib/parser/parser.y:2463
+		{
+			yyVAL.queryexpr = Unpivot{BaseExpr: NewBaseExpr(yyDollar[2].token), Table: yyDollar[1].queryexpr, Value: yyDollar[4].identifier, Name: yyDollar[6].identifier, Columns: yyDollar[9].queryexprs}
+		}
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2469
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
+		}
+	case 451:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2473
+		{
+			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
+		}
+	case 452:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2479
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 453:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2483
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2180
+//line lib/parser/parser.y:2489
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 397:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2184
+//line lib/parser/parser.y:2493
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[4].queryexpr, JoinType: yyDollar[2].token, Condition: yyDollar[5].queryexpr}
 		}
-	case 398:
+	case 456:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2188
+//line lib/parser/parser.y:2497
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 399:
+	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2192
+//line lib/parser/parser.y:2501
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].queryexpr, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 400:
+	case 458:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2196
+//line lib/parser/parser.y:2505
 		{
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].queryexpr, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 401:
+	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2200
+//line lib/parser/parser.y:2509
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: nil}
 		}
-	case 402:
+	case 460:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2206
+//line lib/parser/parser.y:2515
 		{
 			yyDollar[5].table.Lateral = yyDollar[4].token
 			yyDollar[5].table.BaseExpr = NewBaseExpr(yyDollar[4].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[5].table, JoinType: yyDollar[2].token, Condition: yyDollar[6].queryexpr}
 		}
-	case 403:
+	case 461:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:2212
+//line lib/parser/parser.y:2521
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Direction: yyDollar[2].token, Condition: yyDollar[7].queryexpr}
 		}
-	case 404:
+	case 462:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2218
+//line lib/parser/parser.y:2527
 		{
 			yyDollar[6].table.Lateral = yyDollar[5].token
 			yyDollar[6].table.BaseExpr = NewBaseExpr(yyDollar[5].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[6].table, JoinType: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 405:
+	case 463:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:2224
+//line lib/parser/parser.y:2533
 		{
 			yyDollar[7].table.Lateral = yyDollar[6].token
 			yyDollar[7].table.BaseExpr = NewBaseExpr(yyDollar[6].token)
 			yyVAL.queryexpr = Join{Table: yyDollar[1].queryexpr, JoinTable: yyDollar[7].table, JoinType: yyDollar[4].token, Direction: yyDollar[3].token, Natural: yyDollar[2].token}
 		}
-	case 406:
+	case 464:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2232
+//line lib/parser/parser.y:2541
 		{
 			yyVAL.queryexpr = JoinCondition{On: yyDollar[2].queryexpr}
 		}
-	case 407:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2236
+//line lib/parser/parser.y:2545
 		{
 			yyVAL.queryexpr = JoinCondition{Using: yyDollar[3].queryexprs}
 		}
-	case 408:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2242
+//line lib/parser/parser.y:2551
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 409:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2246
+//line lib/parser/parser.y:2555
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 410:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2250
+//line lib/parser/parser.y:2559
 		{
 			yyVAL.queryexpr = Field{Object: yyDollar[1].queryexpr}
 		}
-	case 411:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2254
+//line lib/parser/parser.y:2563
 		{
 			yyVAL.queryexpr = Field{Object: FieldReference{BaseExpr: yyDollar[1].identifier.BaseExpr, View: yyDollar[1].identifier, Column: yyDollar[3].queryexpr}}
 		}
-	case 412:
+	case 470:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2260
+//line lib/parser/parser.y:2569
 		{
 			yyVAL.queryexpr = CaseExpr{Value: yyDollar[2].queryexpr, When: yyDollar[3].queryexprs, Else: yyDollar[4].queryexpr}
 		}
-	case 413:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2266
+//line lib/parser/parser.y:2575
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 414:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2270
+//line lib/parser/parser.y:2579
 		{
 			yyVAL.queryexpr = yyDollar[1].queryexpr
 		}
-	case 415:
+	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2276
+//line lib/parser/parser.y:2585
 		{
 			yyVAL.queryexprs = []QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}
 		}
-	case 416:
+	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2280
+//line lib/parser/parser.y:2589
 		{
 			yyVAL.queryexprs = append([]QueryExpression{CaseExprWhen{Condition: yyDollar[2].queryexpr, Result: yyDollar[4].queryexpr}}, yyDollar[5].queryexprs...)
 		}
-	case 417:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2286
+//line lib/parser/parser.y:2595
 		{
 			yyVAL.queryexpr = nil
 		}
-	case 418:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2290
+//line lib/parser/parser.y:2599
 		{
 			yyVAL.queryexpr = CaseExprElse{Result: yyDollar[2].queryexpr}
 		}
-	case 419:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2296
+//line lib/parser/parser.y:2605
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 420:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2300
+//line lib/parser/parser.y:2609
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 421:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2306
+//line lib/parser/parser.y:2615
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 422:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2310
+//line lib/parser/parser.y:2619
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 423:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2316
+//line lib/parser/parser.y:2625
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 424:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2320
+//line lib/parser/parser.y:2629
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 425:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2326
+//line lib/parser/parser.y:2635
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
 		}
-	case 426:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2330
+//line lib/parser/parser.y:2639
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
 		}
-	case 427:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2336
+//line lib/parser/parser.y:2645
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 428:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2340
+//line lib/parser/parser.y:2649
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 429:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2346
+//line lib/parser/parser.y:2655
 		{
 			yyVAL.queryexprs = []QueryExpression{Table{Object: yyDollar[1].queryexpr}}
 		}
-	case 430:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2350
+//line lib/parser/parser.y:2659
 		{
 			yyVAL.queryexprs = append([]QueryExpression{Table{Object: yyDollar[1].queryexpr}}, yyDollar[3].queryexprs...)
 		}
-	case 431:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2356
+//line lib/parser/parser.y:2665
 		{
 			yyVAL.queryexprs = []QueryExpression{yyDollar[1].identifier}
 		}
-	case 432:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2360
+//line lib/parser/parser.y:2669
 		{
 			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].identifier}, yyDollar[3].queryexprs...)
 		}
-	case 433:
+	case 491:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2675
+		{
+			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+		}
+	case 492:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2679
+		{
+			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+		}
+	case 493:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lib/parser/parser.y:2685
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs, ReturningClause: yyDollar[7].queryexpr}
+		}
+	case 494:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:2689
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs, ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 495:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lib/parser/parser.y:2693
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery), ReturningClause: yyDollar[6].queryexpr}
+		}
+	case 496:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lib/parser/parser.y:2697
+		{
+			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery), ReturningClause: yyDollar[9].queryexpr}
+		}
+	case 497:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lib/parser/parser.y:2703
+		{
+			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr, ReturningClause: yyDollar[8].queryexpr}
+		}
+	case 498:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2709
+		{
+			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
+		}
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2715
+		{
+			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
+		}
+	case 500:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line lib/parser/parser.y:2719
+		{
+			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
+		}
+	case 501:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lib/parser/parser.y:2725
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs, ReturningClause: yyDollar[11].queryexpr}
+		}
+	case 502:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line lib/parser/parser.y:2729
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs, ReturningClause: yyDollar[14].queryexpr}
+		}
+	case 503:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:2733
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery), ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 504:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line lib/parser/parser.y:2737
+		{
+			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery), ReturningClause: yyDollar[13].queryexpr}
+		}
+	case 505:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:2741
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs, ReturningClause: yyDollar[10].queryexpr}
+		}
+	case 506:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line lib/parser/parser.y:2745
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs, ReturningClause: yyDollar[13].queryexpr}
+		}
+	case 507:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lib/parser/parser.y:2749
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery), ReturningClause: yyDollar[9].queryexpr}
+		}
+	case 508:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line lib/parser/parser.y:2753
+		{
+			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery), ReturningClause: yyDollar[12].queryexpr}
+		}
+	case 509:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line lib/parser/parser.y:2759
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Table: yyDollar[4].table, Source: yyDollar[6].queryexpr, Condition: yyDollar[8].queryexpr, WhenClauses: yyDollar[9].mergewhens}
+		}
+	case 510:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lib/parser/parser.y:2763
+		{
+			yyVAL.expression = MergeQuery{BaseExpr: NewBaseExpr(yyDollar[1].token), Table: yyDollar[3].table, Source: yyDollar[5].queryexpr, Condition: yyDollar[7].queryexpr, WhenClauses: yyDollar[8].mergewhens}
+		}
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2366
+//line lib/parser/parser.y:2769
 		{
-			yyVAL.queryexprs = []QueryExpression{yyDollar[1].queryexpr}
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr}
 		}
-	case 434:
+	case 512:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2773
+		{
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, Alias: yyDollar[2].identifier}
+		}
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2370
+//line lib/parser/parser.y:2777
 		{
-			yyVAL.queryexprs = append([]QueryExpression{yyDollar[1].queryexpr}, yyDollar[3].queryexprs...)
+			yyVAL.table = Table{Object: yyDollar[1].queryexpr, As: yyDollar[2].token, Alias: yyDollar[3].identifier}
 		}
-	case 435:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2376
+	case 514:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lib/parser/parser.y:2783
+		{
+			yyVAL.queryexpr = nil
+		}
+	case 515:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2787
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, ValuesList: yyDollar[6].queryexprs}
+			yyVAL.queryexpr = yyDollar[2].queryexpr
 		}
-	case 436:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:2380
+	case 516:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lib/parser/parser.y:2793
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Action: yyDollar[5].token, SetList: yyDollar[7].updatesets}
 		}
-	case 437:
+	case 517:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2384
+//line lib/parser/parser.y:2797
 		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Query: yyDollar[5].queryexpr.(SelectQuery)}
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Matched: true, Condition: yyDollar[3].queryexpr, Action: yyDollar[5].token}
 		}
-	case 438:
+	case 518:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:2388
-		{
-			yyVAL.expression = InsertQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
-		}
-	case 439:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line lib/parser/parser.y:2394
+//line lib/parser/parser.y:2801
 		{
-			yyVAL.expression = UpdateQuery{WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, SetList: yyDollar[5].updatesets, FromClause: yyDollar[6].queryexpr, WhereClause: yyDollar[7].queryexpr}
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[4].queryexpr, Action: yyDollar[6].token, Values: yyDollar[8].queryexpr}
 		}
-	case 440:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2400
+	case 519:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line lib/parser/parser.y:2805
 		{
-			yyVAL.updateset = UpdateSet{Field: yyDollar[1].queryexpr, Value: yyDollar[3].queryexpr}
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), Condition: yyDollar[4].queryexpr, Action: yyDollar[6].token, Fields: yyDollar[8].queryexprs, Values: yyDollar[11].queryexpr}
 		}
-	case 441:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2406
+	case 520:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line lib/parser/parser.y:2809
 		{
-			yyVAL.updatesets = []UpdateSet{yyDollar[1].updateset}
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), BySource: true, Condition: yyDollar[6].queryexpr, Action: yyDollar[8].token, SetList: yyDollar[10].updatesets}
 		}
-	case 442:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2410
+	case 521:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line lib/parser/parser.y:2813
 		{
-			yyVAL.updatesets = append([]UpdateSet{yyDollar[1].updateset}, yyDollar[3].updatesets...)
+			yyVAL.mergewhen = MergeWhen{BaseExpr: NewBaseExpr(yyDollar[1].token), BySource: true, Condition: yyDollar[6].queryexpr, Action: yyDollar[8].token}
 		}
-	case 443:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line lib/parser/parser.y:2416
+	case 522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2819
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, ValuesList: yyDollar[10].queryexprs}
+			yyVAL.mergewhens = []MergeWhen{yyDollar[1].mergewhen}
 		}
-	case 444:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line lib/parser/parser.y:2420
+	case 523:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2823
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, ValuesList: yyDollar[13].queryexprs}
+			yyVAL.mergewhens = append([]MergeWhen{yyDollar[1].mergewhen}, yyDollar[2].mergewhens...)
 		}
-	case 445:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:2424
+	case 524:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line lib/parser/parser.y:2829
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Keys: yyDollar[7].queryexprs, Query: yyDollar[9].queryexpr.(SelectQuery)}
+			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr, ReturningClause: yyDollar[6].queryexpr}
 		}
-	case 446:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line lib/parser/parser.y:2428
+	case 525:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line lib/parser/parser.y:2833
 		{
-			yyVAL.expression = ReplaceQuery{WithClause: yyDollar[1].queryexpr, Table: Table{Object: yyDollar[4].queryexpr}, Fields: yyDollar[6].queryexprs, Keys: yyDollar[10].queryexprs, Query: yyDollar[12].queryexpr.(SelectQuery)}
+			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr, ReturningClause: yyDollar[7].queryexpr}
 		}
-	case 447:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line lib/parser/parser.y:2432
+	case 526:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line lib/parser/parser.y:2839
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, ValuesList: yyDollar[9].queryexprs}
+			yyVAL.queryexpr = nil
 		}
-	case 448:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line lib/parser/parser.y:2436
+	case 527:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line lib/parser/parser.y:2843
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, ValuesList: yyDollar[12].queryexprs}
+			yyVAL.queryexpr = ReturningClause{BaseExpr: NewBaseExpr(yyDollar[1].token), Fields: yyDollar[2].queryexprs}
 		}
-	case 449:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line lib/parser/parser.y:2440
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2849
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Keys: yyDollar[6].queryexprs, Query: yyDollar[8].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 450:
-		yyDollar = yyS[yypt-11 : yypt+1]
-//line lib/parser/parser.y:2444
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2853
 		{
-			yyVAL.expression = ReplaceQuery{Table: Table{Object: yyDollar[3].queryexpr}, Fields: yyDollar[5].queryexprs, Keys: yyDollar[9].queryexprs, Query: yyDollar[11].queryexpr.(SelectQuery)}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 451:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2450
+	case 530:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2857
 		{
-			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, FromClause: FromClause{Tables: yyDollar[4].queryexprs}, WhereClause: yyDollar[5].queryexpr}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 452:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line lib/parser/parser.y:2454
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:2861
 		{
-			yyVAL.expression = DeleteQuery{BaseExpr: NewBaseExpr(yyDollar[2].token), WithClause: yyDollar[1].queryexpr, Tables: yyDollar[3].queryexprs, FromClause: FromClause{Tables: yyDollar[5].queryexprs}, WhereClause: yyDollar[6].queryexpr}
+			yyVAL.queryexpr = yyDollar[1].expression.(QueryExpression)
 		}
-	case 453:
+	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2460
+//line lib/parser/parser.y:2867
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 454:
+	case 533:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2464
+//line lib/parser/parser.y:2871
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 455:
+	case 534:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2470
+//line lib/parser/parser.y:2877
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 456:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2474
+//line lib/parser/parser.y:2881
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 457:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2480
+//line lib/parser/parser.y:2887
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 458:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2484
+//line lib/parser/parser.y:2891
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 459:
+	case 538:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2490
+//line lib/parser/parser.y:2897
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 460:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2494
+//line lib/parser/parser.y:2901
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 461:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2500
+//line lib/parser/parser.y:2907
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 462:
+	case 541:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2504
+//line lib/parser/parser.y:2911
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 463:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2510
+//line lib/parser/parser.y:2917
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 464:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2514
+//line lib/parser/parser.y:2921
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 465:
+	case 544:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2520
+//line lib/parser/parser.y:2927
 		{
 			yyVAL.elseif = []ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 466:
+	case 545:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2524
+//line lib/parser/parser.y:2931
 		{
 			yyVAL.elseif = append([]ElseIf{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].elseif...)
 		}
-	case 467:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2530
+//line lib/parser/parser.y:2937
 		{
 			yyVAL.elseexpr = Else{}
 		}
-	case 468:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2534
+//line lib/parser/parser.y:2941
 		{
 			yyVAL.elseexpr = Else{Statements: yyDollar[2].program}
 		}
-	case 469:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2540
+//line lib/parser/parser.y:2947
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 470:
+	case 549:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2544
+//line lib/parser/parser.y:2951
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 471:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2550
+//line lib/parser/parser.y:2957
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 472:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2554
+//line lib/parser/parser.y:2961
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 473:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2560
+//line lib/parser/parser.y:2967
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 474:
+	case 553:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2564
+//line lib/parser/parser.y:2971
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 475:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2570
+//line lib/parser/parser.y:2977
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 476:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2574
+//line lib/parser/parser.y:2981
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 477:
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2580
+//line lib/parser/parser.y:2987
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 478:
+	case 557:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2584
+//line lib/parser/parser.y:2991
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 479:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2590
+//line lib/parser/parser.y:2997
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 480:
+	case 559:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2594
+//line lib/parser/parser.y:3001
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 481:
+	case 560:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line lib/parser/parser.y:2600
+//line lib/parser/parser.y:3007
 		{
 			yyVAL.casewhen = []CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}
 		}
-	case 482:
+	case 561:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line lib/parser/parser.y:2604
+//line lib/parser/parser.y:3011
 		{
 			yyVAL.casewhen = append([]CaseWhen{{Condition: yyDollar[2].queryexpr, Statements: yyDollar[4].program}}, yyDollar[5].casewhen...)
 		}
-	case 483:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2610
+//line lib/parser/parser.y:3017
 		{
 			yyVAL.caseelse = CaseElse{}
 		}
-	case 484:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line lib/parser/parser.y:2614
+//line lib/parser/parser.y:3021
 		{
 			yyVAL.caseelse = CaseElse{Statements: yyDollar[2].program}
 		}
-	case 485:
+	case 564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3027
+		{
+			yyVAL.identifier = yyDollar[1].identifier
+		}
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2620
+//line lib/parser/parser.y:3031
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 486:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2624
+//line lib/parser/parser.y:3035
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 487:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2628
+//line lib/parser/parser.y:3039
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 488:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2632
+//line lib/parser/parser.y:3043
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 489:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2636
+//line lib/parser/parser.y:3049
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 490:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2640
+//line lib/parser/parser.y:3053
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 491:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2644
+//line lib/parser/parser.y:3057
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 492:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2648
+//line lib/parser/parser.y:3061
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 493:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2652
+//line lib/parser/parser.y:3065
 		{
 			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 494:
+	case 574:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3069
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3073
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 576:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3077
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3081
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 578:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3085
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3089
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3093
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3097
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3101
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3105
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 584:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3109
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 585:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3113
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 586:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3117
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3121
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3125
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3129
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 590:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3133
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3137
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 592:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3141
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 593:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3145
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 594:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3149
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3153
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line lib/parser/parser.y:3157
+		{
+			yyVAL.identifier = Identifier{BaseExpr: NewBaseExpr(yyDollar[1].token), Literal: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
+		}
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2658
+//line lib/parser/parser.y:3163
 		{
 			yyVAL.variable = Variable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 495:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2664
+//line lib/parser/parser.y:3169
 		{
 			yyVAL.variables = []Variable{yyDollar[1].variable}
 		}
-	case 496:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2668
+//line lib/parser/parser.y:3173
 		{
 			yyVAL.variables = append([]Variable{yyDollar[1].variable}, yyDollar[3].variables...)
 		}
-	case 497:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2674
+//line lib/parser/parser.y:3179
 		{
 			yyVAL.queryexpr = VariableSubstitution{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 498:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2680
+//line lib/parser/parser.y:3185
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable}
 		}
-	case 499:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2684
+//line lib/parser/parser.y:3189
 		{
 			yyVAL.varassign = VariableAssignment{Variable: yyDollar[1].variable, Value: yyDollar[3].queryexpr}
 		}
-	case 500:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2690
+//line lib/parser/parser.y:3195
 		{
 			yyVAL.varassigns = []VariableAssignment{yyDollar[1].varassign}
 		}
-	case 501:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2694
+//line lib/parser/parser.y:3199
 		{
 			yyVAL.varassigns = append([]VariableAssignment{yyDollar[1].varassign}, yyDollar[3].varassigns...)
 		}
-	case 502:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2700
+//line lib/parser/parser.y:3205
 		{
 			yyVAL.envvar = EnvironmentVariable{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal, Quoted: yyDollar[1].token.Quoted}
 		}
-	case 503:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2706
+//line lib/parser/parser.y:3211
 		{
 			yyVAL.queryexpr = RuntimeInformation{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 504:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2712
+//line lib/parser/parser.y:3217
 		{
 			items := strings.Split(yyDollar[1].token.Literal, ConstantDelimiter)
 			space := ""
@@ -5369,136 +6610,136 @@ yydefault:
 
 			yyVAL.queryexpr = Constant{BaseExpr: NewBaseExpr(yyDollar[1].token), Space: space, Name: name}
 		}
-	case 505:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2728
+//line lib/parser/parser.y:3233
 		{
 			yyVAL.flag = Flag{BaseExpr: NewBaseExpr(yyDollar[1].token), Name: yyDollar[1].token.Literal}
 		}
-	case 506:
+	case 609:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2734
+//line lib/parser/parser.y:3239
 		{
 			yyVAL.token = Token{}
 		}
-	case 507:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2738
+//line lib/parser/parser.y:3243
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 508:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2744
+//line lib/parser/parser.y:3249
 		{
 			yyVAL.token = Token{}
 		}
-	case 509:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2748
+//line lib/parser/parser.y:3253
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 510:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2754
+//line lib/parser/parser.y:3259
 		{
 			yyVAL.token = Token{}
 		}
-	case 511:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2758
+//line lib/parser/parser.y:3263
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 512:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2764
+//line lib/parser/parser.y:3269
 		{
 			yyVAL.token = Token{}
 		}
-	case 513:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2768
+//line lib/parser/parser.y:3273
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 514:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2774
+//line lib/parser/parser.y:3279
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 515:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2778
+//line lib/parser/parser.y:3283
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 516:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2782
+//line lib/parser/parser.y:3287
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 517:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2788
+//line lib/parser/parser.y:3293
 		{
 			yyVAL.token = Token{}
 		}
-	case 518:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2792
+//line lib/parser/parser.y:3297
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 519:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2798
+//line lib/parser/parser.y:3303
 		{
 			yyVAL.token = Token{}
 		}
-	case 520:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2802
+//line lib/parser/parser.y:3307
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 521:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2808
+//line lib/parser/parser.y:3313
 		{
 			yyVAL.token = Token{}
 		}
-	case 522:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2812
+//line lib/parser/parser.y:3317
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 523:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2818
+//line lib/parser/parser.y:3323
 		{
 			yyVAL.token = yyDollar[1].token
 		}
-	case 524:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line lib/parser/parser.y:2822
+//line lib/parser/parser.y:3327
 		{
 			yyDollar[1].token.Token = COMPARISON_OP
 			yyVAL.token = yyDollar[1].token
 		}
-	case 525:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line lib/parser/parser.y:2829
+//line lib/parser/parser.y:3334
 		{
 			yyVAL.bool = false
 		}
-	case 526:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line lib/parser/parser.y:2833
+//line lib/parser/parser.y:3338
 		{
 			yyVAL.bool = true
 		}
@@ -2,6 +2,7 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,8 @@ import (
     fetchpos    FetchPosition
     replaceval  ReplaceValue
     replacevals []ReplaceValue
+    mergewhen   MergeWhen
+    mergewhens  []MergeWhen
     token       Token
     bool        bool
 }
@@ -62,6 +65,8 @@ import (
 %type<statement>   environment_variable_statement
 %type<statement>   transaction_statement
 %type<statement>   table_operation_statement
+%type<queryexpr>   column_definition
+%type<queryexprs>  column_definitions
 %type<columndef>   column_default
 %type<columndefs>  column_defaults
 %type<expression>  column_position
@@ -82,6 +87,8 @@ import (
 %type<statement>   trigger_statement
 %type<queryexpr>   select_query
 %type<queryexpr>   select_into_query
+%type<queryexpr>   returning_clause
+%type<queryexpr>   returning_query
 %type<queryexpr>   select_entity
 %type<queryexpr>   select_set_entity
 %type<queryexpr>   select_clause
@@ -89,7 +96,12 @@ import (
 %type<queryexpr>   from_clause
 %type<queryexpr>   where_clause
 %type<queryexpr>   group_by_clause
+%type<queryexpr>   grouping_element
+%type<queryexprs>  grouping_elements
+%type<queryexpr>   grouping_set
+%type<queryexprs>  grouping_sets
 %type<queryexpr>   having_clause
+%type<queryexpr>   qualify_clause
 %type<queryexpr>   order_by_clause
 %type<queryexpr>   limit_clause
 %type<token>       limit_restriction
@@ -123,6 +135,8 @@ import (
 %type<queryexprs>  arguments
 %type<queryexpr>   function
 %type<queryexpr>   aggregate_function
+%type<queryexpr>   aggregate_function_call
+%type<queryexpr>   filter_clause
 %type<queryexpr>   list_function
 %type<queryexpr>   analytic_function
 %type<queryexpr>   analytic_clause
@@ -143,9 +157,14 @@ import (
 %type<queryexprs>  updatable_tables
 %type<queryexpr>   table_object
 %type<table>       laterable_query_table
+%type<queryexpr>   unnest
+%type<queryexpr>   array_value
 %type<queryexprs>  joinable_tables
 %type<queryexpr>   table
 %type<queryexpr>   join
+%type<queryexpr>   pivot
+%type<queryexpr>   pivot_value
+%type<queryexprs>  pivot_values
 %type<queryexpr>   join_condition
 %type<queryexpr>   field
 %type<queryexpr>   case_expr
@@ -163,6 +182,11 @@ import (
 %type<updateset>   update_set
 %type<updatesets>  update_set_list
 %type<expression>  replace_query
+%type<expression>  merge_query
+%type<table>       merge_target
+%type<queryexpr>   merge_condition
+%type<mergewhen>   merge_when_clause
+%type<mergewhens>  merge_when_clauses
 %type<expression>  delete_query
 %type<elseif>      elseif
 %type<elseexpr>    else
@@ -181,6 +205,7 @@ import (
 %type<casewhen>    in_function_in_loop_case_when
 %type<caseelse>    in_function_in_loop_case_else
 %type<identifier>  identifier
+%type<identifier>  table_alias
 %type<variable>    variable
 %type<variables>   variables
 %type<queryexpr>   variable_substitution
@@ -206,9 +231,13 @@ import (
 %token<token> CONSTANT TABLE_FUNCTION URL
 %token<token> SELECT FROM UPDATE SET UNSET DELETE WHERE INSERT INTO VALUES REPLACE AS DUAL STDIN
 %token<token> RECURSIVE
+%token<token> MERGE MATCHED
+%token<token> RETURNING
 %token<token> CREATE ADD DROP ALTER TABLE FIRST LAST AFTER BEFORE DEFAULT RENAME TO VIEW
-%token<token> ORDER GROUP HAVING BY ASC DESC LIMIT OFFSET PERCENT
+%token<token> ORDER GROUP HAVING QUALIFY BY ASC DESC LIMIT OFFSET PERCENT
+%token<token> ROLLUP CUBE GROUPING SETS
 %token<token> JOIN INNER OUTER LEFT RIGHT FULL CROSS ON USING NATURAL LATERAL
+%token<token> PIVOT UNPIVOT
 %token<token> UNION INTERSECT EXCEPT
 %token<token> ALL ANY EXISTS IN
 %token<token> AND OR NOT BETWEEN LIKE IS NULL
@@ -222,18 +251,22 @@ import (
 %token<token> CONTINUE BREAK EXIT
 %token<token> ECHO PRINT PRINTF SOURCE EXECUTE CHDIR PWD RELOAD REMOVE SYNTAX TRIGGER
 %token<token> FUNCTION AGGREGATE BEGIN RETURN
-%token<token> IGNORE WITHIN
+%token<token> TRY CATCH
+%token<token> IGNORE WITHIN FILTER
 %token<token> VAR SHOW
+%token<token> EXPLAIN ANALYZE
 %token<token> TIES NULLS ROWS ONLY
-%token<token> CSV JSON JSONL FIXED LTSV
+%token<token> CSV JSON JSONL FIXED LTSV XLSX PARQUET XML YAML
 %token<token> CSV_INLINE JSON_INLINE JSON_TABLE
 %token<token> JSON_ROW
-%token<token> SUBSTRING COUNT JSON_OBJECT
+%token<token> INTERVAL ARRAY UNNEST
+%token<token> SUBSTRING EXTRACT COUNT JSON_OBJECT
 %token<token> AGGREGATE_FUNCTION LIST_FUNCTION ANALYTIC_FUNCTION FUNCTION_NTH FUNCTION_WITH_INS
 %token<token> COMPARISON_OP STRING_OP SUBSTITUTION_OP
 %token<token> UMINUS UPLUS
-%token<token> ';' '=' '-' '+' '*' '/' '%' '!' '(' ')'
+%token<token> ';' '=' '-' '+' '*' '/' '%' '!' '(' ')' '[' ']'
 
+%nonassoc EMPTY_WITH_CLAUSE
 %right SUBSTITUTION_OP
 %left UNION EXCEPT
 %left INTERSECT
@@ -246,6 +279,7 @@ import (
 %left '+' '-'
 %left '*' '/' '%'
 %right UMINUS UPLUS '!'
+%nonassoc SELECT REPLACE MERGE '('
 
 %%
 
@@ -317,6 +351,10 @@ common_statement
     {
         $$ = $1
     }
+    | merge_query
+    {
+        $$ = $1
+    }
     | delete_query
     {
         $$ = $1
@@ -463,6 +501,10 @@ flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY program END TRY BEGIN CATCH program END CATCH
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), Try: $3, Catch: $8}
+    }
 
 loop_flow_control_statement
     : IF substantial_value THEN loop_program in_loop_else END IF
@@ -489,6 +531,10 @@ loop_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY loop_program END TRY BEGIN CATCH loop_program END CATCH
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), Try: $3, Catch: $8}
+    }
 
 function_statement
     : common_statement
@@ -563,6 +609,10 @@ function_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY function_program END TRY BEGIN CATCH function_program END CATCH
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), Try: $3, Catch: $8}
+    }
 
 function_loop_flow_control_statement
     : IF substantial_value THEN function_loop_program in_function_in_loop_else END IF
@@ -589,6 +639,10 @@ function_loop_flow_control_statement
     {
         $$ = $1
     }
+    | BEGIN TRY function_loop_program END TRY BEGIN CATCH function_loop_program END CATCH
+    {
+        $$ = TryCatch{BaseExpr: NewBaseExpr($1), Try: $3, Catch: $8}
+    }
 
 variable_statement
     : VAR variable_assignments
@@ -641,11 +695,11 @@ transaction_statement
     }
 
 table_operation_statement
-    : CREATE TABLE if_not_exists identifier '(' identifiers ')'
+    : CREATE TABLE if_not_exists identifier '(' column_definitions ')'
     {
         $$ = CreateTable{Table: $4, Fields: $6, IfNotExists: $3}
     }
-    | CREATE TABLE if_not_exists identifier '(' identifiers ')' as select_query
+    | CREATE TABLE if_not_exists identifier '(' column_definitions ')' as select_query
     {
         $$ = CreateTable{Table: $4, Fields: $6, Query: $9, IfNotExists: $3}
     }
@@ -682,12 +736,40 @@ table_operation_statement
         $$ = SetTableAttribute{BaseExpr: NewBaseExpr($1), Table: $3, Attribute: $5, Value: $7}
     }
 
-column_default
+column_definition
     : identifier
+    {
+        $$ = $1
+    }
+    | identifier identifier
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2}
+    }
+    | identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, NotNull: true}
+    }
+    | identifier identifier NOT NULL
+    {
+        $$ = ColumnDefinition{BaseExpr: $1.BaseExpr, Column: $1, Type: $2, NotNull: true}
+    }
+
+column_definitions
+    : column_definition
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | column_definition ',' column_definitions
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+column_default
+    : column_definition
     {
         $$ = ColumnDefault{Column: $1}
     }
-    | identifier DEFAULT value
+    | column_definition DEFAULT value
     {
         $$ = ColumnDefault{Column: $1, Value: $3}
     }
@@ -727,7 +809,11 @@ column_position
 cursor_statement
     : DECLARE identifier CURSOR FOR select_query
     {
-        $$ = CursorDeclaration{Cursor:$2, Query: $5.(SelectQuery)}
+        $$ = CursorDeclaration{Cursor:$2, Query: $5}
+    }
+    | DECLARE identifier CURSOR FOR returning_query
+    {
+        $$ = CursorDeclaration{Cursor:$2, Query: $5}
     }
     | DECLARE identifier CURSOR FOR identifier
     {
@@ -767,6 +853,14 @@ temporary_table_statement
     {
         $$ = ViewDeclaration{View: $2, Query: $5}
     }
+    | DECLARE identifier VIEW '(' identifiers ')' AS returning_query
+    {
+        $$ = ViewDeclaration{View: $2, Fields: $5, Query: $8}
+    }
+    | DECLARE identifier VIEW AS returning_query
+    {
+        $$ = ViewDeclaration{View: $2, Query: $5}
+    }
     | DISPOSE VIEW identifier
     {
         $$ = DisposeView{View: $3}
@@ -1023,6 +1117,14 @@ command_statement
     {
         $$ = Reload{BaseExpr: NewBaseExpr($1), Type: $2}
     }
+    | EXPLAIN select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Query: $2.(SelectQuery)}
+    }
+    | EXPLAIN ANALYZE select_query
+    {
+        $$ = Explain{BaseExpr: NewBaseExpr($1), Analyze: true, Query: $3.(SelectQuery)}
+    }
 
 trigger_statement
     : TRIGGER identifier
@@ -1077,7 +1179,7 @@ select_query
     }
 
 select_into_query
-    : select_clause into_clause from_clause where_clause group_by_clause having_clause order_by_clause limit_clause
+    : select_clause into_clause from_clause where_clause group_by_clause having_clause qualify_clause order_by_clause limit_clause
     {
         $$ = SelectQuery{
             SelectEntity:  SelectEntity{
@@ -1087,12 +1189,13 @@ select_into_query
                 WhereClause:   $4,
                 GroupByClause: $5,
                 HavingClause:  $6,
+                QualifyClause: $7,
             },
-            OrderByClause: $7,
-            LimitClause:   $8,
+            OrderByClause: $8,
+            LimitClause:   $9,
         }
     }
-    | select_clause into_clause from_clause where_clause group_by_clause having_clause order_by_clause limit_clause FOR UPDATE
+    | select_clause into_clause from_clause where_clause group_by_clause having_clause qualify_clause order_by_clause limit_clause FOR UPDATE
     {
         $$ = SelectQuery{
             SelectEntity:  SelectEntity{
@@ -1102,13 +1205,14 @@ select_into_query
                 WhereClause:   $4,
                 GroupByClause: $5,
                 HavingClause:  $6,
+                QualifyClause: $7,
             },
-            OrderByClause: $7,
-            LimitClause:   $8,
-            Context:       $10,
+            OrderByClause: $8,
+            LimitClause:   $9,
+            Context:       $11,
         }
     }
-    | with_clause select_clause into_clause from_clause where_clause group_by_clause having_clause order_by_clause limit_clause
+    | with_clause select_clause into_clause from_clause where_clause group_by_clause having_clause qualify_clause order_by_clause limit_clause
     {
         $$ = SelectQuery{
             WithClause:    $1,
@@ -1119,12 +1223,13 @@ select_into_query
                 WhereClause:   $5,
                 GroupByClause: $6,
                 HavingClause:  $7,
+                QualifyClause: $8,
             },
-            OrderByClause: $8,
-            LimitClause:   $9,
+            OrderByClause: $9,
+            LimitClause:   $10,
         }
     }
-    | with_clause select_clause into_clause from_clause where_clause group_by_clause having_clause order_by_clause limit_clause FOR UPDATE
+    | with_clause select_clause into_clause from_clause where_clause group_by_clause having_clause qualify_clause order_by_clause limit_clause FOR UPDATE
     {
         $$ = SelectQuery{
             WithClause:    $1,
@@ -1135,15 +1240,16 @@ select_into_query
                 WhereClause:   $5,
                 GroupByClause: $6,
                 HavingClause:  $7,
+                QualifyClause: $8,
             },
-            OrderByClause: $8,
-            LimitClause:   $9,
-            Context:       $11,
+            OrderByClause: $9,
+            LimitClause:   $10,
+            Context:       $12,
         }
     }
 
 select_entity
-    : select_clause from_clause where_clause group_by_clause having_clause
+    : select_clause from_clause where_clause group_by_clause having_clause qualify_clause
     {
         $$ = SelectEntity{
             SelectClause:  $1,
@@ -1151,6 +1257,7 @@ select_entity
             WhereClause:   $3,
             GroupByClause: $4,
             HavingClause:  $5,
+            QualifyClause: $6,
         }
     }
     | select_set_entity UNION all select_set_entity
@@ -1196,6 +1303,10 @@ select_clause
     {
         $$ = SelectClause{BaseExpr: NewBaseExpr($1), Distinct: $2, Fields: $3}
     }
+    | SELECT DISTINCT ON '(' values ')' fields
+    {
+        $$ = SelectClause{BaseExpr: NewBaseExpr($1), Distinct: $2, DistinctOn: $5, Fields: $7}
+    }
 
 into_clause
     : INTO variables
@@ -1228,11 +1339,59 @@ group_by_clause
     {
         $$ = nil
     }
-    | GROUP BY values
+    | GROUP BY grouping_elements
     {
         $$ = GroupByClause{Items: $3}
     }
 
+grouping_element
+    : value
+    {
+        $$ = $1
+    }
+    | ROLLUP '(' values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1, Items: $3}
+    }
+    | CUBE '(' values ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1, Items: $3}
+    }
+    | GROUPING SETS '(' grouping_sets ')'
+    {
+        $$ = GroupingSets{BaseExpr: NewBaseExpr($1), Type: $1, Items: $4}
+    }
+
+grouping_elements
+    : grouping_element
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_element ',' grouping_elements
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
+grouping_set
+    : grouping_element
+    {
+        $$ = $1
+    }
+    | '(' ')'
+    {
+        $$ = RowValue{BaseExpr: NewBaseExpr($1), Value: ValueList{}}
+    }
+
+grouping_sets
+    : grouping_set
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | grouping_set ',' grouping_sets
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
+
 having_clause
     :
     {
@@ -1243,6 +1402,16 @@ having_clause
         $$ = HavingClause{Filter: $2}
     }
 
+qualify_clause
+    :
+    {
+        $$ = nil
+    }
+    | QUALIFY value
+    {
+        $$ = QualifyClause{Filter: $2}
+    }
+
 order_by_clause
     :
     {
@@ -1350,7 +1519,7 @@ offset_clause
     }
 
 with_clause
-    :
+    : %prec EMPTY_WITH_CLAUSE
     {
         $$ = nil
     }
@@ -1399,6 +1568,13 @@ primitive_type
     {
         $$ = NewFloatValueFromString($1.Literal)
     }
+    | INTERVAL STRING
+    {
+        if _, ok := value.StrToInterval($2.Literal); !ok && yylex.(*Lexer).err == nil {
+            yylex.(*Lexer).err = NewSyntaxError(fmt.Sprintf("invalid interval %q", $2.Literal), $2)
+        }
+        $$ = NewIntervalValueFromString($2.Literal)
+    }
     | ternary
     {
         $$ = $1
@@ -1477,6 +1653,10 @@ substantial_value
     {
         $$ = $1
     }
+    | array_value
+    {
+        $$ = $1
+    }
     | aggregate_function
     {
         $$ = $1
@@ -1791,6 +1971,12 @@ arguments
         $$ = $1
     }
 
+array_value
+    : ARRAY '[' arguments ']'
+    {
+        $$ = ArrayValue{BaseExpr: NewBaseExpr($1), Values: $3}
+    }
+
 function
     : identifier '(' arguments ')'
     {
@@ -1808,6 +1994,14 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: []QueryExpression{$3, $5, $7}, From: $4, For: $6}
     }
+    | EXTRACT '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
+    | EXTRACT '(' identifier FROM value ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: []QueryExpression{NewStringValue($3.Literal), $5}, From: $4}
+    }
     | JSON_OBJECT '(' ')'
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal}
@@ -1824,9 +2018,34 @@ function
     {
         $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
     }
+    | GROUPING '(' arguments ')'
+    {
+        $$ = Function{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Args: $3}
+    }
 
 
 aggregate_function
+    : aggregate_function_call
+    {
+        $$ = $1
+    }
+    | aggregate_function_call filter_clause
+    {
+        switch f := $1.(type) {
+        case AggregateFunction:
+            f.Filter = $2
+            $$ = f
+        case ListFunction:
+            f.Filter = $2
+            $$ = f
+        }
+    }
+    | identifier '(' arguments ')' filter_clause
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, Filter: $5}
+    }
+
+aggregate_function_call
     : identifier '(' distinct arguments ')'
     {
         $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Distinct: $3, Args: $4}
@@ -1847,6 +2066,10 @@ aggregate_function
     {
         $$ = AggregateFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: []QueryExpression{$4}}
     }
+    | identifier '(' arguments ')' WITHIN GROUP '(' order_by_clause ')'
+    {
+        $$ = AggregateFunction{BaseExpr: $1.BaseExpr, Name: $1.Literal, Args: $3, OrderBy: $8}
+    }
     | list_function
     {
         $$ = $1
@@ -1862,6 +2085,12 @@ list_function
         $$ = ListFunction{BaseExpr: NewBaseExpr($1), Name: $1.Literal, Distinct: $3, Args: $4, OrderBy: $9}
     }
 
+filter_clause
+    : FILTER '(' WHERE value ')'
+    {
+        $$ = WhereClause{Filter: $4}
+    }
+
 analytic_function
     : identifier '(' arguments ')' OVER '(' analytic_clause_with_windowing ')'
     {
@@ -2038,6 +2267,22 @@ table_format
     {
         $$ = $1
     }
+    | XLSX
+    {
+        $$ = $1
+    }
+    | PARQUET
+    {
+        $$ = $1
+    }
+    | XML
+    {
+        $$ = $1
+    }
+    | YAML
+    {
+        $$ = $1
+    }
 
 inline_table_format
     : CSV_INLINE
@@ -2094,6 +2339,10 @@ updatable_table_identifier
     {
         $$ = $1
     }
+    | identifier '.' identifier
+    {
+        $$ = ArchiveMember{BaseExpr: $1.BaseExpr, Archive: $1, Member: $3}
+    }
     | format_specified_function
     {
         $$ = $1
@@ -2114,7 +2363,7 @@ laterable_query_table
     {
         $$ = Table{Object: $1}
     }
-    | subquery identifier
+    | subquery table_alias
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -2122,6 +2371,24 @@ laterable_query_table
     {
         $$ = Table{Object: $1, As: $2, Alias: $3}
     }
+    | unnest
+    {
+        $$ = Table{Object: $1}
+    }
+    | unnest table_alias
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | unnest AS identifier
+    {
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
+
+unnest
+    : UNNEST '(' value ')'
+    {
+        $$ = Unnest{BaseExpr: NewBaseExpr($1), Expr: $3}
+    }
 
 joinable_tables
     : table
@@ -2150,7 +2417,7 @@ table
     {
         $$ = Table{Object: $1}
     }
-    | table_object identifier
+    | table_object table_alias
     {
         $$ = Table{Object: $1, Alias: $2}
     }
@@ -2174,6 +2441,48 @@ table
     {
         $$ = Parentheses{Expr: $2}
     }
+    | pivot
+    {
+        $$ = Table{Object: $1}
+    }
+    | pivot table_alias
+    {
+        $$ = Table{Object: $1, Alias: $2}
+    }
+    | pivot AS identifier
+    {
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
+
+pivot
+    : table PIVOT '(' value FOR field_reference IN '(' pivot_values ')' ')'
+    {
+        $$ = Pivot{BaseExpr: NewBaseExpr($2), Table: $1, Aggregate: $4, Column: $6, Values: $9}
+    }
+    | table UNPIVOT '(' identifier FOR identifier IN '(' field_references ')' ')'
+    {
+        $$ = Unpivot{BaseExpr: NewBaseExpr($2), Table: $1, Value: $4, Name: $6, Columns: $9}
+    }
+
+pivot_value
+    : value
+    {
+        $$ = Field{Object: $1}
+    }
+    | value AS identifier
+    {
+        $$ = Field{Object: $1, As: $2, Alias: $3}
+    }
+
+pivot_values
+    : pivot_value
+    {
+        $$ = []QueryExpression{$1}
+    }
+    | pivot_value ',' pivot_values
+    {
+        $$ = append([]QueryExpression{$1}, $3...)
+    }
 
 join
     : table CROSS JOIN table
@@ -2372,27 +2681,27 @@ fields
     }
 
 insert_query
-    : with_clause INSERT INTO updatable_table_identifier VALUES row_values
+    : with_clause INSERT INTO updatable_table_identifier VALUES row_values returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, ValuesList: $6, ReturningClause: $7}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, ValuesList: $9, ReturningClause: $10}
     }
-    | with_clause INSERT INTO updatable_table_identifier select_query
+    | with_clause INSERT INTO updatable_table_identifier select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Query: $5.(SelectQuery), ReturningClause: $6}
     }
-    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query
+    | with_clause INSERT INTO updatable_table_identifier '(' field_references ')' select_query returning_clause
     {
-        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery)}
+        $$ = InsertQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Query: $8.(SelectQuery), ReturningClause: $9}
     }
 
 update_query
-    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause
+    : with_clause UPDATE updatable_tables SET update_set_list from_clause where_clause returning_clause
     {
-        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7}
+        $$ = UpdateQuery{WithClause: $1, Tables: $3, SetList: $5, FromClause: $6, WhereClause: $7, ReturningClause: $8}
     }
 
 update_set
@@ -2412,47 +2721,145 @@ update_set_list
     }
 
 replace_query
-    : with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
+    : with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values returning_clause
+    {
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10, ReturningClause: $11}
+    }
+    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values returning_clause
+    {
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13, ReturningClause: $14}
+    }
+    | with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query returning_clause
+    {
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery), ReturningClause: $10}
+    }
+    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query returning_clause
+    {
+        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery), ReturningClause: $13}
+    }
+    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, ValuesList: $10}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, ValuesList: $9, ReturningClause: $10}
     }
-    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
+    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, ValuesList: $13}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12, ReturningClause: $13}
     }
-    | with_clause REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
+    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Keys: $7, Query: $9.(SelectQuery)}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery), ReturningClause: $9}
     }
-    | with_clause REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
+    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query returning_clause
     {
-        $$ = ReplaceQuery{WithClause: $1, Table: Table{Object: $4}, Fields: $6, Keys: $10, Query: $12.(SelectQuery)}
+        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery), ReturningClause: $12}
     }
-    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' VALUES row_values
+
+merge_query
+    : with_clause MERGE INTO merge_target USING table ON value merge_when_clauses
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, ValuesList: $9}
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Table: $4, Source: $6, Condition: $8, WhenClauses: $9}
     }
-    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' VALUES row_values
+    | MERGE INTO merge_target USING table ON value merge_when_clauses
+    {
+        $$ = MergeQuery{BaseExpr: NewBaseExpr($1), Table: $3, Source: $5, Condition: $7, WhenClauses: $8}
+    }
+
+merge_target
+    : updatable_table_identifier
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, ValuesList: $12}
+        $$ = Table{Object: $1}
     }
-    | REPLACE INTO updatable_table_identifier USING '(' field_references ')' select_query
+    | updatable_table_identifier identifier
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Keys: $6, Query: $8.(SelectQuery)}
+        $$ = Table{Object: $1, Alias: $2}
     }
-    | REPLACE INTO updatable_table_identifier '(' field_references ')' USING '(' field_references ')' select_query
+    | updatable_table_identifier AS identifier
     {
-        $$ = ReplaceQuery{Table: Table{Object: $3}, Fields: $5, Keys: $9, Query: $11.(SelectQuery)}
+        $$ = Table{Object: $1, As: $2, Alias: $3}
+    }
+
+merge_condition
+    :
+    {
+        $$ = nil
+    }
+    | AND value
+    {
+        $$ = $2
+    }
+
+merge_when_clause
+    : WHEN MATCHED merge_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Action: $5, SetList: $7}
+    }
+    | WHEN MATCHED merge_condition THEN DELETE
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Matched: true, Condition: $3, Action: $5}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $4, Action: $6, Values: $8}
+    }
+    | WHEN NOT MATCHED merge_condition THEN INSERT '(' field_references ')' VALUES row_value
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), Condition: $4, Action: $6, Fields: $8, Values: $11}
+    }
+    | WHEN NOT MATCHED BY SOURCE merge_condition THEN UPDATE SET update_set_list
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), BySource: true, Condition: $6, Action: $8, SetList: $10}
+    }
+    | WHEN NOT MATCHED BY SOURCE merge_condition THEN DELETE
+    {
+        $$ = MergeWhen{BaseExpr: NewBaseExpr($1), BySource: true, Condition: $6, Action: $8}
+    }
+
+merge_when_clauses
+    : merge_when_clause
+    {
+        $$ = []MergeWhen{$1}
+    }
+    | merge_when_clause merge_when_clauses
+    {
+        $$ = append([]MergeWhen{$1}, $2...)
     }
 
 delete_query
-    : with_clause DELETE FROM tables where_clause
+    : with_clause DELETE FROM tables where_clause returning_clause
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5}
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, FromClause: FromClause{Tables: $4}, WhereClause: $5, ReturningClause: $6}
     }
-    | with_clause DELETE identified_tables FROM tables where_clause
+    | with_clause DELETE identified_tables FROM tables where_clause returning_clause
     {
-        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6}
+        $$ = DeleteQuery{BaseExpr: NewBaseExpr($2), WithClause: $1, Tables: $3, FromClause: FromClause{Tables: $5}, WhereClause: $6, ReturningClause: $7}
+    }
+
+returning_clause
+    :
+    {
+        $$ = nil
+    }
+    | RETURNING fields
+    {
+        $$ = ReturningClause{BaseExpr: NewBaseExpr($1), Fields: $2}
+    }
+
+returning_query
+    : insert_query
+    {
+        $$ = $1.(QueryExpression)
+    }
+    | update_query
+    {
+        $$ = $1.(QueryExpression)
+    }
+    | replace_query
+    {
+        $$ = $1.(QueryExpression)
+    }
+    | delete_query
+    {
+        $$ = $1.(QueryExpression)
     }
 
 elseif
@@ -2616,6 +3023,28 @@ in_function_in_loop_case_else
     }
 
 identifier
+    : table_alias
+    {
+        $$ = $1
+    }
+    | PIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNPIVOT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | QUALIFY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | RETURNING
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+
+table_alias
     : IDENTIFIER
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2624,6 +3053,10 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | ANALYZE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
     | NULLS
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
@@ -2652,6 +3085,78 @@ identifier
     {
         $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
     }
+    | XLSX
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | PARQUET
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | XML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | YAML
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | FILTER
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | SETS
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CUBE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ROLLUP
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | GROUPING
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MERGE
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | MATCHED
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | INTERVAL
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXTRACT
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | ARRAY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | UNNEST
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | TRY
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | CATCH
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
+    | EXPLAIN
+    {
+        $$ = Identifier{BaseExpr: NewBaseExpr($1), Literal: $1.Literal, Quoted: $1.Quoted}
+    }
 
 variable
     : VARIABLE
@@ -161,6 +161,33 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from `exports.zip`.`2024/sales.csv` t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: ArchiveMember{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Archive:  Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "exports.zip", Quoted: true},
+								Member:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 30}, Literal: "2024/sales.csv", Quoted: true},
+							},
+							Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "t"},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 into @var from stdin",
 		Output: []Statement{
@@ -556,6 +583,112 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select c1 from xlsx(`book.xlsx`, 'Sheet1', 'A1:F200')",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: FormatSpecifiedFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: XLSX, Literal: "xlsx", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "book.xlsx", Quoted: true},
+								Args:     []QueryExpression{NewStringValue("Sheet1"), NewStringValue("A1:F200")},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from parquet(`table.parquet`)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: FormatSpecifiedFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: PARQUET, Literal: "parquet", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "table.parquet", Quoted: true},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from xml('items.item', `table.xml`)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: FormatSpecifiedFunction{
+								BaseExpr:      &BaseExpr{line: 1, char: 16},
+								Type:          Token{Token: XML, Literal: "xml", Line: 1, Char: 16},
+								FormatElement: NewStringValue("items.item"),
+								Path:          Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "table.xml", Quoted: true},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select c1 from yaml(`table.yaml`)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{
+								Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "c1"}},
+							},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{
+						Table{
+							Object: FormatSpecifiedFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 16},
+								Type:     Token{Token: YAML, Literal: "yaml", Line: 1, Char: 16},
+								Path:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "table.yaml", Quoted: true},
+							},
+						},
+					}},
+				},
+			},
+		},
+	},
 	{
 		Input: "select c1 from ltsv(stdin, 'utf8')",
 		Output: []Statement{
@@ -885,6 +1018,46 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select grouping(c1) from dual group by rollup(c1, c2), grouping sets ((c1), (), c2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{
+						Field{Object: Function{
+							BaseExpr: &BaseExpr{line: 1, char: 8},
+							Name:     "grouping",
+							Args: []QueryExpression{
+								FieldReference{BaseExpr: &BaseExpr{line: 1, char: 17}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 17}, Literal: "c1"}},
+							},
+						}},
+					}},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Dual{}}}},
+					GroupByClause: GroupByClause{
+						Items: []QueryExpression{
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 40},
+								Type:     Token{Token: ROLLUP, Literal: "rollup", Line: 1, Char: 40},
+								Items: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 47}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 47}, Literal: "c1"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 51}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 51}, Literal: "c2"}},
+								},
+							},
+							GroupingSets{
+								BaseExpr: &BaseExpr{line: 1, char: 56},
+								Type:     Token{Token: GROUPING, Literal: "grouping", Line: 1, Char: 56},
+								Items: []QueryExpression{
+									Parentheses{Expr: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 72}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 72}, Literal: "c1"}}},
+									RowValue{BaseExpr: &BaseExpr{line: 1, char: 77}, Value: ValueList{}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 81}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 81}, Literal: "c2"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 \n" +
 			" from dual \n" +
@@ -1163,6 +1336,45 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select distinct on (column1) * from dual",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Distinct: Token{Token: DISTINCT, Literal: "distinct", Line: 1, Char: 8},
+						DistinctOn: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 21}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "column1"}},
+						},
+						Fields: []QueryExpression{
+							Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 30}}},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Dual{}}}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select column1 from t qualify column2",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "column1"}}},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 21}, Literal: "t"}}}},
+					QualifyClause: QualifyClause{
+						Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select * from (select 2)",
 		Output: []Statement{
@@ -2585,6 +2797,72 @@ var parseTests = []struct {
 		ErrorLine: 1,
 		ErrorChar: 32,
 	},
+	{
+		Input: "select extract(day from column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: Function{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "extract",
+								Args: []QueryExpression{
+									NewStringValue("day"),
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 25}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 25}, Literal: "column1"}},
+								},
+								From: Token{Token: FROM, Literal: "from", Line: 1, Char: 20},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select array[], array[1, 'a']",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ArrayValue{BaseExpr: &BaseExpr{line: 1, char: 8}}},
+							Field{Object: ArrayValue{
+								BaseExpr: &BaseExpr{line: 1, char: 17},
+								Values: []QueryExpression{
+									NewIntegerValueFromString("1"),
+									NewStringValue("a"),
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select interval '3 days 4 hours'",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: NewIntervalValueFromString("3 days 4 hours")},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input:     "select interval '3 fortnights'",
+		Error:     "invalid interval \"3 fortnights\"",
+		ErrorLine: 1,
+		ErrorChar: 17,
+	},
 	{
 		Input: "select json_object()",
 		Output: []Statement{
@@ -2898,6 +3176,162 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select sets, cube, rollup, grouping, pivot, unpivot, merge, matched, returning, interval, extract, array, unnest, try, catch, qualify, explain from kw",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 8}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 8}, Literal: "sets"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 14}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "cube"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "rollup"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 28}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "grouping"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "pivot"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "unpivot"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "merge"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 61}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 61}, Literal: "matched"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 70}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "returning"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 81}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 81}, Literal: "interval"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 91}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 91}, Literal: "extract"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 100}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 100}, Literal: "array"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 107}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 107}, Literal: "unnest"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 115}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 115}, Literal: "try"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 120}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 120}, Literal: "catch"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 127}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 127}, Literal: "qualify"}}},
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 136}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 136}, Literal: "explain"}}},
+						},
+					},
+					FromClause: FromClause{Tables: []QueryExpression{Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 149}, Literal: "kw"}}}},
+				},
+			},
+		},
+	},
+	{
+		Input: "select count(*) filter (where column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "count",
+								Args: []QueryExpression{
+									AllColumns{BaseExpr: &BaseExpr{line: 1, char: 14}},
+								},
+								Filter: WhereClause{
+									Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 31}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select percentile_cont(0.9) within group (order by column1) filter (where column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "percentile_cont",
+								Args: []QueryExpression{
+									NewFloatValueFromString("0.9"),
+								},
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "column1"}}},
+									},
+								},
+								Filter: WhereClause{
+									Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 75}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 75}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select mode() within group (order by column1)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "mode",
+								OrderBy: OrderByClause{
+									Items: []QueryExpression{
+										OrderItem{Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 38}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 38}, Literal: "column1"}}},
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select useraggfunc(column1) filter (where column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: AggregateFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "useraggfunc",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 20}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 20}, Literal: "column1"}},
+								},
+								Filter: WhereClause{
+									Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 43}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 43}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select listagg(column1) filter (where column2)",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{
+						BaseExpr: &BaseExpr{line: 1, char: 1},
+						Fields: []QueryExpression{
+							Field{Object: ListFunction{
+								BaseExpr: &BaseExpr{line: 1, char: 8},
+								Name:     "listagg",
+								Args: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 16}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 16}, Literal: "column1"}},
+								},
+								Filter: WhereClause{
+									Filter: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "column2"}},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select cursor cur is not open",
 		Output: []Statement{
@@ -3736,6 +4170,69 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 cross join lateral unnest(array[column1, 2]) as t",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Join{
+									Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									JoinTable: Table{
+										BaseExpr: &BaseExpr{line: 1, char: 33},
+										Lateral:  Token{Token: LATERAL, Literal: "lateral", Line: 1, Char: 33},
+										Object: Unnest{
+											BaseExpr: &BaseExpr{line: 1, char: 41},
+											Expr: ArrayValue{
+												BaseExpr: &BaseExpr{line: 1, char: 48},
+												Values: []QueryExpression{
+													FieldReference{BaseExpr: &BaseExpr{line: 1, char: 54}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 54}, Literal: "column1"}},
+													NewIntegerValueFromString("2"),
+												},
+											},
+										},
+										As:    Token{Token: AS, Literal: "as", Line: 1, Char: 67},
+										Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "t"},
+									},
+									JoinType: Token{Token: CROSS, Literal: "cross", Line: 1, Char: 22},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select * from unnest(split(column1, ';'))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 8}}}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Unnest{
+									BaseExpr: &BaseExpr{line: 1, char: 15},
+									Expr: Function{
+										BaseExpr: &BaseExpr{line: 1, char: 22},
+										Name:     "split",
+										Args: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 28}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "column1"}},
+											NewStringValue(";"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 cross join table2 cross join table3",
 		Output: []Statement{
@@ -4042,6 +4539,66 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "select 1 from table1 pivot (sum(amount) for month in ('Jan', 'Feb' as feb)) as p",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Pivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Aggregate: AggregateFunction{
+										BaseExpr: &BaseExpr{line: 1, char: 29},
+										Name:     "sum",
+										Args: []QueryExpression{
+											FieldReference{BaseExpr: &BaseExpr{line: 1, char: 33}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 33}, Literal: "amount"}},
+										},
+									},
+									Column: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 45}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 45}, Literal: "month"}},
+									Values: []QueryExpression{
+										Field{Object: NewStringValue("Jan")},
+										Field{Object: NewStringValue("Feb"), As: Token{Token: AS, Literal: "as", Line: 1, Char: 68}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 71}, Literal: "feb"}},
+									},
+								},
+								As:    Token{Token: AS, Literal: "as", Line: 1, Char: 77},
+								Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 80}, Literal: "p"},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "select 1 from table1 unpivot (value for metric in (col_a, col_b))",
+		Output: []Statement{
+			SelectQuery{
+				SelectEntity: SelectEntity{
+					SelectClause: SelectClause{BaseExpr: &BaseExpr{line: 1, char: 1}, Fields: []QueryExpression{Field{Object: NewIntegerValueFromString("1")}}},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{
+								Object: Unpivot{
+									BaseExpr: &BaseExpr{line: 1, char: 22},
+									Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 15}, Literal: "table1"}},
+									Value:    Identifier{BaseExpr: &BaseExpr{line: 1, char: 31}, Literal: "value"},
+									Name:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "metric"},
+									Columns: []QueryExpression{
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 52}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 52}, Literal: "col_a"}},
+										FieldReference{BaseExpr: &BaseExpr{line: 1, char: 59}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 59}, Literal: "col_b"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "select 1 from table1 left join table2 using(id) left join table3 using(id)",
 		Output: []Statement{
@@ -4394,6 +4951,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "insert into table1 values (1) returning *",
+		Output: []Statement{
+			InsertQuery{
+				Table: Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"}},
+				ValuesList: []QueryExpression{
+					RowValue{
+						BaseExpr: &BaseExpr{line: 1, char: 27},
+						Value: ValueList{
+							Values: []QueryExpression{
+								NewIntegerValueFromString("1"),
+							},
+						},
+					},
+				},
+				ReturningClause: ReturningClause{
+					BaseExpr: &BaseExpr{line: 1, char: 31},
+					Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 41}}}},
+				},
+			},
+		},
+	},
 	{
 		Input: "insert into table1 (column1, column2) select 1, 2",
 		Output: []Statement{
@@ -4810,6 +5389,58 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "merge into table1 t using table2 s on t.id = s.id when matched and t.v <> s.v then update set v = s.v when not matched by source then delete when not matched then insert (id, v) values (s.id, s.v)",
+		Output: []Statement{
+			MergeQuery{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Table:    Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 12}, Literal: "table1"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 19}, Literal: "t"}},
+				Source:   Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 27}, Literal: "table2"}, Alias: Identifier{BaseExpr: &BaseExpr{line: 1, char: 34}, Literal: "s"}},
+				Condition: Comparison{
+					LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 41}, Literal: "id"}},
+					Operator: Token{Token: '=', Literal: "=", Line: 1, Char: 44},
+					RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 46}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 46}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 48}, Literal: "id"}},
+				},
+				WhenClauses: []MergeWhen{
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 51},
+						Matched:  true,
+						Condition: Comparison{
+							LHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 68}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 68}, Literal: "t"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 70}, Literal: "v"}},
+							Operator: Token{Token: COMPARISON_OP, Literal: "<>", Line: 1, Char: 72},
+							RHS:      FieldReference{BaseExpr: &BaseExpr{line: 1, char: 75}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 75}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 77}, Literal: "v"}},
+						},
+						Action: Token{Token: UPDATE, Literal: "update", Line: 1, Char: 84},
+						SetList: []UpdateSet{
+							{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 95}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 95}, Literal: "v"}}, Value: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 99}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 99}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 101}, Literal: "v"}}},
+						},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 103},
+						BySource: true,
+						Action:   Token{Token: DELETE, Literal: "delete", Line: 1, Char: 135},
+					},
+					{
+						BaseExpr: &BaseExpr{line: 1, char: 142},
+						Action:   Token{Token: INSERT, Literal: "insert", Line: 1, Char: 164},
+						Fields: []QueryExpression{
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 172}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 172}, Literal: "id"}},
+							FieldReference{BaseExpr: &BaseExpr{line: 1, char: 176}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 176}, Literal: "v"}},
+						},
+						Values: RowValue{
+							BaseExpr: &BaseExpr{line: 1, char: 186},
+							Value: ValueList{
+								Values: []QueryExpression{
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 187}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 187}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 189}, Literal: "id"}},
+									FieldReference{BaseExpr: &BaseExpr{line: 1, char: 193}, View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 193}, Literal: "s"}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 195}, Literal: "v"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "delete table1 from table1 where true",
 		Output: []Statement{
@@ -4841,6 +5472,32 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "create table newtable (column1 integer not null, column2 string, column3 not null)",
+		Output: []Statement{
+			CreateTable{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 14}, Literal: "newtable"},
+				Fields: []QueryExpression{
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 24},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "integer"},
+						NotNull:  true,
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 50},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 50}, Literal: "column2"},
+						Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 58}, Literal: "string"},
+					},
+					ColumnDefinition{
+						BaseExpr: &BaseExpr{line: 1, char: 66},
+						Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 66}, Literal: "column3"},
+						NotNull:  true,
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "create table if not exists newtable (column1, column2)",
 		Output: []Statement{
@@ -5057,6 +5714,25 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "alter table table1 add column1 float not null default 1",
+		Output: []Statement{
+			AddColumns{
+				Table: Identifier{BaseExpr: &BaseExpr{line: 1, char: 13}, Literal: "table1"},
+				Columns: []ColumnDefault{
+					{
+						Column: ColumnDefinition{
+							BaseExpr: &BaseExpr{line: 1, char: 24},
+							Column:   Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "column1"},
+							Type:     Identifier{BaseExpr: &BaseExpr{line: 1, char: 32}, Literal: "float"},
+							NotNull:  true,
+						},
+						Value: NewIntegerValueFromString("1"),
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "alter table table1 add column1 last",
 		Output: []Statement{
@@ -5434,6 +6110,48 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "explain select 1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 9},
+							Fields: []QueryExpression{
+								Field{Object: NewIntegerValueFromString("1")},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "explain analyze select analyze from table1",
+		Output: []Statement{
+			Explain{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Analyze:  true,
+				Query: SelectQuery{
+					SelectEntity: SelectEntity{
+						SelectClause: SelectClause{
+							BaseExpr: &BaseExpr{line: 1, char: 17},
+							Fields: []QueryExpression{
+								Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 24}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 24}, Literal: "analyze"}}},
+							},
+						},
+						FromClause: FromClause{
+							Tables: []QueryExpression{
+								Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 37}, Literal: "table1"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "trigger error",
 		Output: []Statement{
@@ -5491,6 +6209,28 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare cur cursor for delete from table1 returning column1",
+		Output: []Statement{
+			CursorDeclaration{
+				Cursor: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "cur"},
+				Query: DeleteQuery{
+					BaseExpr: &BaseExpr{line: 1, char: 24},
+					FromClause: FromClause{
+						Tables: []QueryExpression{
+							Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 36}, Literal: "table1"}},
+						},
+					},
+					ReturningClause: ReturningClause{
+						BaseExpr: &BaseExpr{line: 1, char: 43},
+						Fields: []QueryExpression{
+							Field{Object: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 53}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 53}, Literal: "column1"}}},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "open cur",
 		Output: []Statement{
@@ -5666,6 +6406,26 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "declare tbl view as update table1 set column1 = 1 returning *",
+		Output: []Statement{
+			ViewDeclaration{
+				View: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "tbl"},
+				Query: UpdateQuery{
+					Tables: []QueryExpression{
+						Table{Object: Identifier{BaseExpr: &BaseExpr{line: 1, char: 28}, Literal: "table1"}},
+					},
+					SetList: []UpdateSet{
+						{Field: FieldReference{BaseExpr: &BaseExpr{line: 1, char: 39}, Column: Identifier{BaseExpr: &BaseExpr{line: 1, char: 39}, Literal: "column1"}}, Value: NewIntegerValueFromString("1")},
+					},
+					ReturningClause: ReturningClause{
+						BaseExpr: &BaseExpr{line: 1, char: 51},
+						Fields:   []QueryExpression{Field{Object: AllColumns{BaseExpr: &BaseExpr{line: 1, char: 61}}}},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "declare tbl view as select 1, 2",
 		Output: []Statement{
@@ -5812,6 +6572,73 @@ var parseTests = []struct {
 			},
 		},
 	},
+	{
+		Input: "begin try print 1; end try begin catch print error_message(); end catch",
+		Output: []Statement{
+			TryCatch{
+				BaseExpr: &BaseExpr{line: 1, char: 1},
+				Try: []Statement{
+					Print{Value: NewIntegerValueFromString("1")},
+				},
+				Catch: []Statement{
+					Print{Value: Function{BaseExpr: &BaseExpr{line: 1, char: 46}, Name: "error_message"}},
+				},
+			},
+		},
+	},
+	{
+		Input: "while true do begin try continue; end try begin catch break; end catch; end while",
+		Output: []Statement{
+			While{
+				Condition: NewTernaryValueFromString("true"),
+				Statements: []Statement{
+					TryCatch{
+						BaseExpr: &BaseExpr{line: 1, char: 15},
+						Try: []Statement{
+							FlowControl{Token: CONTINUE},
+						},
+						Catch: []Statement{
+							FlowControl{Token: BREAK},
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Input: "declare func1 function () as begin begin try return 1; end try begin catch return; end catch; " +
+			"while true do begin try continue; end try begin catch return; end catch; end while; end",
+		Output: []Statement{
+			FunctionDeclaration{
+				Name: Identifier{BaseExpr: &BaseExpr{line: 1, char: 9}, Literal: "func1"},
+				Statements: []Statement{
+					TryCatch{
+						BaseExpr: &BaseExpr{line: 1, char: 36},
+						Try: []Statement{
+							Return{Value: NewIntegerValueFromString("1")},
+						},
+						Catch: []Statement{
+							Return{Value: NewNullValue()},
+						},
+					},
+					While{
+						Condition: NewTernaryValueFromString("true"),
+						Statements: []Statement{
+							TryCatch{
+								BaseExpr: &BaseExpr{line: 1, char: 109},
+								Try: []Statement{
+									FlowControl{Token: CONTINUE},
+								},
+								Catch: []Statement{
+									Return{Value: NewNullValue()},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	{
 		Input: "while @var1 in cur do print @var1; end while",
 		Output: []Statement{
@@ -2,6 +2,7 @@ package query
 
 import (
 	"math"
+	"math/big"
 	"sort"
 	"strings"
 
@@ -27,6 +28,15 @@ var AggregateFunctions = map[string]AggregateFunction{
 	"VAR":    Var,
 	"VARP":   VarP,
 	"MEDIAN": Median,
+	"MODE":   Mode,
+}
+
+// OrderedSetAggregateFunction calculates a list of values sorted by a WITHIN GROUP clause with a fraction.
+type OrderedSetAggregateFunction func([]value.Primary, float64, *option.Flags) value.Primary
+
+var OrderedSetAggregateFunctions = map[string]OrderedSetAggregateFunction{
+	"PERCENTILE_CONT": PercentileCont,
+	"PERCENTILE_DISC": PercentileDisc,
 }
 
 func Count(list []value.Primary, _ *option.Flags) value.Primary {
@@ -85,6 +95,13 @@ func Min(list []value.Primary, flags *option.Flags) value.Primary {
 }
 
 func Sum(list []value.Primary, _ *option.Flags) value.Primary {
+	if values, ok := decimalList(list); ok {
+		if len(values) < 1 {
+			return value.NewNull()
+		}
+		return decimalSum(values)
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -93,6 +110,13 @@ func Sum(list []value.Primary, _ *option.Flags) value.Primary {
 }
 
 func Avg(list []value.Primary, _ *option.Flags) value.Primary {
+	if values, ok := decimalList(list); ok {
+		if len(values) < 1 {
+			return value.NewNull()
+		}
+		return decimalQuotient(decimalSum(values), value.NewDecimal(big.NewInt(int64(len(values))), 0))
+	}
+
 	values := floatList(list)
 	if len(values) < 1 {
 		return value.NewNull()
@@ -142,6 +166,37 @@ func floatList(list []value.Primary) []float64 {
 	return values
 }
 
+// decimalList returns the values converted to decimals if the list contains any decimal value.
+func decimalList(list []value.Primary) ([]*value.Decimal, bool) {
+	containsDecimal := false
+	for _, v := range list {
+		if _, ok := v.(*value.Decimal); ok {
+			containsDecimal = true
+			break
+		}
+	}
+	if !containsDecimal {
+		return nil, false
+	}
+
+	values := make([]*value.Decimal, 0, len(list))
+	for _, v := range list {
+		if d := value.ToDecimal(v); !value.IsNull(d) {
+			values = append(values, d.(*value.Decimal))
+		}
+	}
+	return values, true
+}
+
+func decimalSum(list []*value.Decimal) *value.Decimal {
+	sum := value.NewDecimal(new(big.Int), 0)
+	for _, v := range list {
+		n, s, scale := value.AlignDecimals(sum, v)
+		sum = value.NewDecimal(n.Add(n, s), scale)
+	}
+	return sum
+}
+
 func sum(list []float64) float64 {
 	var sum float64
 	for _, v := range list {
@@ -215,6 +270,90 @@ func Median(list []value.Primary, flags *option.Flags) value.Primary {
 	return value.NewFloat(median)
 }
 
+// Mode returns the most frequent value. If some values are equally frequent, the one that appears first in the list is returned.
+func Mode(list []value.Primary, flags *option.Flags) value.Primary {
+	counts := make(map[string]int, 40)
+	firstIndices := make(map[string]int, 40)
+	keys := make([]string, 0, 40)
+
+	buf := GetComparisonKeysBuf()
+	for i, v := range list {
+		if value.IsNull(v) {
+			continue
+		}
+
+		buf.Reset()
+		SerializeComparisonKeys(buf, []value.Primary{v}, flags)
+		key := buf.String()
+		if _, ok := counts[key]; !ok {
+			firstIndices[key] = i
+			keys = append(keys, key)
+		}
+		counts[key]++
+	}
+	PutComparisonkeysBuf(buf)
+
+	var result value.Primary = value.NewNull()
+	maxCount := 0
+	for _, key := range keys {
+		if maxCount < counts[key] {
+			maxCount = counts[key]
+			result = list[firstIndices[key]]
+		}
+	}
+	return result
+}
+
+// PercentileCont returns the value at the fraction in the list interpolated linearly between adjacent values.
+// The list must be sorted by the WITHIN GROUP clause.
+func PercentileCont(list []value.Primary, fraction float64, flags *option.Flags) value.Primary {
+	var values []float64
+
+	for _, v := range list {
+		if f := value.ToFloat(v); !value.IsNull(f) {
+			values = append(values, f.(*value.Float).Raw())
+			continue
+		}
+		if d := value.ToDatetime(v, flags.DatetimeFormat, flags.GetTimeLocation()); !value.IsNull(d) {
+			values = append(values, float64(d.(*value.Datetime).Raw().UnixNano())/1e9)
+			continue
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	pos := fraction * float64(len(values)-1)
+	low := math.Floor(pos)
+	high := math.Ceil(pos)
+	if low == high {
+		return value.NewFloat(values[int(low)])
+	}
+	return value.NewFloat(values[int(low)] + (values[int(high)]-values[int(low)])*(pos-low))
+}
+
+// PercentileDisc returns the first value in the list whose cumulative distribution is greater than or equal to the fraction.
+// The list must be sorted by the WITHIN GROUP clause.
+func PercentileDisc(list []value.Primary, fraction float64, _ *option.Flags) value.Primary {
+	values := make([]value.Primary, 0, len(list))
+	for _, v := range list {
+		if !value.IsNull(v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) < 1 {
+		return value.NewNull()
+	}
+
+	idx := int(math.Ceil(fraction*float64(len(values)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return values[idx]
+}
+
 func ListAgg(list []value.Primary, separator string) value.Primary {
 	strlist := make([]string, 0)
 	for _, v := range list {
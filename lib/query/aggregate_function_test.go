@@ -112,6 +112,15 @@ var sumTests = []aggregateTests{
 		},
 		Result: value.NewFloat(8),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("0.10"),
+			value.NewString("0.2"),
+			value.NewNull(),
+			value.NewFloat(0.1),
+		},
+		Result: value.NewDecimalFromString("0.40"),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -141,6 +150,22 @@ var avgTests = []aggregateTests{
 		},
 		Result: value.NewFloat(2),
 	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("10.00"),
+			value.NewDecimalFromString("0.01"),
+			value.NewInteger(0),
+			value.NewNull(),
+		},
+		Result: value.NewDecimalFromString("3.3366666666666667"),
+	},
+	{
+		List: []value.Primary{
+			value.NewDecimalFromString("1.50"),
+			value.NewDecimalFromString("2.50"),
+		},
+		Result: value.NewDecimalFromString("2.00"),
+	},
 	{
 		List: []value.Primary{
 			value.NewNull(),
@@ -352,6 +377,121 @@ func TestMedian(t *testing.T) {
 	}
 }
 
+var modeTests = []aggregateTests{
+	{
+		List: []value.Primary{
+			value.NewString("b"),
+			value.NewInteger(1),
+			value.NewNull(),
+			value.NewString("a"),
+			value.NewNull(),
+			value.NewString("1"),
+			value.NewString("a"),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+}
+
+func TestMode(t *testing.T) {
+	for _, v := range modeTests {
+		r := Mode(v.List, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Result) {
+			t.Errorf("mode list = %s: result = %s, want %s", v.List, r, v.Result)
+		}
+	}
+}
+
+var percentileTests = []struct {
+	List     []value.Primary
+	Fraction float64
+	Cont     value.Primary
+	Disc     value.Primary
+}{
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewNull(),
+			value.NewInteger(20),
+			value.NewInteger(30),
+			value.NewInteger(40),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewFloat(25),
+		Disc:     value.NewInteger(20),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+			value.NewInteger(30),
+			value.NewInteger(40),
+			value.NewInteger(50),
+		},
+		Fraction: 0.75,
+		Cont:     value.NewFloat(40),
+		Disc:     value.NewInteger(40),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+		},
+		Fraction: 0,
+		Cont:     value.NewFloat(10),
+		Disc:     value.NewInteger(10),
+	},
+	{
+		List: []value.Primary{
+			value.NewInteger(10),
+			value.NewInteger(20),
+		},
+		Fraction: 1,
+		Cont:     value.NewFloat(20),
+		Disc:     value.NewInteger(20),
+	},
+	{
+		List: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+			value.NewDatetime(time.Date(2012, 2, 5, 9, 18, 15, 0, GetTestLocation())),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewFloat(float64(time.Date(2012, 2, 4, 9, 18, 15, 0, GetTestLocation()).UnixNano()) / 1e9),
+		Disc:     value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+	},
+	{
+		List: []value.Primary{
+			value.NewNull(),
+		},
+		Fraction: 0.5,
+		Cont:     value.NewNull(),
+		Disc:     value.NewNull(),
+	},
+}
+
+func TestPercentileCont(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileCont(v.List, v.Fraction, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Cont) {
+			t.Errorf("percentile_cont list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Cont)
+		}
+	}
+}
+
+func TestPercentileDisc(t *testing.T) {
+	for _, v := range percentileTests {
+		r := PercentileDisc(v.List, v.Fraction, TestTx.Flags)
+		if !reflect.DeepEqual(r, v.Disc) {
+			t.Errorf("percentile_disc list = %s, fraction = %f: result = %s, want %s", v.List, v.Fraction, r, v.Disc)
+		}
+	}
+}
+
 var listAggTests = []struct {
 	List      []value.Primary
 	Separator string
@@ -3,13 +3,32 @@ package query
 import (
 	"errors"
 	"math"
+	"math/big"
 
+	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/value"
 )
 
 var errIntegerDevidedByZero = errors.New("integer devided by zero")
+var errDecimalDevidedByZero = errors.New("decimal devided by zero")
+var errIntervalDevidedByZero = errors.New("interval devided by zero")
+
+// DecimalDivisionScale is the minimum number of fractional digits of decimal quotients.
+const DecimalDivisionScale = 16
+
+func Calculate(p1 value.Primary, p2 value.Primary, operator int, flags *option.Flags) (value.Primary, error) {
+	if isTemporalOperand(p1, p2) {
+		return calculateTemporal(p1, p2, operator, flags)
+	}
+
+	if isDecimalOperand(p1, p2) {
+		if d1 := value.ToDecimal(p1); !value.IsNull(d1) {
+			if d2 := value.ToDecimal(p2); !value.IsNull(d2) {
+				return calculateDecimal(d1.(*value.Decimal), d2.(*value.Decimal), operator)
+			}
+		}
+	}
 
-func Calculate(p1 value.Primary, p2 value.Primary, operator int) (value.Primary, error) {
 	if i1 := value.ToIntegerStrictly(p1); !value.IsNull(i1) {
 		if i2 := value.ToIntegerStrictly(p2); !value.IsNull(i2) {
 			val1 := i1.(*value.Integer).Raw()
@@ -37,6 +56,93 @@ func Calculate(p1 value.Primary, p2 value.Primary, operator int) (value.Primary,
 	return value.NewNull(), nil
 }
 
+func isTemporalOperand(p1 value.Primary, p2 value.Primary) bool {
+	for _, p := range []value.Primary{p1, p2} {
+		switch p.(type) {
+		case *value.Datetime, *value.Interval:
+			return true
+		}
+	}
+	return false
+}
+
+// calculateTemporal operates on datetimes and intervals.
+// Intervals can be added to or subtracted from datetimes, and multiplied or divided by numbers.
+// Subtracting a datetime from another datetime results in an interval.
+func calculateTemporal(p1 value.Primary, p2 value.Primary, operator int, flags *option.Flags) (value.Primary, error) {
+	toDatetime := func(p value.Primary) (*value.Datetime, bool) {
+		if _, ok := p.(*value.Interval); ok {
+			return nil, false
+		}
+		dt, ok := value.ToDatetime(p, flags.DatetimeFormat, flags.GetTimeLocation()).(*value.Datetime)
+		return dt, ok
+	}
+	toInterval := func(p value.Primary) (*value.Interval, bool) {
+		iv, ok := value.ToInterval(p).(*value.Interval)
+		return iv, ok
+	}
+	toFactor := func(p value.Primary) (*big.Rat, bool) {
+		d, ok := value.ToDecimal(p).(*value.Decimal)
+		if !ok {
+			return nil, false
+		}
+		return d.Rat(), true
+	}
+
+	switch operator {
+	case '+', '-':
+		if dt1, ok := toDatetime(p1); ok {
+			if iv2, ok := toInterval(p2); ok {
+				if operator == '-' {
+					iv2 = iv2.Negate()
+				}
+				return value.NewDatetime(iv2.AddTo(dt1.Raw())), nil
+			}
+			if dt2, ok := toDatetime(p2); ok && operator == '-' {
+				return value.NewIntervalBetween(dt1.Raw(), dt2.Raw()), nil
+			}
+		} else if iv1, ok := toInterval(p1); ok {
+			if dt2, ok := toDatetime(p2); ok && operator == '+' {
+				return value.NewDatetime(iv1.AddTo(dt2.Raw())), nil
+			}
+			if iv2, ok := toInterval(p2); ok {
+				if operator == '-' {
+					iv2 = iv2.Negate()
+				}
+				return iv1.Add(iv2), nil
+			}
+		}
+	case '*':
+		if iv1, ok := p1.(*value.Interval); ok {
+			if r, ok := toFactor(p2); ok {
+				return multiplyInterval(iv1, r), nil
+			}
+		} else if iv2, ok := p2.(*value.Interval); ok {
+			if r, ok := toFactor(p1); ok {
+				return multiplyInterval(iv2, r), nil
+			}
+		}
+	case '/':
+		if iv1, ok := p1.(*value.Interval); ok {
+			if r, ok := toFactor(p2); ok {
+				if r.Sign() == 0 {
+					return nil, errIntervalDevidedByZero
+				}
+				return multiplyInterval(iv1, r.Inv(r)), nil
+			}
+		}
+	}
+
+	return value.NewNull(), nil
+}
+
+func multiplyInterval(iv *value.Interval, r *big.Rat) value.Primary {
+	if ret, ok := iv.Multiply(r); ok {
+		return ret
+	}
+	return value.NewNull()
+}
+
 func calculateInteger(i1 int64, i2 int64, operator int) (value.Primary, error) {
 	var result int64 = 0
 	switch operator {
@@ -78,3 +184,63 @@ func calculateFloat(f1 float64, f2 float64, operator int) value.Primary {
 
 	return value.NewFloat(result)
 }
+
+func isDecimalOperand(p1 value.Primary, p2 value.Primary) bool {
+	if _, ok := p1.(*value.Decimal); ok {
+		return true
+	}
+	_, ok := p2.(*value.Decimal)
+	return ok
+}
+
+func calculateDecimal(d1 *value.Decimal, d2 *value.Decimal, operator int) (value.Primary, error) {
+	switch operator {
+	case '*':
+		return value.NewDecimal(new(big.Int).Mul(d1.Unscaled(), d2.Unscaled()), d1.Scale()+d2.Scale()), nil
+	case '/':
+		if d2.Sign() == 0 {
+			return nil, errDecimalDevidedByZero
+		}
+		return decimalQuotient(d1, d2), nil
+	}
+
+	v1, v2, scale := value.AlignDecimals(d1, d2)
+	switch operator {
+	case '+':
+		v1.Add(v1, v2)
+	case '-':
+		v1.Sub(v1, v2)
+	case '%':
+		if v2.Sign() == 0 {
+			return nil, errDecimalDevidedByZero
+		}
+		v1.Rem(v1, v2)
+	}
+	return value.NewDecimal(v1, scale), nil
+}
+
+// decimalQuotient returns d1 / d2 rounded to at least DecimalDivisionScale fractional digits.
+// Trailing zeros are removed as long as the scale is not less than that of the operands.
+func decimalQuotient(d1 *value.Decimal, d2 *value.Decimal) *value.Decimal {
+	scale := d1.Scale()
+	if scale < d2.Scale() {
+		scale = d2.Scale()
+	}
+	divScale := DecimalDivisionScale
+	if divScale < scale {
+		divScale = scale
+	}
+
+	num := new(big.Int).Mul(d1.Unscaled(), pow10(divScale+d2.Scale()))
+	den := new(big.Int).Mul(d2.Unscaled(), pow10(d1.Scale()))
+	q := value.NewDecimal(value.RoundQuotient(num, den), divScale)
+
+	if n := q.Normalize(); scale < n.Scale() {
+		return n
+	}
+	return q.Rescale(scale)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -111,11 +112,113 @@ var calculateTests = []struct {
 		Operator: '+',
 		Result:   value.NewFloat(math.NaN()),
 	},
+	{
+		LHS:      value.NewDecimalFromString("0.1"),
+		RHS:      value.NewString("0.20"),
+		Operator: '+',
+		Result:   value.NewDecimalFromString("0.30"),
+	},
+	{
+		LHS:      value.NewInteger(3),
+		RHS:      value.NewDecimalFromString("0.05"),
+		Operator: '-',
+		Result:   value.NewDecimalFromString("2.95"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.10"),
+		RHS:      value.NewDecimalFromString("0.3"),
+		Operator: '*',
+		Result:   value.NewDecimalFromString("0.330"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("10.00"),
+		RHS:      value.NewInteger(4),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("2.50"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("2"),
+		RHS:      value.NewInteger(3),
+		Operator: '/',
+		Result:   value.NewDecimalFromString("0.6666666666666667"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("-7.5"),
+		RHS:      value.NewInteger(2),
+		Operator: '%',
+		Result:   value.NewDecimalFromString("-1.5"),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewString("abc"),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
+	{
+		LHS:      value.NewDecimalFromString("1.5"),
+		RHS:      value.NewDecimalFromString("0.00"),
+		Operator: '/',
+		Error:    "decimal devided by zero",
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 1, 31, 9, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInterval(1, 2, 3600000000000),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 3, 4, 10, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewString("2012-03-04 10:00:00"),
+		RHS:      value.NewInterval(0, 3, 0),
+		Operator: '-',
+		Result:   value.NewDatetime(time.Date(2012, 3, 1, 10, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewInterval(0, 3, 0),
+		RHS:      value.NewDatetime(time.Date(2012, 3, 1, 10, 0, 0, 0, GetTestLocation())),
+		Operator: '+',
+		Result:   value.NewDatetime(time.Date(2012, 3, 4, 10, 0, 0, 0, GetTestLocation())),
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 4, 10, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewString("2012-03-01 12:30:00"),
+		Operator: '-',
+		Result:   value.NewInterval(0, 2, 77400000000000),
+	},
+	{
+		LHS:      value.NewInterval(0, 3, 0),
+		RHS:      value.NewString("12 hours"),
+		Operator: '-',
+		Result:   value.NewInterval(0, 3, -43200000000000),
+	},
+	{
+		LHS:      value.NewInteger(3),
+		RHS:      value.NewInterval(0, 1, 3600000000000),
+		Operator: '*',
+		Result:   value.NewInterval(0, 3, 10800000000000),
+	},
+	{
+		LHS:      value.NewInterval(1, 0, 0),
+		RHS:      value.NewInteger(4),
+		Operator: '/',
+		Result:   value.NewInterval(0, 7, 43200000000000),
+	},
+	{
+		LHS:      value.NewInterval(1, 0, 0),
+		RHS:      value.NewInteger(0),
+		Operator: '/',
+		Error:    "interval devided by zero",
+	},
+	{
+		LHS:      value.NewDatetime(time.Date(2012, 3, 4, 10, 0, 0, 0, GetTestLocation())),
+		RHS:      value.NewInteger(1),
+		Operator: '+',
+		Result:   value.NewNull(),
+	},
 }
 
 func TestCalculate(t *testing.T) {
 	for _, v := range calculateTests {
-		r, err := Calculate(v.LHS, v.RHS, v.Operator)
+		r, err := Calculate(v.LHS, v.RHS, v.Operator, TestTx.Flags)
 
 		if err != nil {
 			if len(v.Error) < 1 {
@@ -217,9 +217,11 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 
 	switch strings.ToUpper(expr.Flag.Name) {
 	case option.RepositoryFlag, option.TimezoneFlag, option.DatetimeFormatFlag,
-		option.ImportFormatFlag, option.DelimiterFlag, option.DelimiterPositionsFlag, option.JsonQueryFlag, option.EncodingFlag,
+		option.ImportFormatFlag, option.DelimiterFlag, option.DelimiterPositionsFlag, option.JsonQueryFlag,
+		option.XlsxSheetFlag, option.XlsxRangeFlag, option.XmlRowPathFlag, option.SourcePathColumnFlag, option.EncodingFlag,
 		option.ExportEncodingFlag, option.FormatFlag, option.ExportDelimiterFlag, option.ExportDelimiterPositionsFlag,
-		option.LineBreakFlag, option.JsonEscapeFlag:
+		option.LineBreakFlag, option.JsonEscapeFlag, option.SqlDialectFlag, option.SqlTableNameFlag,
+		option.XmlRootElementFlag, option.XmlRowElementFlag:
 		p = value.ToString(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
@@ -228,6 +230,7 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 	case option.AnsiQuotesFlag, option.StrictEqualFlag, option.AllowUnevenFieldsFlag,
 		option.NoHeaderFlag, option.WithoutNullFlag, option.WithoutHeaderFlag, option.EncloseAllFlag,
 		option.PrettyPrintFlag, option.ScientificNotationFlag, option.StripEndingLineBreakFlag,
+		option.HtmlDocumentFlag, option.XmlAttributesFlag,
 		option.EastAsianEncodingFlag, option.CountDiacriticalSignFlag, option.CountFormatCodeFlag, option.ColorFlag,
 		option.QuietFlag, option.StatsFlag:
 		p = value.ToBoolean(v)
@@ -241,7 +244,7 @@ func SetFlag(ctx context.Context, scope *ReferenceScope, expr parser.SetFlag) er
 			return NewFlagValueNotAllowedFormatError(expr)
 		}
 		val = p.(*value.Float).Raw()
-	case option.LimitRecursion, option.CPUFlag:
+	case option.LimitRecursion, option.CPUFlag, option.SortMemoryLimitFlag, option.SqlBatchSizeFlag:
 		p = value.ToInteger(v)
 		if value.IsNull(p) {
 			return NewFlagValueNotAllowedFormatError(expr)
@@ -270,14 +273,15 @@ func AddFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.AddF
 		return SetFlag(ctx, scope, e)
 	case option.RepositoryFlag, option.TimezoneFlag, option.AnsiQuotesFlag, option.StrictEqualFlag,
 		option.ImportFormatFlag, option.DelimiterFlag, option.AllowUnevenFieldsFlag, option.DelimiterPositionsFlag,
-		option.JsonQueryFlag, option.EncodingFlag,
+		option.JsonQueryFlag, option.XlsxSheetFlag, option.XlsxRangeFlag, option.XmlRowPathFlag, option.SourcePathColumnFlag, option.EncodingFlag,
 		option.ExportEncodingFlag, option.FormatFlag, option.ExportDelimiterFlag, option.ExportDelimiterPositionsFlag,
-		option.LineBreakFlag, option.JsonEscapeFlag, option.NoHeaderFlag, option.WithoutNullFlag, option.WithoutHeaderFlag,
-		option.EncloseAllFlag, option.PrettyPrintFlag, option.ScientificNotationFlag, option.StripEndingLineBreakFlag,
+		option.LineBreakFlag, option.JsonEscapeFlag, option.SqlDialectFlag, option.SqlTableNameFlag, option.SqlBatchSizeFlag,
+		option.HtmlDocumentFlag, option.XmlRootElementFlag, option.XmlRowElementFlag, option.XmlAttributesFlag,
+		option.NoHeaderFlag, option.WithoutNullFlag, option.WithoutHeaderFlag, option.EncloseAllFlag, option.PrettyPrintFlag, option.ScientificNotationFlag, option.StripEndingLineBreakFlag,
 		option.EastAsianEncodingFlag, option.CountDiacriticalSignFlag, option.CountFormatCodeFlag, option.ColorFlag,
 		option.QuietFlag, option.StatsFlag,
 		option.WaitTimeoutFlag,
-		option.LimitRecursion, option.CPUFlag:
+		option.LimitRecursion, option.CPUFlag, option.SortMemoryLimitFlag:
 
 		return NewAddFlagNotSupportedNameError(expr)
 	default:
@@ -319,14 +323,15 @@ func RemoveFlagElement(ctx context.Context, scope *ReferenceScope, expr parser.R
 		}
 	case option.RepositoryFlag, option.TimezoneFlag, option.AnsiQuotesFlag, option.StrictEqualFlag,
 		option.ImportFormatFlag, option.DelimiterFlag, option.AllowUnevenFieldsFlag, option.DelimiterPositionsFlag,
-		option.JsonQueryFlag, option.EncodingFlag,
+		option.JsonQueryFlag, option.XlsxSheetFlag, option.XlsxRangeFlag, option.XmlRowPathFlag, option.SourcePathColumnFlag, option.EncodingFlag,
 		option.ExportEncodingFlag, option.FormatFlag, option.ExportDelimiterFlag, option.ExportDelimiterPositionsFlag,
-		option.LineBreakFlag, option.JsonEscapeFlag, option.NoHeaderFlag, option.WithoutNullFlag, option.WithoutHeaderFlag,
-		option.EncloseAllFlag, option.PrettyPrintFlag, option.ScientificNotationFlag, option.StripEndingLineBreakFlag,
+		option.LineBreakFlag, option.JsonEscapeFlag, option.SqlDialectFlag, option.SqlTableNameFlag, option.SqlBatchSizeFlag,
+		option.HtmlDocumentFlag, option.XmlRootElementFlag, option.XmlRowElementFlag, option.XmlAttributesFlag,
+		option.NoHeaderFlag, option.WithoutNullFlag, option.WithoutHeaderFlag, option.EncloseAllFlag, option.PrettyPrintFlag, option.ScientificNotationFlag, option.StripEndingLineBreakFlag,
 		option.EastAsianEncodingFlag, option.CountDiacriticalSignFlag, option.CountFormatCodeFlag, option.ColorFlag,
 		option.QuietFlag, option.StatsFlag,
 		option.WaitTimeoutFlag,
-		option.LimitRecursion, option.CPUFlag:
+		option.LimitRecursion, option.CPUFlag, option.SortMemoryLimitFlag:
 
 		return NewRemoveFlagNotSupportedNameError(expr)
 	default:
@@ -376,9 +381,37 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		} else {
 			s = tx.Palette.Render(option.StringEffect, p.Raw())
 		}
+	case option.XlsxSheetFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(option.NullEffect, "(first sheet)")
+		} else {
+			s = tx.Palette.Render(option.StringEffect, p.Raw())
+		}
+	case option.XlsxRangeFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(option.NullEffect, "(used range)")
+		} else {
+			s = tx.Palette.Render(option.StringEffect, p.Raw())
+		}
+	case option.XmlRowPathFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(option.NullEffect, "(empty)")
+		} else {
+			s = tx.Palette.Render(option.StringEffect, p.Raw())
+		}
+	case option.SourcePathColumnFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(option.NullEffect, "(none)")
+		} else {
+			s = tx.Palette.Render(option.StringEffect, p.Raw())
+		}
 	case option.ExportEncodingFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case option.JSON, option.JSONL:
+		case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML, option.HTML:
 			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		default:
 			s = tx.Palette.Render(option.StringEffect, val.(*value.String).Raw())
@@ -399,7 +432,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case option.WithoutHeaderFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case option.CSV, option.TSV, option.FIXED, option.GFM, option.ORG:
+		case option.CSV, option.TSV, option.FIXED, option.XLSX, option.SQL, option.HTML, option.GFM, option.ORG:
 			if tx.Flags.ExportOptions.Format == option.FIXED && tx.Flags.ExportOptions.SingleLine {
 				s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 			} else {
@@ -409,7 +442,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
 		}
 	case option.LineBreakFlag:
-		if tx.Flags.ExportOptions.Format == option.FIXED && tx.Flags.ExportOptions.SingleLine {
+		if (tx.Flags.ExportOptions.Format == option.FIXED && tx.Flags.ExportOptions.SingleLine) || tx.Flags.ExportOptions.Format == option.XLSX || tx.Flags.ExportOptions.Format == option.PARQUET {
 			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		} else {
 			s = tx.Palette.Render(option.StringEffect, val.(*value.String).Raw())
@@ -428,6 +461,43 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		default:
 			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
 		}
+	case option.SqlDialectFlag:
+		if tx.Flags.ExportOptions.Format == option.SQL {
+			s = tx.Palette.Render(option.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case option.SqlTableNameFlag:
+		p := val.(*value.String)
+		if len(p.Raw()) < 1 {
+			s = tx.Palette.Render(option.NullEffect, "(source table name)")
+		} else {
+			s = tx.Palette.Render(option.StringEffect, p.Raw())
+		}
+	case option.SqlBatchSizeFlag:
+		if tx.Flags.ExportOptions.Format == option.SQL {
+			s = tx.Palette.Render(option.NumberEffect, val.(*value.Integer).String())
+		} else {
+			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Integer).String())
+		}
+	case option.HtmlDocumentFlag:
+		if tx.Flags.ExportOptions.Format == option.HTML {
+			s = tx.Palette.Render(option.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
+	case option.XmlRootElementFlag, option.XmlRowElementFlag:
+		if tx.Flags.ExportOptions.Format == option.XML {
+			s = tx.Palette.Render(option.StringEffect, val.(*value.String).Raw())
+		} else {
+			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.String).Raw())
+		}
+	case option.XmlAttributesFlag:
+		if tx.Flags.ExportOptions.Format == option.XML {
+			s = tx.Palette.Render(option.BooleanEffect, val.(*value.Boolean).String())
+		} else {
+			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
+		}
 	case option.PrettyPrintFlag:
 		switch tx.Flags.ExportOptions.Format {
 		case option.JSON, option.JSONL:
@@ -437,7 +507,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		}
 	case option.EastAsianEncodingFlag, option.CountDiacriticalSignFlag, option.CountFormatCodeFlag:
 		switch tx.Flags.ExportOptions.Format {
-		case option.GFM, option.ORG, option.BOX, option.TEXT:
+		case option.GFM, option.ORG, option.BOX, option.TEXT, option.VERTICAL:
 			s = tx.Palette.Render(option.BooleanEffect, val.(*value.Boolean).String())
 		default:
 			s = tx.Palette.Render(option.NullEffect, IgnoredFlagPrefix+val.(*value.Boolean).String())
@@ -446,7 +516,7 @@ func showFlag(tx *Transaction, flagName string) (string, bool) {
 		s = tx.Palette.Render(option.StringEffect, val.(*value.String).String())
 	case option.TimezoneFlag, option.ImportFormatFlag, option.DelimiterPositionsFlag, option.EncodingFlag, option.FormatFlag:
 		s = tx.Palette.Render(option.StringEffect, val.(*value.String).Raw())
-	case option.LimitRecursion:
+	case option.LimitRecursion, option.SortMemoryLimitFlag:
 		p := val.(*value.Integer)
 		if p.Raw() < 0 {
 			s = tx.Palette.Render(option.NullEffect, "(no limit)")
@@ -492,6 +562,10 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 						w.WriteColor("*Updated* ", option.EmphasisEffect)
 					}
 					w.WriteColorWithoutLineBreak(info.Path, option.ObjectEffect)
+					if 0 < len(info.ArchivePath) {
+						w.WriteColorWithoutLineBreak(" in ", option.LableEffect)
+						w.WriteColorWithoutLineBreak(info.ArchivePath, option.ObjectEffect)
+					}
 					writeFields(w, fields)
 
 					w.NewLine()
@@ -586,7 +660,7 @@ func ShowObjects(scope *ReferenceScope, expr parser.ShowObjects) (string, error)
 					}
 
 					w.NewLine()
-					if cur.query.SelectEntity != nil {
+					if cur.query != nil {
 						w.WriteColor("Query:", option.LableEffect)
 						writeQuery(w, cur.query.String())
 					} else {
@@ -752,6 +826,36 @@ func writeTableAttribute(w *doc.Writer, flags *option.Flags, info *FileInfo) {
 		}
 		w.WriteSpaces(spaces)
 
+		w.WriteColorWithoutLineBreak("Query: ", option.LableEffect)
+		if len(info.JsonQuery) < 1 {
+			w.WriteColorWithoutLineBreak("(empty)", option.NullEffect)
+		} else {
+			w.WriteColorWithoutLineBreak(info.JsonQuery, option.NullEffect)
+		}
+	case option.XLSX:
+		w.WriteColorWithoutLineBreak("Sheet: ", option.LableEffect)
+		if len(info.XlsxSheet) < 1 {
+			w.WriteColorWithoutLineBreak("(first sheet)", option.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.XlsxSheet)
+		}
+
+		w.WriteSpaces(2)
+
+		w.WriteColorWithoutLineBreak("Range: ", option.LableEffect)
+		if len(info.XlsxRange) < 1 {
+			w.WriteColorWithoutLineBreak("(used range)", option.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.XlsxRange)
+		}
+	case option.XML:
+		w.WriteColorWithoutLineBreak("Row Path: ", option.LableEffect)
+		if len(info.XmlRowPath) < 1 {
+			w.WriteColorWithoutLineBreak("(empty)", option.NullEffect)
+		} else {
+			w.WriteWithoutLineBreak(info.XmlRowPath)
+		}
+	case option.YAML:
 		w.WriteColorWithoutLineBreak("Query: ", option.LableEffect)
 		if len(info.JsonQuery) < 1 {
 			w.WriteColorWithoutLineBreak("(empty)", option.NullEffect)
@@ -771,13 +875,13 @@ func writeTableAttribute(w *doc.Writer, flags *option.Flags, info *FileInfo) {
 
 	w.WriteColor("Encoding: ", option.LableEffect)
 	switch info.Format {
-	case option.JSON, option.JSONL:
+	case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML, option.HTML:
 		w.WriteColorWithoutLineBreak(text.UTF8.String(), option.NullEffect)
 	default:
 		w.WriteWithoutLineBreak(info.Encoding.String())
 	}
 
-	if !(info.Format == option.FIXED && info.SingleLine) {
+	if !(info.Format == option.FIXED && info.SingleLine) && info.Format != option.XLSX && info.Format != option.PARQUET {
 		w.WriteSpaces(encWidth + 2 - (option.TextWidth(info.Encoding.String(), flags)))
 		w.WriteColorWithoutLineBreak("LineBreak: ", option.LableEffect)
 		w.WriteWithoutLineBreak(info.LineBreak.String())
@@ -788,6 +892,10 @@ func writeTableAttribute(w *doc.Writer, flags *option.Flags, info *FileInfo) {
 		w.WriteSpaces(6 - (option.TextWidth(info.LineBreak.String(), flags)))
 		w.WriteColorWithoutLineBreak("Pretty Print: ", option.LableEffect)
 		w.WriteWithoutLineBreak(strconv.FormatBool(info.PrettyPrint))
+	case option.XLSX:
+		w.WriteSpaces(encWidth + 2 - (option.TextWidth(text.UTF8.String(), flags)))
+		w.WriteColorWithoutLineBreak("Header: ", option.LableEffect)
+		w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 	case option.CSV, option.TSV, option.FIXED, option.GFM, option.ORG:
 		if !(info.Format == option.FIXED && info.SingleLine) {
 			w.WriteSpaces(6 - (option.TextWidth(info.LineBreak.String(), flags)))
@@ -795,6 +903,12 @@ func writeTableAttribute(w *doc.Writer, flags *option.Flags, info *FileInfo) {
 			w.WriteWithoutLineBreak(strconv.FormatBool(!info.NoHeader))
 		}
 	}
+
+	if info.Compression != file.NoCompression {
+		w.NewLine()
+		w.WriteColor("Compression: ", option.LableEffect)
+		w.WriteWithoutLineBreak(info.Compression.String())
+	}
 }
 
 func writeFields(w *doc.Writer, fields []string) {
@@ -860,6 +974,8 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 			return e.String()
 		case HttpObject:
 			return "Remote Object"
+		case FileSetObject:
+			return e.String()
 		case DataObject:
 			return "String Object"
 		default:
@@ -912,6 +1028,19 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 		w.NewLine()
 		w.WriteColorWithoutLineBreak("Path: ", option.LableEffect)
 		w.WriteColorWithoutLineBreak(view.FileInfo.Path, option.ObjectEffect)
+	} else if view.FileInfo.IsArchivedFile() {
+		w.WriteWithoutLineBreak("Archived File")
+		w.NewLine()
+		w.WriteColorWithoutLineBreak("Path: ", option.LableEffect)
+		w.WriteColorWithoutLineBreak(view.FileInfo.Path, option.ObjectEffect)
+		w.NewLine()
+		w.WriteColorWithoutLineBreak("Archive: ", option.LableEffect)
+		w.WriteColorWithoutLineBreak(view.FileInfo.ArchivePath, option.ObjectEffect)
+	} else if view.FileInfo.IsFileSet() {
+		w.WriteWithoutLineBreak("Multiple Files")
+		w.NewLine()
+		w.WriteColorWithoutLineBreak("Path: ", option.LableEffect)
+		w.WriteColorWithoutLineBreak(view.FileInfo.Path, option.ObjectEffect)
 	} else if view.FileInfo.IsRemoteObject() {
 		w.WriteWithoutLineBreak("Remote Object")
 		w.NewLine()
@@ -944,7 +1073,7 @@ func ShowFields(ctx context.Context, scope *ReferenceScope, expr parser.ShowFiel
 	}
 
 	w.NewLine()
-	writeFieldList(w, view.Header.TableColumnNames())
+	writeFieldList(w, view.Header.TableColumnDefinitions())
 
 	w.Title1 = "Fields in"
 	tablePath := func() parser.QueryExpression {
@@ -584,6 +584,49 @@ var setFlagTests = []struct {
 			Value: parser.NewIntegerValue(int64(runtime.NumCPU())),
 		},
 	},
+	{
+		Name: "Set SortMemoryLimit",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "sort_memory_limit"},
+			Value: parser.NewIntegerValue(int64(64)),
+		},
+	},
+	{
+		Name: "Set SqlDialect",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "sql_dialect"},
+			Value: parser.NewStringValue("postgresql"),
+		},
+	},
+	{
+		Name: "Set SqlBatchSize",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "sql_batch_size"},
+			Value: parser.NewIntegerValue(int64(500)),
+		},
+	},
+	{
+		Name: "Set XmlRowElement",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "xml_row_element"},
+			Value: parser.NewStringValue("item"),
+		},
+	},
+	{
+		Name: "Set XmlRowElement Invalid Name Error",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "xml_row_element"},
+			Value: parser.NewStringValue("1item"),
+		},
+		Error: "xml row element \"1item\" is not a valid name",
+	},
+	{
+		Name: "Set HtmlDocument",
+		Expr: parser.SetFlag{
+			Flag:  parser.Flag{Name: "html_document"},
+			Value: parser.NewTernaryValueFromString("true"),
+		},
+	},
 	{
 		Name: "Set Stats",
 		Expr: parser.SetFlag{
@@ -1591,6 +1634,92 @@ var showFlagTests = []struct {
 		},
 		Result: "\033[34;1m@@LIMIT_RECURSION:\033[0m \033[90m(no limit)\033[0m",
 	},
+	{
+		Name: "Show SortMemoryLimit",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sort_memory_limit"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "sort_memory_limit"},
+				Value: parser.NewIntegerValue(64),
+			},
+		},
+		Result: "\033[34;1m@@SORT_MEMORY_LIMIT:\033[0m \033[35m64\033[0m",
+	},
+	{
+		Name: "Show SortMemoryLimit No Limit",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sort_memory_limit"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "sort_memory_limit"},
+				Value: parser.NewIntegerValue(-1),
+			},
+		},
+		Result: "\033[34;1m@@SORT_MEMORY_LIMIT:\033[0m \033[90m(no limit)\033[0m",
+	},
+	{
+		Name: "Show SqlDialect",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sql_dialect"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("sql"),
+			},
+			{
+				Flag:  parser.Flag{Name: "sql_dialect"},
+				Value: parser.NewStringValue("mysql"),
+			},
+		},
+		Result: "\033[34;1m@@SQL_DIALECT:\033[0m \033[32mMYSQL\033[0m",
+	},
+	{
+		Name: "Show SqlDialect Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "sql_dialect"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("csv"),
+			},
+		},
+		Result: "\033[34;1m@@SQL_DIALECT:\033[0m \033[90m(ignored) ANSI\033[0m",
+	},
+	{
+		Name: "Show XmlRootElement",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "xml_root_element"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("xml"),
+			},
+			{
+				Flag:  parser.Flag{Name: "xml_root_element"},
+				Value: parser.NewStringValue("feed"),
+			},
+		},
+		Result: "\033[34;1m@@XML_ROOT_ELEMENT:\033[0m \033[32mfeed\033[0m",
+	},
+	{
+		Name: "Show HtmlDocument Ignored",
+		Expr: parser.ShowFlag{
+			Flag: parser.Flag{Name: "html_document"},
+		},
+		SetExprs: []parser.SetFlag{
+			{
+				Flag:  parser.Flag{Name: "format"},
+				Value: parser.NewStringValue("csv"),
+			},
+		},
+		Result: "\033[34;1m@@HTML_DOCUMENT:\033[0m \033[90m(ignored) false\033[0m",
+	},
 	{
 		Name: "Show CPU",
 		Expr: parser.ShowFlag{
@@ -2276,6 +2405,10 @@ var showObjectsTests = []struct {
 			"       @@ALLOW_UNEVEN_FIELDS: false\n" +
 			"       @@DELIMITER_POSITIONS: SPACES\n" +
 			"                @@JSON_QUERY: (empty)\n" +
+			"                @@XLSX_SHEET: (first sheet)\n" +
+			"                @@XLSX_RANGE: (used range)\n" +
+			"              @@XML_ROW_PATH: (empty)\n" +
+			"        @@SOURCE_PATH_COLUMN: (none)\n" +
 			"                  @@ENCODING: AUTO\n" +
 			"                 @@NO_HEADER: false\n" +
 			"              @@WITHOUT_NULL: false\n" +
@@ -2288,6 +2421,13 @@ var showObjectsTests = []struct {
 			"                @@LINE_BREAK: LF\n" +
 			"               @@ENCLOSE_ALL: false\n" +
 			"               @@JSON_ESCAPE: (ignored) BACKSLASH\n" +
+			"               @@SQL_DIALECT: (ignored) ANSI\n" +
+			"            @@SQL_TABLE_NAME: (source table name)\n" +
+			"            @@SQL_BATCH_SIZE: (ignored) 100\n" +
+			"             @@HTML_DOCUMENT: (ignored) false\n" +
+			"          @@XML_ROOT_ELEMENT: (ignored) records\n" +
+			"           @@XML_ROW_ELEMENT: (ignored) record\n" +
+			"            @@XML_ATTRIBUTES: (ignored) false\n" +
 			"              @@PRETTY_PRINT: (ignored) false\n" +
 			"       @@SCIENTIFIC_NOTATION: false\n" +
 			"       @@EAST_ASIAN_ENCODING: (ignored) false\n" +
@@ -2297,6 +2437,7 @@ var showObjectsTests = []struct {
 			"                     @@QUIET: false\n" +
 			"           @@LIMIT_RECURSION: 5\n" +
 			"                       @@CPU: " + strconv.Itoa(TestTx.Flags.CPU) + "\n" +
+			"         @@SORT_MEMORY_LIMIT: (no limit)\n" +
 			"                     @@STATS: false\n" +
 			"\n",
 	},
@@ -0,0 +1,255 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+// columnProjection is a set of upper-cased names that may refer to columns in a query.
+// It is used to decode only the required columns of columnar files.
+// A nil projection means that all columns are required.
+type columnProjection map[string]bool
+
+// newColumnProjection collects names referred in the query.
+// Identifiers in nested queries are also collected because they can refer to the columns in the outer query,
+// but wildcards in nested queries are ignored because nested queries are evaluated with their own projections.
+func newColumnProjection(query parser.SelectQuery) columnProjection {
+	p := make(columnProjection)
+	if !p.collectQuery(query, false) {
+		return nil
+	}
+	return p
+}
+
+func (p columnProjection) add(name string) {
+	if 0 < len(name) {
+		p[strings.ToUpper(name)] = true
+	}
+}
+
+// Contains reports whether the column is required.
+func (p columnProjection) Contains(column string) bool {
+	return p == nil || p[strings.ToUpper(column)]
+}
+
+// Covers reports whether all the columns required by other are included in p.
+func (p columnProjection) Covers(other columnProjection) bool {
+	if p == nil {
+		return true
+	}
+	if other == nil {
+		return false
+	}
+	for name := range other {
+		if !p[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge returns a projection that requires the columns in both p and other.
+func (p columnProjection) Merge(other columnProjection) columnProjection {
+	if p == nil || other == nil {
+		return nil
+	}
+
+	merged := make(columnProjection, len(p)+len(other))
+	for name := range p {
+		merged[name] = true
+	}
+	for name := range other {
+		merged[name] = true
+	}
+	return merged
+}
+
+func (p columnProjection) collectQuery(query parser.SelectQuery, nested bool) bool {
+	return p.collectList([]parser.QueryExpression{query.WithClause, query.SelectEntity, query.OrderByClause, query.LimitClause}, nested)
+}
+
+// collect walks through the expression, and returns false if all columns are required.
+// Unknown expressions are regarded as requiring all columns.
+func (p columnProjection) collect(expr parser.QueryExpression, nested bool) bool {
+	switch e := expr.(type) {
+	case nil:
+		return true
+	case parser.SelectQuery:
+		return p.collectQuery(e, nested)
+	case parser.Subquery:
+		return p.collectQuery(e.Query, true)
+	case parser.Exists:
+		return p.collectQuery(e.Query.Query, true)
+	case parser.WithClause:
+		return p.collectList(e.InlineTables, nested)
+	case parser.InlineTable:
+		p.add(e.Name.Literal)
+		return p.collectList(e.Fields, nested) && p.collectQuery(e.Query, true)
+	case parser.SelectSet:
+		return p.collect(e.LHS, nested) && p.collect(e.RHS, nested)
+	case parser.SelectEntity:
+		return p.collectList([]parser.QueryExpression{e.SelectClause, e.IntoClause, e.FromClause, e.WhereClause, e.GroupByClause, e.HavingClause, e.QualifyClause}, nested)
+	case parser.SelectClause:
+		return p.collectList(e.DistinctOn, nested) && p.collectList(e.Fields, nested)
+	case parser.IntoClause:
+		return true
+	case parser.FromClause:
+		return p.collectList(e.Tables, nested)
+	case parser.WhereClause:
+		return p.collect(e.Filter, nested)
+	case parser.GroupByClause:
+		return p.collectList(e.Items, nested)
+	case parser.GroupingSets:
+		return p.collectList(e.Items, nested)
+	case parser.HavingClause:
+		return p.collect(e.Filter, nested)
+	case parser.QualifyClause:
+		return p.collect(e.Filter, nested)
+	case parser.OrderByClause:
+		return p.collectList(e.Items, nested)
+	case parser.OrderItem:
+		return p.collect(e.Value, nested)
+	case parser.LimitClause:
+		return p.collect(e.Value, nested) && p.collect(e.OffsetClause, nested)
+	case parser.OffsetClause:
+		return p.collect(e.Value, nested)
+	case parser.Field:
+		return p.collect(e.Object, nested) && p.collect(e.Alias, nested)
+	case parser.Table:
+		return p.collect(e.Object, nested) && p.collect(e.Alias, nested)
+	case parser.Join:
+		if !e.Natural.IsEmpty() && !nested {
+			return false
+		}
+		return p.collect(e.Table, nested) && p.collect(e.JoinTable, nested) && p.collect(e.Condition, nested)
+	case parser.JoinCondition:
+		return p.collect(e.On, nested) && p.collectList(e.Using, nested)
+	case parser.Pivot:
+		return p.collect(e.Table, nested) && p.collect(e.Aggregate, nested) && p.collect(e.Column, nested) && p.collectList(e.Values, nested)
+	case parser.Unpivot:
+		p.add(e.Value.Literal)
+		p.add(e.Name.Literal)
+		return p.collect(e.Table, nested) && p.collectList(e.Columns, nested)
+	case parser.Unnest:
+		return p.collect(e.Expr, nested)
+	case parser.TableFunction:
+		return p.collectList(e.Args, nested)
+	case parser.FormatSpecifiedFunction:
+		return p.collect(e.FormatElement, nested) && p.collect(e.Path, nested) && p.collectList(e.Args, nested)
+	case parser.ArchiveMember:
+		p.add(e.Archive.Literal)
+		p.add(e.Member.Literal)
+		return true
+	case parser.JsonQuery:
+		return p.collect(e.Query, nested) && p.collect(e.JsonText, nested)
+	case parser.Identifier:
+		p.add(e.Literal)
+		return true
+	case parser.FieldReference:
+		if _, ok := e.Column.(parser.AllColumns); ok {
+			return nested
+		}
+		p.add(e.View.Literal)
+		if col, ok := e.Column.(parser.Identifier); ok && 0 < len(e.View.Literal) {
+			p.add(e.View.Literal + "." + col.Literal)
+		}
+		return p.collect(e.Column, nested)
+	case parser.ColumnNumber:
+		return false
+	case parser.AllColumns:
+		return nested
+	case parser.Parentheses:
+		return p.collect(e.Expr, nested)
+	case parser.RowValue:
+		return p.collect(e.Value, nested)
+	case parser.ValueList:
+		return p.collectList(e.Values, nested)
+	case parser.RowValueList:
+		return p.collectList(e.RowValues, nested)
+	case parser.ArrayValue:
+		return p.collectList(e.Values, nested)
+	case parser.Comparison:
+		return p.collect(e.LHS, nested) && p.collect(e.RHS, nested)
+	case parser.Is:
+		return p.collect(e.LHS, nested) && p.collect(e.RHS, nested)
+	case parser.Between:
+		return p.collect(e.LHS, nested) && p.collect(e.Low, nested) && p.collect(e.High, nested)
+	case parser.In:
+		return p.collect(e.LHS, nested) && p.collect(e.Values, nested)
+	case parser.All:
+		return p.collect(e.LHS, nested) && p.collect(e.Values, nested)
+	case parser.Any:
+		return p.collect(e.LHS, nested) && p.collect(e.Values, nested)
+	case parser.Like:
+		return p.collect(e.LHS, nested) && p.collect(e.Pattern, nested)
+	case parser.Arithmetic:
+		return p.collect(e.LHS, nested) && p.collect(e.RHS, nested)
+	case parser.UnaryArithmetic:
+		return p.collect(e.Operand, nested)
+	case parser.Logic:
+		return p.collect(e.LHS, nested) && p.collect(e.RHS, nested)
+	case parser.UnaryLogic:
+		return p.collect(e.Operand, nested)
+	case parser.Concat:
+		return p.collectList(e.Items, nested)
+	case parser.CaseExpr:
+		return p.collect(e.Value, nested) && p.collectList(e.When, nested) && p.collect(e.Else, nested)
+	case parser.CaseExprWhen:
+		return p.collect(e.Condition, nested) && p.collect(e.Result, nested)
+	case parser.CaseExprElse:
+		return p.collect(e.Result, nested)
+	case parser.Function:
+		if strings.EqualFold(e.Name, "JSON_OBJECT") && len(e.Args) < 1 {
+			return nested
+		}
+		return p.collectList(e.Args, nested)
+	case parser.AggregateFunction:
+		return p.collectArgs(e.Args, nested) && p.collect(e.OrderBy, nested) && p.collect(e.Filter, nested)
+	case parser.ListFunction:
+		return p.collectList(e.Args, nested) && p.collect(e.OrderBy, nested) && p.collect(e.Filter, nested)
+	case parser.AnalyticFunction:
+		return p.collectArgs(e.Args, nested) && p.collect(e.AnalyticClause, nested)
+	case parser.AnalyticClause:
+		return p.collect(e.PartitionClause, nested) && p.collect(e.OrderByClause, nested) && p.collect(e.WindowingClause, nested)
+	case parser.WindowingClause:
+		return p.collect(e.FrameLow, nested) && p.collect(e.FrameHigh, nested)
+	case parser.PartitionClause:
+		return p.collectList(e.Values, nested)
+	case parser.VariableSubstitution:
+		return p.collect(e.Value, nested)
+	case parser.CursorStatus:
+		p.add(e.Cursor.Literal)
+		return true
+	case parser.CursorAttrebute:
+		p.add(e.Cursor.Literal)
+		return true
+	case parser.WindowFramePosition, parser.PrimitiveType, parser.Placeholder, parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation,
+		parser.Constant, parser.Flag, parser.Url, parser.Dual, parser.Stdin:
+		return true
+	}
+	return false
+}
+
+func (p columnProjection) collectList(list []parser.QueryExpression, nested bool) bool {
+	for _, v := range list {
+		if !p.collect(v, nested) {
+			return false
+		}
+	}
+	return true
+}
+
+// collectArgs collects names in the arguments of a function.
+// A wildcard in the arguments such as COUNT(*) does not refer to any column.
+func (p columnProjection) collectArgs(args []parser.QueryExpression, nested bool) bool {
+	for _, arg := range args {
+		if _, ok := arg.(parser.AllColumns); ok {
+			continue
+		}
+		if !p.collect(arg, nested) {
+			return false
+		}
+	}
+	return true
+}
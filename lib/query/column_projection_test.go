@@ -0,0 +1,84 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var newColumnProjectionTests = []struct {
+	Query  string
+	Result columnProjection
+}{
+	{
+		Query:  "SELECT id, `address.city` FROM t WHERE price > 1",
+		Result: columnProjection{"ID": true, "ADDRESS.CITY": true, "T": true, "PRICE": true},
+	},
+	{
+		Query:  "SELECT t.name, COUNT(*) FROM t GROUP BY t.name",
+		Result: columnProjection{"T": true, "NAME": true, "T.NAME": true},
+	},
+	{
+		Query:  "SELECT id FROM t WHERE EXISTS (SELECT * FROM u WHERE u.id = t.id)",
+		Result: columnProjection{"ID": true, "T": true, "U": true, "U.ID": true, "T.ID": true},
+	},
+	{
+		Query:  "SELECT * FROM t",
+		Result: nil,
+	},
+	{
+		Query:  "SELECT t.* FROM t",
+		Result: nil,
+	},
+	{
+		Query:  "SELECT t.1 FROM t",
+		Result: nil,
+	},
+	{
+		Query:  "SELECT id FROM t NATURAL JOIN u",
+		Result: nil,
+	},
+	{
+		Query:  "SELECT a FROM t JOIN u USING (b) PIVOT (SUM(c) FOR d IN (1 AS x, 2)) p",
+		Result: columnProjection{"A": true, "T": true, "U": true, "B": true, "C": true, "D": true, "X": true, "P": true},
+	},
+	{
+		Query:  "SELECT a FROM t WHERE b > ALL (SELECT * FROM u) ORDER BY SUM(c) OVER (PARTITION BY d ORDER BY e ROWS UNBOUNDED PRECEDING)",
+		Result: columnProjection{"A": true, "T": true, "B": true, "U": true, "C": true, "D": true, "E": true},
+	},
+	{
+		Query:  "SELECT JSON_OBJECT() FROM t",
+		Result: nil,
+	},
+}
+
+func TestNewColumnProjection(t *testing.T) {
+	for _, v := range newColumnProjectionTests {
+		statements, _, err := parser.Parse(v.Query, "", false, false)
+		if err != nil {
+			t.Fatalf("unexpected error %q for %q", err, v.Query)
+		}
+
+		result := newColumnProjection(statements[0].(parser.SelectQuery))
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %q", result, v.Result, v.Query)
+		}
+	}
+}
+
+func TestColumnProjection_Merge(t *testing.T) {
+	p := columnProjection{"ID": true}
+
+	if !p.Contains("id") || p.Contains("name") {
+		t.Errorf("contains = %t, %t, want %t, %t", p.Contains("id"), p.Contains("name"), true, false)
+	}
+
+	merged := p.Merge(columnProjection{"NAME": true})
+	if !merged.Covers(p) || p.Covers(merged) {
+		t.Errorf("covers = %t, %t, want %t, %t", merged.Covers(p), p.Covers(merged), true, false)
+	}
+	if result := p.Merge(nil); result != nil {
+		t.Errorf("merged projection = %v, want nil", result)
+	}
+}
@@ -0,0 +1,297 @@
+package query
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text/jsonl"
+)
+
+type recordReadFunc func() (Record, error)
+
+// Convert reads records from a file and writes them to the writer in the export format chunk by chunk
+// without loading the whole file.
+//
+// Fields in JSONL and LTSV files can vary from record to record, so these files are read twice,
+// and the fields are determined in the first reading.
+func Convert(ctx context.Context, tx *Transaction, fileIdentifier parser.Identifier, options option.ImportOptions, writer io.Writer) error {
+	exportOptions := tx.Flags.ExportOptions.Copy()
+	switch exportOptions.Format {
+	case option.CSV, option.TSV, option.JSONL, option.LTSV:
+	default:
+		return NewIncorrectCommandUsageError("format to convert to must be one of CSV|TSV|JSONL|LTSV")
+	}
+	if !isStreamableExportOptions(exportOptions) {
+		return NewIncorrectCommandUsageError("encoding to convert to must be one of UTF8|SJIS")
+	}
+	if exportOptions.PrettyPrint {
+		return NewIncorrectCommandUsageError("pretty-print cannot be used to convert files")
+	}
+
+	fileInfo, err := NewFileInfo(fileIdentifier, tx.Flags.Repository, options, tx.Flags.ImportOptions.Format)
+	if err != nil {
+		return err
+	}
+	switch fileInfo.Format {
+	case option.CSV, option.TSV, option.JSONL, option.LTSV:
+	default:
+		return NewIncorrectCommandUsageError("format to convert from must be one of CSV|TSV|JSONL|LTSV")
+	}
+	fileInfo.SetDefaultFileInfoAttributes(options, exportOptions)
+
+	var fields []string
+	switch fileInfo.Format {
+	case option.JSONL, option.LTSV:
+		if err = readFileForConversion(ctx, tx, fileInfo, fileIdentifier, func(fp *file.Reader) (e error) {
+			fields, e = scanFieldsForConversion(ctx, fp, fileInfo, fileIdentifier)
+			return e
+		}); err != nil {
+			return err
+		}
+	}
+
+	encoder := &streamingEncoder{
+		writer:  writer,
+		options: exportOptions,
+		palette: tx.Palette,
+	}
+
+	if err = readFileForConversion(ctx, tx, fileInfo, fileIdentifier, func(fp *file.Reader) error {
+		var read recordReadFunc
+		var e error
+
+		switch fileInfo.Format {
+		case option.JSONL:
+			read, e = newJsonLinesRecordReadFunc(fp, fileInfo, fields, fileIdentifier)
+		case option.LTSV:
+			read, e = newLTSVRecordReadFunc(fp, fileInfo, fields, options.WithoutNull, fileIdentifier)
+		default:
+			read, fields, e = newCSVRecordReadFunc(fp, fileInfo, options.WithoutNull, fileIdentifier)
+		}
+		if e != nil {
+			return e
+		}
+
+		return encodeRecordsForConversion(ctx, encoder, NewHeader(FormatTableName(fileInfo.Path), fields), fileInfo, read)
+	}); err != nil {
+		return err
+	}
+
+	if !encoder.written {
+		return DataEmpty
+	}
+	if exportOptions.Format != option.JSONL && !exportOptions.StripEndingLineBreak {
+		if _, err = writer.Write([]byte(exportOptions.LineBreak.Value())); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	return nil
+}
+
+func readFileForConversion(ctx context.Context, tx *Transaction, fileInfo *FileInfo, fileIdentifier parser.Identifier, fn func(fp *file.Reader) error) (err error) {
+	h, err := tx.FileContainer.CreateHandlerForRead(ctx, fileInfo.Path, tx.WaitTimeout, tx.RetryDelay)
+	if err != nil {
+		fileIdentifier.Literal = fileInfo.Path
+		return ConvertFileHandlerError(err, fileIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, tx.FileContainer.Close(h))
+	}()
+
+	fileReader, err := newFileReader(h.File(), fileInfo, fileIdentifier)
+	if err != nil {
+		return err
+	}
+	return fn(fileReader)
+}
+
+func scanFieldsForConversion(ctx context.Context, fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) ([]string, error) {
+	if fileInfo.Format == option.LTSV {
+		reader, err := newLTSVReader(fp, fileInfo, expr)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; ; i++ {
+			if i&15 == 0 && ctx.Err() != nil {
+				return nil, ConvertContextError(ctx.Err())
+			}
+			if _, err = reader.Read(); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, NewDataParsingError(expr, fileInfo.Path, err.Error())
+			}
+		}
+		return reader.Header.Fields(), nil
+	}
+
+	jsonQuery, err := json.Query.Parse(fileInfo.JsonQuery)
+	if err != nil {
+		return nil, NewLoadJsonError(expr, err.Error())
+	}
+
+	reader := jsonl.NewReader(fp)
+	fields := make([]string, 0, 32)
+	fieldMap := make(map[string]bool, 32)
+
+	for i := 0; ; i++ {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		obj, _, err := readJsonLinesObject(reader, jsonQuery, expr)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, key := range obj.Keys() {
+			if !fieldMap[key] {
+				fieldMap[key] = true
+				fields = append(fields, key)
+			}
+		}
+	}
+	return fields, nil
+}
+
+func newCSVRecordReadFunc(fp *file.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (recordReadFunc, []string, error) {
+	reader, fields, err := newCSVReader(fp, fileInfo, false, withoutNull, expr)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(expr, fileInfo.Path, err.Error())
+		}
+		return nil, nil, err
+	}
+
+	read := func() (Record, error) {
+		row, err := reader.Read()
+		if err != nil {
+			if err != io.EOF {
+				err = NewDataParsingError(expr, fileInfo.Path, err.Error())
+			}
+			return nil, err
+		}
+		return newRecordFromRawText(row), nil
+	}
+
+	if fields != nil {
+		return read, fields, nil
+	}
+
+	// The number of fields is determined by the first record.
+	first, err := read()
+	if err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+	fields = make([]string, reader.FieldsPerRecord)
+	for i := 0; i < reader.FieldsPerRecord; i++ {
+		fields[i] = "c" + strconv.Itoa(i+1)
+	}
+
+	return func() (Record, error) {
+		if first != nil {
+			record := first
+			first = nil
+			return record, nil
+		}
+		return read()
+	}, fields, nil
+}
+
+func newLTSVRecordReadFunc(fp *file.Reader, fileInfo *FileInfo, fields []string, withoutNull bool, expr parser.QueryExpression) (recordReadFunc, error) {
+	reader, err := newLTSVReader(fp, fileInfo, expr)
+	if err != nil {
+		return nil, err
+	}
+	reader.WithoutNull = withoutNull
+
+	return func() (Record, error) {
+		row, err := reader.Read()
+		if err != nil {
+			if err != io.EOF {
+				err = NewDataParsingError(expr, fileInfo.Path, err.Error())
+			}
+			return nil, err
+		}
+
+		record := newRecordFromRawText(row)
+		for i := len(record); i < len(fields); i++ {
+			if withoutNull {
+				record = append(record, NewCell(value.NewString("")))
+			} else {
+				record = append(record, NewCell(value.NewNull()))
+			}
+		}
+		return record, nil
+	}, nil
+}
+
+func newJsonLinesRecordReadFunc(fp *file.Reader, fileInfo *FileInfo, fields []string, expr parser.QueryExpression) (recordReadFunc, error) {
+	jsonQuery, err := json.Query.Parse(fileInfo.JsonQuery)
+	if err != nil {
+		return nil, NewLoadJsonError(expr, err.Error())
+	}
+
+	reader := jsonl.NewReader(fp)
+	reader.SetUseInteger(false)
+
+	return func() (Record, error) {
+		obj, _, err := readJsonLinesObject(reader, jsonQuery, expr)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]value.Primary, len(fields))
+		for i, v := range fields {
+			if obj.Exists(v) {
+				values[i] = json.ConvertToValue(obj.Value(v))
+			} else {
+				values[i] = value.NewNull()
+			}
+		}
+		return NewRecord(values), nil
+	}, nil
+}
+
+func encodeRecordsForConversion(ctx context.Context, encoder *streamingEncoder, header Header, fileInfo *FileInfo, read recordReadFunc) error {
+	for {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		chunk := make(RecordSet, 0, streamingSelectChunkSize)
+		eof := false
+		for len(chunk) < streamingSelectChunkSize {
+			record, err := read()
+			if err == io.EOF {
+				eof = true
+				break
+			}
+			if err != nil {
+				return err
+			}
+			chunk = append(chunk, record)
+		}
+
+		view := NewView()
+		view.Header = header
+		view.RecordSet = chunk
+		view.FileInfo = fileInfo
+
+		if err := encoder.encode(ctx, view); err != nil {
+			return err
+		}
+		if eof {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+
+	"github.com/mithrandie/go-text"
+)
+
+var convertTests = []struct {
+	Name          string
+	File          string
+	ImportFormat  option.Format
+	NoHeader      bool
+	JsonQuery     string
+	Format        option.Format
+	Encoding      text.Encoding
+	WithoutHeader bool
+	PrettyPrint   bool
+	Result        string
+	Error         string
+}{
+	{
+		Name:         "Convert CSV to JSONL",
+		File:         "table1",
+		ImportFormat: option.CSV,
+		Format:       option.JSONL,
+		Result: "{\"column1\":\"1\",\"column2\":\"str1\"}\n" +
+			"{\"column1\":\"2\",\"column2\":\"str2\"}\n" +
+			"{\"column1\":\"3\",\"column2\":\"str3\"}\n",
+	},
+	{
+		Name:         "Convert CSV without Header",
+		File:         "table_noheader.csv",
+		ImportFormat: option.AutoSelect,
+		NoHeader:     true,
+		Format:       option.TSV,
+		Result: "c1\tc2\n" +
+			"1\tstr1\n" +
+			"2\tstr2\n",
+	},
+	{
+		Name:          "Convert Compressed CSV",
+		File:          "table_gzip.csv.gz",
+		ImportFormat:  option.AutoSelect,
+		Format:        option.CSV,
+		WithoutHeader: true,
+		Result: "1,str1\n" +
+			"2,str2\n" +
+			"3,str3\n",
+	},
+	{
+		Name:         "Convert JSONL to CSV",
+		File:         "table7.jsonl",
+		ImportFormat: option.AutoSelect,
+		Format:       option.CSV,
+		Result: "item1,item2\n" +
+			"value1,1\n" +
+			"value2,2\n",
+	},
+	{
+		Name:         "Convert LTSV to CSV",
+		File:         "table6.ltsv",
+		ImportFormat: option.LTSV,
+		Format:       option.CSV,
+		Result: "f1,f2,f3,f4\n" +
+			"value1,value2,value3,\n" +
+			"value4,value5,,value6\n",
+	},
+	{
+		Name:         "Convert Query Error",
+		File:         "table7.jsonl",
+		ImportFormat: option.JSONL,
+		JsonQuery:    "{",
+		Format:       option.CSV,
+		Error:        "json loading error: column 1: unexpected termination",
+	},
+	{
+		Name:         "Convert File Not Exist Error",
+		File:         "notexist",
+		ImportFormat: option.JSONL,
+		Format:       option.CSV,
+		Error:        "file notexist does not exist",
+	},
+	{
+		Name:         "Convert Import Format Error",
+		File:         "table.json",
+		ImportFormat: option.AutoSelect,
+		Format:       option.CSV,
+		Error:        "incorrect usage: format to convert from must be one of CSV|TSV|JSONL|LTSV",
+	},
+	{
+		Name:         "Convert Export Format Error",
+		File:         "table1.csv",
+		ImportFormat: option.AutoSelect,
+		Format:       option.JSON,
+		Error:        "incorrect usage: format to convert to must be one of CSV|TSV|JSONL|LTSV",
+	},
+	{
+		Name:         "Convert Export Encoding Error",
+		File:         "table1.csv",
+		ImportFormat: option.AutoSelect,
+		Format:       option.CSV,
+		Encoding:     text.UTF16,
+		Error:        "incorrect usage: encoding to convert to must be one of UTF8|SJIS",
+	},
+	{
+		Name:         "Convert Pretty Print Error",
+		File:         "table1.csv",
+		ImportFormat: option.AutoSelect,
+		Format:       option.JSONL,
+		PrettyPrint:  true,
+		Error:        "incorrect usage: pretty-print cannot be used to convert files",
+	},
+}
+
+func TestConvert(t *testing.T) {
+	defer func() {
+		initFlag(TestTx.Flags)
+	}()
+
+	for _, v := range convertTests {
+		initFlag(TestTx.Flags)
+		TestTx.Flags.Repository = TestDir
+		TestTx.Flags.ExportOptions.Format = v.Format
+		TestTx.Flags.ExportOptions.WithoutHeader = v.WithoutHeader
+		TestTx.Flags.ExportOptions.PrettyPrint = v.PrettyPrint
+		if v.Encoding != text.AUTO {
+			TestTx.Flags.ExportOptions.Encoding = v.Encoding
+		}
+
+		options := TestTx.Flags.ImportOptions.Copy()
+		options.Format = v.ImportFormat
+		options.NoHeader = v.NoHeader
+		options.JsonQuery = v.JsonQuery
+
+		buf := &bytes.Buffer{}
+		err := Convert(context.Background(), TestTx, parser.Identifier{Literal: v.File}, options, buf)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
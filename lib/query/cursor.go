@@ -132,7 +132,7 @@ func (m CursorMap) Count(name parser.Identifier) (int, error) {
 
 type Cursor struct {
 	Name      string
-	query     parser.SelectQuery
+	query     parser.QueryExpression
 	statement parser.Identifier
 	view      *View
 	index     int
@@ -187,8 +187,12 @@ func (c *Cursor) Open(ctx context.Context, scope *ReferenceScope, name parser.Id
 
 	var view *View
 	var err error
-	if c.query.SelectEntity != nil {
-		view, err = Select(ctx, scope, c.query)
+	if c.query != nil {
+		if selectQuery, ok := c.query.(parser.SelectQuery); ok {
+			view, err = Select(ctx, scope, selectQuery)
+		} else {
+			view, err = selectReturning(ctx, scope, c.query)
+		}
 	} else {
 		prepared, e := scope.Tx.PreparedStatements.Get(c.statement)
 		if e != nil {
@@ -197,11 +201,14 @@ func (c *Cursor) Open(ctx context.Context, scope *ReferenceScope, name parser.Id
 		if len(prepared.Statements) != 1 {
 			return NewInvalidCursorStatementError(c.statement)
 		}
-		stmt, ok := prepared.Statements[0].(parser.SelectQuery)
-		if !ok {
+		switch stmt := prepared.Statements[0].(type) {
+		case parser.SelectQuery:
+			view, err = Select(ContextForPreparedStatement(ctx, NewReplaceValues(values)), scope, stmt)
+		case parser.InsertQuery, parser.UpdateQuery, parser.ReplaceQuery, parser.DeleteQuery:
+			view, err = selectReturning(ContextForPreparedStatement(ctx, NewReplaceValues(values)), scope, stmt.(parser.QueryExpression))
+		default:
 			return NewInvalidCursorStatementError(c.statement)
 		}
-		view, err = Select(ContextForPreparedStatement(ctx, NewReplaceValues(values)), scope, stmt)
 	}
 	if err != nil {
 		return err
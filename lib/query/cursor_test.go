@@ -478,7 +478,7 @@ func TestCursorMap_Open(t *testing.T) {
 	})
 	_ = TestTx.PreparedStatements.Prepare(scope.Tx.Flags, parser.StatementPreparation{
 		Name:      parser.Identifier{Literal: "invalid_stmt"},
-		Statement: value.NewString("insert into table1 values (?, ?)"),
+		Statement: value.NewString("print ?"),
 	})
 	_ = TestTx.PreparedStatements.Prepare(scope.Tx.Flags, parser.StatementPreparation{
 		Name:      parser.Identifier{Literal: "invalid_stmt2"},
@@ -6,13 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parquet"
+	"github.com/mithrandie/csvq/lib/sql"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/color"
@@ -27,6 +34,8 @@ import (
 var EmptyResultSetError = errors.New("empty result set")
 var DataEmpty = errors.New("data empty")
 
+const DefaultSqlTableName = "result"
+
 func EncodeView(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions, palette *color.Palette) (string, error) {
 	switch options.Format {
 	case option.FIXED:
@@ -37,8 +46,22 @@ func EncodeView(ctx context.Context, fp io.Writer, view *View, options option.Ex
 		return "", encodeJsonLines(ctx, fp, view, options, palette)
 	case option.LTSV:
 		return "", encodeLTSV(ctx, fp, view, options)
+	case option.XLSX:
+		return "", encodeXlsx(ctx, fp, view, options)
+	case option.PARQUET:
+		return "", encodeParquet(ctx, fp, view)
+	case option.XML:
+		return "", encodeXml(ctx, fp, view, options)
+	case option.YAML:
+		return "", encodeYaml(ctx, fp, view, options)
+	case option.SQL:
+		return "", encodeSql(ctx, fp, view, options)
+	case option.HTML:
+		return "", encodeHtml(ctx, fp, view, options)
 	case option.GFM, option.ORG, option.BOX, option.TEXT:
 		return encodeText(ctx, fp, view, options, palette)
+	case option.VERTICAL:
+		return encodeVertical(ctx, fp, view, options, palette)
 	case option.TSV:
 		options.Delimiter = '\t'
 		fallthrough
@@ -420,6 +443,78 @@ func encodeText(ctx context.Context, fp io.Writer, view *View, options option.Ex
 	return "", nil
 }
 
+const verticalSeparator = "***************************"
+
+func encodeVertical(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions, palette *color.Palette) (string, error) {
+	if view.FieldLen() < 1 {
+		return "Empty Fields", EmptyResultSetError
+	}
+	if view.RecordLen() < 1 {
+		return "Empty RecordSet", EmptyResultSetError
+	}
+
+	lb := options.LineBreak.Value()
+	nameReplacer := strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+	names := make([]string, view.FieldLen())
+	widths := make([]int, view.FieldLen())
+	nameWidth := 0
+	for i := range view.Header {
+		names[i] = nameReplacer.Replace(view.Header[i].Column)
+		widths[i] = text.Width(names[i], options.EastAsianEncoding, options.CountDiacriticalSign, options.CountFormatCode)
+		if nameWidth < widths[i] {
+			nameWidth = widths[i]
+		}
+	}
+	indent := strings.Repeat(" ", nameWidth+2)
+
+	tw, err := text.GetTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return "", NewDataEncodingError(err.Error())
+	}
+	w := bufio.NewWriter(tw)
+
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return "", ConvertContextError(ctx.Err())
+		}
+
+		if 0 < i {
+			w.WriteString(lb)
+		}
+		w.WriteString(verticalSeparator + " " + strconv.Itoa(i+1) + ". row " + verticalSeparator)
+
+		for j := range view.RecordSet[i] {
+			w.WriteString(lb)
+			w.WriteString(strings.Repeat(" ", nameWidth-widths[j]))
+			w.WriteString(palette.Render(option.LableEffect, names[j]))
+			w.WriteString(": ")
+
+			str, effect, _ := ConvertFieldContents(view.RecordSet[i][j][0], true, options.ScientificNotation)
+			str = strings.ReplaceAll(strings.ReplaceAll(str, "\r\n", "\n"), "\r", "\n")
+			for k, line := range strings.Split(str, "\n") {
+				if 0 < k {
+					w.WriteString(lb)
+					w.WriteString(indent)
+				}
+				if 0 < len(line) {
+					w.WriteString(palette.Render(effect, line))
+				}
+			}
+		}
+	}
+
+	if err = w.Flush(); err != nil {
+		return "", NewSystemError(err.Error())
+	}
+	if c, ok := tw.(io.Closer); ok {
+		if err = c.Close(); err != nil {
+			return "", NewSystemError(err.Error())
+		}
+	}
+	return "", nil
+}
+
 func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
 	if view.RecordLen() < 1 {
 		return DataEmpty
@@ -454,6 +549,247 @@ func encodeLTSV(ctx context.Context, fp io.Writer, view *View, options option.Ex
 	return nil
 }
 
+func encodeXlsx(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
+	w, err := xlsx.NewWriter(fp, "")
+	if err != nil {
+		return NewSystemError(err.Error())
+	}
+
+	if !options.WithoutHeader {
+		hfields := make([]string, view.FieldLen())
+		for i := range view.Header {
+			hfields[i] = view.Header[i].Column
+		}
+		if err = w.WriteHeader(hfields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	fields := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			fields[j] = view.RecordSet[i][j][0]
+		}
+		if err = w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeParquet(ctx context.Context, fp io.Writer, view *View) error {
+	hfields := make([]string, view.FieldLen())
+	for i := range view.Header {
+		hfields[i] = view.Header[i].Column
+	}
+	w := parquet.NewWriter(fp, hfields)
+
+	fields := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			fields[j] = view.RecordSet[i][j][0]
+		}
+		if err := w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err := w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeSql(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
+	if options.WithoutHeader && view.RecordLen() < 1 {
+		return DataEmpty
+	}
+
+	tableName := options.SqlTableName
+	if len(tableName) < 1 {
+		if view.FileInfo != nil && 0 < len(view.FileInfo.Path) {
+			tableName = FormatTableName(view.FileInfo.Path)
+		} else {
+			tableName = DefaultSqlTableName
+		}
+	}
+
+	tw, err := text.GetTransformWriter(fp, options.Encoding)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+
+	hfields := make([]string, view.FieldLen())
+	for i := range view.Header {
+		hfields[i] = view.Header[i].Column
+	}
+	w := sql.NewWriter(tw, tableName, hfields, options.SqlDialect)
+	w.BatchSize = options.SqlBatchSize
+	w.LineBreak = options.LineBreak.Value()
+	w.WithoutCreateTable = options.WithoutHeader
+
+	fields := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			fields[j] = view.RecordSet[i][j][0]
+		}
+		if err = w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if c, ok := tw.(io.Closer); ok {
+		if err = c.Close(); err != nil {
+			return NewDataEncodingError(err.Error())
+		}
+	}
+	return nil
+}
+
+func encodeXml(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
+	header := view.Header.TableColumnNames()
+	w, err := xml.NewWriter(fp, options.XmlRootElement, options.XmlRowElement, header)
+	if err != nil {
+		return NewDataEncodingError(err.Error())
+	}
+	if options.XmlAttributes {
+		for _, name := range header {
+			if !option.IsXmlName(name) {
+				return NewDataEncodingError(fmt.Sprintf("column %q cannot be written as an attribute because it is not a valid xml name", name))
+			}
+		}
+	}
+	w.UseAttributes = options.XmlAttributes
+	w.LineBreak = options.LineBreak.Value()
+
+	fields := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			fields[j] = view.RecordSet[i][j][0]
+		}
+		if err = w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err = w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func encodeYaml(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
+	w := yaml.NewWriter(fp, view.Header.TableColumnNames())
+	w.LineBreak = options.LineBreak.Value()
+
+	fields := make([]value.Primary, view.FieldLen())
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for j := range view.RecordSet[i] {
+			fields[j] = view.RecordSet[i][j][0]
+		}
+		if err := w.Write(fields); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+	if err := w.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+const (
+	htmlTableStyle       = "border-collapse: collapse;"
+	htmlHeaderCellStyle  = "border: 1px solid #999999; padding: 4px 8px; background-color: #eeeeee;"
+	htmlCellStyle        = "border: 1px solid #999999; padding: 4px 8px;"
+	htmlNumberCellStyle  = htmlCellStyle + " text-align: right;"
+	htmlCenterCellStyle  = htmlCellStyle + " text-align: center;"
+	htmlDocumentTitle    = "csvq"
+	htmlDocumentEncoding = "UTF-8"
+)
+
+func encodeHtml(ctx context.Context, fp io.Writer, view *View, options option.ExportOptions) error {
+	lb := options.LineBreak.Value()
+	w := bufio.NewWriter(fp)
+
+	indent := ""
+	if options.HtmlDocument {
+		indent = "  "
+		w.WriteString("<!DOCTYPE html>" + lb)
+		w.WriteString("<html>" + lb)
+		w.WriteString("<head>" + lb)
+		w.WriteString("  <meta charset=\"" + htmlDocumentEncoding + "\">" + lb)
+		w.WriteString("  <title>" + htmlDocumentTitle + "</title>" + lb)
+		w.WriteString("</head>" + lb)
+		w.WriteString("<body>" + lb)
+	}
+
+	w.WriteString(indent + "<table style=\"" + htmlTableStyle + "\">")
+
+	if !options.WithoutHeader {
+		w.WriteString(lb + indent + "  <thead>" + lb + indent + "    <tr>")
+		for i := range view.Header {
+			w.WriteString(lb + indent + "      <th style=\"" + htmlHeaderCellStyle + "\">" + html.EscapeString(view.Header[i].Column) + "</th>")
+		}
+		w.WriteString(lb + indent + "    </tr>" + lb + indent + "  </thead>")
+	}
+
+	w.WriteString(lb + indent + "  <tbody>")
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		w.WriteString(lb + indent + "    <tr>")
+		for j := range view.RecordSet[i] {
+			str, _, align := ConvertFieldContents(view.RecordSet[i][j][0], false, options.ScientificNotation)
+			style := htmlCellStyle
+			switch align {
+			case text.RightAligned:
+				style = htmlNumberCellStyle
+			case text.Centering:
+				style = htmlCenterCellStyle
+			}
+			str = strings.ReplaceAll(html.EscapeString(str), "\n", "<br>")
+			w.WriteString(lb + indent + "      <td style=\"" + style + "\">" + str + "</td>")
+		}
+		w.WriteString(lb + indent + "    </tr>")
+	}
+	w.WriteString(lb + indent + "  </tbody>")
+	w.WriteString(lb + indent + "</table>")
+
+	if options.HtmlDocument {
+		w.WriteString(lb + "</body>" + lb + "</html>")
+	}
+
+	if err := w.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
 func jsonFloatFormat(useScientificNotation bool) txjson.FloatFormat {
 	if useScientificNotation {
 		return txjson.ENotationForLargeExponents
@@ -478,6 +814,10 @@ func ConvertFieldContents(val value.Primary, forTextTable bool, useScientificNot
 		s = value.Float64ToStr(v.Raw(), useScientificNotation)
 		effect = option.NumberEffect
 		align = text.RightAligned
+	case *value.Decimal:
+		s = v.String()
+		effect = option.NumberEffect
+		align = text.RightAligned
 	case *value.Boolean:
 		s = v.String()
 		effect = option.BooleanEffect
@@ -495,6 +835,15 @@ func ConvertFieldContents(val value.Primary, forTextTable bool, useScientificNot
 	case *value.Datetime:
 		s = v.Format(time.RFC3339Nano)
 		effect = option.DatetimeEffect
+	case *value.Interval:
+		s = v.Format()
+		effect = option.DatetimeEffect
+	case *value.Array:
+		s = v.Encode()
+		effect = option.StringEffect
+	case *value.Map:
+		s = v.Encode()
+		effect = option.StringEffect
 	case *value.Null:
 		if forTextTable {
 			s = "NULL"
@@ -3,10 +3,14 @@ package query
 import (
 	"bytes"
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/json"
@@ -24,9 +28,17 @@ var encodeViewTests = []struct {
 	WriteAsSingleLine       bool
 	WithoutHeader           bool
 	EncloseAll              bool
+	EastAsianEncoding       bool
 	JsonEscape              json.EscapeType
 	PrettyPrint             bool
 	ScientificNotation      bool
+	SqlDialect              option.SqlDialect
+	SqlTableName            string
+	SqlBatchSize            int
+	HtmlDocument            bool
+	XmlRootElement          string
+	XmlRowElement           string
+	XmlAttributes           bool
 	UseColor                bool
 	Result                  string
 	Error                   string
@@ -197,6 +209,66 @@ var encodeViewTests = []struct {
 			"│        │ \033[32mghijkl\033[0m │\n" +
 			"└────────┴────────┘",
 	},
+	{
+		Name: "Vertical",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "column2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.UNKNOWN), value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewString("abc\r\ndef\n"), value.NewNull()}),
+			},
+		},
+		Format: option.VERTICAL,
+		Result: "*************************** 1. row ***************************\n" +
+			"     c1: -1\n" +
+			"column2: UNKNOWN\n" +
+			"     c3: true\n" +
+			"*************************** 2. row ***************************\n" +
+			"     c1: 2.0123\n" +
+			"column2: abc\n" +
+			"         def\n" +
+			"         \n" +
+			"     c3: NULL",
+	},
+	{
+		Name: "Vertical with East Asian Encoding",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "列２"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("日本語"), value.NewString("ｱ")}),
+			},
+		},
+		Format:            option.VERTICAL,
+		LineBreak:         text.CRLF,
+		EastAsianEncoding: true,
+		Result: "*************************** 1. row ***************************\r\n" +
+			"  c1: 日本語\r\n" +
+			"列２: ｱ",
+	},
+	{
+		Name: "Vertical with colors",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("abc\ndef")}),
+			},
+		},
+		Format:   option.VERTICAL,
+		UseColor: true,
+		Result: "*************************** 1. row ***************************\n" +
+			"\033[34;1mc1\033[0m: \033[35m-1\033[0m\n" +
+			"\033[34;1mc2\033[0m: \033[32mabc\033[0m\n" +
+			"    \033[32mdef\033[0m",
+	},
+	{
+		Name: "Vertical Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: option.VERTICAL,
+		Error:  "empty result set",
+	},
 	{
 		Name: "Fixed-Length Format",
 		View: &View{
@@ -629,6 +701,260 @@ var encodeViewTests = []struct {
 		Format: option.LTSV,
 		Error:  "data encode error: unpermitted character in field-value: U+0009",
 	},
+	{
+		Name: "SQL",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4", "c5"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 123456000, GetTestLocation())), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("it's"), value.NewTernary(ternary.UNKNOWN), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewInteger(3), value.NewBoolean(true), value.NewNull(), value.NewNull(), value.NewNull()}),
+			},
+		},
+		Format:       option.SQL,
+		SqlTableName: "tbl",
+		SqlBatchSize: 2,
+		Result: "CREATE TABLE \"tbl\" (\n" +
+			"  \"c1\" DOUBLE PRECISION,\n" +
+			"  \"c2\" BOOLEAN,\n" +
+			"  \"c3\" VARCHAR(4),\n" +
+			"  \"c4\" TIMESTAMP,\n" +
+			"  \"c5\" VARCHAR(1)\n" +
+			");\n" +
+			"INSERT INTO \"tbl\" (\"c1\", \"c2\", \"c3\", \"c4\", \"c5\") VALUES\n" +
+			"  (-1, FALSE, 'abc', TIMESTAMP '2016-02-01 16:00:00.123456', '1'),\n" +
+			"  (2.0123, NULL, 'it''s', NULL, 'a');\n" +
+			"INSERT INTO \"tbl\" (\"c1\", \"c2\", \"c3\", \"c4\", \"c5\") VALUES\n" +
+			"  (3, TRUE, NULL, NULL, NULL);",
+	},
+	{
+		Name: "SQL MySQL Dialect",
+		View: &View{
+			Header: NewHeader("test", []string{"c`1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("a\\b"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+			},
+		},
+		Format:     option.SQL,
+		SqlDialect: option.MYSQL,
+		Result: "CREATE TABLE `result` (\n" +
+			"  `c``1` BIGINT,\n" +
+			"  `c2` TEXT,\n" +
+			"  `c3` DATETIME(6)\n" +
+			");\n" +
+			"INSERT INTO `result` (`c``1`, `c2`, `c3`) VALUES\n" +
+			"  (1, 'a\\\\b', '2016-02-01 16:00:00');",
+	},
+	{
+		Name: "SQL SQLite Dialect Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewBoolean(true), value.NewFloat(1.5)}),
+			},
+		},
+		Format:        option.SQL,
+		SqlDialect:    option.SQLITE,
+		SqlTableName:  "tbl",
+		WithoutHeader: true,
+		Result: "INSERT INTO \"tbl\" (\"c1\", \"c2\") VALUES\n" +
+			"  (1, 1.5);",
+	},
+	{
+		Name: "SQL Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format:       option.SQL,
+		SqlDialect:   option.POSTGRESQL,
+		SqlTableName: "tbl",
+		Result: "CREATE TABLE \"tbl\" (\n" +
+			"  \"c1\" TEXT\n" +
+			");",
+	},
+	{
+		Name: "XML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("a<b&c")}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("")}),
+			},
+		},
+		Format: option.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<records>\n" +
+			"  <record>\n" +
+			"    <c1>-1</c1>\n" +
+			"    <c2>false</c2>\n" +
+			"    <c3>a&lt;b&amp;c</c3>\n" +
+			"  </record>\n" +
+			"  <record>\n" +
+			"    <c1>2.0123</c1>\n" +
+			"    <c3></c3>\n" +
+			"  </record>\n" +
+			"</records>",
+	},
+	{
+		Name: "XML Attributes",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("say \"hi\"")}),
+				NewRecord([]value.Primary{value.NewNull(), value.NewString("abc")}),
+			},
+		},
+		Format:         option.XML,
+		XmlRootElement: "feed",
+		XmlRowElement:  "item",
+		XmlAttributes:  true,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<feed>\n" +
+			"  <item c1=\"1\" c2=\"say &#34;hi&#34;\"/>\n" +
+			"  <item c2=\"abc\"/>\n" +
+			"</feed>",
+	},
+	{
+		Name: "XML Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: option.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<records>\n" +
+			"</records>",
+	},
+	{
+		Name: "XML Invalid Column Name",
+		View: &View{
+			Header: NewHeader("test", []string{"c 1", "c2", "1<c\""}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)}),
+			},
+		},
+		Format: option.XML,
+		Result: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<records>\n" +
+			"  <record>\n" +
+			"    <field name=\"c 1\">1</field>\n" +
+			"    <c2>2</c2>\n" +
+			"    <field name=\"1&lt;c&#34;\">3</field>\n" +
+			"  </record>\n" +
+			"</records>",
+	},
+	{
+		Name: "XML Attributes Invalid Column Name Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c 2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+			},
+		},
+		Format:        option.XML,
+		XmlAttributes: true,
+		Error:         "data encode error: column \"c 2\" cannot be written as an attribute because it is not a valid xml name",
+	},
+	{
+		Name: "XML Invalid Row Element Name Error",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Format:        option.XML,
+		XmlRowElement: "row 1",
+		Error:         "data encode error: \"row 1\" is not a valid xml name",
+	},
+	{
+		Name: "YAML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4", "c5"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, time.UTC)), value.NewArray([]value.Primary{value.NewInteger(1), value.NewString("a, b")})}),
+				NewRecord([]value.Primary{value.NewFloat(2), value.NewNull(), value.NewString("true"), value.NewTernary(ternary.UNKNOWN), value.NewString("line1\nline2")}),
+			},
+		},
+		Format: option.YAML,
+		Result: "- c1: -1\n" +
+			"  c2: false\n" +
+			"  c3: abc\n" +
+			"  c4: 2016-02-01T16:00:00Z\n" +
+			"  c5: [1, \"a, b\"]\n" +
+			"- c1: 2.0\n" +
+			"  c2: null\n" +
+			"  c3: \"true\"\n" +
+			"  c4: null\n" +
+			"  c5: \"line1\\nline2\"",
+	},
+	{
+		Name: "YAML Empty RecordSet",
+		View: &View{
+			Header:    NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{},
+		},
+		Format: option.YAML,
+		Result: "[]",
+	},
+	{
+		Name: "HTML",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c<2>"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewString("a&b\nc")}),
+				NewRecord([]value.Primary{value.NewBoolean(true), value.NewNull()}),
+			},
+		},
+		Format: option.HTML,
+		Result: "<table style=\"border-collapse: collapse;\">\n" +
+			"  <thead>\n" +
+			"    <tr>\n" +
+			"      <th style=\"border: 1px solid #999999; padding: 4px 8px; background-color: #eeeeee;\">c1</th>\n" +
+			"      <th style=\"border: 1px solid #999999; padding: 4px 8px; background-color: #eeeeee;\">c&lt;2&gt;</th>\n" +
+			"    </tr>\n" +
+			"  </thead>\n" +
+			"  <tbody>\n" +
+			"    <tr>\n" +
+			"      <td style=\"border: 1px solid #999999; padding: 4px 8px; text-align: right;\">-1</td>\n" +
+			"      <td style=\"border: 1px solid #999999; padding: 4px 8px;\">a&amp;b<br>c</td>\n" +
+			"    </tr>\n" +
+			"    <tr>\n" +
+			"      <td style=\"border: 1px solid #999999; padding: 4px 8px; text-align: center;\">true</td>\n" +
+			"      <td style=\"border: 1px solid #999999; padding: 4px 8px;\"></td>\n" +
+			"    </tr>\n" +
+			"  </tbody>\n" +
+			"</table>",
+	},
+	{
+		Name: "HTML Document Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("abc")}),
+			},
+		},
+		Format:        option.HTML,
+		HtmlDocument:  true,
+		WithoutHeader: true,
+		Result: "<!DOCTYPE html>\n" +
+			"<html>\n" +
+			"<head>\n" +
+			"  <meta charset=\"UTF-8\">\n" +
+			"  <title>csvq</title>\n" +
+			"</head>\n" +
+			"<body>\n" +
+			"  <table style=\"border-collapse: collapse;\">\n" +
+			"    <tbody>\n" +
+			"      <tr>\n" +
+			"        <td style=\"border: 1px solid #999999; padding: 4px 8px;\">abc</td>\n" +
+			"      </tr>\n" +
+			"    </tbody>\n" +
+			"  </table>\n" +
+			"</body>\n" +
+			"</html>",
+	},
 	{
 		Name: "CSV Encode Character Code",
 		View: &View{
@@ -667,6 +993,15 @@ func TestEncodeView(t *testing.T) {
 		if v.WriteDelimiter == 0 {
 			v.WriteDelimiter = ','
 		}
+		if v.SqlBatchSize == 0 {
+			v.SqlBatchSize = option.DefaultSqlBatchSize
+		}
+		if v.XmlRootElement == "" {
+			v.XmlRootElement = option.DefaultXmlRootElement
+		}
+		if v.XmlRowElement == "" {
+			v.XmlRowElement = option.DefaultXmlRowElement
+		}
 		TestTx.UseColor(v.UseColor)
 
 		options := TestTx.Flags.ExportOptions.Copy()
@@ -680,7 +1015,15 @@ func TestEncodeView(t *testing.T) {
 		options.JsonEscape = v.JsonEscape
 		options.PrettyPrint = v.PrettyPrint
 		options.ScientificNotation = v.ScientificNotation
+		options.EastAsianEncoding = v.EastAsianEncoding
 		options.SingleLine = v.WriteAsSingleLine
+		options.SqlDialect = v.SqlDialect
+		options.SqlTableName = v.SqlTableName
+		options.SqlBatchSize = v.SqlBatchSize
+		options.HtmlDocument = v.HtmlDocument
+		options.XmlRootElement = v.XmlRootElement
+		options.XmlRowElement = v.XmlRowElement
+		options.XmlAttributes = v.XmlAttributes
 
 		buf.Reset()
 		_, err := EncodeView(ctx, buf, v.View, options, TestTx.Palette)
@@ -703,3 +1046,105 @@ func TestEncodeView(t *testing.T) {
 		}
 	}
 }
+
+var encodeViewXlsxTests = []struct {
+	Name          string
+	View          *View
+	WithoutHeader bool
+	Header        []string
+	Records       [][]value.Primary
+}{
+	{
+		Name: "XLSX",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+				NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("<def>"), value.NewTernary(ternary.UNKNOWN)}),
+			},
+		},
+		Header: []string{"c1", "c2", "c3", "c4"},
+		Records: [][]value.Primary{
+			{value.NewInteger(-1), value.NewBoolean(false), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))},
+			{value.NewFloat(2.0123), value.NewNull(), value.NewString("<def>"), value.NewNull()},
+		},
+	},
+	{
+		Name: "XLSX Without Header",
+		View: &View{
+			Header: NewHeader("test", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1), value.NewString("abc")}),
+			},
+		},
+		WithoutHeader: true,
+		Header:        []string{"1", "abc"},
+		Records:       [][]value.Primary{},
+	},
+}
+
+func TestEncodeView_Xlsx(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := context.Background()
+
+	for _, v := range encodeViewXlsxTests {
+		options := TestTx.Flags.ExportOptions.Copy()
+		options.Format = option.XLSX
+		options.WithoutHeader = v.WithoutHeader
+
+		buf.Reset()
+		if _, err := EncodeView(ctx, buf, v.View, options, TestTx.Palette); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		header, records, err := xlsx.LoadTable(bytes.NewReader(buf.Bytes()), int64(buf.Len()), "", xlsx.Range{}, false, false, GetTestLocation())
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %s, want %s", v.Name, records, v.Records)
+		}
+	}
+}
+
+func TestEncodeView_Parquet(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := context.Background()
+
+	view := &View{
+		Header: NewHeader("test", []string{"c1", "c2", "c3", "c4"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewInteger(-1), value.NewTernary(ternary.FALSE), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))}),
+			NewRecord([]value.Primary{value.NewFloat(2.0123), value.NewNull(), value.NewString("<def>"), value.NewTernary(ternary.UNKNOWN)}),
+		},
+	}
+	expectHeader := []string{"c1", "c2", "c3", "c4"}
+	expectRecords := [][]value.Primary{
+		{value.NewFloat(-1), value.NewBoolean(false), value.NewString("abc"), value.NewDatetime(time.Date(2016, 2, 1, 16, 0, 0, 0, GetTestLocation()))},
+		{value.NewFloat(2.0123), value.NewNull(), value.NewString("<def>"), value.NewNull()},
+	}
+
+	options := TestTx.Flags.ExportOptions.Copy()
+	options.Format = option.PARQUET
+	options.WithoutHeader = true
+
+	if _, err := EncodeView(ctx, buf, view, options, TestTx.Palette); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	header, records, err := parquet.LoadTable(bytes.NewReader(buf.Bytes()), int64(buf.Len()), nil, GetTestLocation())
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(header, expectHeader) {
+		t.Errorf("header = %q, want %q", header, expectHeader)
+	}
+	if !reflect.DeepEqual(records, expectRecords) {
+		t.Errorf("records = %s, want %s", records, expectRecords)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -37,6 +38,7 @@ const (
 	ErrMsgDuplicateFieldName                   = "field name %s is a duplicate"
 	ErrMsgNotGroupingRecords                   = "function %s cannot aggregate not grouping records"
 	ErrMsgNotAllowedAnalyticFunction           = "analytic function %s is only available in select clause or order by clause"
+	ErrMsgTooManyGroupingKeys                  = "grouping sets can have at most %d group keys"
 	ErrMsgUndeclaredVariable                   = "variable %s is undeclared"
 	ErrMsgVariableRedeclared                   = "variable %s is redeclared"
 	ErrMsgUndefinedConstant                    = "constant %s is not defined"
@@ -54,8 +56,14 @@ const (
 	ErrMsgJsonQueryTooManyRecords              = "json query returns too many records, should return only one record"
 	ErrMsgLoadJson                             = "json loading error: %s"
 	ErrMsgJsonLinesStructure                   = "json lines must be an array of objects"
+	ErrMsgLoadXlsx                             = "xlsx loading error: %s"
+	ErrMsgLoadParquet                          = "parquet loading error: %s"
+	ErrMsgLoadXml                              = "xml loading error: %s"
+	ErrMsgLoadYaml                             = "yaml loading error: %s"
 	ErrMsgIncorrectLateralUsage                = "LATERAL cannot to be used in a RIGHT or FULL outer join"
 	ErrMsgEmptyInlineTable                     = "inline table is empty"
+	ErrMsgPivotAggregateRequired               = "PIVOT value %s must contain an aggregate function"
+	ErrMsgUnnestNotArray                       = "UNNEST value %s is not an array"
 	ErrMsgInvalidTableObject                   = "invalid table object: %s"
 	ErrMsgTableObjectInvalidDelimiter          = "invalid delimiter: %s"
 	ErrMsgTableObjectInvalidDelimiterPositions = "invalid delimiter positions: %s"
@@ -77,8 +85,12 @@ const (
 	ErrMsgFileNotExist                         = "file %s does not exist"
 	ErrMsgFileAlreadyExist                     = "file %s already exists"
 	ErrMsgFileUnableToRead                     = "file %s is unable to be read"
+	ErrMsgArchiveMemberNotExist                = "file %s does not exist in archive %s"
+	ErrMsgDirectoryNotExist                    = "directory %s does not exist"
+	ErrMsgNoMatchingFile                       = "no file matches %s"
 	ErrMsgFileLockTimeout                      = "file %s: lock wait timeout period exceeded"
 	ErrMsgFileNameAmbiguous                    = "filename %s is ambiguous"
+	ErrMsgInvalidFilePattern                   = "invalid file pattern: %s"
 	ErrMsgDataParsing                          = "data parse error in %s: %s"
 	ErrMsgDataEncoding                         = "data encode error: %s"
 	ErrMsgTableFieldLength                     = "select query should return exactly %s for table %s"
@@ -90,6 +102,9 @@ const (
 	ErrMsgStdinEmpty                           = "STDIN is empty"
 	ErrMsgInlineTableCannotBeUpdated           = "inline table cannot be updated"
 	ErrMsgAliasMustBeSpecifiedForUpdate        = "alias to table identification function or URL must be specified for update"
+	ErrMsgArchivedFileCannotBeUpdated          = "file %s in archive %s cannot be updated"
+	ErrMsgFormatCannotBeUpdated                = "%s file %s cannot be updated"
+	ErrMsgFileSetCannotBeUpdated               = "multiple files %s cannot be updated"
 	ErrMsgRowValueLengthInComparison           = "row value should contain exactly %s"
 	ErrMsgFieldLengthInComparison              = "select query should return exactly %s"
 	ErrMsgInvalidLimitPercentage               = "limit percentage %s is not a float value"
@@ -135,7 +150,16 @@ const (
 	ErrMsgStatementReplaceValueNotSpecified    = "replace value for %s is not specified"
 	ErrMsgSelectIntoQueryFieldLengthNotMatch   = "select into query should return exactly %s"
 	ErrMsgSelectIntoQueryTooManyRecords        = "select into query returns too many records, should return only one record"
+	ErrMsgMergeMultipleMatches                 = "record in %s is matched by more than one record of the merge source"
+	ErrMsgReturningClauseRequired              = "RETURNING clause is required to use the query as a result set"
+	ErrMsgReturningMultipleTables              = "RETURNING clause cannot be used to modify multiple tables"
+	ErrMsgInvalidColumnType                    = "column type %s does not exist"
+	ErrMsgColumnTypeConversion                 = "value %s in row %d cannot be converted to %s for column %s"
+	ErrMsgColumnNotNull                        = "column %s cannot be null in row %d"
+	ErrMsgSchemaParsing                        = "schema parse error in %s: %s"
 	ErrMsgIntegerDevidedByZero                 = "integer divided by zero"
+	ErrMsgDecimalDevidedByZero                 = "decimal divided by zero"
+	ErrMsgIntervalDevidedByZero                = "interval divided by zero"
 )
 
 type Error interface {
@@ -538,6 +562,16 @@ func NewNotAllowedAnalyticFunctionError(expr parser.AnalyticFunction) error {
 	}
 }
 
+type TooManyGroupingKeysError struct {
+	*BaseError
+}
+
+func NewTooManyGroupingKeysError(expr parser.QueryExpression) error {
+	return &TooManyGroupingKeysError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgTooManyGroupingKeys, MaxGroupingKeys), ReturnCodeApplicationError, ErrorTooManyGroupingKeys),
+	}
+}
+
 type UndeclaredVariableError struct {
 	*BaseError
 }
@@ -730,6 +764,46 @@ func NewJsonLinesStructureError(expr parser.QueryExpression) error {
 	}
 }
 
+type LoadXlsxError struct {
+	*BaseError
+}
+
+func NewLoadXlsxError(expr parser.QueryExpression, message string) error {
+	return &LoadXlsxError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadXlsx, message), ReturnCodeApplicationError, ErrorLoadXlsx),
+	}
+}
+
+type LoadParquetError struct {
+	*BaseError
+}
+
+func NewLoadParquetError(expr parser.QueryExpression, message string) error {
+	return &LoadParquetError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadParquet, message), ReturnCodeApplicationError, ErrorLoadParquet),
+	}
+}
+
+type LoadXmlError struct {
+	*BaseError
+}
+
+func NewLoadXmlError(expr parser.QueryExpression, message string) error {
+	return &LoadXmlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadXml, message), ReturnCodeApplicationError, ErrorLoadXml),
+	}
+}
+
+type LoadYamlError struct {
+	*BaseError
+}
+
+func NewLoadYamlError(expr parser.QueryExpression, message string) error {
+	return &LoadYamlError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgLoadYaml, message), ReturnCodeApplicationError, ErrorLoadYaml),
+	}
+}
+
 type IncorrectLateralUsageError struct {
 	*BaseError
 }
@@ -750,6 +824,26 @@ func NewEmptyInlineTableError(expr parser.FormatSpecifiedFunction) error {
 	}
 }
 
+type PivotAggregateRequiredError struct {
+	*BaseError
+}
+
+func NewPivotAggregateRequiredError(expr parser.QueryExpression) error {
+	return &PivotAggregateRequiredError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgPivotAggregateRequired, expr), ReturnCodeApplicationError, ErrorPivotAggregateRequired),
+	}
+}
+
+type UnnestNotArrayError struct {
+	*BaseError
+}
+
+func NewUnnestNotArrayError(expr parser.Unnest) error {
+	return &UnnestNotArrayError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgUnnestNotArray, expr.Expr), ReturnCodeApplicationError, ErrorUnnestNotArray),
+	}
+}
+
 type InvalidTableObjectError struct {
 	*BaseError
 }
@@ -962,6 +1056,36 @@ func NewFileUnableToReadError(file parser.Identifier) error {
 	}
 }
 
+type ArchiveMemberNotExistError struct {
+	*BaseError
+}
+
+func NewArchiveMemberNotExistError(expr parser.QueryExpression, member string, archivePath string) error {
+	return &ArchiveMemberNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgArchiveMemberNotExist, member, archivePath), ReturnCodeIOError, ErrorArchiveMemberNotExist),
+	}
+}
+
+type DirectoryNotExistError struct {
+	*BaseError
+}
+
+func NewDirectoryNotExistError(expr parser.QueryExpression, dir string) error {
+	return &DirectoryNotExistError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgDirectoryNotExist, dir), ReturnCodeIOError, ErrorDirectoryNotExist),
+	}
+}
+
+type NoMatchingFileError struct {
+	*BaseError
+}
+
+func NewNoMatchingFileError(expr parser.QueryExpression, pattern string) error {
+	return &NoMatchingFileError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgNoMatchingFile, pattern), ReturnCodeIOError, ErrorNoMatchingFile),
+	}
+}
+
 type FileLockTimeoutError struct {
 	*BaseError
 }
@@ -982,6 +1106,16 @@ func NewFileNameAmbiguousError(file parser.Identifier) error {
 	}
 }
 
+type InvalidFilePatternError struct {
+	*BaseError
+}
+
+func NewInvalidFilePatternError(expr parser.QueryExpression, pattern string) error {
+	return &InvalidFilePatternError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgInvalidFilePattern, pattern), ReturnCodeApplicationError, ErrorInvalidFilePattern),
+	}
+}
+
 type DataParsingError struct {
 	*BaseError
 }
@@ -1096,6 +1230,36 @@ func NewAliasMustBeSpecifiedForUpdateError(expr parser.QueryExpression) error {
 	}
 }
 
+type ArchivedFileCannotBeUpdatedError struct {
+	*BaseError
+}
+
+func NewArchivedFileCannotBeUpdatedError(expr parser.QueryExpression, member string, archivePath string) error {
+	return &ArchivedFileCannotBeUpdatedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgArchivedFileCannotBeUpdated, member, archivePath), ReturnCodeApplicationError, ErrorArchivedFileCannotBeUpdated),
+	}
+}
+
+type FormatCannotBeUpdatedError struct {
+	*BaseError
+}
+
+func NewFormatCannotBeUpdatedError(expr parser.QueryExpression, format option.Format) error {
+	return &FormatCannotBeUpdatedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgFormatCannotBeUpdated, strings.ToLower(format.String()), expr), ReturnCodeApplicationError, ErrorFormatCannotBeUpdated),
+	}
+}
+
+type FileSetCannotBeUpdatedError struct {
+	*BaseError
+}
+
+func NewFileSetCannotBeUpdatedError(expr parser.QueryExpression, pattern string) error {
+	return &FileSetCannotBeUpdatedError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgFileSetCannotBeUpdated, pattern), ReturnCodeApplicationError, ErrorFileSetCannotBeUpdated),
+	}
+}
+
 type RowValueLengthInComparisonError struct {
 	*BaseError
 }
@@ -1558,6 +1722,76 @@ func NewSelectIntoQueryTooManyRecordsError(query parser.SelectQuery) error {
 	}
 }
 
+type MergeMultipleMatchesError struct {
+	*BaseError
+}
+
+func NewMergeMultipleMatchesError(table parser.QueryExpression) error {
+	return &MergeMultipleMatchesError{
+		NewBaseError(table, fmt.Sprintf(ErrMsgMergeMultipleMatches, table), ReturnCodeApplicationError, ErrorMergeMultipleMatches),
+	}
+}
+
+type ReturningClauseRequiredError struct {
+	*BaseError
+}
+
+func NewReturningClauseRequiredError(expr parser.Expression) error {
+	return &ReturningClauseRequiredError{
+		NewBaseError(expr, ErrMsgReturningClauseRequired, ReturnCodeApplicationError, ErrorReturningClauseRequired),
+	}
+}
+
+type ReturningMultipleTablesError struct {
+	*BaseError
+}
+
+func NewReturningMultipleTablesError(clause parser.ReturningClause) error {
+	return &ReturningMultipleTablesError{
+		NewBaseError(clause, ErrMsgReturningMultipleTables, ReturnCodeApplicationError, ErrorReturningMultipleTables),
+	}
+}
+
+type InvalidColumnTypeError struct {
+	*BaseError
+}
+
+func NewInvalidColumnTypeError(columnType parser.Identifier) error {
+	return &InvalidColumnTypeError{
+		NewBaseError(columnType, fmt.Sprintf(ErrMsgInvalidColumnType, columnType.Literal), ReturnCodeApplicationError, ErrorInvalidColumnType),
+	}
+}
+
+type ColumnTypeConversionError struct {
+	*BaseError
+}
+
+func NewColumnTypeConversionError(expr parser.Expression, p value.Primary, field HeaderField, row int) error {
+	return &ColumnTypeConversionError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnTypeConversion, p, row, field.Type, field.Column), ReturnCodeApplicationError, ErrorColumnTypeConversion),
+	}
+}
+
+type ColumnNotNullError struct {
+	*BaseError
+}
+
+func NewColumnNotNullError(expr parser.Expression, field HeaderField, row int) error {
+	return &ColumnNotNullError{
+		NewBaseError(expr, fmt.Sprintf(ErrMsgColumnNotNull, field.Column, row), ReturnCodeApplicationError, ErrorColumnNotNull),
+	}
+}
+
+type SchemaParsingError struct {
+	*BaseError
+}
+
+func NewSchemaParsingError(file parser.QueryExpression, filepath string, message string) error {
+	return &SchemaParsingError{
+		NewBaseError(file, fmt.Sprintf(ErrMsgSchemaParsing, filepath, message), ReturnCodeApplicationError, ErrorSchemaParsing),
+	}
+}
+
 type IntegerDevidedByZeroError struct {
 	*BaseError
 }
@@ -1568,6 +1802,26 @@ func NewIntegerDevidedByZeroError(expr parser.Arithmetic) error {
 	}
 }
 
+type DecimalDevidedByZeroError struct {
+	*BaseError
+}
+
+func NewDecimalDevidedByZeroError(expr parser.Arithmetic) error {
+	return &DecimalDevidedByZeroError{
+		NewBaseError(expr, ErrMsgDecimalDevidedByZero, ReturnCodeApplicationError, ErrorDecimalDevidedByZero),
+	}
+}
+
+type IntervalDevidedByZeroError struct {
+	*BaseError
+}
+
+func NewIntervalDevidedByZeroError(expr parser.Arithmetic) error {
+	return &IntervalDevidedByZeroError{
+		NewBaseError(expr, ErrMsgIntervalDevidedByZero, ReturnCodeApplicationError, ErrorIntervalDevidedByZero),
+	}
+}
+
 func searchSelectClause(query parser.SelectQuery) parser.SelectClause {
 	return searchSelectClauseInSelectEntity(query.SelectEntity)
 }
@@ -23,6 +23,7 @@ const (
 	ErrorDuplicateFieldName                   = 10104
 	ErrorNotGroupingRecords                   = 10201
 	ErrorNotAllowedAnalyticFunction           = 10202
+	ErrorTooManyGroupingKeys                  = 10203
 	ErrorUndeclaredVariable                   = 10301
 	ErrorVariableRedeclared                   = 10302
 	ErrorUndefinedConstant                    = 10321
@@ -40,9 +41,15 @@ const (
 	ErrorLoadJson                             = 10702
 	ErrorEmptyJsonQuery                       = 10703 // Not in use after v1.14.0
 	ErrorJsonLinesStructure                   = 10704
+	ErrorLoadXlsx                             = 10705
+	ErrorLoadParquet                          = 10706
+	ErrorLoadXml                              = 10707
+	ErrorLoadYaml                             = 10708
 	ErrorEmptyJsonTable                       = 10801 // Not in use after v1.14.0
 	ErrorIncorrectLateralUsage                = 10802
 	ErrorEmptyInlineTable                     = 10803
+	ErrorPivotAggregateRequired               = 10804
+	ErrorUnnestNotArray                       = 10805
 	ErrorInvalidTableObject                   = 10901
 	ErrorTableObjectInvalidDelimiter          = 10902
 	ErrorTableObjectInvalidDelimiterPositions = 10903
@@ -62,6 +69,7 @@ const (
 	ErrorUndefinedInlineTable                 = 11102
 	ErrorInlineTableFieldLength               = 11103
 	ErrorFileNameAmbiguous                    = 11201
+	ErrorInvalidFilePattern                   = 11202
 	ErrorDataParsing                          = 11301
 	ErrorDataEncoding                         = 11351
 	ErrorTableFieldLength                     = 11401
@@ -73,6 +81,9 @@ const (
 	ErrorStdinEmpty                           = 11603
 	ErrorInlineTableCannotBeUpdated           = 11604
 	ErrorAliasMustBeSpecifiedForUpdate        = 11605
+	ErrorArchivedFileCannotBeUpdated          = 11606
+	ErrorFormatCannotBeUpdated                = 11607
+	ErrorFileSetCannotBeUpdated               = 11608
 	ErrorRowValueLengthInComparison           = 11701
 	ErrorFieldLengthInComparison              = 11702
 	ErrorInvalidLimitPercentage               = 11801
@@ -116,7 +127,16 @@ const (
 	ErrorReplaceKeyNotSet                     = 13901
 	ErrorSelectIntoQueryFieldLengthNotMatch   = 14001
 	ErrorSelectIntoQueryTooManyRecords        = 14002
+	ErrorMergeMultipleMatches                 = 14101
+	ErrorReturningClauseRequired              = 14201
+	ErrorReturningMultipleTables              = 14202
+	ErrorInvalidColumnType                    = 14301
+	ErrorColumnTypeConversion                 = 14302
+	ErrorColumnNotNull                        = 14303
+	ErrorSchemaParsing                        = 14304
 	ErrorIntegerDevidedByZero                 = 30000
+	ErrorDecimalDevidedByZero                 = 30001
+	ErrorIntervalDevidedByZero                = 30002
 
 	//Incorrect Command Usage
 	ErrorIncorrectCommandUsage = 90020
@@ -133,13 +153,16 @@ const (
 	ErrorFileLockTimeout = 90082
 
 	//IO Error
-	ErrorIO               = 90160
-	ErrorCommit           = 90171
-	ErrorRollback         = 90172
-	ErrorInvalidPath      = 90180
-	ErrorFileNotExist     = 90181
-	ErrorFileAlreadyExist = 90182
-	ErrorFileUnableToRead = 90183
+	ErrorIO                    = 90160
+	ErrorCommit                = 90171
+	ErrorRollback              = 90172
+	ErrorInvalidPath           = 90180
+	ErrorFileNotExist          = 90181
+	ErrorFileAlreadyExist      = 90182
+	ErrorFileUnableToRead      = 90183
+	ErrorArchiveMemberNotExist = 90184
+	ErrorDirectoryNotExist     = 90185
+	ErrorNoMatchingFile        = 90186
 
 	//System Error
 	ErrorSystemError      = 90320
@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	"context"
+	"math/big"
 	"os"
 	"strings"
 
@@ -57,6 +58,8 @@ func Evaluate(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpre
 		val, err = evalSubqueryForValue(ctx, scope, expr.(parser.Subquery))
 	case parser.Function:
 		val, err = evalFunction(ctx, scope, expr.(parser.Function))
+	case parser.ArrayValue:
+		val, err = evalArrayValue(ctx, scope, expr.(parser.ArrayValue))
 	case parser.AggregateFunction:
 		val, err = evalAggregateFunction(ctx, scope, expr.(parser.AggregateFunction))
 	case parser.ListFunction:
@@ -120,6 +123,7 @@ func evaluateSequentialRoutine(ctx context.Context, scope *ReferenceScope, view
 			Header:    view.Header,
 			RecordSet: view.RecordSet[start:end],
 			isGrouped: view.isGrouped,
+			grouping:  view.grouping,
 		},
 	)
 
@@ -210,8 +214,14 @@ func evalArithmetic(ctx context.Context, scope *ReferenceScope, expr parser.Arit
 		return nil, err
 	}
 
-	ret, err := Calculate(lhs, rhs, expr.Operator.Token)
+	ret, err := Calculate(lhs, rhs, expr.Operator.Token, scope.Tx.Flags)
 	if err != nil {
+		switch err {
+		case errDecimalDevidedByZero:
+			return nil, NewDecimalDevidedByZeroError(expr)
+		case errIntervalDevidedByZero:
+			return nil, NewIntervalDevidedByZeroError(expr)
+		}
 		return nil, NewIntegerDevidedByZeroError(expr)
 	}
 
@@ -224,6 +234,20 @@ func evalUnaryArithmetic(ctx context.Context, scope *ReferenceScope, expr parser
 		return nil, err
 	}
 
+	if pd, ok := ope.(*value.Decimal); ok {
+		if expr.Operator.Token == '-' {
+			return value.NewDecimal(new(big.Int).Neg(pd.Unscaled()), pd.Scale()), nil
+		}
+		return pd, nil
+	}
+
+	if iv, ok := ope.(*value.Interval); ok {
+		if expr.Operator.Token == '-' {
+			return value.NewInterval(-iv.Months(), -iv.Days(), -iv.Nanoseconds()), nil
+		}
+		return iv, nil
+	}
+
 	if pi := value.ToIntegerStrictly(ope); !value.IsNull(pi) {
 		val := pi.(*value.Integer).Raw()
 		value.Discard(pi)
@@ -555,7 +579,14 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	var ok bool
 	var err error
 
-	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "JSON_OBJECT" {
+	if _, ok := AggregateFunctions[name]; ok {
+		return evalAggregateFunction(ctx, scope, parser.AggregateFunction{BaseExpr: expr.BaseExpr, Name: expr.Name, Args: expr.Args})
+	}
+	if _, ok := OrderedSetAggregateFunctions[name]; ok {
+		return evalAggregateFunction(ctx, scope, parser.AggregateFunction{BaseExpr: expr.BaseExpr, Name: expr.Name, Args: expr.Args})
+	}
+
+	if fn, ok = Functions[name]; !ok && name != "CALL" && name != "NOW" && name != "ERROR_CODE" && name != "ERROR_MESSAGE" && name != "JSON_OBJECT" && name != "GROUPING" {
 		udfn, err = scope.GetFunction(expr, name)
 		if err != nil {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
@@ -577,6 +608,9 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	if name == "JSON_OBJECT" {
 		return JsonObject(ctx, scope, expr)
 	}
+	if name == "GROUPING" {
+		return Grouping(scope, expr)
+	}
 
 	args := make([]value.Primary, len(expr.Args))
 	for i, v := range expr.Args {
@@ -591,6 +625,10 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 		return Call(ctx, expr, args)
 	} else if name == "NOW" {
 		return Now(scope, expr, args)
+	} else if name == "ERROR_CODE" {
+		return ErrorCode(scope, expr, args)
+	} else if name == "ERROR_MESSAGE" {
+		return ErrorMessage(scope, expr, args)
 	}
 
 	if fn != nil {
@@ -599,46 +637,86 @@ func evalFunction(ctx context.Context, scope *ReferenceScope, expr parser.Functi
 	return udfn.Execute(ctx, scope, args)
 }
 
+func evalArrayValue(ctx context.Context, scope *ReferenceScope, expr parser.ArrayValue) (value.Primary, error) {
+	values := make([]value.Primary, len(expr.Values))
+	for i, v := range expr.Values {
+		p, err := Evaluate(ctx, scope, v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = p
+	}
+	return value.NewArray(values), nil
+}
+
 func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr parser.AggregateFunction) (value.Primary, error) {
 	var aggfn func([]value.Primary, *option.Flags) value.Primary
+	var osfn OrderedSetAggregateFunction
 	var udfn *UserDefinedFunction
 	var err error
 
 	uname := strings.ToUpper(expr.Name)
 	if fn, ok := AggregateFunctions[uname]; ok {
 		aggfn = fn
+	} else if fn, ok := OrderedSetAggregateFunctions[uname]; ok {
+		osfn = fn
 	} else {
 		if udfn, err = scope.GetFunction(expr, uname); err != nil || !udfn.IsAggregate {
 			return nil, NewFunctionNotExistError(expr, expr.Name)
 		}
 	}
 
-	if aggfn == nil {
-		if err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1); err != nil {
+	var argsExprs []parser.QueryExpression
+	if 0 < len(expr.Args) {
+		argsExprs = expr.Args[1:]
+	}
+
+	if udfn != nil {
+		if expr.OrderBy == nil || 0 < len(expr.Args) {
+			err = udfn.CheckArgsLen(expr, expr.Name, len(expr.Args)-1)
+		} else {
+			err = udfn.CheckArgsLen(expr, expr.Name, 0)
+		}
+		if err != nil {
 			return nil, err
 		}
-	} else {
-		if len(expr.Args) != 1 {
+	} else if aggfn != nil {
+		if 1 < len(expr.Args) {
 			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
 		}
 	}
 
+	listExpr, err := checkArgsForAggregateFunction(expr, osfn != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fraction float64
+	if osfn != nil {
+		if fraction, err = evalFractionForOrderedSetAggregateFunction(ctx, scope, expr); err != nil {
+			return nil, err
+		}
+	}
+
 	var list []value.Primary
 	if 0 < len(scope.Records) {
 		if !scope.Records[0].view.isGrouped {
 			return nil, NewNotGroupingRecordsError(expr, expr.Name)
 		}
 
-		listExpr := expr.Args[0]
 		if _, ok := listExpr.(parser.AllColumns); ok {
 			listExpr = parser.NewIntegerValue(1)
 		}
 
-		if uname == "COUNT" {
+		if uname == "COUNT" && expr.Filter == nil {
 			if pt, ok := listExpr.(parser.PrimitiveType); ok {
 				v := pt.Value
 				if !value.IsNull(v) && !value.IsUnknown(v) && scope.Records[0].IsInRange() {
-					return value.NewInteger(int64(scope.Records[0].view.RecordSet[scope.Records[0].recordIndex].GroupLen())), nil
+					record := scope.Records[0].view.RecordSet[scope.Records[0].recordIndex]
+					if scope.Records[0].view.grouping != nil {
+						record = scope.Records[0].view.grouping.restore(record)
+					}
+					return value.NewInteger(int64(record.GroupLen())), nil
 				} else {
 					return value.NewInteger(0), nil
 				}
@@ -650,6 +728,16 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 			if err != nil {
 				return nil, err
 			}
+			if expr.Filter != nil {
+				if err = view.Where(ctx, scope, expr.Filter.(parser.WhereClause)); err != nil {
+					return nil, err
+				}
+			}
+			if expr.OrderBy != nil {
+				if err = view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause)); err != nil {
+					return nil, err
+				}
+			}
 			list, err = view.ListValuesForAggregateFunctions(ctx, scope, expr, listExpr, expr.IsDistinct())
 			if err != nil {
 				return nil, err
@@ -657,8 +745,7 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 		}
 	}
 
-	if aggfn == nil {
-		argsExprs := expr.Args[1:]
+	if udfn != nil {
 		args := make([]value.Primary, len(argsExprs))
 		for i, v := range argsExprs {
 			arg, err := Evaluate(ctx, scope, v)
@@ -670,9 +757,57 @@ func evalAggregateFunction(ctx context.Context, scope *ReferenceScope, expr pars
 		return udfn.ExecuteAggregate(ctx, scope, list, args)
 	}
 
+	if osfn != nil {
+		return osfn(list, fraction, scope.Tx.Flags), nil
+	}
 	return aggfn(list, scope.Tx.Flags), nil
 }
 
+// checkArgsForAggregateFunction returns the expression of the values to be aggregated.
+// If a WITHIN GROUP clause is specified without any arguments, or for ordered-set aggregate functions,
+// the values are the ones of the sort key.
+func checkArgsForAggregateFunction(expr parser.AggregateFunction, isOrderedSet bool) (parser.QueryExpression, error) {
+	if isOrderedSet {
+		if expr.OrderBy == nil {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "WITHIN GROUP clause is required")
+		}
+		if len(expr.Args) != 1 {
+			return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+		}
+	}
+
+	if expr.OrderBy != nil && (isOrderedSet || len(expr.Args) < 1) {
+		items := expr.OrderBy.(parser.OrderByClause).Items
+		if len(items) != 1 {
+			return nil, NewFunctionInvalidArgumentError(expr, expr.Name, "WITHIN GROUP clause must have exactly one sort key")
+		}
+		return items[0].(parser.OrderItem).Value, nil
+	}
+
+	if len(expr.Args) < 1 {
+		return nil, NewFunctionArgumentLengthError(expr, expr.Name, []int{1})
+	}
+	return expr.Args[0], nil
+}
+
+func evalFractionForOrderedSetAggregateFunction(ctx context.Context, scope *ReferenceScope, expr parser.AggregateFunction) (float64, error) {
+	p, err := Evaluate(ctx, scope, expr.Args[0])
+	if err != nil {
+		return 0, err
+	}
+
+	f := value.ToFloat(p)
+	if value.IsNull(f) {
+		return 0, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a number between 0 and 1")
+	}
+	fraction := f.(*value.Float).Raw()
+	value.Discard(f)
+	if fraction < 0 || 1 < fraction {
+		return 0, NewFunctionInvalidArgumentError(expr, expr.Name, "the first argument must be a number between 0 and 1")
+	}
+	return fraction, nil
+}
+
 func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.ListFunction) (value.Primary, error) {
 	var separator string
 	var err error
@@ -698,6 +833,11 @@ func evalListFunction(ctx context.Context, scope *ReferenceScope, expr parser.Li
 		if err != nil {
 			return nil, err
 		}
+		if expr.Filter != nil {
+			if err = view.Where(ctx, scope, expr.Filter.(parser.WhereClause)); err != nil {
+				return nil, err
+			}
+		}
 		if expr.OrderBy != nil {
 			err := view.OrderBy(ctx, scope, expr.OrderBy.(parser.OrderByClause))
 			if err != nil {
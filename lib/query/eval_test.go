@@ -307,6 +307,14 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewFloat(-1.234),
 	},
+	{
+		Name: "UnaryArithmetic Interval",
+		Expr: parser.UnaryArithmetic{
+			Operand:  parser.NewIntervalValueFromString("1 month 2 days 2 hours"),
+			Operator: parser.Token{Token: '-', Literal: "-"},
+		},
+		Result: value.NewInterval(-1, -2, -7200000000000),
+	},
 	{
 		Name: "UnaryArithmetic Operand Error",
 		Expr: parser.UnaryArithmetic{
@@ -2814,6 +2822,434 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewInteger(0),
 	},
+	{
+		Name: "Aggregate Function With Filter",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "count",
+			Args: []parser.QueryExpression{
+				parser.AllColumns{},
+			},
+			Filter: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("ok"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Result: value.NewInteger(3),
+	},
+	{
+		Name: "Aggregate Function With Filter Evaluation Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "sum",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Filter: parser.WhereClause{
+				Filter: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "Aggregate Function Within Group Without Arguments",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(10),
+	},
+	{
+		Name: "Aggregate Function Within Group Sort Key Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "mode",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		Error: "WITHIN GROUP clause must have exactly one sort key for function mode",
+	},
+	{
+		Name: "Ordered-Set Aggregate Function",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+			Filter: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("ok"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Result: value.NewFloat(30),
+	},
+	{
+		Name: "Ordered-Set Aggregate Function Discrete",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "percentile_disc",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: value.NewInteger(20),
+	},
+	{
+		Name: "Ordered-Set Aggregate Function Within Group Required Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(0.5),
+			},
+		},
+		Error: "WITHIN GROUP clause is required for function percentile_cont",
+	},
+	{
+		Name: "Ordered-Set Aggregate Function Argument Length Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "percentile_cont",
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "function percentile_cont takes exactly 1 argument",
+	},
+	{
+		Name: "Ordered-Set Aggregate Function Invalid Fraction Error",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.AggregateFunction{
+			Name: "percentile_cont",
+			Args: []parser.QueryExpression{
+				parser.NewFloatValue(1.5),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Error: "the first argument must be a number between 0 and 1 for function percentile_cont",
+	},
 	{
 		Name: "Aggregate Function As a Statement Error",
 		Expr: parser.AggregateFunction{
@@ -3298,6 +3734,63 @@ var evaluateTests = []struct {
 		},
 		Result: value.NewString("str1,str2"),
 	},
+	{
+		Name: "ListAgg Function With Filter",
+		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
+			{
+				view: &View{
+					Header: NewHeaderWithId("table1", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						{
+							NewGroupCell([]value.Primary{
+								value.NewInteger(1),
+								value.NewInteger(2),
+								value.NewInteger(3),
+								value.NewInteger(4),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewInteger(40),
+								value.NewInteger(10),
+								value.NewInteger(30),
+								value.NewInteger(20),
+							}),
+							NewGroupCell([]value.Primary{
+								value.NewString("ok"),
+								value.NewString("ng"),
+								value.NewString("ok"),
+								value.NewString("ok"),
+							}),
+						},
+					},
+					isGrouped: true,
+				},
+				recordIndex: 0,
+				cache:       NewFieldIndexCache(10, LimitToUseFieldIndexSliceChache),
+			},
+		}),
+		Expr: parser.ListFunction{
+			Name: "listagg",
+			Args: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				parser.NewStringValue(","),
+			},
+			OrderBy: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{
+						Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+			Filter: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.NewStringValue("ok"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Result: value.NewString("20,30,40"),
+	},
 	{
 		Name: "ListAgg Function Null",
 		Scope: GenerateReferenceScope(nil, nil, time.Time{}, []ReferenceRecord{
@@ -0,0 +1,773 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/file"
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+const (
+	explainBranch     = "├─ "
+	explainLastBranch = "└─ "
+	explainVertical   = "│  "
+	explainBlank      = "   "
+)
+
+type explainNode struct {
+	Operation string
+	Details   []string
+	Children  []*explainNode
+
+	Analyzed bool
+	Rows     int
+	Elapsed  time.Duration
+}
+
+// Explain returns the tree of operations to be run for the query.
+// If the statement has ANALYZE, the query is executed and each operation is shown
+// with the number of records and the time spent on the operation.
+func Explain(ctx context.Context, scope *ReferenceScope, expr parser.Explain) (string, error) {
+	var root *explainNode
+
+	if expr.Analyze {
+		tracer := &explainTracer{}
+		tracer.open()
+
+		queryScope := scope.CreateNode()
+		queryScope.explain = tracer
+		_, err := Select(ctx, queryScope, expr.Query)
+		queryScope.CloseCurrentNode()
+		if err != nil {
+			return "", err
+		}
+
+		if nodes := tracer.take(); 0 < len(nodes) {
+			root = nodes[0]
+		}
+	} else {
+		queryScope := scope.CreateNode()
+		defer queryScope.CloseCurrentNode()
+
+		builder := &explainPlanBuilder{
+			scope:        queryScope,
+			inlineTables: make(map[string]bool),
+		}
+		node, err := builder.query(ctx, expr.Query)
+		if err != nil {
+			return "", err
+		}
+		root = node
+	}
+
+	return "\n" + formatExplainNode(scope.Tx, root) + "\n", nil
+}
+
+func formatExplainNode(tx *Transaction, root *explainNode) string {
+	if root == nil {
+		return ""
+	}
+
+	var buf strings.Builder
+
+	var write func(node *explainNode, prefix string, branch string, childPrefix string)
+	write = func(node *explainNode, prefix string, branch string, childPrefix string) {
+		buf.WriteString(prefix + branch)
+		buf.WriteString(tx.Palette.Render(option.ObjectEffect, node.Operation))
+		if node.Analyzed {
+			stats := "time: " + node.Elapsed.String()
+			if -1 < node.Rows {
+				stats = "rows: " + strconv.Itoa(node.Rows) + ", " + stats
+			}
+			buf.WriteString(" ")
+			buf.WriteString(tx.Palette.Render(option.NullEffect, "("+stats+")"))
+		}
+		buf.WriteString("\n")
+
+		detailPrefix := prefix + childPrefix
+		if 0 < len(node.Children) {
+			detailPrefix = detailPrefix + explainVertical
+		} else {
+			detailPrefix = detailPrefix + explainBlank
+		}
+		for _, d := range node.Details {
+			buf.WriteString(detailPrefix)
+			buf.WriteString(tx.Palette.Render(option.AttributeEffect, d))
+			buf.WriteString("\n")
+		}
+
+		for i, child := range node.Children {
+			if i < len(node.Children)-1 {
+				write(child, prefix+childPrefix, explainBranch, explainVertical)
+			} else {
+				write(child, prefix+childPrefix, explainLastBranch, explainBlank)
+			}
+		}
+	}
+
+	write(root, "", "", "")
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+type explainFrame struct {
+	nodes    []*explainNode
+	appendix []*explainNode
+}
+
+// explainTracer records the operations actually run for a query.
+// Operations are recorded in frames. A recorded operation takes the nodes in the current frame
+// as its children, so the nodes in a frame are chained in the order of the operations.
+type explainTracer struct {
+	frames []*explainFrame
+}
+
+func (t *explainTracer) now() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Now()
+}
+
+func (t *explainTracer) open() {
+	if t == nil {
+		return
+	}
+	t.frames = append(t.frames, &explainFrame{})
+}
+
+// close closes the current frame and adds its nodes to the parent frame.
+func (t *explainTracer) close() {
+	if t == nil {
+		return
+	}
+	nodes := t.take()
+	if 0 < len(t.frames) {
+		current := t.frames[len(t.frames)-1]
+		current.nodes = append(current.nodes, nodes...)
+	}
+}
+
+// take closes the current frame and returns its nodes.
+// Nodes set aside are added to the children of the first node.
+func (t *explainTracer) take() []*explainNode {
+	if t == nil || len(t.frames) < 1 {
+		return nil
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	if len(frame.nodes) < 1 {
+		return frame.appendix
+	}
+	frame.nodes[0].Children = append(frame.nodes[0].Children, frame.appendix...)
+	return frame.nodes
+}
+
+// setAside removes the nodes from the current frame to attach them to the result of the frame.
+func (t *explainTracer) setAside() {
+	if t == nil || len(t.frames) < 1 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	frame.appendix = append(frame.appendix, frame.nodes...)
+	frame.nodes = nil
+}
+
+func (t *explainTracer) push(node *explainNode) {
+	if t == nil || len(t.frames) < 1 || node == nil {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	frame.nodes = append(frame.nodes, node)
+}
+
+func (t *explainTracer) record(start time.Time, rows int, operation string, details ...string) {
+	if t == nil || len(t.frames) < 1 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	frame.nodes = []*explainNode{
+		{
+			Operation: operation,
+			Details:   details,
+			Children:  frame.nodes,
+			Analyzed:  true,
+			Rows:      rows,
+			Elapsed:   time.Since(start),
+		},
+	}
+}
+
+type explainPlanBuilder struct {
+	scope          *ReferenceScope
+	inlineTables   map[string]bool
+	recursiveTable *parser.InlineTable
+}
+
+func (b *explainPlanBuilder) query(ctx context.Context, query parser.SelectQuery) (*explainNode, error) {
+	var with *explainNode
+	if query.WithClause != nil {
+		with = &explainNode{Operation: "With"}
+		for _, v := range query.WithClause.(parser.WithClause).InlineTables {
+			inlineTable := v.(parser.InlineTable)
+			if inlineTable.IsRecursive() {
+				if b.recursiveTable != nil {
+					return nil, NewNestedRecursionError(inlineTable.Name)
+				}
+				b.recursiveTable = &inlineTable
+			}
+			child, err := b.query(ctx, inlineTable.Query)
+			b.recursiveTable = nil
+			if err != nil {
+				return nil, err
+			}
+			with.Children = append(with.Children, &explainNode{
+				Operation: "Inline Table",
+				Details:   []string{explainInlineTableName(inlineTable)},
+				Children:  []*explainNode{child},
+			})
+			b.inlineTables[strings.ToUpper(inlineTable.Name.Literal)] = true
+		}
+		with.Details = explainInlineTableNames(query.WithClause.(parser.WithClause))
+	}
+
+	node, err := b.entity(ctx, query.SelectEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.OrderByClause != nil {
+		orderByClause := query.OrderByClause.(parser.OrderByClause)
+		fns, err := SearchAnalyticFunctionsInList(GetValuesInOrderByClause(orderByClause))
+		if err != nil {
+			return nil, err
+		}
+		node = explainAnalyticFunctions(node, fns)
+		node = &explainNode{Operation: "Sort", Details: []string{orderByClause.String()}, Children: []*explainNode{node}}
+	}
+	node = explainDistinctOn(node, query.SelectEntity)
+
+	if query.LimitClause != nil {
+		limitClause := query.LimitClause.(parser.LimitClause)
+		if limitClause.OffsetClause != nil {
+			node = &explainNode{Operation: "Offset", Details: []string{limitClause.OffsetClause.String()}, Children: []*explainNode{node}}
+		}
+		if !limitClause.Type.IsEmpty() {
+			limitClause.OffsetClause = nil
+			node = &explainNode{Operation: "Limit", Details: []string{limitClause.String()}, Children: []*explainNode{node}}
+		}
+	}
+
+	if with != nil {
+		node.Children = append(node.Children, with)
+	}
+	return node, nil
+}
+
+func (b *explainPlanBuilder) entity(ctx context.Context, expr parser.QueryExpression) (*explainNode, error) {
+	switch e := expr.(type) {
+	case parser.Subquery:
+		return b.query(ctx, e.Query)
+	case parser.SelectSet:
+		return b.set(ctx, e)
+	}
+
+	entity := expr.(parser.SelectEntity)
+
+	var tables []parser.QueryExpression
+	if entity.FromClause != nil {
+		tables = entity.FromClause.(parser.FromClause).Tables
+	}
+	node, err := b.tables(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := false
+	if entity.WhereClause != nil {
+		node = &explainNode{Operation: "Filter", Details: []string{entity.WhereClause.String()}, Children: []*explainNode{node}}
+	}
+	if entity.GroupByClause != nil {
+		grouped = true
+		node = &explainNode{Operation: "Group", Details: []string{entity.GroupByClause.String()}, Children: []*explainNode{node}}
+	}
+	if entity.HavingClause != nil {
+		grouped = true
+		node = &explainNode{Operation: "Having", Details: []string{entity.HavingClause.String()}, Children: []*explainNode{node}}
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	fieldObjects := make([]parser.QueryExpression, 0, len(selectClause.Fields))
+	for _, f := range selectClause.Fields {
+		fieldObjects = append(fieldObjects, f.(parser.Field).Object)
+	}
+
+	if !grouped {
+		hasAggregateFunction, err := HasAggregateFunctionInList(fieldObjects, b.scope)
+		if err != nil {
+			return nil, err
+		}
+		if hasAggregateFunction {
+			node = &explainNode{Operation: "Group", Details: []string{explainGroupAll}, Children: []*explainNode{node}}
+		}
+	}
+
+	analyticTargets := fieldObjects
+	if entity.QualifyClause != nil {
+		analyticTargets = append(analyticTargets, entity.QualifyClause.(parser.QualifyClause).Filter)
+	}
+	fns, err := SearchAnalyticFunctionsInList(analyticTargets)
+	if err != nil {
+		return nil, err
+	}
+	node = explainAnalyticFunctions(node, fns)
+
+	if entity.QualifyClause != nil {
+		node = &explainNode{Operation: "Qualify", Details: []string{entity.QualifyClause.String()}, Children: []*explainNode{node}}
+	}
+
+	return &explainNode{Operation: "Select", Details: []string{selectClause.String()}, Children: []*explainNode{node}}, nil
+}
+
+func (b *explainPlanBuilder) set(ctx context.Context, set parser.SelectSet) (*explainNode, error) {
+	lhs, err := b.entity(ctx, set.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := b.entity(ctx, set.RHS)
+	if err != nil {
+		return nil, err
+	}
+
+	lhs = explainDistinctOn(lhs, set.LHS)
+	rhs = explainDistinctOn(rhs, set.RHS)
+
+	return &explainNode{Operation: explainSetOperation(set, b.recursiveTable != nil), Children: []*explainNode{lhs, rhs}}, nil
+}
+
+func (b *explainPlanBuilder) tables(ctx context.Context, tables []parser.QueryExpression) (*explainNode, error) {
+	if tables == nil {
+		var obj parser.QueryExpression
+		if b.scope.Tx.Session.CanReadStdin {
+			obj = parser.Stdin{}
+		} else {
+			obj = parser.Dual{}
+		}
+		tables = []parser.QueryExpression{parser.Table{Object: obj}}
+	}
+
+	node, err := b.table(ctx, tables[0])
+	if err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(tables); i++ {
+		joinNode, err := b.table(ctx, tables[i])
+		if err != nil {
+			return nil, err
+		}
+		node = &explainNode{Operation: explainCrossJoin, Details: []string{parser.TokenLiteral(parser.CROSS)}, Children: []*explainNode{node, joinNode}}
+	}
+	return node, nil
+}
+
+func (b *explainPlanBuilder) table(ctx context.Context, expr parser.QueryExpression) (*explainNode, error) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return b.table(ctx, parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+
+	switch obj := table.Object.(type) {
+	case parser.Dual:
+		return &explainNode{Operation: "Dual"}, nil
+	case parser.Join:
+		lhs, err := b.table(ctx, obj.Table)
+		if err != nil {
+			return nil, err
+		}
+		rhs, err := b.table(ctx, obj.JoinTable)
+		if err != nil {
+			return nil, err
+		}
+		operation, err := b.joinOperation(ctx, obj)
+		if err != nil {
+			return nil, err
+		}
+		return &explainNode{
+			Operation: operation,
+			Details:   explainJoinDetails(obj),
+			Children:  []*explainNode{lhs, rhs},
+		}, nil
+	case parser.Subquery:
+		child, err := b.query(ctx, obj.Query)
+		if err != nil {
+			return nil, err
+		}
+		return &explainNode{Operation: "Subquery", Details: explainTableName(table), Children: []*explainNode{child}}, nil
+	case parser.Pivot:
+		child, err := b.table(ctx, obj.Table)
+		if err != nil {
+			return nil, err
+		}
+		return &explainNode{Operation: "Pivot", Details: explainPivotDetails(table), Children: []*explainNode{child}}, nil
+	case parser.Unpivot:
+		child, err := b.table(ctx, obj.Table)
+		if err != nil {
+			return nil, err
+		}
+		return &explainNode{Operation: "Unpivot", Details: explainPivotDetails(table), Children: []*explainNode{child}}, nil
+	case parser.Identifier:
+		details := []string{table.String()}
+
+		if b.inlineTables[strings.ToUpper(obj.Literal)] || b.scope.InlineTableExists(obj) {
+			details = append(details, "Type: Inline Table")
+		} else if b.recursiveTable != nil && strings.EqualFold(obj.Literal, b.recursiveTable.Name.Literal) {
+			details = append(details, "Type: Recursive Table")
+		} else if b.scope.TemporaryTableExists(obj.Literal) {
+			details = append(details, "Type: Temporary Table")
+		} else if isTableObjectAsURL(obj) {
+			details = append(details, "Type: Remote Object", "URL: "+obj.Literal)
+		} else {
+			options := b.scope.Tx.Flags.ImportOptions.Copy()
+			options.Format = option.AutoSelect
+			fileInfo, err := NewFileInfo(obj, b.scope.Tx.Flags.Repository, options, b.scope.Tx.Flags.ImportOptions.Format)
+			if err != nil {
+				return nil, err
+			}
+			fileInfo.SetDefaultFileInfoAttributes(options, b.scope.Tx.Flags.ExportOptions)
+			details = append(details, explainFileInfoDetails(fileInfo)...)
+		}
+		return &explainNode{Operation: "Load", Details: details}, nil
+	default:
+		return &explainNode{Operation: "Load", Details: []string{table.String()}}, nil
+	}
+}
+
+const (
+	explainCrossJoin        = "Cross Join"
+	explainHashJoin         = "Hash Join"
+	explainNestedLoopJoin   = "Nested Loop Join"
+	explainLateralJoin      = "Lateral Join"
+	explainUndeterminedJoin = "Join"
+
+	explainGroupAll = "(all records)"
+)
+
+func explainAnalyticFunctions(node *explainNode, fns []parser.AnalyticFunction) *explainNode {
+	for _, fn := range fns {
+		node = &explainNode{Operation: "Analytic Function", Details: []string{fn.String()}, Children: []*explainNode{node}}
+	}
+	return node
+}
+
+func explainDistinctOn(node *explainNode, expr parser.QueryExpression) *explainNode {
+	entity, ok := expr.(parser.SelectEntity)
+	if !ok || !entity.SelectClause.(parser.SelectClause).IsDistinctOn() {
+		return node
+	}
+	details := explainExpressionList(entity.SelectClause.(parser.SelectClause).DistinctOn)
+	return &explainNode{Operation: "Distinct On", Details: []string{details}, Children: []*explainNode{node}}
+}
+
+func explainSetOperation(set parser.SelectSet, recursive bool) string {
+	s := set.Operator.String()
+	if !set.All.IsEmpty() {
+		s = s + " " + set.All.String()
+	}
+	if recursive {
+		s = "RECURSIVE " + s
+	}
+	return s
+}
+
+func explainInlineTableName(inlineTable parser.InlineTable) string {
+	s := inlineTable.Name.String()
+	if inlineTable.IsRecursive() {
+		s = parser.TokenLiteral(parser.RECURSIVE) + " " + s
+	}
+	return s
+}
+
+func explainInlineTableNames(clause parser.WithClause) []string {
+	names := make([]string, 0, len(clause.InlineTables))
+	for _, v := range clause.InlineTables {
+		names = append(names, v.(parser.InlineTable).Name.String())
+	}
+	return []string{strings.Join(names, ", ")}
+}
+
+func explainTableName(table parser.Table) []string {
+	if table.Alias == nil {
+		return nil
+	}
+	return []string{parser.TokenLiteral(parser.AS) + " " + table.Alias.String()}
+}
+
+func explainPivotDetails(table parser.Table) []string {
+	var s []string
+	switch obj := table.Object.(type) {
+	case parser.Pivot:
+		s = []string{obj.Aggregate.String(), parser.TokenLiteral(parser.FOR), obj.Column.String(), parser.TokenLiteral(parser.IN), "(" + explainExpressionList(obj.Values) + ")"}
+	case parser.Unpivot:
+		s = []string{obj.Value.String(), parser.TokenLiteral(parser.FOR), obj.Name.String(), parser.TokenLiteral(parser.IN), "(" + explainExpressionList(obj.Columns) + ")"}
+	}
+	return append([]string{strings.Join(s, " ")}, explainTableName(table)...)
+}
+
+func explainExpressionList(exprs []parser.QueryExpression) string {
+	s := make([]string, len(exprs))
+	for i, v := range exprs {
+		s[i] = v.String()
+	}
+	return strings.Join(s, ", ")
+}
+
+func explainJoinType(join parser.Join) string {
+	s := make([]string, 0, 3)
+	if !join.Natural.IsEmpty() {
+		s = append(s, join.Natural.String())
+	}
+	if !join.Direction.IsEmpty() {
+		s = append(s, join.Direction.String())
+	}
+	if !join.JoinType.IsEmpty() {
+		s = append(s, join.JoinType.String())
+	} else if join.Direction.IsEmpty() {
+		s = append(s, parser.TokenLiteral(parser.INNER))
+	} else {
+		s = append(s, parser.TokenLiteral(parser.OUTER))
+	}
+	return strings.Join(s, " ")
+}
+
+func explainJoinDetails(join parser.Join) []string {
+	details := []string{explainJoinType(join)}
+	if join.Condition != nil {
+		details = append(details, join.Condition.String())
+	}
+	return details
+}
+
+// joinOperation returns the operation to be used to join the tables without loading them.
+// Equality comparisons are used as keys of a hash join only if the fields on both sides are qualified with
+// the names of tables on the different sides of the join, otherwise the operation depends on the loaded headers.
+func (b *explainPlanBuilder) joinOperation(ctx context.Context, join parser.Join) (string, error) {
+	if t, ok := join.JoinTable.(parser.Table); ok && !t.Lateral.IsEmpty() {
+		return explainLateralJoin, nil
+	}
+	if join.JoinType.Token == parser.CROSS {
+		return explainCrossJoin, nil
+	}
+	if !join.Natural.IsEmpty() {
+		return explainUndeterminedJoin, nil
+	}
+	if join.Condition == nil {
+		return explainCrossJoin, nil
+	}
+
+	condition := join.Condition.(parser.JoinCondition)
+	if condition.On == nil {
+		return explainHashJoin, nil
+	}
+
+	leftNames, err := b.tableNames(ctx, join.Table)
+	if err != nil {
+		return "", err
+	}
+	rightNames, err := b.tableNames(ctx, join.JoinTable)
+	if err != nil {
+		return "", err
+	}
+
+	var side = func(expr parser.QueryExpression) int {
+		var view parser.Identifier
+		switch e := expr.(type) {
+		case parser.FieldReference:
+			view = e.View
+		case parser.ColumnNumber:
+			view = e.View
+		default:
+			return 0
+		}
+
+		switch {
+		case len(view.Literal) < 1:
+		case InStrSliceWithCaseInsensitive(view.Literal, leftNames):
+			return 1
+		case InStrSliceWithCaseInsensitive(view.Literal, rightNames):
+			return 2
+		}
+		return -1
+	}
+
+	operation := explainNestedLoopJoin
+
+	var search func(parser.QueryExpression) bool
+	search = func(expr parser.QueryExpression) bool {
+		switch e := expr.(type) {
+		case parser.Parentheses:
+			return search(e.Expr)
+		case parser.Logic:
+			return e.Operator.Token == parser.AND && (search(e.LHS) || search(e.RHS))
+		case parser.Comparison:
+			if e.Operator.Literal != "=" {
+				return false
+			}
+
+			lhs := side(e.LHS)
+			rhs := side(e.RHS)
+			if lhs == 0 || rhs == 0 {
+				return false
+			}
+			if lhs < 0 || rhs < 0 {
+				operation = explainUndeterminedJoin
+				return false
+			}
+			return lhs != rhs
+		}
+		return false
+	}
+
+	if search(condition.On) {
+		return explainHashJoin, nil
+	}
+	return operation, nil
+}
+
+func (b *explainPlanBuilder) tableNames(ctx context.Context, expr parser.QueryExpression) ([]string, error) {
+	if parentheses, ok := expr.(parser.Parentheses); ok {
+		return b.tableNames(ctx, parentheses.Expr)
+	}
+
+	table := expr.(parser.Table)
+	if join, ok := table.Object.(parser.Join); ok && table.Alias == nil {
+		names, err := b.tableNames(ctx, join.Table)
+		if err != nil {
+			return nil, err
+		}
+		joinNames, err := b.tableNames(ctx, join.JoinTable)
+		if err != nil {
+			return nil, err
+		}
+		return append(names, joinNames...), nil
+	}
+
+	name, err := ParseTableName(ctx, b.scope, table)
+	if err != nil {
+		return nil, err
+	}
+	if len(name.Literal) < 1 {
+		return nil, nil
+	}
+	return []string{name.Literal}, nil
+}
+
+// explainJoinOperation returns the operation that is actually used to join the views.
+func explainJoinOperation(join parser.Join, view *View, joinView *View) string {
+	if join.JoinType.Token == parser.CROSS {
+		return explainCrossJoin
+	}
+
+	condition, _, _, err := ParseJoinCondition(join, view, joinView)
+	if err != nil || condition == nil {
+		if join.JoinType.Token == parser.OUTER || (join.JoinType.IsEmpty() && !join.Direction.IsEmpty()) {
+			return explainNestedLoopJoin
+		}
+		return explainCrossJoin
+	}
+
+	if leftIndices, _ := EquiJoinKeyIndices(condition, view.Header.Merge(joinView.Header), view.FieldLen()); 0 < len(leftIndices) {
+		return explainHashJoin
+	}
+	return explainNestedLoopJoin
+}
+
+// explainLoadDetails returns the details of a loaded view.
+func explainLoadDetails(table parser.Table, view *View) []string {
+	details := []string{table.String()}
+
+	switch {
+	case view.FileInfo == nil:
+		details = append(details, "Type: Inline Table")
+	case view.FileInfo.IsTemporaryTable():
+		details = append(details, "Type: Temporary Table")
+	case view.FileInfo.IsStdin():
+		details = append(details, "Type: STDIN")
+		details = append(details, explainFileInfoAttributes(view.FileInfo)...)
+	case view.FileInfo.IsFile():
+		details = append(details, explainFileInfoDetails(view.FileInfo)...)
+	case view.FileInfo.IsArchivedFile():
+		details = append(details, "Type: Archived File", "Path: "+view.FileInfo.Path, "Archive: "+view.FileInfo.ArchivePath)
+		details = append(details, explainFileInfoAttributes(view.FileInfo)...)
+	case view.FileInfo.IsFileSet():
+		details = append(details, "Type: Multiple Files", "Path: "+view.FileInfo.Path)
+		details = append(details, explainFileInfoAttributes(view.FileInfo)...)
+	case view.FileInfo.IsRemoteObject():
+		details = append(details, "Type: Remote Object", "URL: "+view.FileInfo.Path)
+		details = append(details, explainFileInfoAttributes(view.FileInfo)...)
+	case view.FileInfo.IsStringObject():
+		details = append(details, "Type: String Object")
+		details = append(details, explainFileInfoAttributes(view.FileInfo)...)
+	default:
+		details = append(details, "Type: Inline Table")
+	}
+	return details
+}
+
+func explainFileInfoDetails(info *FileInfo) []string {
+	return append([]string{"Type: File", "Path: " + info.Path}, explainFileInfoAttributes(info)...)
+}
+
+func explainFileInfoAttributes(info *FileInfo) []string {
+	attrs := []string{"Format: " + info.Format.String()}
+	if info.Compression != file.NoCompression {
+		attrs = append(attrs, "Compression: "+info.Compression.String())
+	}
+
+	switch info.Format {
+	case option.CSV, option.TSV:
+		attrs = append(attrs, "Delimiter: '"+option.EscapeString(string(info.Delimiter))+"'")
+	case option.FIXED:
+		dp := info.DelimiterPositions.String()
+		if info.SingleLine {
+			dp = "S" + dp
+		}
+		attrs = append(attrs, "Delimiter Positions: "+dp)
+	case option.JSON, option.JSONL, option.YAML:
+		if 0 < len(info.JsonQuery) {
+			attrs = append(attrs, "Query: "+info.JsonQuery)
+		}
+	case option.XLSX:
+		if 0 < len(info.XlsxSheet) {
+			attrs = append(attrs, "Sheet: "+info.XlsxSheet)
+		}
+		if 0 < len(info.XlsxRange) {
+			attrs = append(attrs, "Range: "+info.XlsxRange)
+		}
+	case option.XML:
+		if 0 < len(info.XmlRowPath) {
+			attrs = append(attrs, "Row Path: "+info.XmlRowPath)
+		}
+	}
+
+	switch info.Format {
+	case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML:
+	default:
+		attrs = append(attrs, "Encoding: "+info.Encoding.String())
+	}
+
+	switch info.Format {
+	case option.CSV, option.TSV, option.FIXED, option.XLSX:
+		if !(info.Format == option.FIXED && info.SingleLine) {
+			attrs = append(attrs, "Header: "+strconv.FormatBool(!info.NoHeader))
+		}
+	}
+
+	return []string{strings.Join(attrs, ", ")}
+}
@@ -0,0 +1,328 @@
+package query
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var explainTests = []struct {
+	Name   string
+	Expr   parser.Explain
+	Result string
+	Error  string
+}{
+	{
+		Name: "Explain",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{
+								Object: parser.Join{
+									Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+									JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+									JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+									Condition: parser.JoinCondition{
+										On: parser.Comparison{
+											LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+											RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+											Operator: parser.Token{Token: '=', Literal: "="},
+										},
+									},
+								},
+							},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(1),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+						},
+					},
+				},
+				OrderByClause: parser.OrderByClause{
+					Items: []parser.QueryExpression{
+						parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				LimitClause: parser.LimitClause{
+					Type:  parser.Token{Token: parser.LIMIT, Literal: "limit"},
+					Value: parser.NewIntegerValue(1),
+				},
+			},
+		},
+		Result: "\n" +
+			"Limit\n" +
+			"│  LIMIT 1\n" +
+			"└─ Sort\n" +
+			"   │  ORDER BY column1\n" +
+			"   └─ Select\n" +
+			"      │  SELECT column1, column4\n" +
+			"      └─ Filter\n" +
+			"         │  WHERE column1 > 1\n" +
+			"         └─ Hash Join\n" +
+			"            │  INNER\n" +
+			"            │  ON table1.column1 = table2.column3\n" +
+			"            ├─ Load\n" +
+			"            │     table1\n" +
+			"            │     Type: File\n" +
+			"            │     Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"            │     Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n" +
+			"            └─ Load\n" +
+			"                  table2\n" +
+			"                  Type: File\n" +
+			"                  Path: " + GetTestFilePath("table2.csv") + "\n" +
+			"                  Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n",
+	},
+	{
+		Name: "Explain Join with Equality of Fields in the Same Table",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{
+								Object: parser.Join{
+									Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t1"}},
+									JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+									JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+									Condition: parser.JoinCondition{
+										On: parser.Comparison{
+											LHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column1"}},
+											RHS:      parser.FieldReference{View: parser.Identifier{Literal: "t1"}, Column: parser.Identifier{Literal: "column2"}},
+											Operator: parser.Token{Token: '=', Literal: "="},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"Select\n" +
+			"│  SELECT *\n" +
+			"└─ Nested Loop Join\n" +
+			"   │  INNER\n" +
+			"   │  ON t1.column1 = t1.column2\n" +
+			"   ├─ Load\n" +
+			"   │     table1 t1\n" +
+			"   │     Type: File\n" +
+			"   │     Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"   │     Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n" +
+			"   └─ Load\n" +
+			"         table2\n" +
+			"         Type: File\n" +
+			"         Path: " + GetTestFilePath("table2.csv") + "\n" +
+			"         Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n",
+	},
+	{
+		Name: "Explain Join with Equality of Unqualified Fields",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{
+								Object: parser.Join{
+									Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t1"}},
+									JoinTable: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+									JoinType:  parser.Token{Token: parser.INNER, Literal: "inner"},
+									Condition: parser.JoinCondition{
+										On: parser.Comparison{
+											LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+											RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+											Operator: parser.Token{Token: '=', Literal: "="},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"Select\n" +
+			"│  SELECT *\n" +
+			"└─ Join\n" +
+			"   │  INNER\n" +
+			"   │  ON column1 = column3\n" +
+			"   ├─ Load\n" +
+			"   │     table1 t1\n" +
+			"   │     Type: File\n" +
+			"   │     Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"   │     Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n" +
+			"   └─ Load\n" +
+			"         table2\n" +
+			"         Type: File\n" +
+			"         Path: " + GetTestFilePath("table2.csv") + "\n" +
+			"         Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n",
+	},
+	{
+		Name: "Explain Qualify and Distinct On",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+						DistinctOn: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					QualifyClause: parser.QualifyClause{
+						Filter: parser.Comparison{
+							LHS:      parser.AnalyticFunction{Name: "row_number", AnalyticClause: parser.AnalyticClause{}},
+							RHS:      parser.NewIntegerValue(1),
+							Operator: parser.Token{Token: '=', Literal: "="},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"Distinct On\n" +
+			"│  column1\n" +
+			"└─ Select\n" +
+			"   │  SELECT DISTINCT ON (column1) column1\n" +
+			"   └─ Qualify\n" +
+			"      │  QUALIFY ROW_NUMBER() OVER () = 1\n" +
+			"      └─ Analytic Function\n" +
+			"         │  ROW_NUMBER() OVER ()\n" +
+			"         └─ Load\n" +
+			"               table1\n" +
+			"               Type: File\n" +
+			"               Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"               Format: CSV, Delimiter: ',', Encoding: AUTO, Header: true\n",
+	},
+	{
+		Name: "Explain Analyze",
+		Expr: parser.Explain{
+			Analyze: true,
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+							parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table1"}},
+						},
+					},
+					WhereClause: parser.WhereClause{
+						Filter: parser.Comparison{
+							LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							RHS:      parser.NewIntegerValue(1),
+							Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+						},
+					},
+					GroupByClause: parser.GroupByClause{
+						Items: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						},
+					},
+				},
+			},
+		},
+		Result: "\n" +
+			"Select (rows: 2, time: -)\n" +
+			"│  SELECT column1, COUNT(*)\n" +
+			"└─ Group (rows: 2, time: -)\n" +
+			"   │  GROUP BY column1\n" +
+			"   └─ Filter (rows: 2, time: -)\n" +
+			"      │  WHERE column1 > 1\n" +
+			"      └─ Load (rows: 3, time: -)\n" +
+			"            table1\n" +
+			"            Type: File\n" +
+			"            Path: " + GetTestFilePath("table1.csv") + "\n" +
+			"            Format: CSV, Delimiter: ',', Encoding: UTF8, Header: true\n",
+	},
+	{
+		Name: "Explain Table Not Loaded Error",
+		Expr: parser.Explain{
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.AllColumns{}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "notexist"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "file notexist does not exist",
+	},
+}
+
+var explainElapsedTime = regexp.MustCompile(`time: [^)]+\)`)
+
+func TestExplain(t *testing.T) {
+	defer func() {
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+
+	for _, v := range explainTests {
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+
+		result, err := Explain(ctx, NewReferenceScope(TestTx), v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		result = explainElapsedTime.ReplaceAllString(result, "time: -)")
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
@@ -0,0 +1,683 @@
+package query
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const externalSortMergeWidth = 64
+
+const (
+	externalSortNullTag byte = iota
+	externalSortStringTag
+	externalSortIntegerTag
+	externalSortFloatTag
+	externalSortBooleanTag
+	externalSortTernaryTag
+	externalSortDatetimeTag
+	externalSortDecimalTag
+	externalSortIntervalTag
+	externalSortArrayTag
+	externalSortMapTag
+)
+
+// externalSort sorts the records using temporary files when the estimated memory size of the records
+// and their sort values exceeds the limit.
+// Sorted runs that fit in the limit are written to temporary files, and then merged into the record set.
+// The limit only bounds the buffers used for sorting, the merged records are held in memory as the view.
+// It returns false if the records can be sorted in memory.
+func (view *View) externalSort(ctx context.Context, scope *ReferenceScope, sortIndices []int, memoryLimit int64) (bool, error) {
+	recordSizes := make([]int64, view.RecordLen())
+	var total int64 = 0
+	for i := range view.RecordSet {
+		recordSizes[i] = estimateRecordSize(view.RecordSet[i], sortIndices)
+		total = total + recordSizes[i]
+	}
+	if total <= memoryLimit {
+		return false, nil
+	}
+
+	sorter := &externalSorter{
+		flags:         scope.Tx.Flags,
+		sortIndices:   sortIndices,
+		directions:    view.sortDirections,
+		nullPositions: view.sortNullPositions,
+	}
+	defer sorter.clean()
+
+	runs := make([]*externalSortRun, 0, 8)
+	for begin := 0; begin < view.RecordLen(); {
+		if ctx.Err() != nil {
+			return true, ConvertContextError(ctx.Err())
+		}
+
+		end := begin + 1
+		size := recordSizes[begin]
+		for end < view.RecordLen() && size+recordSizes[end] <= memoryLimit {
+			size = size + recordSizes[end]
+			end++
+		}
+
+		run, err := sorter.writeRun(view.RecordSet[begin:end])
+		if err != nil {
+			return true, err
+		}
+		runs = append(runs, run)
+
+		for i := begin; i < end; i++ {
+			view.RecordSet[i] = nil
+		}
+		begin = end
+	}
+
+	for externalSortMergeWidth < len(runs) {
+		merged := make([]*externalSortRun, 0, len(runs)/externalSortMergeWidth+1)
+		for i := 0; i < len(runs); i = i + externalSortMergeWidth {
+			end := i + externalSortMergeWidth
+			if len(runs) < end {
+				end = len(runs)
+			}
+
+			run, err := sorter.newRun()
+			if err != nil {
+				return true, err
+			}
+			if err = sorter.merge(ctx, runs[i:end], func(record Record, _ SortValues) error {
+				return run.writeRecord(record)
+			}); err != nil {
+				return true, err
+			}
+			if err = run.finish(); err != nil {
+				return true, err
+			}
+			merged = append(merged, run)
+		}
+		runs = merged
+	}
+
+	recordSet := make(RecordSet, 0, view.RecordLen())
+	sortValuesInEachRecord := make([]SortValues, 0, view.RecordLen())
+	if err := sorter.merge(ctx, runs, func(record Record, sortValues SortValues) error {
+		recordSet = append(recordSet, record)
+		sortValuesInEachRecord = append(sortValuesInEachRecord, sortValues)
+		return nil
+	}); err != nil {
+		return true, err
+	}
+
+	view.RecordSet = recordSet
+	view.sortValuesInEachRecord = sortValuesInEachRecord
+	view.sortValuesInEachCell = nil
+	return true, nil
+}
+
+func estimateRecordSize(record Record, sortIndices []int) int64 {
+	var size int64 = 24 + int64(cap(record))*24
+	for _, cell := range record {
+		for _, p := range cell {
+			size = size + estimatePrimarySize(p)
+		}
+	}
+	for _, idx := range sortIndices {
+		size = size + 80
+		if s, ok := record[idx][0].(*value.String); ok {
+			size = size + int64(len(s.Raw()))
+		}
+	}
+	return size
+}
+
+func estimatePrimarySize(p value.Primary) int64 {
+	switch p.(type) {
+	case *value.String:
+		return 32 + int64(len(p.(*value.String).Raw()))
+	case *value.Datetime:
+		return 40
+	default:
+		return 24
+	}
+}
+
+type externalSorter struct {
+	flags         *option.Flags
+	sortIndices   []int
+	directions    []int
+	nullPositions []int
+
+	locations     []*time.Location
+	locationIndex map[*time.Location]uint64
+	runs          []*externalSortRun
+}
+
+func (sorter *externalSorter) newRun() (*externalSortRun, error) {
+	fp, err := os.CreateTemp("", "csvq_sort_")
+	if err != nil {
+		return nil, NewSystemError(err.Error())
+	}
+
+	run := &externalSortRun{
+		sorter: sorter,
+		path:   fp.Name(),
+		fp:     fp,
+		writer: bufio.NewWriter(fp),
+	}
+	sorter.runs = append(sorter.runs, run)
+	return run, nil
+}
+
+func (sorter *externalSorter) writeRun(records RecordSet) (*externalSortRun, error) {
+	list := &externalSortList{
+		records:    records,
+		sortValues: make([]SortValues, len(records)),
+		sorter:     sorter,
+	}
+	for i := range records {
+		list.sortValues[i] = sorter.sortValues(records[i])
+	}
+	sort.Sort(list)
+
+	run, err := sorter.newRun()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range list.records {
+		if err = run.writeRecord(record); err != nil {
+			return nil, err
+		}
+	}
+	return run, run.finish()
+}
+
+func (sorter *externalSorter) merge(ctx context.Context, runs []*externalSortRun, fn func(Record, SortValues) error) error {
+	h := &externalSortHeap{
+		sorter: sorter,
+		items:  make([]*externalSortHeapItem, 0, len(runs)),
+	}
+
+	for i, run := range runs {
+		if err := run.open(); err != nil {
+			return err
+		}
+		record, err := run.readRecord()
+		if err != nil {
+			return err
+		}
+		if record != nil {
+			h.items = append(h.items, &externalSortHeapItem{
+				run:        i,
+				record:     record,
+				sortValues: sorter.sortValues(record),
+			})
+		}
+	}
+	heap.Init(h)
+
+	for cnt := 0; 0 < h.Len(); cnt++ {
+		if cnt&1023 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		item := h.items[0]
+		if err := fn(item.record, item.sortValues); err != nil {
+			return err
+		}
+
+		record, err := runs[item.run].readRecord()
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			heap.Pop(h)
+			runs[item.run].close()
+		} else {
+			item.record = record
+			item.sortValues = sorter.sortValues(record)
+			heap.Fix(h, 0)
+		}
+	}
+	return nil
+}
+
+func (sorter *externalSorter) sortValues(record Record) SortValues {
+	sortValues := make(SortValues, len(sorter.sortIndices))
+	for i, idx := range sorter.sortIndices {
+		sortValues[i] = NewSortValue(record[idx][0], sorter.flags)
+	}
+	return sortValues
+}
+
+func (sorter *externalSorter) less(values SortValues, compareValues SortValues) bool {
+	return values.Less(compareValues, sorter.directions, sorter.nullPositions)
+}
+
+func (sorter *externalSorter) location(idx uint64) *time.Location {
+	return sorter.locations[idx]
+}
+
+func (sorter *externalSorter) locationNumber(loc *time.Location) uint64 {
+	if sorter.locationIndex == nil {
+		sorter.locationIndex = make(map[*time.Location]uint64)
+	}
+	if idx, ok := sorter.locationIndex[loc]; ok {
+		return idx
+	}
+
+	idx := uint64(len(sorter.locations))
+	sorter.locations = append(sorter.locations, loc)
+	sorter.locationIndex[loc] = idx
+	return idx
+}
+
+func (sorter *externalSorter) clean() {
+	for _, run := range sorter.runs {
+		run.close()
+	}
+	sorter.runs = nil
+}
+
+type externalSortList struct {
+	records    RecordSet
+	sortValues []SortValues
+	sorter     *externalSorter
+}
+
+func (list *externalSortList) Len() int {
+	return len(list.records)
+}
+
+func (list *externalSortList) Swap(i, j int) {
+	list.records[i], list.records[j] = list.records[j], list.records[i]
+	list.sortValues[i], list.sortValues[j] = list.sortValues[j], list.sortValues[i]
+}
+
+func (list *externalSortList) Less(i, j int) bool {
+	return list.sorter.less(list.sortValues[i], list.sortValues[j])
+}
+
+type externalSortHeapItem struct {
+	run        int
+	record     Record
+	sortValues SortValues
+}
+
+type externalSortHeap struct {
+	sorter *externalSorter
+	items  []*externalSortHeapItem
+}
+
+func (h *externalSortHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *externalSortHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *externalSortHeap) Less(i, j int) bool {
+	if h.sorter.less(h.items[i].sortValues, h.items[j].sortValues) {
+		return true
+	}
+	if h.sorter.less(h.items[j].sortValues, h.items[i].sortValues) {
+		return false
+	}
+	return h.items[i].run < h.items[j].run
+}
+
+func (h *externalSortHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(*externalSortHeapItem))
+}
+
+func (h *externalSortHeap) Pop() interface{} {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items[n-1] = nil
+	h.items = h.items[:n-1]
+	return item
+}
+
+type externalSortRun struct {
+	sorter *externalSorter
+	path   string
+	fp     *os.File
+	writer *bufio.Writer
+	reader *bufio.Reader
+	buf    [binary.MaxVarintLen64]byte
+}
+
+// finish flushes the written records and closes the file so that a large number of runs
+// do not hold file descriptors until they are merged.
+func (run *externalSortRun) finish() error {
+	if err := run.writer.Flush(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	if err := run.fp.Close(); err != nil {
+		return NewSystemError(err.Error())
+	}
+	run.writer = nil
+	run.fp = nil
+	return nil
+}
+
+func (run *externalSortRun) open() error {
+	fp, err := os.Open(run.path)
+	if err != nil {
+		return NewSystemError(err.Error())
+	}
+	run.fp = fp
+	run.reader = bufio.NewReader(fp)
+	return nil
+}
+
+func (run *externalSortRun) close() {
+	if run.fp != nil {
+		_ = run.fp.Close()
+		run.fp = nil
+	}
+	if 0 < len(run.path) {
+		_ = os.Remove(run.path)
+		run.path = ""
+	}
+	run.reader = nil
+}
+
+func (run *externalSortRun) writeUvarint(i uint64) error {
+	n := binary.PutUvarint(run.buf[:], i)
+	_, err := run.writer.Write(run.buf[:n])
+	return err
+}
+
+func (run *externalSortRun) writeVarint(i int64) error {
+	n := binary.PutVarint(run.buf[:], i)
+	_, err := run.writer.Write(run.buf[:n])
+	return err
+}
+
+func (run *externalSortRun) writeRecord(record Record) error {
+	if err := run.writeRecordData(record); err != nil {
+		return NewSystemError(err.Error())
+	}
+	return nil
+}
+
+func (run *externalSortRun) writeRecordData(record Record) error {
+	if err := run.writeUvarint(uint64(len(record))); err != nil {
+		return err
+	}
+	if err := run.writeUvarint(uint64(cap(record))); err != nil {
+		return err
+	}
+
+	for _, cell := range record {
+		if err := run.writeUvarint(uint64(len(cell))); err != nil {
+			return err
+		}
+		for _, p := range cell {
+			if err := run.writePrimary(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (run *externalSortRun) writePrimary(p value.Primary) error {
+	var err error
+
+	switch p.(type) {
+	case *value.String:
+		s := p.(*value.String).Raw()
+		if err = run.writer.WriteByte(externalSortStringTag); err == nil {
+			if err = run.writeUvarint(uint64(len(s))); err == nil {
+				_, err = run.writer.WriteString(s)
+			}
+		}
+	case *value.Integer:
+		if err = run.writer.WriteByte(externalSortIntegerTag); err == nil {
+			err = run.writeVarint(p.(*value.Integer).Raw())
+		}
+	case *value.Float:
+		if err = run.writer.WriteByte(externalSortFloatTag); err == nil {
+			err = run.writeUvarint(math.Float64bits(p.(*value.Float).Raw()))
+		}
+	case *value.Decimal:
+		s := p.(*value.Decimal).String()
+		if err = run.writer.WriteByte(externalSortDecimalTag); err == nil {
+			if err = run.writeUvarint(uint64(len(s))); err == nil {
+				_, err = run.writer.WriteString(s)
+			}
+		}
+	case *value.Interval:
+		iv := p.(*value.Interval)
+		if err = run.writer.WriteByte(externalSortIntervalTag); err == nil {
+			if err = run.writeVarint(iv.Months()); err == nil {
+				if err = run.writeVarint(iv.Days()); err == nil {
+					err = run.writeVarint(iv.Nanoseconds())
+				}
+			}
+		}
+	case *value.Array:
+		values := p.(*value.Array).Values()
+		if err = run.writer.WriteByte(externalSortArrayTag); err == nil {
+			if err = run.writeUvarint(uint64(len(values))); err == nil {
+				for _, v := range values {
+					if err = run.writePrimary(v); err != nil {
+						break
+					}
+				}
+			}
+		}
+	case *value.Map:
+		m := p.(*value.Map)
+		if err = run.writer.WriteByte(externalSortMapTag); err == nil {
+			if err = run.writeUvarint(uint64(m.Len())); err == nil {
+				for _, k := range m.Keys() {
+					if err = run.writeUvarint(uint64(len(k))); err != nil {
+						break
+					}
+					if _, err = run.writer.WriteString(k); err != nil {
+						break
+					}
+					v, _ := m.Get(k)
+					if err = run.writePrimary(v); err != nil {
+						break
+					}
+				}
+			}
+		}
+	case *value.Boolean:
+		if err = run.writer.WriteByte(externalSortBooleanTag); err == nil {
+			if p.(*value.Boolean).Raw() {
+				err = run.writer.WriteByte(1)
+			} else {
+				err = run.writer.WriteByte(0)
+			}
+		}
+	case *value.Ternary:
+		if err = run.writer.WriteByte(externalSortTernaryTag); err == nil {
+			err = run.writeVarint(int64(p.(*value.Ternary).Ternary()))
+		}
+	case *value.Datetime:
+		t := p.(*value.Datetime).Raw()
+		if err = run.writer.WriteByte(externalSortDatetimeTag); err == nil {
+			if err = run.writeVarint(t.Unix()); err == nil {
+				if err = run.writeUvarint(uint64(t.Nanosecond())); err == nil {
+					err = run.writeUvarint(run.sorter.locationNumber(t.Location()))
+				}
+			}
+		}
+	default:
+		err = run.writer.WriteByte(externalSortNullTag)
+	}
+
+	return err
+}
+
+// readRecord returns nil when the run has no more records.
+func (run *externalSortRun) readRecord() (Record, error) {
+	record, err := run.readRecordData()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, NewSystemError(err.Error())
+	}
+	return record, nil
+}
+
+func (run *externalSortRun) readRecordData() (Record, error) {
+	fieldLen, err := binary.ReadUvarint(run.reader)
+	if err != nil {
+		return nil, err
+	}
+	fieldCap, err := binary.ReadUvarint(run.reader)
+	if err != nil {
+		return nil, run.unexpectedEOF(err)
+	}
+
+	record := make(Record, fieldLen, fieldCap)
+	for i := range record {
+		cellLen, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, run.unexpectedEOF(err)
+		}
+		cell := make(Cell, cellLen)
+		for j := range cell {
+			if cell[j], err = run.readPrimary(); err != nil {
+				return nil, run.unexpectedEOF(err)
+			}
+		}
+		record[i] = cell
+	}
+	return record, nil
+}
+
+func (run *externalSortRun) readPrimary() (value.Primary, error) {
+	tag, err := run.reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case externalSortStringTag:
+		l, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, l)
+		if _, err = io.ReadFull(run.reader, b); err != nil {
+			return nil, err
+		}
+		return value.NewString(string(b)), nil
+	case externalSortIntegerTag:
+		i, err := binary.ReadVarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewInteger(i), nil
+	case externalSortFloatTag:
+		f, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewFloat(math.Float64frombits(f)), nil
+	case externalSortDecimalTag:
+		l, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, l)
+		if _, err = io.ReadFull(run.reader, b); err != nil {
+			return nil, err
+		}
+		return value.NewDecimalFromString(string(b)), nil
+	case externalSortIntervalTag:
+		var elems [3]int64
+		for i := range elems {
+			if elems[i], err = binary.ReadVarint(run.reader); err != nil {
+				return nil, err
+			}
+		}
+		return value.NewInterval(elems[0], elems[1], elems[2]), nil
+	case externalSortArrayTag:
+		l, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]value.Primary, l)
+		for i := range values {
+			if values[i], err = run.readPrimary(); err != nil {
+				return nil, err
+			}
+		}
+		return value.NewArray(values), nil
+	case externalSortMapTag:
+		l, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		m := value.NewMap(int(l))
+		for i := uint64(0); i < l; i++ {
+			kl, err := binary.ReadUvarint(run.reader)
+			if err != nil {
+				return nil, err
+			}
+			k := make([]byte, kl)
+			if _, err = io.ReadFull(run.reader, k); err != nil {
+				return nil, err
+			}
+			v, err := run.readPrimary()
+			if err != nil {
+				return nil, err
+			}
+			m.Set(string(k), v)
+		}
+		return m, nil
+	case externalSortBooleanTag:
+		b, err := run.reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		return value.NewBoolean(b == 1), nil
+	case externalSortTernaryTag:
+		t, err := binary.ReadVarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewTernary(ternary.Value(t)), nil
+	case externalSortDatetimeTag:
+		sec, err := binary.ReadVarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		nsec, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		loc, err := binary.ReadUvarint(run.reader)
+		if err != nil {
+			return nil, err
+		}
+		return value.NewDatetime(time.Unix(sec, int64(nsec)).In(run.sorter.location(loc))), nil
+	default:
+		return value.NewNull(), nil
+	}
+}
+
+func (run *externalSortRun) unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+func externalSortTestView() *View {
+	numbers := []value.Primary{
+		value.NewInteger(3),
+		value.NewNull(),
+		value.NewFloat(1.5),
+		value.NewString("2"),
+		value.NewInteger(-1),
+		value.NewInteger(10),
+	}
+	strs := []value.Primary{
+		value.NewString("abc"),
+		value.NewString(" ABD "),
+		value.NewNull(),
+		value.NewString("aaa"),
+	}
+	datetimes := []value.Primary{
+		value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, time.FixedZone("JST", 9*60*60))),
+		value.NewNull(),
+		value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 15, 123456789, GetTestLocation())),
+		value.NewDatetime(time.Date(2012, 2, 3, 1, 18, 15, 0, GetTestLocation())),
+	}
+
+	recordSet := make(RecordSet, 0, 60)
+	for i := 0; i < 60; i++ {
+		record := make(Record, 5, 7)
+		record[0] = NewCell(numbers[(i*7)%len(numbers)])
+		record[1] = NewCell(strs[(i*5)%len(strs)])
+		record[2] = NewCell(datetimes[(i*3)%len(datetimes)])
+		record[3] = NewCell(value.NewInteger(int64(i)))
+		tags := value.NewMap(1)
+		tags.Set("tags", value.NewArray([]value.Primary{value.NewString("a"), value.NewInteger(int64(i))}))
+		record[4] = Cell{value.NewBoolean(i%2 == 0), value.NewTernary(ternary.UNKNOWN), value.NewNull(), tags}
+		recordSet = append(recordSet, record)
+	}
+
+	return &View{
+		Header:    NewHeader("table1", []string{"column1", "column2", "column3", "column4", "column5"}),
+		RecordSet: recordSet,
+	}
+}
+
+var viewExternalSortTests = []struct {
+	Name    string
+	OrderBy parser.OrderByClause
+}{
+	{
+		Name: "External Sort Ascending",
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+			},
+		},
+	},
+	{
+		Name: "External Sort Descending",
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, Direction: parser.Token{Token: parser.DESC}},
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}, Direction: parser.Token{Token: parser.DESC}},
+			},
+		},
+	},
+	{
+		Name: "External Sort Ascending with Nulls Last",
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, NullsPosition: parser.Token{Token: parser.LAST}},
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}, Direction: parser.Token{Token: parser.DESC}},
+			},
+		},
+	},
+	{
+		Name: "External Sort Descending with Nulls First",
+		OrderBy: parser.OrderByClause{
+			Items: []parser.QueryExpression{
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}, Direction: parser.Token{Token: parser.DESC}, NullsPosition: parser.Token{Token: parser.FIRST}},
+				parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+			},
+		},
+	},
+}
+
+func TestView_ExternalSort(t *testing.T) {
+	defer initFlag(TestTx.Flags)
+
+	scope := NewReferenceScope(TestTx)
+	ctx := context.Background()
+
+	for _, v := range viewExternalSortTests {
+		TestTx.Flags.SortMemoryLimit = -1
+		expect := externalSortTestView()
+		if err := expect.OrderBy(ctx, scope, v.OrderBy); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		TestTx.Flags.SortMemoryLimit = 0
+		view := externalSortTestView()
+		if err := view.OrderBy(ctx, scope, v.OrderBy); err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(view.RecordSet, expect.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, view.RecordSet, expect.RecordSet)
+		}
+		if cap(view.RecordSet[0]) != cap(expect.RecordSet[0]) {
+			t.Errorf("%s: record capacity = %d, want %d", v.Name, cap(view.RecordSet[0]), cap(expect.RecordSet[0]))
+		}
+		if len(view.sortValuesInEachRecord) != view.RecordLen() {
+			t.Errorf("%s: length of sort values = %d, want %d", v.Name, len(view.sortValuesInEachRecord), view.RecordLen())
+		}
+	}
+}
+
+func TestExternalSorter_WriteRun(t *testing.T) {
+	view := externalSortTestView()
+	sorter := &externalSorter{
+		flags:         TestTx.Flags,
+		sortIndices:   []int{3},
+		directions:    []int{parser.DESC},
+		nullPositions: []int{parser.LAST},
+	}
+	defer sorter.clean()
+
+	runs := make([]*externalSortRun, 0, view.RecordLen())
+	for i := range view.RecordSet {
+		run, err := sorter.writeRun(view.RecordSet[i : i+1])
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+		if run.fp != nil {
+			t.Fatalf("file of the written run is not closed")
+		}
+		runs = append(runs, run)
+	}
+
+	cnt := 0
+	err := sorter.merge(context.Background(), runs, func(record Record, _ SortValues) error {
+		if i := record[3][0].(*value.Integer).Raw(); i != int64(view.RecordLen()-1-cnt) {
+			t.Errorf("record %d = %d, want %d", cnt, i, view.RecordLen()-1-cnt)
+		}
+		cnt++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if cnt != view.RecordLen() {
+		t.Errorf("merged records = %d, want %d", cnt, view.RecordLen())
+	}
+	for _, run := range runs {
+		if run.fp != nil || 0 < len(run.path) {
+			t.Errorf("run is not closed after merging")
+			break
+		}
+	}
+}
@@ -12,15 +12,24 @@ func HasAggregateFunction(expr parser.QueryExpression, scope *ReferenceScope) (b
 		return true, nil
 	case parser.Function:
 		e := expr.(parser.Function)
-		if strings.ToUpper(e.Name) == "JSON_OBJECT" {
+		uname := strings.ToUpper(e.Name)
+		if uname == "JSON_OBJECT" {
 			return false, nil
 		}
+		if _, ok := AggregateFunctions[uname]; ok {
+			return true, nil
+		}
+		if _, ok := OrderedSetAggregateFunctions[uname]; ok {
+			return true, nil
+		}
 
 		if udfn, err := scope.GetFunction(expr, expr.(parser.Function).Name); err == nil && udfn.IsAggregate {
 			return true, nil
 		}
 
 		return HasAggregateFunctionInList(e.Args, scope)
+	case parser.ArrayValue:
+		return HasAggregateFunctionInList(expr.(parser.ArrayValue).Values, scope)
 	case parser.PrimitiveType, parser.FieldReference, parser.ColumnNumber, parser.Subquery, parser.Exists,
 		parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation, parser.Constant, parser.Flag,
 		parser.CursorStatus, parser.CursorAttrebute, parser.Placeholder,
@@ -216,6 +225,8 @@ func SearchAnalyticFunctions(expr parser.QueryExpression) ([]parser.AnalyticFunc
 			return nil, nil
 		}
 		return SearchAnalyticFunctionsInList(expr.(parser.Function).Args)
+	case parser.ArrayValue:
+		return SearchAnalyticFunctionsInList(expr.(parser.ArrayValue).Values)
 	case parser.AggregateFunction:
 		return SearchAnalyticFunctionsInList(expr.(parser.AggregateFunction).Args)
 	case parser.ListFunction:
@@ -333,3 +344,133 @@ func searchAnalyticFunctionsInRowValue(expr parser.QueryExpression) ([]parser.An
 		return SearchAnalyticFunctions(expr)
 	}
 }
+
+func SearchFieldReferences(expr parser.QueryExpression) ([]parser.QueryExpression, error) {
+	switch expr.(type) {
+	case parser.FieldReference, parser.ColumnNumber:
+		return []parser.QueryExpression{expr}, nil
+	case parser.PrimitiveType, parser.Subquery, parser.Exists,
+		parser.Variable, parser.EnvironmentVariable, parser.RuntimeInformation, parser.Constant, parser.Flag,
+		parser.CursorStatus, parser.CursorAttrebute, parser.Placeholder,
+		parser.AllColumns:
+		return nil, nil
+	case parser.Field:
+		return SearchFieldReferences(expr.(parser.Field).Object)
+	case parser.Parentheses:
+		return SearchFieldReferences(expr.(parser.Parentheses).Expr)
+	case parser.Arithmetic:
+		e := expr.(parser.Arithmetic)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.RHS})
+	case parser.UnaryArithmetic:
+		return SearchFieldReferences(expr.(parser.UnaryArithmetic).Operand)
+	case parser.Concat:
+		return SearchFieldReferencesInList(expr.(parser.Concat).Items)
+	case parser.Comparison:
+		e := expr.(parser.Comparison)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.RHS})
+	case parser.Is:
+		e := expr.(parser.Is)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.RHS})
+	case parser.Between:
+		e := expr.(parser.Between)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.Low, e.High})
+	case parser.Like:
+		e := expr.(parser.Like)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.Pattern})
+	case parser.In:
+		e := expr.(parser.In)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.Values})
+	case parser.Any:
+		e := expr.(parser.Any)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.Values})
+	case parser.All:
+		e := expr.(parser.All)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.Values})
+	case parser.JsonQuery:
+		e := expr.(parser.JsonQuery)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.Query, e.JsonText})
+	case parser.ValueList:
+		return SearchFieldReferencesInList(expr.(parser.ValueList).Values)
+	case parser.RowValue:
+		return SearchFieldReferences(expr.(parser.RowValue).Value)
+	case parser.RowValueList:
+		return SearchFieldReferencesInList(expr.(parser.RowValueList).RowValues)
+	case parser.Function:
+		return SearchFieldReferencesInList(expr.(parser.Function).Args)
+	case parser.ArrayValue:
+		return SearchFieldReferencesInList(expr.(parser.ArrayValue).Values)
+	case parser.AggregateFunction:
+		e := expr.(parser.AggregateFunction)
+		values := make([]parser.QueryExpression, 0, len(e.Args)+2)
+		values = append(values, e.Args...)
+		if e.OrderBy != nil {
+			values = append(values, GetValuesInOrderByClause(e.OrderBy.(parser.OrderByClause))...)
+		}
+		if e.Filter != nil {
+			values = append(values, e.Filter.(parser.WhereClause).Filter)
+		}
+		return SearchFieldReferencesInList(values)
+	case parser.ListFunction:
+		e := expr.(parser.ListFunction)
+		values := make([]parser.QueryExpression, 0, len(e.Args)+2)
+		values = append(values, e.Args...)
+		if e.OrderBy != nil {
+			values = append(values, GetValuesInOrderByClause(e.OrderBy.(parser.OrderByClause))...)
+		}
+		if e.Filter != nil {
+			values = append(values, e.Filter.(parser.WhereClause).Filter)
+		}
+		return SearchFieldReferencesInList(values)
+	case parser.AnalyticFunction:
+		e := expr.(parser.AnalyticFunction)
+		values := make([]parser.QueryExpression, 0, len(e.Args)+2)
+		values = append(values, e.Args...)
+
+		if e.AnalyticClause.PartitionClause != nil {
+			values = append(values, e.AnalyticClause.PartitionClause.(parser.PartitionClause).Values...)
+		}
+		if e.AnalyticClause.OrderByClause != nil {
+			values = append(values, GetValuesInOrderByClause(e.AnalyticClause.OrderByClause.(parser.OrderByClause))...)
+		}
+
+		return SearchFieldReferencesInList(values)
+	case parser.CaseExpr:
+		e := expr.(parser.CaseExpr)
+		values := make([]parser.QueryExpression, 0, len(e.When)+2)
+		if e.Value != nil {
+			values = append(values, e.Value)
+		}
+
+		for _, v := range e.When {
+			w := v.(parser.CaseExprWhen)
+			values = append(values, w.Condition, w.Result)
+		}
+
+		if e.Else != nil {
+			values = append(values, e.Else.(parser.CaseExprElse).Result)
+		}
+
+		return SearchFieldReferencesInList(values)
+	case parser.Logic:
+		e := expr.(parser.Logic)
+		return SearchFieldReferencesInList([]parser.QueryExpression{e.LHS, e.RHS})
+	case parser.UnaryLogic:
+		return SearchFieldReferences(expr.(parser.UnaryLogic).Operand)
+	case parser.VariableSubstitution:
+		return SearchFieldReferences(expr.(parser.VariableSubstitution).Value)
+	default:
+		return nil, NewInvalidValueExpressionError(expr)
+	}
+}
+
+func SearchFieldReferencesInList(list []parser.QueryExpression) ([]parser.QueryExpression, error) {
+	var refs []parser.QueryExpression = nil
+	for _, op := range list {
+		children, err := SearchFieldReferences(op)
+		if err != nil {
+			return refs, err
+		}
+		refs = append(refs, children...)
+	}
+	return refs, nil
+}
@@ -27,6 +27,7 @@ const (
 	TableEncloseAll         = "ENCLOSE_ALL"
 	TableJsonEscape         = "JSON_ESCAPE"
 	TablePrettyPrint        = "PRETTY_PRINT"
+	TableSchema             = "SCHEMA"
 )
 
 type ViewType int
@@ -38,6 +39,8 @@ const (
 	ViewTypeRemoteObject
 	ViewTypeStringObject
 	ViewTypeInlineTable
+	ViewTypeArchivedFile
+	ViewTypeFileSet
 )
 
 var FileAttributeList = []string{
@@ -50,6 +53,7 @@ var FileAttributeList = []string{
 	TableEncloseAll,
 	TableJsonEscape,
 	TablePrettyPrint,
+	TableSchema,
 }
 
 type TableAttributeUnchangedError struct {
@@ -76,12 +80,16 @@ type FileInfo struct {
 	Delimiter          rune
 	DelimiterPositions fixedlen.DelimiterPositions
 	JsonQuery          string
+	XlsxSheet          string
+	XlsxRange          string
+	XmlRowPath         string
 	Encoding           text.Encoding
 	LineBreak          text.LineBreak
 	NoHeader           bool
 	EncloseAll         bool
 	JsonEscape         json.EscapeType
 	PrettyPrint        bool
+	Compression        file.Compression
 
 	SingleLine bool
 
@@ -90,6 +98,9 @@ type FileInfo struct {
 	ForUpdate bool
 	ViewType  ViewType
 
+	// Columns to be loaded from a columnar file. Nil means all columns.
+	projection columnProjection
+
 	restorePointHeader    Header
 	restorePointRecordSet RecordSet
 }
@@ -110,19 +121,51 @@ func NewFileInfo(
 	switch format {
 	case option.TSV:
 		delimiter = '\t'
-	case option.JSON, option.JSONL:
+	case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML:
 		encoding = text.UTF8
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Format:    format,
-		Delimiter: delimiter,
-		Encoding:  encoding,
-		ViewType:  ViewTypeFile,
+		Path:        fpath,
+		Format:      format,
+		Delimiter:   delimiter,
+		Encoding:    encoding,
+		Compression: file.CompressionFromExt(fpath),
+		ViewType:    ViewTypeFile,
 	}, nil
 }
 
+func NewArchivedFileInfo(
+	archivePath string,
+	member string,
+	options option.ImportOptions,
+	defaultFormat option.Format,
+) *FileInfo {
+	format := options.Format
+	if format == option.AutoSelect {
+		format = FormatFromExt(member, defaultFormat)
+	}
+
+	delimiter := options.Delimiter
+	encoding := options.Encoding
+	switch format {
+	case option.TSV:
+		delimiter = '\t'
+	case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML:
+		encoding = text.UTF8
+	}
+
+	return &FileInfo{
+		Path:        member,
+		ArchivePath: archivePath,
+		Format:      format,
+		Delimiter:   delimiter,
+		Encoding:    encoding,
+		Compression: file.CompressionFromExt(member),
+		ViewType:    ViewTypeArchivedFile,
+	}
+}
+
 func NewTemporaryTableFileInfo(name string) *FileInfo {
 	return &FileInfo{
 		Path:     name,
@@ -156,7 +199,7 @@ func (f *FileInfo) SetAllDefaultFileInfoAttributes(importOptions option.ImportOp
 	switch f.Format {
 	case option.TSV:
 		f.Delimiter = '\t'
-	case option.JSON, option.JSONL:
+	case option.JSON, option.JSONL, option.XML, option.YAML:
 		f.Encoding = text.UTF8
 	}
 
@@ -167,6 +210,9 @@ func (f *FileInfo) SetDefaultFileInfoAttributes(importOptions option.ImportOptio
 	f.DelimiterPositions = importOptions.DelimiterPositions
 	f.SingleLine = importOptions.SingleLine
 	f.JsonQuery = option.TrimSpace(importOptions.JsonQuery)
+	f.XlsxSheet = importOptions.XlsxSheet
+	f.XlsxRange = importOptions.XlsxRange
+	f.XmlRowPath = option.TrimSpace(importOptions.XmlRowPath)
 	f.LineBreak = exportOptions.LineBreak
 	f.NoHeader = importOptions.NoHeader
 	f.EncloseAll = exportOptions.EncloseAll
@@ -237,7 +283,7 @@ func (f *FileInfo) SetFormat(s string) error {
 	switch format {
 	case option.TSV:
 		delimiter = '\t'
-	case option.JSON, option.JSONL:
+	case option.JSON, option.JSONL, option.XLSX, option.PARQUET, option.XML, option.YAML, option.HTML:
 		encoding = text.UTF8
 	}
 
@@ -349,6 +395,14 @@ func (f *FileInfo) IsInlineTable() bool {
 	return f.ViewType == ViewTypeInlineTable
 }
 
+func (f *FileInfo) IsArchivedFile() bool {
+	return f.ViewType == ViewTypeArchivedFile
+}
+
+func (f *FileInfo) IsFileSet() bool {
+	return f.ViewType == ViewTypeFileSet
+}
+
 func (f *FileInfo) IdentifiedPath() string {
 	s := strings.ToUpper(f.Path)
 	if 0 < len(f.ArchivePath) {
@@ -389,28 +443,49 @@ func SearchFilePath(filename parser.Identifier, repository string, options optio
 		fpath, err = SearchFixedLengthFilePath(filename, repository)
 	case option.LTSV:
 		fpath, err = SearchLTSVFilePath(filename, repository)
+	case option.XLSX:
+		fpath, err = SearchXlsxFilePath(filename, repository)
+	case option.PARQUET:
+		fpath, err = SearchParquetFilePath(filename, repository)
+	case option.XML:
+		fpath, err = SearchXmlFilePath(filename, repository)
+	case option.YAML:
+		fpath, err = SearchYamlFilePath(filename, repository)
 	default: // AutoSelect
 		if fpath, err = SearchFilePathFromAllTypes(filename, repository); err == nil {
-			switch strings.ToLower(filepath.Ext(fpath)) {
-			case option.CsvExt:
-				format = option.CSV
-			case option.TsvExt:
-				format = option.TSV
-			case option.JsonExt:
-				format = option.JSON
-			case option.JsonlExt:
-				format = option.JSONL
-			case option.LtsvExt:
-				format = option.LTSV
-			default:
-				format = defaultFormat
-			}
+			format = FormatFromExt(fpath, defaultFormat)
 		}
 	}
 
 	return fpath, format, err
 }
 
+// FormatFromExt returns the format corresponding to the extension of the file path.
+// If the extension is not associated with any format, defaultFormat is returned.
+func FormatFromExt(fpath string, defaultFormat option.Format) option.Format {
+	switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
+	case option.CsvExt:
+		return option.CSV
+	case option.TsvExt:
+		return option.TSV
+	case option.JsonExt:
+		return option.JSON
+	case option.JsonlExt:
+		return option.JSONL
+	case option.LtsvExt:
+		return option.LTSV
+	case option.XlsxExt:
+		return option.XLSX
+	case option.ParquetExt:
+		return option.PARQUET
+	case option.XmlExt:
+		return option.XML
+	case option.YamlExt, option.YmlExt:
+		return option.YAML
+	}
+	return defaultFormat
+}
+
 func SearchCSVFilePath(filename parser.Identifier, repository string) (string, error) {
 	return SearchFilePathWithExtType(filename, repository, []string{option.CsvExt, option.TsvExt, option.TextExt})
 }
@@ -431,8 +506,24 @@ func SearchLTSVFilePath(filename parser.Identifier, repository string) (string,
 	return SearchFilePathWithExtType(filename, repository, []string{option.LtsvExt, option.TextExt})
 }
 
+func SearchXlsxFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{option.XlsxExt})
+}
+
+func SearchParquetFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{option.ParquetExt})
+}
+
+func SearchXmlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{option.XmlExt})
+}
+
+func SearchYamlFilePath(filename parser.Identifier, repository string) (string, error) {
+	return SearchFilePathWithExtType(filename, repository, []string{option.YamlExt, option.YmlExt})
+}
+
 func SearchFilePathFromAllTypes(filename parser.Identifier, repository string) (string, error) {
-	return SearchFilePathWithExtType(filename, repository, []string{option.CsvExt, option.TsvExt, option.JsonExt, option.JsonlExt, option.LtsvExt, option.TextExt})
+	return SearchFilePathWithExtType(filename, repository, []string{option.CsvExt, option.TsvExt, option.JsonExt, option.JsonlExt, option.LtsvExt, option.XlsxExt, option.ParquetExt, option.XmlExt, option.YamlExt, option.YmlExt, option.TextExt})
 }
 
 func SearchFilePathWithExtType(filename parser.Identifier, repository string, extTypes []string) (string, error) {
@@ -456,6 +547,17 @@ func SearchFilePathWithExtType(filename parser.Identifier, repository string, ex
 				infoList = append(infoList, i)
 			}
 		}
+		if len(pathes) < 1 {
+			// Compressed files are searched only if no uncompressed file is found.
+			for _, ext := range extTypes {
+				for _, cext := range file.CompressionExtensions {
+					if i, err := os.Stat(fpath + ext + cext); err == nil {
+						pathes = append(pathes, fpath+ext+cext)
+						infoList = append(infoList, i)
+					}
+				}
+			}
+		}
 		switch {
 		case len(pathes) < 1:
 			return fpath, NewFileNotExistError(filename)
@@ -485,7 +587,7 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	var format option.Format
-	switch strings.ToLower(filepath.Ext(fpath)) {
+	switch strings.ToLower(filepath.Ext(file.TrimCompressionExt(fpath))) {
 	case option.TsvExt:
 		delimiter = '\t'
 		format = option.TSV
@@ -497,6 +599,12 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 		format = option.JSONL
 	case option.LtsvExt:
 		format = option.LTSV
+	case option.XlsxExt:
+		encoding = text.UTF8
+		format = option.XLSX
+	case option.ParquetExt:
+		encoding = text.UTF8
+		format = option.PARQUET
 	case option.GfmExt:
 		format = option.GFM
 	case option.OrgExt:
@@ -506,11 +614,12 @@ func NewFileInfoForCreate(filename parser.Identifier, repository string, delimit
 	}
 
 	return &FileInfo{
-		Path:      fpath,
-		Delimiter: delimiter,
-		Format:    format,
-		Encoding:  encoding,
-		ViewType:  ViewTypeFile,
+		Path:        fpath,
+		Delimiter:   delimiter,
+		Format:      format,
+		Encoding:    encoding,
+		Compression: file.CompressionFromExt(fpath),
+		ViewType:    ViewTypeFile,
 	}, nil
 }
 
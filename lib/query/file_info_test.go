@@ -162,6 +162,62 @@ var fileInfoTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:       "XML",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     option.XML,
+		Delimiter:  ',',
+		Encoding:   text.UTF16,
+		Result: &FileInfo{
+			Path:      "table8.xml",
+			Delimiter: ',',
+			Format:    option.XML,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "XML with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table8"},
+		Repository: TestDir,
+		Format:     option.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF16,
+		Result: &FileInfo{
+			Path:      "table8.xml",
+			Delimiter: ',',
+			Format:    option.XML,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "YAML",
+		FilePath:   parser.Identifier{Literal: "table9"},
+		Repository: TestDir,
+		Format:     option.YAML,
+		Delimiter:  ',',
+		Encoding:   text.UTF16,
+		Result: &FileInfo{
+			Path:      "table9.yaml",
+			Delimiter: ',',
+			Format:    option.YAML,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:       "YAML with AutoSelect",
+		FilePath:   parser.Identifier{Literal: "table9"},
+		Repository: TestDir,
+		Format:     option.AutoSelect,
+		Delimiter:  ',',
+		Encoding:   text.UTF16,
+		Result: &FileInfo{
+			Path:      "table9.yaml",
+			Delimiter: ',',
+			Format:    option.YAML,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:       "Fixed-Length",
 		FilePath:   parser.Identifier{Literal: "fixed_length.txt"},
@@ -322,6 +378,30 @@ var fileInfoForCreateTests = []struct {
 			Encoding:  text.UTF8,
 		},
 	},
+	{
+		Name:      "XLSX",
+		FilePath:  parser.Identifier{Literal: "table1.xlsx"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.xlsx",
+			Delimiter: ',',
+			Format:    option.XLSX,
+			Encoding:  text.UTF8,
+		},
+	},
+	{
+		Name:      "PARQUET",
+		FilePath:  parser.Identifier{Literal: "table1.parquet"},
+		Delimiter: ',',
+		Encoding:  text.SJIS,
+		Result: &FileInfo{
+			Path:      "table1.parquet",
+			Delimiter: ',',
+			Format:    option.PARQUET,
+			Encoding:  text.UTF8,
+		},
+	},
 	{
 		Name:      "GFM",
 		FilePath:  parser.Identifier{Literal: "table1.md"},
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 )
@@ -13,6 +14,7 @@ func FormatTableName(s string) string {
 	if len(s) < 1 {
 		return ""
 	}
+	s = file.TrimCompressionExt(s)
 	return strings.TrimSuffix(filepath.Base(s), filepath.Ext(s))
 }
 
@@ -32,6 +34,8 @@ func FormatFieldIdentifier(e parser.QueryExpression) string {
 			prefix = prefix + "T"
 		case *value.Datetime:
 			prefix = prefix + "D"
+		case *value.Interval:
+			prefix = prefix + "V"
 		case *value.Null:
 			prefix = prefix + "N"
 		}
@@ -53,6 +57,9 @@ func FormatFieldLabel(e parser.QueryExpression) string {
 		if dt, ok := pt.Value.(*value.Datetime); ok {
 			return dt.Format(time.RFC3339Nano)
 		}
+		if iv, ok := pt.Value.(*value.Interval); ok {
+			return iv.Format()
+		}
 		return pt.Value.String()
 	}
 	if fr, ok := e.(parser.FieldReference); ok {
@@ -25,6 +25,13 @@ func TestFormatTableName(t *testing.T) {
 		t.Errorf("table name = %q, want %q for %q", result, expect, path)
 	}
 
+	path = "/path/to/file.csv.gz"
+	expect = "file"
+	result = FormatTableName(path)
+	if result != expect {
+		t.Errorf("table name = %q, want %q for %q", result, expect, path)
+	}
+
 	path = "file.txt"
 	expect = "file"
 	result = FormatTableName(path)
@@ -9,13 +9,13 @@ import (
 	"crypto/sha512"
 	"encoding/base64"
 	"encoding/hex"
-	gojson "encoding/json"
 	"errors"
 	"fmt"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"hash"
 	"math"
+	"math/big"
 	"os/exec"
 	"regexp"
 	"strconv"
@@ -99,6 +99,7 @@ var Functions = map[string]BuiltInFunction{
 	"SUBSTR":                 Substr,
 	"INSTR":                  Instr,
 	"LIST_ELEM":              ListElem,
+	"SPLIT":                  Split,
 	"REPLACE":                ReplaceFn,
 	"REGEXP_MATCH":           RegExpMatch,
 	"REGEXP_FIND":            RegExpFind,
@@ -108,6 +109,8 @@ var Functions = map[string]BuiltInFunction{
 	"TITLE_CASE":             TitleCase,
 	"FORMAT":                 Format,
 	"JSON_VALUE":             JsonValue,
+	"ARRAY_LENGTH":           ArrayLength,
+	"ARRAY_CONTAINS":         ArrayContains,
 	"MD5":                    Md5,
 	"SHA1":                   Sha1,
 	"SHA256":                 Sha256,
@@ -131,6 +134,7 @@ var Functions = map[string]BuiltInFunction{
 	"UNIX_NANO_TIME":         UnixNanoTime,
 	"DAY_OF_YEAR":            DayOfYear,
 	"WEEK_OF_YEAR":           WeekOfYear,
+	"EXTRACT":                Extract,
 	"ADD_YEAR":               AddYear,
 	"ADD_MONTH":              AddMonth,
 	"ADD_DAY":                AddDay,
@@ -158,6 +162,7 @@ var Functions = map[string]BuiltInFunction{
 	"STRING":                 String,
 	"INTEGER":                Integer,
 	"FLOAT":                  Float,
+	"DECIMAL":                Decimal,
 	"BOOLEAN":                Boolean,
 	"TERNARY":                Ternary,
 	"DATETIME":               Datetime,
@@ -298,9 +303,25 @@ func Round(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Pri
 		return value.NewNull(), nil
 	}
 
+	if d, ok := args[0].(*value.Decimal); ok {
+		return roundDecimal(d, int(place)), nil
+	}
 	return value.NewFloat(round(number, place)), nil
 }
 
+func roundDecimal(d *value.Decimal, place int) *value.Decimal {
+	if d.Scale() <= place {
+		return value.NewDecimal(d.Unscaled(), d.Scale())
+	}
+	if 0 <= place {
+		return d.Rescale(place)
+	}
+
+	unit := pow10(-place)
+	r := value.RoundQuotient(d.Unscaled(), new(big.Int).Mul(pow10(d.Scale()), unit))
+	return value.NewDecimal(r.Mul(r, unit), 0)
+}
+
 func execMath1Arg(fn parser.Function, args []value.Primary, mathf func(float64) float64) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1087,6 +1108,17 @@ func Instr(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Pri
 }
 
 func ListElem(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
+	if a, ok := listElemArray(args); ok {
+		i := value.ToInteger(args[1])
+		if value.IsNull(i) {
+			return value.NewNull(), nil
+		}
+		index := int(i.(*value.Integer).Raw())
+		value.Discard(i)
+
+		return a.Index(index), nil
+	}
+
 	if len(args) < 3 || 3 < len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
 	}
@@ -1124,6 +1156,39 @@ func ListElem(fn parser.Function, args []value.Primary, _ *option.Flags) (value.
 	return value.NewString(list[index]), nil
 }
 
+func listElemArray(args []value.Primary) (*value.Array, bool) {
+	if len(args) != 2 {
+		return nil, false
+	}
+	a, ok := args[0].(*value.Array)
+	return a, ok
+}
+
+func Split(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	s := value.ToString(args[0])
+	if value.IsNull(s) {
+		return value.NewNull(), nil
+	}
+	str := s.(*value.String).Raw()
+	value.Discard(s)
+
+	sep := value.ToString(args[1])
+	if value.IsNull(sep) {
+		return value.NewNull(), nil
+	}
+	sepStr := sep.(*value.String).Raw()
+	value.Discard(sep)
+
+	if len(str) < 1 {
+		return value.NewArray([]value.Primary{}), nil
+	}
+	return stringsToArray(strings.Split(str, sepStr)), nil
+}
+
 func ReplaceFn(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
 	if 3 != len(args) {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{3})
@@ -1277,8 +1342,7 @@ func RegExpFindSubMatches(fn parser.Function, args []value.Primary, _ *option.Fl
 		return value.NewNull(), nil
 	}
 
-	b, _ := gojson.Marshal(m)
-	return value.NewString(string(b)), err
+	return stringsToArray(m), err
 }
 
 func RegExpFindAll(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
@@ -1296,8 +1360,19 @@ func RegExpFindAll(fn parser.Function, args []value.Primary, _ *option.Flags) (v
 		return value.NewNull(), nil
 	}
 
-	b, _ := gojson.Marshal(m)
-	return value.NewString(string(b)), err
+	list := make([]value.Primary, len(m))
+	for i := range m {
+		list[i] = stringsToArray(m[i])
+	}
+	return value.NewArray(list), err
+}
+
+func stringsToArray(list []string) *value.Array {
+	values := make([]value.Primary, len(list))
+	for i := range list {
+		values[i] = value.NewString(list[i])
+	}
+	return value.NewArray(values)
 }
 
 func RegExpReplace(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
@@ -1370,6 +1445,42 @@ func JsonValue(fn parser.Function, args []value.Primary, _ *option.Flags) (value
 	return v, nil
 }
 
+func ArrayLength(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
+	if len(args) != 1 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
+	}
+
+	switch args[0].(type) {
+	case *value.Array:
+		return value.NewInteger(int64(args[0].(*value.Array).Len())), nil
+	case *value.Map:
+		return value.NewInteger(int64(args[0].(*value.Map).Len())), nil
+	}
+	return value.NewNull(), nil
+}
+
+func ArrayContains(fn parser.Function, args []value.Primary, flags *option.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	a, ok := args[0].(*value.Array)
+	if !ok {
+		return value.NewTernary(ternary.UNKNOWN), nil
+	}
+
+	result := ternary.FALSE
+	for _, v := range a.Values() {
+		switch value.Equal(v, args[1], flags.DatetimeFormat, flags.GetTimeLocation()) {
+		case ternary.TRUE:
+			return value.NewTernary(ternary.TRUE), nil
+		case ternary.UNKNOWN:
+			result = ternary.UNKNOWN
+		}
+	}
+	return value.NewTernary(result), nil
+}
+
 func Md5(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
 	return execCrypto(fn, args, md5.New)
 }
@@ -1618,6 +1729,78 @@ func WeekOfYear(fn parser.Function, args []value.Primary, flags *option.Flags) (
 	return execDatetimeToInt(fn, args, weekOfYear, flags)
 }
 
+var extractDatetimeFunctions = map[string]func(time.Time) int64{
+	"YEAR":         year,
+	"MONTH":        month,
+	"DAY":          day,
+	"HOUR":         hour,
+	"MINUTE":       minute,
+	"SECOND":       second,
+	"MILLISECOND":  millisecond,
+	"MICROSECOND":  microsecond,
+	"NANOSECOND":   nanosecond,
+	"WEEKDAY":      weekday,
+	"DAY_OF_YEAR":  dayOfYear,
+	"WEEK_OF_YEAR": weekOfYear,
+}
+
+var extractIntervalFunctions = map[string]func(*value.Interval) int64{
+	"YEAR":        func(iv *value.Interval) int64 { return iv.Months() / 12 },
+	"MONTH":       func(iv *value.Interval) int64 { return iv.Months() % 12 },
+	"DAY":         func(iv *value.Interval) int64 { return iv.Days() },
+	"HOUR":        func(iv *value.Interval) int64 { return iv.Nanoseconds() / int64(time.Hour) },
+	"MINUTE":      func(iv *value.Interval) int64 { return iv.Nanoseconds() % int64(time.Hour) / int64(time.Minute) },
+	"MILLISECOND": func(iv *value.Interval) int64 { return iv.Nanoseconds() % int64(time.Second) / int64(time.Millisecond) },
+	"MICROSECOND": func(iv *value.Interval) int64 { return iv.Nanoseconds() % int64(time.Second) / int64(time.Microsecond) },
+	"NANOSECOND":  func(iv *value.Interval) int64 { return iv.Nanoseconds() % int64(time.Second) },
+}
+
+func Extract(fn parser.Function, args []value.Primary, flags *option.Flags) (value.Primary, error) {
+	if len(args) != 2 {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{2})
+	}
+
+	p := value.ToString(args[0])
+	if value.IsNull(p) {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, "the first argument must be a unit")
+	}
+	unit := strings.ToUpper(p.(*value.String).Raw())
+	value.Discard(p)
+
+	if _, ok := args[1].(*value.Interval); !ok {
+		if dt := value.ToDatetime(args[1], flags.DatetimeFormat, flags.GetTimeLocation()); !value.IsNull(dt) {
+			t := dt.(*value.Datetime).Raw()
+			value.Discard(dt)
+
+			switch unit {
+			case "EPOCH":
+				return value.NewFloat(float64(t.Unix()) + float64(t.Nanosecond())/1e9), nil
+			case "SECOND":
+				return value.NewFloat(float64(t.Second()) + float64(t.Nanosecond())/1e9), nil
+			}
+			if timef, ok := extractDatetimeFunctions[unit]; ok {
+				return value.NewInteger(timef(t)), nil
+			}
+			return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s is not a datetime unit", unit))
+		}
+	}
+
+	iv := value.ToInterval(args[1])
+	if value.IsNull(iv) {
+		return value.NewNull(), nil
+	}
+	switch unit {
+	case "EPOCH":
+		return value.NewFloat(iv.(*value.Interval).TotalSeconds()), nil
+	case "SECOND":
+		return value.NewFloat(float64(iv.(*value.Interval).Nanoseconds()%int64(time.Minute)) / 1e9), nil
+	}
+	if ivf, ok := extractIntervalFunctions[unit]; ok {
+		return value.NewInteger(ivf(iv.(*value.Interval))), nil
+	}
+	return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("%s is not an interval unit", unit))
+}
+
 func AddYear(fn parser.Function, args []value.Primary, flags *option.Flags) (value.Primary, error) {
 	return execDatetimeAdd(fn, args, addYear, flags)
 }
@@ -1886,6 +2069,35 @@ func Float(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Pri
 	}
 }
 
+func Decimal(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
+	if len(args) < 1 || 2 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1, 2})
+	}
+
+	var p value.Primary
+	switch v := args[0].(type) {
+	case *value.Datetime:
+		t := v.Raw()
+		nsec := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(1e9))
+		p = value.NewDecimal(nsec.Add(nsec, big.NewInt(int64(t.Nanosecond()))), 9).Normalize()
+	default:
+		p = value.ToDecimal(args[0])
+	}
+
+	if len(args) < 2 || value.IsNull(p) {
+		return p, nil
+	}
+
+	i := value.ToIntegerStrictly(args[1])
+	if value.IsNull(i) || i.(*value.Integer).Raw() < 0 || value.MaxDecimalScale < i.(*value.Integer).Raw() {
+		return nil, NewFunctionInvalidArgumentError(fn, fn.Name, fmt.Sprintf("the second argument must be an integer from 0 to %d", value.MaxDecimalScale))
+	}
+	scale := int(i.(*value.Integer).Raw())
+	value.Discard(i)
+
+	return p.(*value.Decimal).Rescale(scale), nil
+}
+
 func Boolean(fn parser.Function, args []value.Primary, _ *option.Flags) (value.Primary, error) {
 	if len(args) != 1 {
 		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{1})
@@ -1984,6 +2196,26 @@ func Now(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value
 	return value.NewDatetime(scope.Now()), nil
 }
 
+func ErrorCode(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if err := scope.CaughtError(); err != nil {
+		return value.NewInteger(int64(err.Code())), nil
+	}
+	return value.NewNull(), nil
+}
+
+func ErrorMessage(scope *ReferenceScope, fn parser.Function, args []value.Primary) (value.Primary, error) {
+	if 0 < len(args) {
+		return nil, NewFunctionArgumentLengthError(fn, fn.Name, []int{0})
+	}
+	if err := scope.CaughtError(); err != nil {
+		return value.NewString(err.Message()), nil
+	}
+	return value.NewNull(), nil
+}
+
 func JsonObject(ctx context.Context, scope *ReferenceScope, fn parser.Function) (value.Primary, error) {
 	if len(scope.Records) < 1 {
 		return value.NewNull(), nil
@@ -311,6 +311,38 @@ var roundTests = []functionTest{
 		},
 		Result: value.NewFloat(-2.46),
 	},
+	{
+		Name: "Round Decimal",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("2.345"),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("2.35"),
+	},
+	{
+		Name: "Round Decimal Negative Place",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("-1250.5"),
+			value.NewInteger(-2),
+		},
+		Result: value.NewDecimalFromString("-1300"),
+	},
+	{
+		Name: "Round Decimal Without Place",
+		Function: parser.Function{
+			Name: "round",
+		},
+		Args: []value.Primary{
+			value.NewDecimalFromString("2.5"),
+		},
+		Result: value.NewDecimalFromString("3"),
+	},
 	{
 		Name: "Round Null",
 		Function: parser.Function{
@@ -2090,6 +2122,28 @@ func TestInstr(t *testing.T) {
 }
 
 var listElemTests = []functionTest{
+	{
+		Name: "ListElem Array",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("abc"), value.NewString("def")}),
+			value.NewInteger(1),
+		},
+		Result: value.NewString("def"),
+	},
+	{
+		Name: "ListElem Array Index Out of Range",
+		Function: parser.Function{
+			Name: "list_elem",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("abc"), value.NewString("def")}),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
 	{
 		Name: "ListElem",
 		Function: parser.Function{
@@ -2176,6 +2230,56 @@ func TestListElem(t *testing.T) {
 	testFunction(t, ListElem, listElemTests)
 }
 
+var splitTests = []functionTest{
+	{
+		Name: "Split",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString("tag1;tag2;;tag3"),
+			value.NewString(";"),
+		},
+		Result: value.NewArray([]value.Primary{value.NewString("tag1"), value.NewString("tag2"), value.NewString(""), value.NewString("tag3")}),
+	},
+	{
+		Name: "Split Empty String",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString(""),
+			value.NewString(";"),
+		},
+		Result: value.NewArray([]value.Primary{}),
+	},
+	{
+		Name: "Split String is Null",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString(";"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Split Arguments Error",
+		Function: parser.Function{
+			Name: "split",
+		},
+		Args: []value.Primary{
+			value.NewString("tag1"),
+		},
+		Error: "function split takes exactly 2 arguments",
+	},
+}
+
+func TestSplit(t *testing.T) {
+	testFunction(t, Split, splitTests)
+}
+
 var replaceFnTests = []functionTest{
 	{
 		Name: "Replace",
@@ -2419,7 +2523,7 @@ var regExpFindSubMatchesTests = []functionTest{
 			value.NewString("abcdefg abcdefg"),
 			value.NewString("cd"),
 		},
-		Result: value.NewString("[\"cd\"]"),
+		Result: value.NewArray([]value.Primary{value.NewString("cd")}),
 	},
 	{
 		Name: "RegExpFindSubMatches Sub-Matching",
@@ -2430,7 +2534,7 @@ var regExpFindSubMatchesTests = []functionTest{
 			value.NewString("abcdefg abcdefg"),
 			value.NewString("b(c\\w+)e(f)"),
 		},
-		Result: value.NewString("[\"bcdef\",\"cd\",\"f\"]"),
+		Result: value.NewArray([]value.Primary{value.NewString("bcdef"), value.NewString("cd"), value.NewString("f")}),
 	},
 	{
 		Name: "RegExpFindSubMatches Not Match",
@@ -2480,7 +2584,10 @@ var regExpFindAllTests = []functionTest{
 			value.NewString("abcdefg abcdefg"),
 			value.NewString("cd"),
 		},
-		Result: value.NewString("[[\"cd\"],[\"cd\"]]"),
+		Result: value.NewArray([]value.Primary{
+			value.NewArray([]value.Primary{value.NewString("cd")}),
+			value.NewArray([]value.Primary{value.NewString("cd")}),
+		}),
 	},
 	{
 		Name: "RegExpFindAll Sub-Matching",
@@ -2491,7 +2598,10 @@ var regExpFindAllTests = []functionTest{
 			value.NewString("abcdefg abcdefg"),
 			value.NewString("b(c\\w+)e(f)"),
 		},
-		Result: value.NewString("[[\"bcdef\",\"cd\",\"f\"],[\"bcdef\",\"cd\",\"f\"]]"),
+		Result: value.NewArray([]value.Primary{
+			value.NewArray([]value.Primary{value.NewString("bcdef"), value.NewString("cd"), value.NewString("f")}),
+			value.NewArray([]value.Primary{value.NewString("bcdef"), value.NewString("cd"), value.NewString("f")}),
+		}),
 	},
 	{
 		Name: "RegExpFindAll Not Match",
@@ -2755,6 +2865,110 @@ func TestJsonValue(t *testing.T) {
 	testFunction(t, JsonValue, jsonValueTests)
 }
 
+var arrayLengthTests = []functionTest{
+	{
+		Name: "ArrayLength",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "ArrayLength Map",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewMap(0),
+		},
+		Result: value.NewInteger(0),
+	},
+	{
+		Name: "ArrayLength Not Array",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args: []value.Primary{
+			value.NewString("[1, 2]"),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ArrayLength Arguments Error",
+		Function: parser.Function{
+			Name: "array_length",
+		},
+		Args:  []value.Primary{},
+		Error: "function array_length takes exactly 1 argument",
+	},
+}
+
+func TestArrayLength(t *testing.T) {
+	testFunction(t, ArrayLength, arrayLengthTests)
+}
+
+var arrayContainsTests = []functionTest{
+	{
+		Name: "ArrayContains",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a"), value.NewInteger(2)}),
+			value.NewString("2"),
+		},
+		Result: value.NewTernary(ternary.TRUE),
+	},
+	{
+		Name: "ArrayContains Not Contained",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a"), value.NewString("c")}),
+			value.NewString("b"),
+		},
+		Result: value.NewTernary(ternary.FALSE),
+	},
+	{
+		Name: "ArrayContains Unknown",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewArray([]value.Primary{value.NewString("a"), value.NewNull()}),
+			value.NewString("b"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Not Array",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args: []value.Primary{
+			value.NewNull(),
+			value.NewString("b"),
+		},
+		Result: value.NewTernary(ternary.UNKNOWN),
+	},
+	{
+		Name: "ArrayContains Arguments Error",
+		Function: parser.Function{
+			Name: "array_contains",
+		},
+		Args:  []value.Primary{},
+		Error: "function array_contains takes exactly 2 arguments",
+	},
+}
+
+func TestArrayContains(t *testing.T) {
+	testFunction(t, ArrayContains, arrayContainsTests)
+}
+
 var md5Tests = []functionTest{
 	{
 		Name: "Md5",
@@ -3249,6 +3463,153 @@ func TestWeekOfYear(t *testing.T) {
 	testFunction(t, WeekOfYear, weekOfYearTests)
 }
 
+var extractTests = []functionTest{
+	{
+		Name: "Extract Datetime",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("month"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Result: value.NewInteger(2),
+	},
+	{
+		Name: "Extract Datetime Epoch",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("epoch"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 500000000, GetTestLocation())),
+		},
+		Result: value.NewFloat(1328260695.5),
+	},
+	{
+		Name: "Extract Datetime Second",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("second"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 500000000, GetTestLocation())),
+		},
+		Result: value.NewFloat(15.5),
+	},
+	{
+		Name: "Extract Interval",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("hour"),
+			value.NewInterval(14, 3, 14706500000000),
+		},
+		Result: value.NewInteger(4),
+	},
+	{
+		Name: "Extract Interval Year",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("year"),
+			value.NewInterval(14, 3, 14706500000000),
+		},
+		Result: value.NewInteger(1),
+	},
+	{
+		Name: "Extract Interval from String",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("second"),
+			value.NewString("1 day 04:05:06.5"),
+		},
+		Result: value.NewFloat(6.5),
+	},
+	{
+		Name: "Extract Interval Second",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("second"),
+			value.NewInterval(0, 0, 1500000000),
+		},
+		Result: value.NewFloat(1.5),
+	},
+	{
+		Name: "Extract Interval Whole Second",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("second"),
+			value.NewInterval(0, 0, 62000000000),
+		},
+		Result: value.NewFloat(2),
+	},
+	{
+		Name: "Extract Interval Epoch",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("epoch"),
+			value.NewInterval(0, 1, 1500000000),
+		},
+		Result: value.NewFloat(86401.5),
+	},
+	{
+		Name: "Extract Null",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("day"),
+			value.NewNull(),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Extract Invalid Interval Unit",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("weekday"),
+			value.NewInterval(0, 1, 0),
+		},
+		Error: "WEEKDAY is not an interval unit for function extract",
+	},
+	{
+		Name: "Extract Invalid Datetime Unit",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args: []value.Primary{
+			value.NewString("century"),
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123456789, GetTestLocation())),
+		},
+		Error: "CENTURY is not a datetime unit for function extract",
+	},
+	{
+		Name: "Extract Arguments Error",
+		Function: parser.Function{
+			Name: "extract",
+		},
+		Args:  []value.Primary{},
+		Error: "function extract takes exactly 2 arguments",
+	},
+}
+
+func TestExtract(t *testing.T) {
+	testFunction(t, Extract, extractTests)
+}
+
 var addYearTests = []functionTest{
 	{
 		Name: "AddYear",
@@ -4007,6 +4368,85 @@ func TestFloat(t *testing.T) {
 	testFunction(t, Float, floatTests)
 }
 
+var decimalTests = []functionTest{
+	{
+		Name: "Decimal from String",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("12.340"),
+		},
+		Result: value.NewDecimalFromString("12.340"),
+	},
+	{
+		Name: "Decimal with Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewFloat(2.675),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("2.68"),
+	},
+	{
+		Name: "Decimal with Larger Scale",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewInteger(3),
+			value.NewInteger(2),
+		},
+		Result: value.NewDecimalFromString("3.00"),
+	},
+	{
+		Name: "Decimal from Datetime",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 123450000, GetTestLocation())),
+		},
+		Result: value.NewDecimalFromString("1328260695.12345"),
+	},
+	{
+		Name: "Decimal Null",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("abc"),
+			value.NewInteger(2),
+		},
+		Result: value.NewNull(),
+	},
+	{
+		Name: "Decimal Invalid Scale Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args: []value.Primary{
+			value.NewString("1"),
+			value.NewInteger(-1),
+		},
+		Error: "the second argument must be an integer from 0 to 1000 for function decimal",
+	},
+	{
+		Name: "Decimal Arguments Error",
+		Function: parser.Function{
+			Name: "decimal",
+		},
+		Args:  []value.Primary{},
+		Error: "function decimal takes 1 or 2 arguments",
+	},
+}
+
+func TestDecimal(t *testing.T) {
+	testFunction(t, Decimal, decimalTests)
+}
+
 var booleanTests = []functionTest{
 	{
 		Name: "Boolean from String",
@@ -4287,6 +4727,128 @@ func TestNow(t *testing.T) {
 	}
 }
 
+func referenceScopeWithCaughtError(err error) *ReferenceScope {
+	scope := NewReferenceScope(TestTx).CreateChild()
+	scope.Blocks[0].caughtError = err.(Error)
+	return scope
+}
+
+var errorCodeTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Scope    *ReferenceScope
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "ErrorCode",
+		Function: parser.Function{
+			Name: "error_code",
+		},
+		Scope:  referenceScopeWithCaughtError(NewUserTriggeredError(parser.Trigger{Code: value.NewInteger(200)}, "user error")),
+		Result: value.NewInteger(200),
+	},
+	{
+		Name: "ErrorCode Outside Catch Block",
+		Function: parser.Function{
+			Name: "error_code",
+		},
+		Scope:  NewReferenceScope(TestTx),
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ErrorCode Arguments Error",
+		Function: parser.Function{
+			Name: "error_code",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "function error_code takes no argument",
+	},
+}
+
+func TestErrorCode(t *testing.T) {
+	for _, v := range errorCodeTests {
+		result, err := ErrorCode(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+var errorMessageTests = []struct {
+	Name     string
+	Function parser.Function
+	Args     []value.Primary
+	Scope    *ReferenceScope
+	Result   value.Primary
+	Error    string
+}{
+	{
+		Name: "ErrorMessage",
+		Function: parser.Function{
+			Name: "error_message",
+		},
+		Scope:  referenceScopeWithCaughtError(NewFieldNotExistError(parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 8}), Column: parser.Identifier{Literal: "notexist"}})),
+		Result: value.NewString("field notexist does not exist"),
+	},
+	{
+		Name: "ErrorMessage Outside Catch Block",
+		Function: parser.Function{
+			Name: "error_message",
+		},
+		Scope:  NewReferenceScope(TestTx),
+		Result: value.NewNull(),
+	},
+	{
+		Name: "ErrorMessage Arguments Error",
+		Function: parser.Function{
+			Name: "error_message",
+		},
+		Args: []value.Primary{
+			value.NewInteger(1),
+		},
+		Scope: NewReferenceScope(TestTx),
+		Error: "function error_message takes no argument",
+	},
+}
+
+func TestErrorMessage(t *testing.T) {
+	for _, v := range errorMessageTests {
+		result, err := ErrorMessage(v.Scope, v.Function, v.Args)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
 var jsonObjectTests = []struct {
 	Name               string
 	Function           parser.Function
@@ -0,0 +1,178 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// MaxGroupingKeys is the maximum number of the fields that can be used as group keys in grouping sets.
+const MaxGroupingKeys = 63
+
+// groupingSets holds the positions of the fields added to records grouped by grouping sets.
+type groupingSets struct {
+	keys      []int // Indices of the group key fields
+	originals []int // Indices of the hidden fields holding the original values of the group keys
+	idIndex   int   // Index of the hidden field holding the bits representing the aggregated group keys
+}
+
+// restore returns a record in which the aggregated group keys are replaced with the original values.
+func (g *groupingSets) restore(record Record) Record {
+	restored := record.Copy()
+	for i, idx := range g.keys {
+		restored[idx] = record[g.originals[i]]
+	}
+	return restored
+}
+
+// isAggregated reports whether the field is aggregated in the grouping set of the record.
+// The second return value is false if the field is not included in any grouping set.
+func (g *groupingSets) isAggregated(record Record, fieldIndex int) (bool, bool) {
+	for i, idx := range g.keys {
+		if idx == fieldIndex {
+			bits := record[g.idIndex][0].(*value.Integer).Raw()
+			return bits&(1<<uint(i)) != 0, true
+		}
+	}
+	return false, false
+}
+
+func HasGroupingSets(items []parser.QueryExpression) bool {
+	for _, item := range items {
+		if _, ok := item.(parser.GroupingSets); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandGroupingSets returns the lists of expressions for each grouping set.
+// Multiple items are combined by the cartesian product of their grouping sets.
+func ExpandGroupingSets(items []parser.QueryExpression) [][]parser.QueryExpression {
+	sets := [][]parser.QueryExpression{{}}
+
+	for _, item := range items {
+		var itemSets [][]parser.QueryExpression
+		if gs, ok := item.(parser.GroupingSets); ok {
+			itemSets = expandGroupingSetsExpression(gs)
+		} else {
+			itemSets = [][]parser.QueryExpression{{item}}
+		}
+
+		combined := make([][]parser.QueryExpression, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				s := make([]parser.QueryExpression, 0, len(set)+len(itemSet))
+				s = append(s, set...)
+				s = append(s, itemSet...)
+				combined = append(combined, s)
+			}
+		}
+		sets = combined
+	}
+
+	return sets
+}
+
+func expandGroupingSetsExpression(expr parser.GroupingSets) [][]parser.QueryExpression {
+	var sets [][]parser.QueryExpression
+
+	switch expr.Type.Token {
+	case parser.ROLLUP:
+		sets = make([][]parser.QueryExpression, 0, len(expr.Items)+1)
+		for i := len(expr.Items); 0 <= i; i-- {
+			set := make([]parser.QueryExpression, 0, i)
+			for _, item := range expr.Items[:i] {
+				set = append(set, groupingElementItems(item)...)
+			}
+			sets = append(sets, set)
+		}
+	case parser.CUBE:
+		n := len(expr.Items)
+		sets = make([][]parser.QueryExpression, 0, 1<<uint(n))
+		for bits := 1<<uint(n) - 1; 0 <= bits; bits-- {
+			set := make([]parser.QueryExpression, 0, n)
+			for i, item := range expr.Items {
+				if bits&(1<<uint(n-1-i)) != 0 {
+					set = append(set, groupingElementItems(item)...)
+				}
+			}
+			sets = append(sets, set)
+		}
+	default: // GROUPING SETS
+		sets = make([][]parser.QueryExpression, 0, len(expr.Items))
+		for _, item := range expr.Items {
+			if gs, ok := item.(parser.GroupingSets); ok {
+				sets = append(sets, expandGroupingSetsExpression(gs)...)
+			} else {
+				sets = append(sets, groupingElementItems(item))
+			}
+		}
+	}
+
+	return sets
+}
+
+// groupingElementItems returns the expressions in an element of grouping sets.
+// Parenthesized lists such as "(a, b)" are treated as composite elements.
+func groupingElementItems(expr parser.QueryExpression) []parser.QueryExpression {
+	switch e := expr.(type) {
+	case parser.Parentheses:
+		return []parser.QueryExpression{e.Expr}
+	case parser.RowValue:
+		if list, ok := e.Value.(parser.ValueList); ok {
+			return list.Values
+		}
+	}
+	return []parser.QueryExpression{expr}
+}
+
+// Grouping returns an integer whose bits represent whether each argument is aggregated in the current record.
+func Grouping(scope *ReferenceScope, expr parser.Function) (value.Primary, error) {
+	if len(expr.Args) < 1 {
+		return nil, NewFunctionArgumentLengthErrorWithCustomArgs(expr, expr.Name, "at least 1 argument")
+	}
+	if len(scope.Records) < 1 || !scope.Records[0].view.isGrouped {
+		return nil, NewNotGroupingRecordsError(expr, strings.ToUpper(expr.Name))
+	}
+
+	var bits int64
+	for _, arg := range expr.Args {
+		bits = bits << 1
+
+		switch arg.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+		default:
+			return nil, NewFieldNotGroupKeyError(arg)
+		}
+
+		found := false
+		for i := range scope.Records {
+			idx, err := scope.Records[i].view.Header.SearchIndex(arg)
+			if err != nil {
+				if err == errFieldAmbiguous {
+					return nil, NewFieldAmbiguousError(arg)
+				}
+				continue
+			}
+
+			view := scope.Records[i].view
+			if !view.isGrouped || !view.Header[idx].IsGroupKey {
+				return nil, NewFieldNotGroupKeyError(arg)
+			}
+			if view.grouping != nil && scope.Records[i].IsInRange() {
+				if aggregated, _ := view.grouping.isAggregated(view.RecordSet[scope.Records[i].recordIndex], idx); aggregated {
+					bits = bits | 1
+				}
+			}
+			found = true
+			break
+		}
+		if !found {
+			return nil, NewFieldNotExistError(arg)
+		}
+	}
+
+	return value.NewInteger(bits), nil
+}
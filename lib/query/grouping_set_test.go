@@ -0,0 +1,153 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var expandGroupingSetsTests = []struct {
+	Name   string
+	Items  []parser.QueryExpression
+	Result string
+}{
+	{
+		Name: "Rollup",
+		Items: []parser.QueryExpression{
+			parser.GroupingSets{
+				Type:  parser.Token{Token: parser.ROLLUP},
+				Items: []parser.QueryExpression{parser.Identifier{Literal: "a"}, parser.Identifier{Literal: "b"}},
+			},
+		},
+		Result: "[[a b] [a] []]",
+	},
+	{
+		Name: "Cube",
+		Items: []parser.QueryExpression{
+			parser.GroupingSets{
+				Type:  parser.Token{Token: parser.CUBE},
+				Items: []parser.QueryExpression{parser.Identifier{Literal: "a"}, parser.Identifier{Literal: "b"}},
+			},
+		},
+		Result: "[[a b] [a] [b] []]",
+	},
+	{
+		Name: "Grouping Sets",
+		Items: []parser.QueryExpression{
+			parser.GroupingSets{
+				Type: parser.Token{Token: parser.GROUPING},
+				Items: []parser.QueryExpression{
+					parser.Parentheses{Expr: parser.Identifier{Literal: "a"}},
+					parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.Identifier{Literal: "a"}, parser.Identifier{Literal: "b"}}}},
+					parser.RowValue{Value: parser.ValueList{}},
+				},
+			},
+		},
+		Result: "[[a] [a b] []]",
+	},
+	{
+		Name: "Combination of Group Keys and Rollup with Composite Element",
+		Items: []parser.QueryExpression{
+			parser.Identifier{Literal: "a"},
+			parser.GroupingSets{
+				Type: parser.Token{Token: parser.ROLLUP},
+				Items: []parser.QueryExpression{
+					parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.Identifier{Literal: "b"}, parser.Identifier{Literal: "c"}}}},
+				},
+			},
+		},
+		Result: "[[a b c] [a]]",
+	},
+}
+
+func TestExpandGroupingSets(t *testing.T) {
+	for _, v := range expandGroupingSetsTests {
+		sets := ExpandGroupingSets(v.Items)
+		result := "["
+		for i, set := range sets {
+			if 0 < i {
+				result += " "
+			}
+			result += "["
+			for j, item := range set {
+				if 0 < j {
+					result += " "
+				}
+				result += item.String()
+			}
+			result += "]"
+		}
+		result += "]"
+
+		if result != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, result, v.Result)
+		}
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	view := &View{
+		Header: NewHeader("table1", []string{"column1", "column2", "column3"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(2), value.NewInteger(1)}),
+		},
+	}
+	err := view.GroupBy(ctx, scope, parser.GroupByClause{
+		Items: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+			parser.GroupingSets{
+				Type: parser.Token{Token: parser.CUBE},
+				Items: []parser.QueryExpression{
+					parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+
+	expr := parser.Function{
+		Name: "grouping",
+		Args: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+			parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+		},
+	}
+
+	expect := []value.Primary{
+		value.NewInteger(0),
+		value.NewInteger(0),
+		value.NewInteger(2),
+		value.NewInteger(2),
+		value.NewInteger(4),
+		value.NewInteger(4),
+		value.NewInteger(6),
+	}
+	result := make([]value.Primary, view.RecordLen())
+	for i := range view.RecordSet {
+		if result[i], err = Grouping(scope.CreateScopeForRecordEvaluation(view, i), expr); err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+	}
+	if !reflect.DeepEqual(result, expect) {
+		t.Errorf("result = %s, want %s", result, expect)
+	}
+
+	_, err = Grouping(scope.CreateScopeForRecordEvaluation(view, 0), parser.Function{
+		Name: "grouping",
+		Args: []parser.QueryExpression{parser.NewIntegerValue(1)},
+	})
+	if err == nil || err.Error() != "field 1 is not a group key" {
+		t.Errorf("error = %v, want %q", err, "field 1 is not a group key")
+	}
+}
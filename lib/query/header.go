@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/parser"
 )
 
@@ -19,6 +20,24 @@ type HeaderField struct {
 	IsFromTable  bool
 	IsJoinColumn bool
 	IsGroupKey   bool
+
+	Type    ColumnType
+	NotNull bool
+}
+
+func (f HeaderField) HasConstraint() bool {
+	return f.Type != ColumnTypeAny || f.NotNull
+}
+
+func (f HeaderField) ColumnDefinition() parser.ColumnDefinition {
+	def := parser.ColumnDefinition{
+		Column:  parser.Identifier{Literal: f.Column, Quoted: option.MustBeEnclosed(f.Column)},
+		NotNull: f.NotNull,
+	}
+	if f.Type != ColumnTypeAny {
+		def.Type = parser.Identifier{Literal: f.Type.String()}
+	}
+	return def
 }
 
 var errFieldAmbiguous = errors.New("field ambiguous")
@@ -3,8 +3,11 @@ package query
 import (
 	"context"
 	"math"
+	"sort"
+	"strconv"
 	"sync"
 
+	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
 
@@ -138,6 +141,14 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	mergedHeader := view.Header.Merge(joinView.Header)
 
+	var candidates [][]int
+	if viewKeyIndices, joinViewKeyIndices := EquiJoinKeyIndices(condition, mergedHeader, view.FieldLen()); 0 < len(viewKeyIndices) {
+		var err error
+		if candidates, err = HashJoinCandidates(ctx, scope.Tx.Flags, view, viewKeyIndices, joinView, joinViewKeyIndices); err != nil {
+			return err
+		}
+	}
+
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
 	recordsList := make([]RecordSet, gm.Number)
 
@@ -167,7 +178,8 @@ func InnerJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 
 	InnerJoinLoop:
 		for i := start; i < end; i++ {
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for c, n := 0, joinLoopLen(candidates, i, joinView); c < n; c++ {
+				j := joinLoopIndex(candidates, i, c)
 				if gm.HasError() {
 					break InnerJoinLoop
 				}
@@ -232,9 +244,19 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	}
 
 	mergedHeader := view.Header.Merge(joinView.Header)
+	leftKeyIndices, rightKeyIndices := EquiJoinKeyIndices(condition, mergedHeader, view.FieldLen())
 
 	if direction == parser.RIGHT {
 		view, joinView = joinView, view
+		leftKeyIndices, rightKeyIndices = rightKeyIndices, leftKeyIndices
+	}
+
+	var candidates [][]int
+	if 0 < len(leftKeyIndices) {
+		var err error
+		if candidates, err = HashJoinCandidates(ctx, scope.Tx.Flags, view, leftKeyIndices, joinView, rightKeyIndices); err != nil {
+			return err
+		}
 	}
 
 	gm := NewGoroutineTaskManager(view.RecordLen(), CalcMinimumRequired(view.RecordLen(), joinView.RecordLen(), MinimumRequiredPerCPUCore), scope.Tx.Flags.CPU)
@@ -277,7 +299,8 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	OuterJoinLoop:
 		for i := start; i < end; i++ {
 			match := false
-			for j := 0; j < joinView.RecordLen(); j++ {
+			for c, n := 0, joinLoopLen(candidates, i, joinView); c < n; c++ {
+				j := joinLoopIndex(candidates, i, c)
 				if gm.HasError() {
 					break OuterJoinLoop
 				}
@@ -399,6 +422,275 @@ func OuterJoin(ctx context.Context, scope *ReferenceScope, view *View, joinView
 	return nil
 }
 
+func joinLoopLen(candidates [][]int, viewIndex int, joinView *View) int {
+	if candidates == nil {
+		return joinView.RecordLen()
+	}
+	return len(candidates[viewIndex])
+}
+
+func joinLoopIndex(candidates [][]int, viewIndex int, loopIndex int) int {
+	if candidates == nil {
+		return loopIndex
+	}
+	return candidates[viewIndex][loopIndex]
+}
+
+// EquiJoinKeyIndices extracts the equality comparisons combined with AND from a join condition
+// whose operands are fields of each side of the join, and returns the field indices of the left
+// side and the right side.
+// Indices of the right side are relative to the beginning of the right view's header.
+func EquiJoinKeyIndices(condition parser.QueryExpression, mergedHeader Header, leftFieldLen int) ([]int, []int) {
+	var leftIndices []int
+	var rightIndices []int
+
+	var fieldIndex = func(expr parser.QueryExpression) int {
+		switch expr.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			if idx, err := mergedHeader.SearchIndex(expr); err == nil {
+				return idx
+			}
+		}
+		return -1
+	}
+
+	var extract func(parser.QueryExpression)
+	extract = func(expr parser.QueryExpression) {
+		switch e := expr.(type) {
+		case parser.Parentheses:
+			extract(e.Expr)
+		case parser.Logic:
+			if e.Operator.Token == parser.AND {
+				extract(e.LHS)
+				extract(e.RHS)
+			}
+		case parser.Comparison:
+			if e.Operator.Literal != "=" {
+				return
+			}
+
+			lhs := fieldIndex(e.LHS)
+			rhs := fieldIndex(e.RHS)
+			if lhs < 0 || rhs < 0 {
+				return
+			}
+
+			if rhs < lhs {
+				lhs, rhs = rhs, lhs
+			}
+			if lhs < leftFieldLen && leftFieldLen <= rhs {
+				leftIndices = append(leftIndices, lhs)
+				rightIndices = append(rightIndices, rhs-leftFieldLen)
+			}
+		}
+	}
+
+	if condition != nil {
+		extract(condition)
+	}
+	return leftIndices, rightIndices
+}
+
+// HashJoinCandidates builds a hash table on the smaller view and returns, for each record of the view,
+// the ascending indices of the records of the joinView that can satisfy the equality comparisons
+// on the specified fields.
+// Candidates are not guaranteed to satisfy the join condition, so the condition must be evaluated for them.
+func HashJoinCandidates(ctx context.Context, flags *option.Flags, view *View, viewKeyIndices []int, joinView *View, joinViewKeyIndices []int) ([][]int, error) {
+	candidates := make([][]int, view.RecordLen())
+
+	if view.RecordLen() <= joinView.RecordLen() {
+		table, err := buildJoinHashTable(ctx, flags, view, viewKeyIndices)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range joinView.RecordSet {
+			if j&1023 == 0 && ctx.Err() != nil {
+				return nil, ConvertContextError(ctx.Err())
+			}
+
+			for _, key := range joinKeys(joinView.RecordSet[j], joinViewKeyIndices, flags) {
+				for _, i := range table[key] {
+					if c := candidates[i]; 0 < len(c) && c[len(c)-1] == j {
+						continue
+					}
+					candidates[i] = append(candidates[i], j)
+				}
+			}
+		}
+	} else {
+		table, err := buildJoinHashTable(ctx, flags, joinView, joinViewKeyIndices)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range view.RecordSet {
+			if i&1023 == 0 && ctx.Err() != nil {
+				return nil, ConvertContextError(ctx.Err())
+			}
+
+			keys := joinKeys(view.RecordSet[i], viewKeyIndices, flags)
+			switch len(keys) {
+			case 0:
+			case 1:
+				candidates[i] = table[keys[0]]
+			default:
+				var c []int
+				for _, key := range keys {
+					c = append(c, table[key]...)
+				}
+				sort.Ints(c)
+				candidates[i] = uniqueSortedIndices(c)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+func buildJoinHashTable(ctx context.Context, flags *option.Flags, view *View, keyIndices []int) (map[string][]int, error) {
+	table := make(map[string][]int, view.RecordLen())
+	for i := range view.RecordSet {
+		if i&1023 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for _, key := range joinKeys(view.RecordSet[i], keyIndices, flags) {
+			if list := table[key]; 0 < len(list) && list[len(list)-1] == i {
+				continue
+			}
+			table[key] = append(table[key], i)
+		}
+	}
+	return table, nil
+}
+
+func uniqueSortedIndices(indices []int) []int {
+	n := 0
+	for i := range indices {
+		if 0 < n && indices[n-1] == indices[i] {
+			continue
+		}
+		indices[n] = indices[i]
+		n++
+	}
+	return indices[:n]
+}
+
+// joinKeys returns the serialized keys of the record for a hash join.
+// A record has multiple keys when its values can be equal to other values as different types,
+// and has no key when any of the values cannot be equal to any value.
+func joinKeys(record Record, keyIndices []int, flags *option.Flags) []string {
+	keys := []string{""}
+
+	for i, idx := range keyIndices {
+		if len(record[idx]) < 1 {
+			return nil
+		}
+
+		fragments := joinKeyFragments(record[idx][0], flags)
+		if len(fragments) < 1 {
+			return nil
+		}
+
+		if len(fragments) == 1 && len(keys) == 1 {
+			if 0 < i {
+				keys[0] = keys[0] + ":" + fragments[0]
+			} else {
+				keys[0] = fragments[0]
+			}
+			continue
+		}
+
+		combined := make([]string, 0, len(keys)*len(fragments))
+		for _, key := range keys {
+			for _, fragment := range fragments {
+				if 0 < i {
+					combined = append(combined, key+":"+fragment)
+				} else {
+					combined = append(combined, fragment)
+				}
+			}
+		}
+		keys = combined
+	}
+
+	return keys
+}
+
+// joinKeyFragments returns a serialized key for each type that the value can be compared as
+// by the "=" operator.
+func joinKeyFragments(val value.Primary, flags *option.Flags) []string {
+	if value.IsNull(val) {
+		return nil
+	}
+
+	switch val.(type) {
+	case *value.Array, *value.Map:
+		return structureJoinKeyFragments(val, flags)
+	}
+
+	fragments := make([]string, 0, 4)
+	buf := GetComparisonKeysBuf()
+
+	if f := value.ToFloat(val); !value.IsNull(f) {
+		if fv := f.(*value.Float).Raw(); !math.IsNaN(fv) {
+			if fv == 0 {
+				// Negative zero is equal to positive zero.
+				fv = 0
+			}
+			serializeFloat(buf, value.Float64ToStr(fv, true))
+			fragments = append(fragments, buf.String())
+			buf.Reset()
+		}
+		value.Discard(f)
+	}
+
+	if dt := value.ToDatetime(val, flags.DatetimeFormat, flags.GetTimeLocation()); !value.IsNull(dt) {
+		serializeDatetime(buf, dt.(*value.Datetime).Raw())
+		fragments = append(fragments, buf.String())
+		buf.Reset()
+		value.Discard(dt)
+	}
+
+	if b := value.ToBoolean(val); !value.IsNull(b) {
+		serializeBoolean(buf, b.(*value.Boolean).Raw())
+		fragments = append(fragments, buf.String())
+		buf.Reset()
+	}
+
+	if iv := value.ToInterval(val); !value.IsNull(iv) {
+		// Intervals are compared by their total length, so "1 day" is equal to "24 hours".
+		serializeInterval(buf, iv.(*value.Interval).TotalNanoseconds().String())
+		fragments = append(fragments, buf.String())
+		buf.Reset()
+	}
+
+	if s, ok := val.(*value.String); ok {
+		serializeString(buf, s.Raw())
+		fragments = append(fragments, buf.String())
+	}
+
+	PutComparisonkeysBuf(buf)
+	return fragments
+}
+
+// structureJoinKeyFragments returns fragments for an array or a map.
+// Structures are equal to structures of the same kind and length with equal elements,
+// and to strings equal to their encoded forms.
+func structureJoinKeyFragments(val value.Primary, flags *option.Flags) []string {
+	buf := GetComparisonKeysBuf()
+	switch v := val.(type) {
+	case *value.Array:
+		serializeStructure(buf, "A"+strconv.Itoa(v.Len()))
+	case *value.Map:
+		serializeStructure(buf, "M"+strconv.Itoa(v.Len()))
+	}
+	fragments := append(joinKeyFragments(value.ToString(val), flags), buf.String())
+	PutComparisonkeysBuf(buf)
+	return fragments
+}
+
 func CalcMinimumRequired(i1 int, i2 int, defaultMinimumRequired int) int {
 	if i1 < 1 || i2 < 1 {
 		return defaultMinimumRequired
@@ -1003,6 +1003,222 @@ func TestOuterJoin(t *testing.T) {
 	}
 }
 
+var equiJoinKeyIndicesTests = []struct {
+	Name         string
+	Condition    parser.QueryExpression
+	LeftIndices  []int
+	RightIndices []int
+}{
+	{
+		Name: "Single Equality",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+		},
+		LeftIndices:  []int{1},
+		RightIndices: []int{1},
+	},
+	{
+		Name: "Equalities Combined with AND",
+		Condition: parser.Logic{
+			LHS: parser.Parentheses{
+				Expr: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+					RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+				},
+			},
+			RHS: parser.Logic{
+				LHS: parser.Comparison{
+					LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+					RHS:      parser.NewIntegerValue(1),
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+				},
+				RHS: parser.Comparison{
+					LHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table1"}, Number: value.NewInteger(1)},
+					RHS:      parser.ColumnNumber{View: parser.Identifier{Literal: "table2"}, Number: value.NewInteger(1)},
+					Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+				},
+				Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+			},
+			Operator: parser.Token{Token: parser.AND, Literal: "AND"},
+		},
+		LeftIndices:  []int{2, 1},
+		RightIndices: []int{2, 1},
+	},
+	{
+		Name: "Equalities Combined with OR",
+		Condition: parser.Logic{
+			LHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+			},
+			RHS: parser.Comparison{
+				LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+				RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+			},
+			Operator: parser.Token{Token: parser.OR, Literal: "OR"},
+		},
+	},
+	{
+		Name: "Non-Equality Comparison",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column1"}},
+			Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "<"},
+		},
+	},
+	{
+		Name: "Equality on the Same Side",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table1"}, Column: parser.Identifier{Literal: "column2"}},
+			Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+		},
+	},
+	{
+		Name: "Ambiguous Field",
+		Condition: parser.Comparison{
+			LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			RHS:      parser.FieldReference{View: parser.Identifier{Literal: "table2"}, Column: parser.Identifier{Literal: "column3"}},
+			Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+		},
+	},
+}
+
+func TestEquiJoinKeyIndices(t *testing.T) {
+	header := NewHeaderWithId("table1", []string{"column1", "column2"}).Merge(NewHeaderWithId("table2", []string{"column1", "column3"}))
+
+	for _, v := range equiJoinKeyIndicesTests {
+		leftIndices, rightIndices := EquiJoinKeyIndices(v.Condition, header, 3)
+		if !reflect.DeepEqual(leftIndices, v.LeftIndices) {
+			t.Errorf("%s: left indices = %v, want %v", v.Name, leftIndices, v.LeftIndices)
+		}
+		if !reflect.DeepEqual(rightIndices, v.RightIndices) {
+			t.Errorf("%s: right indices = %v, want %v", v.Name, rightIndices, v.RightIndices)
+		}
+	}
+}
+
+var hashJoinCandidatesTests = []struct {
+	Name     string
+	View     *View
+	JoinView *View
+	Result   [][]int
+}{
+	{
+		Name: "Build on View",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("abc")}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString(" ABC ")}),
+				NewRecord([]value.Primary{value.NewFloat(1)}),
+				NewRecord([]value.Primary{value.NewNull()}),
+				NewRecord([]value.Primary{value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewString("1")}),
+			},
+		},
+		Result: [][]int{
+			{1, 3, 4},
+			{0},
+		},
+	},
+	{
+		Name: "Build on JoinView",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1")}),
+				NewRecord([]value.Primary{value.NewString("2012-02-03 09:18:15")}),
+				NewRecord([]value.Primary{value.NewNull()}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewBoolean(true)}),
+				NewRecord([]value.Primary{value.NewInteger(1)}),
+			},
+		},
+		Result: [][]int{
+			{0, 1},
+			nil,
+			nil,
+		},
+	},
+	{
+		Name: "Interval and Decimal Keys",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInterval(0, 1, 0)}),
+				NewRecord([]value.Primary{value.NewDecimalFromString("1.10")}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInterval(0, 0, 24*3600*1000000000)}),
+				NewRecord([]value.Primary{value.NewString("24 hours")}),
+				NewRecord([]value.Primary{value.NewFloat(1.1)}),
+				NewRecord([]value.Primary{value.NewString("1.1")}),
+				NewRecord([]value.Primary{value.NewInterval(0, 2, 0)}),
+			},
+		},
+		Result: [][]int{
+			{0, 1},
+			{2, 3},
+		},
+	},
+	{
+		Name: "Structure Keys",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewArray([]value.Primary{value.NewInteger(1), value.NewInteger(2)})}),
+				NewRecord([]value.Primary{value.NewMap(0)}),
+			},
+		},
+		JoinView: &View{
+			Header: NewHeader("table2", []string{"column1"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewArray([]value.Primary{value.NewFloat(1), value.NewString("2")})}),
+				NewRecord([]value.Primary{value.NewString("[1,2]")}),
+				NewRecord([]value.Primary{value.NewArray([]value.Primary{value.NewInteger(1)})}),
+				NewRecord([]value.Primary{value.NewMap(0)}),
+				NewRecord([]value.Primary{value.NewString("{}")}),
+			},
+		},
+		Result: [][]int{
+			{0, 1},
+			{3, 4},
+		},
+	},
+}
+
+func TestHashJoinCandidates(t *testing.T) {
+	for _, v := range hashJoinCandidatesTests {
+		result, err := HashJoinCandidates(context.Background(), TestTx.Flags, v.View, []int{0}, v.JoinView, []int{0})
+		if err != nil {
+			t.Errorf("%s: unexpected error %q", v.Name, err)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Name, result, v.Result)
+		}
+	}
+}
+
 var calcMinimumRequiredTests = []struct {
 	Int1    int
 	Int2    int
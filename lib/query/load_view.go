@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,8 +16,12 @@ import (
 	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/json"
 	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parquet"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+	"github.com/mithrandie/csvq/lib/xlsx"
+	"github.com/mithrandie/csvq/lib/xml"
+	"github.com/mithrandie/csvq/lib/yaml"
 
 	"github.com/mithrandie/go-text"
 	"github.com/mithrandie/go-text/csv"
@@ -89,6 +94,11 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		return loadView(ctx, scope, parentheses.Expr, forUpdate, useInternalId)
 	}
 
+	scope.explain.open()
+	defer scope.explain.close()
+	start := scope.explain.now()
+	explainOperation := "Load"
+
 	table := tableExpr.(parser.Table)
 	tableName, err := ParseTableName(ctx, scope, table)
 	if err != nil {
@@ -98,6 +108,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 	switch table.Object.(type) {
 	case parser.Dual:
 		view = NewDualView()
+		explainOperation = "Dual"
 	case parser.FormatSpecifiedFunction:
 		formatSpecifiedFunction := table.Object.(parser.FormatSpecifiedFunction)
 		tablePath := formatSpecifiedFunction.Path
@@ -144,6 +155,8 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		encodingIdx := 0
 		noHeaderIdx := 1
 		withoutNullIdx := 2
+		sheetIdx := -1
+		rangeIdx := -1
 
 		switch formatSpecifiedFunction.Type.Token {
 		case parser.CSV:
@@ -216,11 +229,44 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 			options.Format = option.LTSV
 			withoutNullIdx, noHeaderIdx = noHeaderIdx, withoutNullIdx
+		case parser.XLSX:
+			if 4 < len(formatSpecifiedFunction.Args) {
+				return nil, NewTableObjectArgumentsLengthError(formatSpecifiedFunction, 5)
+			}
+			options.Format = option.XLSX
+			options.Encoding = text.UTF8
+			sheetIdx, rangeIdx, noHeaderIdx, withoutNullIdx, encodingIdx = 0, 1, 2, 3, -1
+		case parser.PARQUET:
+			if 0 < len(formatSpecifiedFunction.Args) {
+				return nil, NewTableObjectArgumentsLengthError(formatSpecifiedFunction, 1)
+			}
+			options.Format = option.PARQUET
+			options.Encoding = text.UTF8
+		case parser.XML, parser.YAML:
+			if felem != nil && value.IsNull(felem) {
+				return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, fmt.Sprintf("cannot be converted as a string: %s", formatSpecifiedFunction.FormatElement.String()))
+			}
+			if 0 < len(formatSpecifiedFunction.Args) {
+				return nil, NewTableObjectArgumentsLengthError(formatSpecifiedFunction, 2)
+			}
+
+			var s string
+			if felem != nil {
+				s = felem.(*value.String).Raw()
+			}
+			options.Encoding = text.UTF8
+			if formatSpecifiedFunction.Type.Token == parser.XML {
+				options.XmlRowPath = s
+				options.Format = option.XML
+			} else {
+				options.JsonQuery = s
+				options.Format = option.YAML
+			}
 		default:
 			return nil, NewInvalidTableObjectError(formatSpecifiedFunction, formatSpecifiedFunction.Type.Literal)
 		}
 
-		args := make([]value.Primary, 3)
+		args := make([]value.Primary, 4)
 		defer func() {
 			for i := range args {
 				if args[i] != nil {
@@ -239,7 +285,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				if col, ok := fr.Column.(parser.Identifier); ok {
 					a = parser.NewStringValue(col.Literal)
 				} else {
-					return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, fmt.Sprintf("cannot be converted as an argument: %s", formatSpecifiedFunction.Args[encodingIdx].String()))
+					return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, fmt.Sprintf("cannot be converted as an argument: %s", formatSpecifiedFunction.Args[i].String()))
 				}
 			}
 			if pv, err := Evaluate(ctx, scope, a); err == nil {
@@ -247,6 +293,20 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 
 			switch i {
+			case sheetIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, fmt.Sprintf("cannot be converted as a sheet value: %s", formatSpecifiedFunction.Args[sheetIdx].String()))
+				}
+			case rangeIdx:
+				v := value.ToString(p)
+				if !value.IsNull(v) {
+					args[i] = v
+				} else {
+					return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, fmt.Sprintf("cannot be converted as a range value: %s", formatSpecifiedFunction.Args[rangeIdx].String()))
+				}
 			case encodingIdx:
 				v := value.ToString(p)
 				if !value.IsNull(v) {
@@ -271,11 +331,17 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			}
 		}
 
-		if args[encodingIdx] != nil {
-			if options.Encoding, err = option.ParseEncoding(args[0].(*value.String).Raw()); err != nil {
+		if -1 < encodingIdx && args[encodingIdx] != nil {
+			if options.Encoding, err = option.ParseEncoding(args[encodingIdx].(*value.String).Raw()); err != nil {
 				return nil, NewTableObjectInvalidArgumentError(formatSpecifiedFunction, err.Error())
 			}
 		}
+		if -1 < sheetIdx && args[sheetIdx] != nil {
+			options.XlsxSheet = option.TrimSpace(args[sheetIdx].(*value.String).Raw())
+		}
+		if -1 < rangeIdx && args[rangeIdx] != nil {
+			options.XlsxRange = strings.ToUpper(option.TrimSpace(args[rangeIdx].(*value.String).Raw()))
+		}
 		if args[noHeaderIdx] != nil {
 			options.NoHeader = args[noHeaderIdx].(*value.Boolean).Raw()
 		}
@@ -297,7 +363,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 			return nil, err
 		}
 
-	case parser.Identifier, parser.Url, parser.TableFunction, parser.Stdin:
+	case parser.Identifier, parser.Url, parser.TableFunction, parser.Stdin, parser.ArchiveMember:
 		options := scope.Tx.Flags.ImportOptions.Copy()
 		options.Format = option.AutoSelect
 
@@ -333,12 +399,20 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				return nil, err
 			}
 
-			subquery := t.Object.(parser.Subquery)
+			start = scope.explain.now()
+			explainOperation = explainLateralJoin
+
 			var hfields Header
 			resultSetList := make([]RecordSet, view.RecordLen())
 
 			if err := EvaluateSequentially(ctx, scope, view, func(seqScope *ReferenceScope, rIdx int) error {
-				appliedView, err := Select(ctx, seqScope, subquery.Query)
+				var appliedView *View
+				var err error
+				if unnest, ok := t.Object.(parser.Unnest); ok {
+					appliedView, err = loadUnnestView(ctx, seqScope, unnest, joinTableName)
+				} else {
+					appliedView, err = Select(ctx, seqScope, t.Object.(parser.Subquery).Query)
+				}
 				if err != nil {
 					return err
 				}
@@ -380,6 +454,11 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 				return nil, err
 			}
 
+			start = scope.explain.now()
+			if scope.explain != nil {
+				explainOperation = explainJoinOperation(join, view, joinView)
+			}
+
 			if err = joinViews(ctx, scope, view, joinView, join); err != nil {
 				return nil, err
 			}
@@ -391,6 +470,7 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		if err != nil {
 			return nil, err
 		}
+		start = scope.explain.now()
 
 		if 0 < len(tableName.Literal) {
 			if err := scope.AddAlias(tableName, ""); err != nil {
@@ -405,9 +485,75 @@ func loadView(ctx context.Context, scope *ReferenceScope, tableExpr parser.Query
 		if view.FileInfo != nil {
 			view.FileInfo.ViewType = ViewTypeInlineTable
 		}
+		explainOperation = "Subquery"
+
+	case parser.Unnest:
+		view, err = loadUnnestView(ctx, scope, table.Object.(parser.Unnest), tableName)
+		if err != nil {
+			return nil, err
+		}
+		start = scope.explain.now()
+
+		if 0 < len(tableName.Literal) {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+		}
+		explainOperation = "Unnest"
+
+	case parser.Pivot, parser.Unpivot:
+		var source parser.QueryExpression
+		if pivot, ok := table.Object.(parser.Pivot); ok {
+			source = pivot.Table
+		} else {
+			source = table.Object.(parser.Unpivot).Table
+		}
+
+		view, err = loadView(ctx, scope, source, false, false)
+		if err != nil {
+			return nil, err
+		}
+		start = scope.explain.now()
+
+		switch obj := table.Object.(type) {
+		case parser.Pivot:
+			view, err = PivotView(ctx, scope, view, obj)
+			explainOperation = "Pivot"
+		case parser.Unpivot:
+			view, err = UnpivotView(ctx, scope, view, obj)
+			explainOperation = "Unpivot"
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if 0 < len(tableName.Literal) {
+			if err := scope.AddAlias(tableName, ""); err != nil {
+				return nil, err
+			}
+
+			if err = view.Header.Update(tableName.Literal, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if scope.explain != nil {
+		switch table.Object.(type) {
+		case parser.Join:
+			scope.explain.record(start, view.RecordLen(), explainOperation, explainJoinDetails(table.Object.(parser.Join))...)
+		case parser.Subquery, parser.Unnest:
+			scope.explain.record(start, view.RecordLen(), explainOperation, explainTableName(table)...)
+		case parser.Pivot, parser.Unpivot:
+			scope.explain.record(start, view.RecordLen(), explainOperation, explainPivotDetails(table)...)
+		case parser.Dual:
+			scope.explain.record(start, view.RecordLen(), explainOperation)
+		default:
+			scope.explain.record(start, view.RecordLen(), explainOperation, explainLoadDetails(table, view)...)
+		}
 	}
 
-	if view.FileInfo != nil && !(view.FileInfo.IsUpdatable() || view.FileInfo.IsRemoteObject()) {
+	if view.FileInfo != nil && !(view.FileInfo.IsUpdatable() || view.FileInfo.IsRemoteObject() || view.FileInfo.IsArchivedFile() || view.FileInfo.IsFileSet()) {
 		view.FileInfo.Path = ""
 	}
 
@@ -667,6 +813,229 @@ func loadHttpObject(
 	return view, err
 }
 
+func loadArchiveObject(
+	ctx context.Context,
+	scope *ReferenceScope,
+	archiveObject ArchiveObject,
+	tablePath parser.QueryExpression,
+	tableName parser.Identifier,
+	forUpdate bool,
+	useInternalId bool,
+	options option.ImportOptions,
+) (view *View, err error) {
+	archiveIdentifier := parser.Identifier{BaseExpr: archiveObject.GetBaseExpr(), Literal: archiveObject.Path}
+	archivePath, err := SearchFilePathWithExtType(archiveIdentifier, scope.Tx.Flags.Repository, file.ArchiveExtensions)
+	if err != nil {
+		return nil, err
+	}
+	if forUpdate {
+		return nil, NewArchivedFileCannotBeUpdatedError(tablePath, archiveObject.Member, archivePath)
+	}
+
+	fileInfo := NewArchivedFileInfo(archivePath, archiveObject.Member, options, scope.Tx.Flags.ImportOptions.Format)
+	fileInfo.SetDefaultFileInfoAttributes(options, scope.Tx.Flags.ExportOptions)
+
+	if err = cacheViewFromArchive(ctx, scope, fileInfo, options, tablePath); err != nil {
+		return nil, err
+	}
+
+	if useInternalId {
+		view, err = scope.Tx.CachedViews.GetWithInternalId(ctx, fileInfo.IdentifiedPath(), scope.Tx.Flags)
+	} else {
+		view, err = scope.Tx.CachedViews.Get(fileInfo.IdentifiedPath())
+	}
+	if err != nil {
+		if err == errTableNotLoaded {
+			err = NewTableNotLoadedError(parser.Identifier{BaseExpr: tablePath.GetBaseExpr(), Literal: archiveObject.String()})
+		}
+		return nil, err
+	}
+
+	if err = scope.AddAlias(tableName, ""); err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(FormatTableName(archiveObject.Member), tableName.Literal) {
+		if err = view.Header.Update(tableName.Literal, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return view, nil
+}
+
+func cacheViewFromArchive(
+	ctx context.Context,
+	scope *ReferenceScope,
+	fileInfo *FileInfo,
+	options option.ImportOptions,
+	tablePath parser.QueryExpression,
+) (err error) {
+	scope.Tx.viewLoadingMutex.Lock()
+	defer scope.Tx.viewLoadingMutex.Unlock()
+
+	if scope.Tx.CachedViews.Exists(fileInfo.IdentifiedPath()) {
+		return nil
+	}
+
+	archiveIdentifier := parser.Identifier{BaseExpr: tablePath.GetBaseExpr(), Literal: fileInfo.ArchivePath}
+
+	h, err := scope.Tx.FileContainer.CreateHandlerForRead(ctx, fileInfo.ArchivePath, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		return ConvertFileHandlerError(err, archiveIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	r, err := file.OpenArchiveMember(h.File(), fileInfo.ArchivePath, fileInfo.Path)
+	if err != nil {
+		if _, ok := err.(*file.ArchiveMemberNotExistError); ok {
+			return NewArchiveMemberNotExistError(tablePath, fileInfo.Path, fileInfo.ArchivePath)
+		}
+		return NewIOError(tablePath, err.Error())
+	}
+
+	view, err := loadViewFromFile(ctx, scope.Tx.Flags, r, fileInfo, options, tablePath)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(tablePath, fileInfo.Path+" in "+fileInfo.ArchivePath, err.Error())
+		}
+		return err
+	}
+
+	scope.Tx.CachedViews.Set(view)
+	return nil
+}
+
+// loadFileSetObject loads the files matching the pattern and unions them by column name.
+// Columns that do not exist in a file are filled with nulls.
+// Key-value pairs in the directory names such as "year=2024" are added as columns,
+// and the path of each file is added as a column if the source path column is specified.
+func loadFileSetObject(
+	ctx context.Context,
+	scope *ReferenceScope,
+	fileSetObject FileSetObject,
+	tablePath parser.QueryExpression,
+	tableName parser.Identifier,
+	forUpdate bool,
+	options option.ImportOptions,
+) (*View, error) {
+	if forUpdate {
+		return nil, NewFileSetCannotBeUpdatedError(tablePath, fileSetObject.String())
+	}
+
+	dir, files, err := fileSetObject.SearchFiles(scope.Tx.Flags.Repository)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) < 1 {
+		return nil, NewNoMatchingFileError(tablePath, fileSetObject.String())
+	}
+
+	columns := make([]string, 0, 16)
+	columnIndices := make(map[string]int, 16)
+	addColumn := func(name string) int {
+		key := strings.ToUpper(name)
+		if idx, ok := columnIndices[key]; ok {
+			return idx
+		}
+		columnIndices[key] = len(columns)
+		columns = append(columns, name)
+		return len(columns) - 1
+	}
+
+	views := make([]*View, len(files))
+	for i, fpath := range files {
+		p, err := cacheViewFromFile(ctx, scope, parser.Identifier{BaseExpr: tablePath.GetBaseExpr(), Literal: fpath}, false, options)
+		if err != nil {
+			return nil, err
+		}
+		if views[i], err = scope.Tx.CachedViews.Get(strings.ToUpper(p)); err != nil {
+			return nil, NewTableNotLoadedError(parser.Identifier{BaseExpr: tablePath.GetBaseExpr(), Literal: p})
+		}
+		files[i] = p
+
+		for j := range views[i].Header {
+			addColumn(views[i].Header[j].Column)
+		}
+	}
+
+	partitions := make([][]PartitionValue, len(files))
+	for i := range files {
+		partitions[i] = ParsePartitionValues(dir, files[i])
+		for _, pv := range partitions[i] {
+			addColumn(pv.Key)
+		}
+	}
+
+	sourcePathIdx := -1
+	if 0 < len(options.SourcePathColumn) {
+		sourcePathIdx = addColumn(options.SourcePathColumn)
+	}
+
+	recordLen := 0
+	for i := range views {
+		recordLen += views[i].RecordLen()
+	}
+	records := make(RecordSet, 0, recordLen)
+
+	for i := range views {
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		indices := make([]int, views[i].FieldLen())
+		for j := range views[i].Header {
+			indices[j] = columnIndices[strings.ToUpper(views[i].Header[j].Column)]
+		}
+
+		fixedValues := make([]value.Primary, len(columns))
+		for _, pv := range partitions[i] {
+			if pv.Value != HivePartitionNullValue {
+				fixedValues[columnIndices[strings.ToUpper(pv.Key)]] = value.NewString(pv.Value)
+			}
+		}
+		if -1 < sourcePathIdx {
+			fixedValues[sourcePathIdx] = value.NewString(files[i])
+		}
+
+		for _, record := range views[i].RecordSet {
+			values := make([]value.Primary, len(columns))
+			copy(values, fixedValues)
+			for j := range record {
+				values[indices[j]] = record[j][0]
+			}
+			for j := range values {
+				if values[j] == nil {
+					values[j] = value.NewNull()
+				}
+			}
+			records = append(records, NewRecord(values))
+		}
+	}
+
+	fileInfo := &FileInfo{
+		Path:      filepath.Join(dir, fileSetObject.Pattern),
+		Format:    views[0].FileInfo.Format,
+		Delimiter: views[0].FileInfo.Delimiter,
+		Encoding:  views[0].FileInfo.Encoding,
+		LineBreak: views[0].FileInfo.LineBreak,
+		NoHeader:  views[0].FileInfo.NoHeader,
+		ViewType:  ViewTypeFileSet,
+	}
+
+	if err = scope.AddAlias(tableName, ""); err != nil {
+		return nil, err
+	}
+
+	view := NewView()
+	view.Header = NewHeader(tableName.Literal, columns)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadInlineObjectFromFile(
 	ctx context.Context,
 	scope *ReferenceScope,
@@ -814,6 +1183,14 @@ func loadObject(
 		return loadHttpObject(ctx, scope, httpObject, originalTablePath, tableName, options)
 	}
 
+	if archiveObject, ok := tablePath.(ArchiveObject); ok {
+		return loadArchiveObject(ctx, scope, archiveObject, originalTablePath, tableName, forUpdate, useInternalId, options)
+	}
+
+	if fileSetObject, ok := tablePath.(FileSetObject); ok {
+		return loadFileSetObject(ctx, scope, fileSetObject, originalTablePath, tableName, forUpdate, options)
+	}
+
 	fileIdentifier := tablePath.(parser.Identifier)
 
 	if isInlineObject {
@@ -911,13 +1288,19 @@ func cacheViewFromFile(
 		return
 	}
 
-	if !isCached || (forUpdate && !view.FileInfo.ForUpdate) {
+	var projection columnProjection
+	if !forUpdate {
+		projection = scope.projection
+	}
+
+	if !isCached || (forUpdate && !view.FileInfo.ForUpdate) || (view.FileInfo.Format == option.PARQUET && !view.FileInfo.projection.Covers(projection)) {
 		var fileInfo *FileInfo = nil
 		if isCached {
 			fileInfo = view.FileInfo
 			if err = scope.Tx.CachedViews.Dispose(scope.Tx.FileContainer, fileInfo.IdentifiedPath()); err != nil {
 				return
 			}
+			projection = fileInfo.projection.Merge(projection)
 		} else {
 			fileInfo, err = NewFileInfo(fileIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
 			if err != nil {
@@ -926,6 +1309,9 @@ func cacheViewFromFile(
 			fileInfo.SetDefaultFileInfoAttributes(options, scope.Tx.Flags.ExportOptions)
 			filePath = fileInfo.Path
 		}
+		if fileInfo.Format == option.PARQUET {
+			fileInfo.projection = projection
+		}
 
 		var fp *os.File
 
@@ -956,6 +1342,14 @@ func cacheViewFromFile(
 		}
 
 		view, err = loadViewFromFile(ctx, scope.Tx.Flags, fp, fileInfo, options, fileIdentifier)
+		if err == nil {
+			err = loadSchemaFile(ctx, scope.Tx.Flags, view, fileIdentifier)
+		}
+		if err == nil && forUpdate {
+			if fileInfo.Format == option.XLSX || fileInfo.Format == option.PARQUET || fileInfo.Format == option.XML || fileInfo.Format == option.YAML {
+				err = NewFormatCannotBeUpdatedError(fileIdentifier, fileInfo.Format)
+			}
+		}
 		if err != nil {
 			if _, ok := err.(Error); !ok {
 				err = NewDataParsingError(fileIdentifier, fileInfo.Path, err.Error())
@@ -976,12 +1370,49 @@ func cacheViewFromFile(
 	return
 }
 
-func loadViewFromFile(ctx context.Context, flags *option.Flags, fp io.Reader, fileInfo *FileInfo, options option.ImportOptions, expr parser.QueryExpression) (*View, error) {
+// newFileReader returns a reader of the data.
+// If the data is compressed, the codec is detected by the magic number and set to fileInfo,
+// and the returned reader decompresses the data.
+func newFileReader(fp io.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*file.Reader, error) {
 	fileReader, err := file.NewReader(fp, 2048)
 	if err != nil {
 		return nil, NewIOError(expr, err.Error())
 	}
 
+	head, err := fileReader.HeadBytes()
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	magic := make([]byte, 8)
+	n, _ := io.ReadFull(head, magic)
+
+	fileInfo.Compression = file.DetectCompression(magic[:n])
+	if fileInfo.Compression == file.NoCompression {
+		return fileReader, nil
+	}
+
+	r, err := file.NewDecompressor(fileReader, fileInfo.Compression)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	if fileReader, err = file.NewReader(r, 2048); err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+	return fileReader, nil
+}
+
+func loadViewFromFile(ctx context.Context, flags *option.Flags, fp io.Reader, fileInfo *FileInfo, options option.ImportOptions, expr parser.QueryExpression) (*View, error) {
+	if fileInfo.Format == option.PARQUET {
+		if f, size, ok := uncompressedRegularFile(fp); ok {
+			return loadViewFromParquetFile(flags, f, size, fileInfo, expr)
+		}
+	}
+
+	fileReader, err := newFileReader(fp, fileInfo, expr)
+	if err != nil {
+		return nil, err
+	}
+
 	switch fileInfo.Format {
 	case option.FIXED:
 		return loadViewFromFixedLengthTextFile(ctx, fileReader, fileInfo, options.WithoutNull, expr)
@@ -991,6 +1422,18 @@ func loadViewFromFile(ctx context.Context, flags *option.Flags, fp io.Reader, fi
 		return loadViewFromJsonFile(fileReader, fileInfo, expr)
 	case option.JSONL:
 		return loadViewFromJsonLinesFile(ctx, flags, fileReader, fileInfo, expr)
+	case option.XLSX:
+		return loadViewFromXlsxFile(flags, fileReader, fileInfo, options.WithoutNull, expr)
+	case option.PARQUET:
+		data, err := io.ReadAll(fileReader)
+		if err != nil {
+			return nil, NewIOError(expr, err.Error())
+		}
+		return loadViewFromParquetFile(flags, bytes.NewReader(data), int64(len(data)), fileInfo, expr)
+	case option.XML:
+		return loadViewFromXmlFile(fileReader, fileInfo, expr)
+	case option.YAML:
+		return loadViewFromYamlFile(fileReader, fileInfo, expr)
 	}
 	return loadViewFromCSVFile(ctx, fileReader, fileInfo, options.AllowUnevenFields, options.WithoutNull, expr)
 }
@@ -1074,25 +1517,25 @@ func loadViewFromFixedLengthTextFile(ctx context.Context, fp *file.Reader, fileI
 	return view, nil
 }
 
-func loadViewFromCSVFile(ctx context.Context, fp *file.Reader, fileInfo *FileInfo, allowUnevenFields bool, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+func newCSVReader(fp *file.Reader, fileInfo *FileInfo, allowUnevenFields bool, withoutNull bool, expr parser.QueryExpression) (*csv.Reader, []string, error) {
 	if fileInfo.Format == option.TSV {
 		fileInfo.Delimiter = '\t'
 	}
 
 	fileHead, err := fp.HeadBytes()
 	if err != nil {
-		return nil, NewIOError(expr, err.Error())
+		return nil, nil, NewIOError(expr, err.Error())
 	}
 
 	enc, err := text.DetectInSpecifiedEncoding(fileHead, fileInfo.Encoding)
 	if err != nil {
-		return nil, NewCannotDetectFileEncodingError(expr)
+		return nil, nil, NewCannotDetectFileEncodingError(expr)
 	}
 	fileInfo.Encoding = enc
 
 	reader, err := csv.NewReader(fp, fileInfo.Encoding)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	reader.Delimiter = fileInfo.Delimiter
 	reader.WithoutNull = withoutNull
@@ -1102,10 +1545,19 @@ func loadViewFromCSVFile(ctx context.Context, fp *file.Reader, fileInfo *FileInf
 	if !fileInfo.NoHeader {
 		header, err = reader.ReadHeader()
 		if err != nil && err != io.EOF {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
+	return reader, header, nil
+}
+
+func loadViewFromCSVFile(ctx context.Context, fp *file.Reader, fileInfo *FileInfo, allowUnevenFields bool, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	reader, header, err := newCSVReader(fp, fileInfo, allowUnevenFields, withoutNull, expr)
+	if err != nil {
+		return nil, err
+	}
+
 	records, err := readRecordSet(ctx, reader, fp.Size())
 	if err != nil {
 		return nil, err
@@ -1156,7 +1608,7 @@ func loadViewFromCSVFile(ctx context.Context, fp *file.Reader, fileInfo *FileInf
 	return view, nil
 }
 
-func loadViewFromLTSVFile(ctx context.Context, flags *option.Flags, fp *file.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+func newLTSVReader(fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*ltsv.Reader, error) {
 	fileHead, err := fp.HeadBytes()
 	if err != nil {
 		return nil, NewIOError(expr, err.Error())
@@ -1172,6 +1624,14 @@ func loadViewFromLTSVFile(ctx context.Context, flags *option.Flags, fp *file.Rea
 	if err != nil {
 		return nil, NewIOError(expr, err.Error())
 	}
+	return reader, nil
+}
+
+func loadViewFromLTSVFile(ctx context.Context, flags *option.Flags, fp *file.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	reader, err := newLTSVReader(fp, fileInfo, expr)
+	if err != nil {
+		return nil, err
+	}
 	reader.WithoutNull = withoutNull
 
 	records, err := readRecordSet(ctx, reader, fp.Size())
@@ -1231,14 +1691,7 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 				break
 			}
 
-			record := make(Record, len(row))
-			for i, v := range row {
-				if v == nil {
-					record[i] = NewCell(value.NewNull())
-				} else {
-					record[i] = NewCell(value.NewString(string(v)))
-				}
-			}
+			record := newRecordFromRawText(row)
 
 			if 0 < fileSize && 0 < pos && len(recordSet) == fileLoadingPreparedRecordSetCap && int64(pos) < fileSize {
 				l := int((float64(fileSize) / float64(pos)) * fileLoadingPreparedRecordSetCap * 1.2)
@@ -1308,6 +1761,18 @@ func readRecordSet(ctx context.Context, reader RecordReader, fileSize int64) (Re
 	return recordSet, err
 }
 
+func newRecordFromRawText(row []text.RawText) Record {
+	record := make(Record, len(row))
+	for i, v := range row {
+		if v == nil {
+			record[i] = NewCell(value.NewNull())
+		} else {
+			record[i] = NewCell(value.NewString(string(v)))
+		}
+	}
+	return record
+}
+
 func loadViewFromJsonFile(fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
 	jsonText, err := io.ReadAll(fp)
 	if err != nil {
@@ -1334,6 +1799,122 @@ func loadViewFromJsonFile(fp *file.Reader, fileInfo *FileInfo, expr parser.Query
 	return view, nil
 }
 
+func loadViewFromXlsxFile(flags *option.Flags, fp *file.Reader, fileInfo *FileInfo, withoutNull bool, expr parser.QueryExpression) (*View, error) {
+	data, err := io.ReadAll(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	rng, err := xlsx.ParseRange(fileInfo.XlsxRange)
+	if err != nil {
+		return nil, NewLoadXlsxError(expr, err.Error())
+	}
+
+	header, rows, err := xlsx.LoadTable(bytes.NewReader(data), int64(len(data)), fileInfo.XlsxSheet, rng, fileInfo.NoHeader, withoutNull, flags.GetTimeLocation())
+	if err != nil {
+		return nil, NewLoadXlsxError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromXmlFile(fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	header, rows, err := xml.LoadTable(fp, fileInfo.XmlRowPath)
+	if err != nil {
+		return nil, NewLoadXmlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+func loadViewFromYamlFile(fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	yamlText, err := io.ReadAll(fp)
+	if err != nil {
+		return nil, NewIOError(expr, err.Error())
+	}
+
+	header, rows, err := yaml.LoadTable(fileInfo.JsonQuery, string(yamlText))
+	if err != nil {
+		return nil, NewLoadYamlError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
+// uncompressedRegularFile returns the file that can be read at random positions.
+// Parquet files are read in this way so that only the column chunks to be referred are read.
+func uncompressedRegularFile(fp io.Reader) (*os.File, int64, bool) {
+	f, ok := fp.(*os.File)
+	if !ok {
+		return nil, 0, false
+	}
+
+	fi, err := f.Stat()
+	if err != nil || !fi.Mode().IsRegular() {
+		return nil, 0, false
+	}
+
+	magic := make([]byte, 8)
+	n, _ := f.ReadAt(magic, 0)
+	if file.DetectCompression(magic[:n]) != file.NoCompression {
+		return nil, 0, false
+	}
+	return f, fi.Size(), true
+}
+
+func loadViewFromParquetFile(flags *option.Flags, r io.ReaderAt, size int64, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
+	header, rows, err := parquet.LoadTable(r, size, fileInfo.projection.Contains, flags.GetTimeLocation())
+	if err != nil {
+		return nil, NewLoadParquetError(expr, err.Error())
+	}
+
+	records := make(RecordSet, len(rows))
+	for i := range rows {
+		records[i] = NewRecord(rows[i])
+	}
+
+	fileInfo.Encoding = text.UTF8
+
+	view := NewView()
+	view.Header = NewHeader(FormatTableName(fileInfo.Path), header)
+	view.RecordSet = records
+	view.FileInfo = fileInfo
+	return view, nil
+}
+
 func loadViewFromJsonLinesFile(ctx context.Context, flags *option.Flags, fp *file.Reader, fileInfo *FileInfo, expr parser.QueryExpression) (*View, error) {
 	var err error
 	headerList := make([]string, 0, 32)
@@ -1413,7 +1994,7 @@ func loadViewFromJsonLinesFile(ctx context.Context, flags *option.Flags, fp *fil
 				break
 			}
 
-			row, et, e := reader.Read()
+			rowObj, et, e := readJsonLinesObject(reader, jsonQuery, expr)
 			if e == io.EOF {
 				break
 			}
@@ -1422,30 +2003,6 @@ func loadViewFromJsonLinesFile(ctx context.Context, flags *option.Flags, fp *fil
 				break
 			}
 
-			rowObj, ok := row.(txjson.Object)
-			if !ok {
-				err = NewJsonLinesStructureError(expr)
-				break
-			}
-
-			if jsonQuery != nil {
-				jstruct, e := json.Extract(jsonQuery, rowObj)
-				if e != nil {
-					err = e
-					break
-				}
-				jarray, ok := jstruct.(txjson.Array)
-				if !ok || len(jarray) < 1 {
-					err = NewJsonLinesStructureError(expr)
-					break
-				}
-				rowObj, ok = jarray[0].(txjson.Object)
-				if !ok {
-					err = NewJsonLinesStructureError(expr)
-					break
-				}
-			}
-
 			if escapeType < et {
 				escapeType = et
 			}
@@ -1503,3 +2060,61 @@ func loadViewFromJsonLinesFile(ctx context.Context, flags *option.Flags, fp *fil
 	view.FileInfo = fileInfo
 	return view, nil
 }
+
+// readJsonLinesObject reads a line and returns the object specified by jsonQuery.
+func readJsonLinesObject(reader *jsonl.Reader, jsonQuery json.QueryExpression, expr parser.QueryExpression) (txjson.Object, txjson.EscapeType, error) {
+	row, et, err := reader.Read()
+	if err != nil {
+		return txjson.Object{}, et, err
+	}
+
+	rowObj, ok := row.(txjson.Object)
+	if !ok {
+		return txjson.Object{}, et, NewJsonLinesStructureError(expr)
+	}
+
+	if jsonQuery != nil {
+		jstruct, err := json.Extract(jsonQuery, rowObj)
+		if err != nil {
+			return txjson.Object{}, et, err
+		}
+		jarray, ok := jstruct.(txjson.Array)
+		if !ok || len(jarray) < 1 {
+			return txjson.Object{}, et, NewJsonLinesStructureError(expr)
+		}
+		if rowObj, ok = jarray[0].(txjson.Object); !ok {
+			return txjson.Object{}, et, NewJsonLinesStructureError(expr)
+		}
+	}
+	return rowObj, et, nil
+}
+
+func loadUnnestView(ctx context.Context, scope *ReferenceScope, unnest parser.Unnest, tableName parser.Identifier) (*View, error) {
+	p, err := Evaluate(ctx, scope, unnest.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []value.Primary
+	switch v := p.(type) {
+	case *value.Array:
+		values = v.Values()
+	case *value.Null:
+		values = nil
+	default:
+		return nil, NewUnnestNotArrayError(unnest)
+	}
+
+	column := "unnest"
+	if 0 < len(tableName.Literal) {
+		column = tableName.Literal
+	}
+
+	view := NewView()
+	view.Header = NewHeader(tableName.Literal, []string{column})
+	view.RecordSet = make(RecordSet, len(values))
+	for i := range values {
+		view.RecordSet[i] = NewRecord([]value.Primary{values[i]})
+	}
+	return view, nil
+}
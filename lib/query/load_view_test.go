@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
@@ -34,6 +35,7 @@ var loadViewTests = []struct {
 	SingleLine         bool
 	JsonQuery          string
 	WithoutNull        bool
+	SourcePathColumn   string
 	Scope              *ReferenceScope
 	Result             *View
 	ResultScope        *ReferenceScope
@@ -146,18 +148,16 @@ var loadViewTests = []struct {
 		}}, time.Time{}, nil),
 	},
 	{
-		Name:      "LoadView from Cached View",
-		TestCache: true,
+		Name: "LoadView Gzip Compressed File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "table1"},
+					Object: parser.Identifier{Literal: "table_gzip"},
 				},
 			},
 		},
-		ForUpdate: true,
 		Result: &View{
-			Header: NewHeader("table1", []string{"column1", "column2"}),
+			Header: NewHeader("table_gzip", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
@@ -173,30 +173,30 @@ var loadViewTests = []struct {
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1.csv",
-				Delimiter: ',',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ForUpdate: true,
+				Path:        "table_gzip.csv.gz",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: file.Gzip,
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{
-			scopeNameAliases: {
-				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
-			},
-		}}, time.Time{}, nil),
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE_GZIP": strings.ToUpper(GetTestFilePath("table_gzip.csv.gz")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView File with UTF-8 BOM",
+		Name: "LoadView Bzip2 Compressed File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "table1_bom.csv"},
+					Object: parser.Identifier{Literal: "table_bzip2.tsv.bz2"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("table1_bom", []string{"column1", "column2"}),
+			Header: NewHeader("table_bzip2", []string{"column5", "column6"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
@@ -206,37 +206,33 @@ var loadViewTests = []struct {
 					value.NewString("2"),
 					value.NewString("str2"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1_bom.csv",
-				Delimiter: ',',
-				Encoding:  text.UTF8M,
-				LineBreak: text.LF,
+				Path:        "table_bzip2.tsv.bz2",
+				Format:      option.TSV,
+				Delimiter:   '\t',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: file.Bzip2,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"TABLE1_BOM": strings.ToUpper(GetTestFilePath("table1_bom.csv")),
+				"TABLE_BZIP2": strings.ToUpper(GetTestFilePath("table_bzip2.tsv.bz2")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView with Parentheses",
+		Name: "LoadView Xz Compressed File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Parentheses{
-					Expr: parser.Table{
-						Object: parser.Identifier{Literal: "table1.csv"},
-					},
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_xz"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("table1", []string{"column1", "column2"}),
+			Header: NewHeader("table_xz", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
@@ -246,406 +242,370 @@ var loadViewTests = []struct {
 					value.NewString("2"),
 					value.NewString("str2"),
 				}),
-				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
-				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table1.csv",
-				Delimiter: ',',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
+				Path:        "table_xz.csv.xz",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: file.Xz,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+				"TABLE_XZ": strings.ToUpper(GetTestFilePath("table_xz.csv.xz")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin",
+		Name: "LoadView Zstd Compressed File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.Identifier{Literal: "table_zstd.csv.zst"},
+				},
 			},
 		},
-		Stdin: "column1,column2\n1,\"str1\"",
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("table_zstd", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
 				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "STDIN",
-				Delimiter: ',',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
+				Path:        "table_zstd.csv.zst",
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				Compression: file.Zstd,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": "STDIN",
+				"TABLE_ZSTD": strings.ToUpper(GetTestFilePath("table_zstd.csv.zst")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin ForUpdate",
+		Name: "LoadView File in Zip Archive",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.ArchiveMember{
+						Archive: parser.Identifier{Literal: "archive_zip"},
+						Member:  parser.Identifier{Literal: "2024/sales.csv"},
+					},
+				},
 			},
 		},
-		Stdin:     "column1,column2\n1,\"str1\"",
-		ForUpdate: true,
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("sales", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
 				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "STDIN",
-				Delimiter: ',',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
+				Path:        "2024/sales.csv",
+				ArchivePath: GetTestFilePath("archive_zip.zip"),
+				Delimiter:   ',',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				ViewType:    ViewTypeArchivedFile,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
-				},
-			},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"SALES": "",
+			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView TSV From Stdin",
+		Name: "LoadView File in Tar Archive with Archive Function",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.TableFunction{
+						Name: "archive",
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("archive_tar.tar.gz"),
+							parser.NewStringValue("data/items.tsv"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
 			},
 		},
-		Stdin:        "column1\tcolumn2\n1\t\"str1\"",
-		ImportFormat: option.TSV,
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column3", "column4"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
+					value.NewString("2"),
+					value.NewString("str22"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str33"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "STDIN",
-				Format:    option.TSV,
-				Delimiter: '\t',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
+				Path:        "data/items.tsv",
+				ArchivePath: GetTestFilePath("archive_tar.tar.gz"),
+				Format:      option.TSV,
+				Delimiter:   '\t',
+				Encoding:    text.UTF8,
+				LineBreak:   text.LF,
+				ViewType:    ViewTypeArchivedFile,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": "STDIN",
+				"T": "",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin With Internal Id",
+		Name: "LoadView File in Archive Not Exist Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
-			},
-		},
-		UseInternalId: true,
-		Stdin:         "column1,column2\n1,\"str1\"",
-		Result: &View{
-			Header: NewHeaderWithId("t", []string{"column1", "column2"}),
-			RecordSet: []Record{
-				NewRecordWithId(0, []value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-				}),
-			},
-			FileInfo: &FileInfo{
-				Path:      "STDIN",
-				Delimiter: ',',
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
-			},
-		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
+				parser.Table{
+					Object: parser.ArchiveMember{
+						Archive: parser.Identifier{Literal: "archive_zip.zip"},
+						Member:  parser.Identifier{Literal: "notexist.csv"},
 					},
 				},
 			},
-		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
+		},
+		Error: "file notexist.csv does not exist in archive " + GetTestFilePath("archive_zip.zip"),
+	},
+	{
+		Name: "LoadView File in Archive ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.ArchiveMember{
+						Archive: parser.Identifier{Literal: "archive_zip.zip"},
+						Member:  parser.Identifier{Literal: "items.tsv"},
+					},
 				},
 			},
-		}, time.Time{}, nil),
+		},
+		ForUpdate: true,
+		Error:     "file items.tsv in archive " + GetTestFilePath("archive_zip.zip") + " cannot be updated",
 	},
 	{
-		Name: "LoadView Json From Stdin",
+		Name: "LoadView Multiple Files with Glob Pattern",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.Identifier{Literal: "logs/*/app.csv"},
+				},
 			},
 		},
-		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
-		ImportFormat: option.JSON,
-		JsonQuery:    "key{}",
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("app", []string{"id", "message", "level", "year"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewFloat(1),
-					value.NewString("str1"),
+					value.NewString("1"),
+					value.NewString("start"),
+					value.NewNull(),
+					value.NewString("2024"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("stop"),
+					value.NewNull(),
+					value.NewString("2024"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("retry"),
+					value.NewString("warn"),
+					value.NewString("2025"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "STDIN",
+				Path:      GetTestFilePath(filepath.Join("logs", "*", "app.csv")),
 				Delimiter: ',',
-				JsonQuery: "key{}",
-				Format:    option.JSON,
 				Encoding:  text.UTF8,
 				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
+				ViewType:  ViewTypeFileSet,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": "STDIN",
+				"APP": "",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView Json Lines From Stdin",
+		Name: "LoadView Multiple Files with Directory Function",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.TableFunction{
+						Name: "directory",
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("logs"),
+						},
+					},
+				},
 			},
 		},
-		Stdin:        "{\"column1\": 1, \"column2\": \"str1\"}\n{\"column1\": 2, \"column2\": \"str2\"}",
-		ImportFormat: option.JSONL,
-		JsonQuery:    "",
+		SourcePathColumn: "source",
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "column2"}),
+			Header: NewHeader("logs", []string{"id", "message", "level", "year", "source"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewFloat(1),
-					value.NewString("str1"),
+					value.NewString("1"),
+					value.NewString("start"),
+					value.NewNull(),
+					value.NewString("2024"),
+					value.NewString(GetTestFilePath(filepath.Join("logs", "year=2024", "app.csv"))),
 				}),
 				NewRecord([]value.Primary{
-					value.NewFloat(2),
-					value.NewString("str2"),
+					value.NewString("2"),
+					value.NewString("stop"),
+					value.NewNull(),
+					value.NewString("2024"),
+					value.NewString(GetTestFilePath(filepath.Join("logs", "year=2024", "app.csv"))),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("retry"),
+					value.NewString("warn"),
+					value.NewString("2025"),
+					value.NewString(GetTestFilePath(filepath.Join("logs", "year=2025", "app.csv"))),
+				}),
+				NewRecord([]value.Primary{
+					value.NewFloat(4),
+					value.NewString("json"),
+					value.NewNull(),
+					value.NewString("2025"),
+					value.NewString(GetTestFilePath(filepath.Join("logs", "year=2025", "app.jsonl"))),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "STDIN",
+				Path:      GetTestFilePath(filepath.Join("logs", "*")),
 				Delimiter: ',',
-				JsonQuery: "",
-				Format:    option.JSONL,
 				Encoding:  text.UTF8,
 				LineBreak: text.LF,
-				ViewType:  ViewTypeStdin,
+				ViewType:  ViewTypeFileSet,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": "STDIN",
+				"LOGS": "",
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView Json Lines From Stdin, Json Structure Error",
+		Name: "LoadView Multiple Files No Matching File Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.Identifier{Literal: "logs/*.xlsx"},
+				},
 			},
 		},
-		Stdin:        "{\"column1\": 1, \"column2\": \"str1\"}\n\"str\"",
-		ImportFormat: option.JSONL,
-		JsonQuery:    "",
-		Error:        "json lines must be an array of objects",
+		Error: "no file matches " + filepath.Join("logs", "*.xlsx"),
 	},
 	{
-		Name: "LoadView JsonH From Stdin",
+		Name: "LoadView Multiple Files Directory Not Exist Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
-			},
-		},
-		Stdin:        "[{\"item1\": \"value\\u00221\",\"item2\": 1},{\"item1\": \"value2\",\"item2\": 2}]",
-		ImportFormat: option.JSON,
-		JsonQuery:    "{}",
-		Result: &View{
-			Header: NewHeader("t", []string{"item1", "item2"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("value\"1"),
-					value.NewFloat(1),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewFloat(2),
-				}),
-			},
-			FileInfo: &FileInfo{
-				Path:       "STDIN",
-				Delimiter:  ',',
-				JsonQuery:  "{}",
-				Format:     option.JSON,
-				Encoding:   text.UTF8,
-				LineBreak:  text.LF,
-				JsonEscape: json.HexDigits,
-				ViewType:   ViewTypeStdin,
-			},
-		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
+				parser.Table{
+					Object: parser.TableFunction{
+						Name: "directory",
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("notexist"),
+							parser.NewStringValue("*.csv"),
+						},
 					},
 				},
 			},
-		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
+		},
+		Error: "directory " + GetTestFilePath("notexist") + " does not exist",
+	},
+	{
+		Name: "LoadView Multiple Files ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "logs/*/app.csv"},
 				},
 			},
-		}, time.Time{}, nil),
+		},
+		ForUpdate: true,
+		Error:     "multiple files " + filepath.Join("logs", "*", "app.csv") + " cannot be updated",
 	},
 	{
-		Name: "LoadView JsonA From Stdin",
+		Name:      "LoadView from Cached View",
+		TestCache: true,
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{
+					Object: parser.Identifier{Literal: "table1"},
+				},
 			},
 		},
-		Stdin:        "[{\"item1\": \"\\u0076\\u0061\\u006c\\u0075\\u0065\\u0031\",\"item2\": 1},{\"item1\": \"\\u0076\\u0061\\u006c\\u0075\\u0065\\u0032\",\"item2\": 2}]",
-		ImportFormat: option.JSON,
-		JsonQuery:    "{}",
+		ForUpdate: true,
 		Result: &View{
-			Header: NewHeader("t", []string{"item1", "item2"}),
+			Header: NewHeader("table1", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewFloat(1),
+					value.NewString("1"),
+					value.NewString("str1"),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewFloat(2),
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:       "STDIN",
-				Delimiter:  ',',
-				JsonQuery:  "{}",
-				Format:     option.JSON,
-				Encoding:   text.UTF8,
-				LineBreak:  text.LF,
-				JsonEscape: json.AllWithHexDigits,
-				ViewType:   ViewTypeStdin,
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
 			},
 		},
-		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
-			{
-				scopeNameTempTables: {
-					"STDIN": &View{
-						FileInfo: &FileInfo{Path: "STDIN"},
-					},
-				},
-			},
-		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"T": "STDIN",
-				},
-			},
-		}, time.Time{}, nil),
-	},
-	{
-		Name: "LoadView Json From Stdin Json Query Error",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{
+			scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
 			},
-		},
-		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
-		ImportFormat: option.JSON,
-		JsonQuery:    "key{",
-		Error:        "json loading error: column 4: unexpected termination",
+		}}, time.Time{}, nil),
 	},
 	{
-		Name:         "LoadView Fixed-Length Text File",
-		ImportFormat: option.FIXED,
+		Name: "LoadView File with UTF-8 BOM",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Identifier{Literal: "fixed_length.txt"},
+					Object: parser.Identifier{Literal: "table1_bom.csv"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("fixed_length", []string{"column1", "__@2__"}),
+			Header: NewHeader("table1_bom", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
@@ -661,39 +621,32 @@ var loadViewTests = []struct {
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:               "fixed_length.txt",
-				Delimiter:          ',',
-				DelimiterPositions: []int{7, 12},
-				Format:             option.FIXED,
-				NoHeader:           false,
-				Encoding:           text.UTF8,
-				LineBreak:          text.LF,
+				Path:      "table1_bom.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8M,
+				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"FIXED_LENGTH": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+				"TABLE1_BOM": strings.ToUpper(GetTestFilePath("table1_bom.csv")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name:         "LoadView Fixed-Length Text File NoHeader",
-		NoHeader:     true,
-		ImportFormat: option.FIXED,
+		Name: "LoadView with Parentheses",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.Identifier{Literal: "fixed_length.txt"},
+				parser.Parentheses{
+					Expr: parser.Table{
+						Object: parser.Identifier{Literal: "table1.csv"},
+					},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("fixed_length", []string{"c1", "c2"}),
+			Header: NewHeader("table1", []string{"column1", "column2"}),
 			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("column1"),
-					value.NewNull(),
-				}),
 				NewRecord([]value.Primary{
 					value.NewString("1"),
 					value.NewString("str1"),
@@ -708,40 +661,28 @@ var loadViewTests = []struct {
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:               "fixed_length.txt",
-				Delimiter:          ',',
-				DelimiterPositions: []int{7, 12},
-				Format:             option.FIXED,
-				NoHeader:           true,
-				Encoding:           text.UTF8,
-				LineBreak:          text.LF,
+				Path:      "table1.csv",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"FIXED_LENGTH": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name:               "LoadView Fixed-Length Text File Position Error",
-		ImportFormat:       option.FIXED,
-		DelimiterPositions: []int{6, 2},
+		Name: "LoadView From Stdin",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.Identifier{Literal: "fixed_length.txt"},
-				},
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Error: fmt.Sprintf("data parse error in %s: invalid delimiter position: [6, 2]", GetTestFilePath("fixed_length.txt")),
-	},
-	{
-		Name:  "LoadView From Stdin With Omitted FromClause",
-		From:  parser.FromClause{},
 		Stdin: "column1,column2\n1,\"str1\"",
 		Result: &View{
-			Header: NewHeader("STDIN", []string{"column1", "column2"}),
+			Header: NewHeader("t", []string{"column1", "column2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("1"),
@@ -765,71 +706,881 @@ var loadViewTests = []struct {
 				},
 			},
 		}, []map[string]map[string]interface{}{
-			{
-				scopeNameAliases: {
-					"STDIN": "STDIN",
-				},
-			},
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin Broken CSV Error",
+		Name: "LoadView From Stdin ForUpdate",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Stdin: "column1,column2\n1\"str1\"",
-		Error: "data parse error in STDIN: line 1, column 8: wrong number of fields in line",
+		Stdin:     "column1,column2\n1,\"str1\"",
+		ForUpdate: true,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView From Stdin Duplicate Table Name Error",
+		Name: "LoadView TSV From Stdin",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		Stdin: "column1,column2\n1,\"str1\"",
-		Error: "table name t is a duplicate",
-	},
-	{
-		Name: "LoadView From Stdin ForUpdate Duplicate Table Name Error",
+		Stdin:        "column1\tcolumn2\n1\t\"str1\"",
+		ImportFormat: option.TSV,
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Format:    option.TSV,
+				Delimiter: '\t',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin With Internal Id",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		UseInternalId: true,
+		Stdin:         "column1,column2\n1,\"str1\"",
+		Result: &View{
+			Header: NewHeaderWithId("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecordWithId(0, []value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Json From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
+		ImportFormat: option.JSON,
+		JsonQuery:    "key{}",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewFloat(1),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				JsonQuery: "key{}",
+				Format:    option.JSON,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Json Lines From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"column1\": 1, \"column2\": \"str1\"}\n{\"column1\": 2, \"column2\": \"str2\"}",
+		ImportFormat: option.JSONL,
+		JsonQuery:    "",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewFloat(1),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewFloat(2),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				JsonQuery: "",
+				Format:    option.JSONL,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": "STDIN",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Json Lines From Stdin, Json Structure Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"column1\": 1, \"column2\": \"str1\"}\n\"str\"",
+		ImportFormat: option.JSONL,
+		JsonQuery:    "",
+		Error:        "json lines must be an array of objects",
+	},
+	{
+		Name: "LoadView JsonH From Stdin",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "[{\"item1\": \"value\\u00221\",\"item2\": 1},{\"item1\": \"value2\",\"item2\": 2}]",
+		ImportFormat: option.JSON,
+		JsonQuery:    "{}",
+		Result: &View{
+			Header: NewHeader("t", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value\"1"),
+					value.NewFloat(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewFloat(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:       "STDIN",
+				Delimiter:  ',',
+				JsonQuery:  "{}",
+				Format:     option.JSON,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
+				JsonEscape: json.HexDigits,
+				ViewType:   ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView JsonA From Stdin",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
 				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
 			},
 		},
-		ForUpdate: true,
-		Stdin:     "column1,column2\n1,\"str1\"",
-		Error:     "table name t is a duplicate",
+		Stdin:        "[{\"item1\": \"\\u0076\\u0061\\u006c\\u0075\\u0065\\u0031\",\"item2\": 1},{\"item1\": \"\\u0076\\u0061\\u006c\\u0075\\u0065\\u0032\",\"item2\": 2}]",
+		ImportFormat: option.JSON,
+		JsonQuery:    "{}",
+		Result: &View{
+			Header: NewHeader("t", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewFloat(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewFloat(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:       "STDIN",
+				Delimiter:  ',',
+				JsonQuery:  "{}",
+				Format:     option.JSON,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
+				JsonEscape: json.AllWithHexDigits,
+				ViewType:   ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"T": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Json From Stdin Json Query Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin:        "{\"key\":[{\"column1\": 1, \"column2\": \"str1\"}]}",
+		ImportFormat: option.JSON,
+		JsonQuery:    "key{",
+		Error:        "json loading error: column 4: unexpected termination",
+	},
+	{
+		Name:         "LoadView Fixed-Length Text File",
+		ImportFormat: option.FIXED,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "fixed_length.txt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("fixed_length", []string{"column1", "__@2__"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "fixed_length.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{7, 12},
+				Format:             option.FIXED,
+				NoHeader:           false,
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"FIXED_LENGTH": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:         "LoadView Fixed-Length Text File NoHeader",
+		NoHeader:     true,
+		ImportFormat: option.FIXED,
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "fixed_length.txt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("fixed_length", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("column1"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "fixed_length.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{7, 12},
+				Format:             option.FIXED,
+				NoHeader:           true,
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"FIXED_LENGTH": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name:               "LoadView Fixed-Length Text File Position Error",
+		ImportFormat:       option.FIXED,
+		DelimiterPositions: []int{6, 2},
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "fixed_length.txt"},
+				},
+			},
+		},
+		Error: fmt.Sprintf("data parse error in %s: invalid delimiter position: [6, 2]", GetTestFilePath("fixed_length.txt")),
+	},
+	{
+		Name:  "LoadView From Stdin With Omitted FromClause",
+		From:  parser.FromClause{},
+		Stdin: "column1,column2\n1,\"str1\"",
+		Result: &View{
+			Header: NewHeader("STDIN", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "STDIN",
+				Delimiter: ',',
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ViewType:  ViewTypeStdin,
+			},
+		},
+		ResultScope: GenerateReferenceScope([]map[string]map[string]interface{}{
+			{
+				scopeNameTempTables: {
+					"STDIN": &View{
+						FileInfo: &FileInfo{Path: "STDIN"},
+					},
+				},
+			},
+		}, []map[string]map[string]interface{}{
+			{
+				scopeNameAliases: {
+					"STDIN": "STDIN",
+				},
+			},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView From Stdin Broken CSV Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1\"str1\"",
+		Error: "data parse error in STDIN: line 1, column 8: wrong number of fields in line",
+	},
+	{
+		Name: "LoadView From Stdin Duplicate Table Name Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		Stdin: "column1,column2\n1,\"str1\"",
+		Error: "table name t is a duplicate",
+	},
+	{
+		Name: "LoadView From Stdin ForUpdate Duplicate Table Name Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+				parser.Table{Object: parser.Stdin{}, Alias: parser.Identifier{Literal: "t"}},
+			},
+		},
+		ForUpdate: true,
+		Stdin:     "column1,column2\n1,\"str1\"",
+		Error:     "table name t is a duplicate",
+	},
+	{
+		Name: "Stdin Empty Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Stdin{},
+				},
+			},
+		},
+		Error: "STDIN is empty",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString(""),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table5.csv",
+				Delimiter: ',',
+				Format:    option.CSV,
+				Encoding:  text.SJIS,
+				LineBreak: text.LF,
+				NoHeader:  true,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table5.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From TSV File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue("\t"),
+						Path:          parser.Identifier{Literal: "table3"},
+						Args: []parser.QueryExpression{
+							parser.FieldReference{Column: parser.Identifier{Literal: "UTF8"}},
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column5", "column6"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table3.tsv",
+				Delimiter: '\t',
+				Format:    option.TSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table3.tsv")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Evaluate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+						Path:          parser.Identifier{Literal: "table1"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Is Not Specified",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type: parser.Token{Token: parser.CSV, Literal: "csv"},
+						Path: parser.Identifier{Literal: "table1"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: delimiter is not specified",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement is Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewNullValue(),
+						Path:          parser.Identifier{Literal: "table1"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid delimiter: NULL",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Invalid Delimiter",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue("invalid"),
+						Path:          parser.Identifier{Literal: "table1"},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid delimiter: 'invalid'",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("extra"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object csv takes at most 5 arguments",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File 3rd Argument Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewTernaryValueFromString("true"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: cannot be converted as a encoding value: TRUE",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File 4th Argument Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+							parser.NewStringValue("SJIS"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: cannot be converted as a no-header value: 'SJIS'",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File 5th Argument Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("SJIS"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: cannot be converted as a without-null value: 'SJIS'",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From CSV File Invalid Encoding Type",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						FormatElement: parser.NewStringValue(","),
+						Path:          parser.Identifier{Literal: "table5"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("INVALID"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("spaces"),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "fixed_length.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{7, 12},
+				Format:             option.FIXED,
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "Stdin Empty Error",
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File with UTF-8 BOM",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.Stdin{},
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("spaces"),
+						Path:          parser.Identifier{Literal: "fixed_length_bom.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "STDIN is empty",
+		Result: &View{
+			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str1"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str3"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:               "fixed_length_bom.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{7, 12},
+				Format:             option.FIXED,
+				Encoding:           text.UTF8M,
+				LineBreak:          text.LF,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("fixed_length_bom.txt")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File",
+		Name: "LoadView FormatSpecifiedFunction From Single-Line Fixed-Length File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewTernaryValueFromString("true"),
-						},
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("s[1,5]"),
+						Path:          parser.Identifier{Literal: "fixed_length_sl.txt", Quoted: true},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
@@ -844,7 +1595,7 @@ var loadViewTests = []struct {
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("2"),
-					value.NewString(""),
+					value.NewString("str2"),
 				}),
 				NewRecord([]value.Primary{
 					value.NewString("3"),
@@ -852,421 +1603,601 @@ var loadViewTests = []struct {
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table5.csv",
+				Path:               "fixed_length_sl.txt",
+				Delimiter:          ',',
+				DelimiterPositions: []int{1, 5},
+				Format:             option.FIXED,
+				Encoding:           text.UTF8,
+				LineBreak:          text.LF,
+				SingleLine:         true,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("fixed_length_sl.txt")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File FormatElement Is Not Specified",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type: parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						Path: parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid argument for fixed: delimiter positions are not specified",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File FormatElement is Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewNullValue(),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid delimiter positions: NULL",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File Invalid Delimiter Positions",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("invalid"),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "invalid delimiter positions: 'invalid'",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File Arguments Length Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
+						FormatElement: parser.NewStringValue("spaces"),
+						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("SJIS"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("extra"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object fixed takes at most 5 arguments",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From Json File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("jt", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewFloat(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewFloat(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table.json",
 				Delimiter: ',',
-				Format:    option.CSV,
-				Encoding:  text.SJIS,
+				JsonQuery: "{}",
+				Format:    option.JSON,
+				Encoding:  text.UTF8,
 				LineBreak: text.LF,
-				NoHeader:  true,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table5.csv")),
+				"JT": strings.ToUpper(GetTestFilePath("table.json")),
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From JsonH File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table_h"},
+					},
+					Alias: parser.Identifier{Literal: "jt"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("jt", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value\"1"),
+					value.NewFloat(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewFloat(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:       "table_h.json",
+				Delimiter:  ',',
+				JsonQuery:  "{}",
+				Format:     option.JSON,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
+				JsonEscape: json.HexDigits,
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"JT": strings.ToUpper(GetTestFilePath("table_h.json")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From TSV File",
+		Name: "LoadView FormatSpecifiedFunction From JsonA File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue("\t"),
-						Path:          parser.Identifier{Literal: "table3"},
-						Args: []parser.QueryExpression{
-							parser.FieldReference{Column: parser.Identifier{Literal: "UTF8"}},
-						},
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table_a"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"column5", "column6"}),
+			Header: NewHeader("jt", []string{"item1", "item2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
+					value.NewString("value1"),
+					value.NewFloat(1),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
+					value.NewString("value2"),
+					value.NewFloat(2),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table3.tsv",
-				Delimiter: '\t',
-				Format:    option.TSV,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
+				Path:       "table_a.json",
+				Delimiter:  ',',
+				JsonQuery:  "{}",
+				Format:     option.JSON,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
+				JsonEscape: json.AllWithHexDigits,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table3.tsv")),
+				"JT": strings.ToUpper(GetTestFilePath("table_a.json")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Evaluate Error",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
-						Path:          parser.Identifier{Literal: "table1"},
-					},
-					Alias: parser.Identifier{Literal: "t"},
-				},
-			},
-		},
-		Error: "field notexist does not exist",
-	},
-	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Is Not Specified",
+		Name: "LoadView FormatSpecifiedFunction From Json File FormatElement Is Not Specified",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.CSV, Literal: "csv"},
-						Path: parser.Identifier{Literal: "table1"},
+						Type: parser.Token{Token: parser.JSON, Literal: "json"},
+						Path: parser.Identifier{Literal: "table"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
-		Error: "invalid argument for csv: delimiter is not specified",
+		Error: "invalid argument for json: json query is not specified",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement is Null",
+		Name: "LoadView FormatSpecifiedFunction From Json File FormatElement is Null",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
 						FormatElement: parser.NewNullValue(),
-						Path:          parser.Identifier{Literal: "table1"},
+						Path:          parser.Identifier{Literal: "table"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
-		Error: "invalid delimiter: NULL",
+		Error: "invalid json query: NULL",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File FormatElement Invalid Delimiter",
+		Name: "LoadView Table Object From Json File Path Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue("invalid"),
-						Path:          parser.Identifier{Literal: "table1"},
+						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "notexist"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
-		Error: "invalid delimiter: 'invalid'",
+		Error: "file notexist does not exist",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File Arguments Length Error",
+		Name: "LoadView FormatSpecifiedFunction From Json Lines File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewStringValue("extra"),
-						},
+						Type:          parser.Token{Token: parser.JSONL, Literal: "jsonl"},
+						FormatElement: parser.NewStringValue("{}"),
+						Path:          parser.Identifier{Literal: "table7"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
+					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
-		Error: "table object csv takes at most 5 arguments",
-	},
-	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File 3rd Argument Error",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
-						Args: []parser.QueryExpression{
-							parser.NewTernaryValueFromString("true"),
-						},
-					},
-					Alias: parser.Identifier{Literal: "t"},
-				},
+		Result: &View{
+			Header: NewHeader("jt", []string{"item1", "item2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewFloat(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value2"),
+					value.NewFloat(2),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table7.jsonl",
+				Delimiter: ',',
+				JsonQuery: "{}",
+				Format:    option.JSONL,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
 			},
 		},
-		Error: "invalid argument for csv: cannot be converted as a encoding value: TRUE",
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"JT": strings.ToUpper(GetTestFilePath("table7.jsonl")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File 4th Argument Error",
+		Name: "LoadView FormatSpecifiedFunction From LTSV File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
-							parser.NewStringValue("SJIS"),
-						},
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid argument for csv: cannot be converted as a no-header value: 'SJIS'",
-	},
-	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File 5th Argument Error",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewStringValue("SJIS"),
-						},
-					},
-					Alias: parser.Identifier{Literal: "t"},
-				},
+		Result: &View{
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewNull(),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewNull(),
+					value.NewString("value6"),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "table6.ltsv",
+				Delimiter: ',',
+				Format:    option.LTSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
 			},
 		},
-		Error: "invalid argument for csv: cannot be converted as a without-null value: 'SJIS'",
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From CSV File Invalid Encoding Type",
+		Name: "LoadView FormatSpecifiedFunction From LTSV File Without Null",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.CSV, Literal: "csv"},
-						FormatElement: parser.NewStringValue(","),
-						Path:          parser.Identifier{Literal: "table5"},
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
 						Args: []parser.QueryExpression{
-							parser.NewStringValue("INVALID"),
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("true"),
 						},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid argument for csv: encoding must be one of AUTO|UTF8|UTF8M|UTF16|UTF16BE|UTF16LE|UTF16BEM|UTF16LEM|SJIS",
-	},
-	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("spaces"),
-						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
-					},
-					Alias: parser.Identifier{Literal: "t"},
-				},
-			},
-		},
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewString(""),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewString(""),
+					value.NewString("value6"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:               "fixed_length.txt",
-				Delimiter:          ',',
-				DelimiterPositions: []int{7, 12},
-				Format:             option.FIXED,
-				Encoding:           text.UTF8,
-				LineBreak:          text.LF,
+				Path:      "table6.ltsv",
+				Delimiter: ',',
+				Format:    option.LTSV,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("fixed_length.txt")),
+				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File with UTF-8 BOM",
+		Name: "LoadView FormatSpecifiedFunction From LTSV File with UTF-8 BOM",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("spaces"),
-						Path:          parser.Identifier{Literal: "fixed_length_bom.txt", Quoted: true},
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6_bom"},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"column1", "__@2__"}),
+			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
+					value.NewString("value1"),
+					value.NewString("value2"),
+					value.NewString("value3"),
+					value.NewNull(),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
+					value.NewString("value4"),
+					value.NewString("value5"),
+					value.NewNull(),
+					value.NewString("value6"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:               "fixed_length_bom.txt",
-				Delimiter:          ',',
-				DelimiterPositions: []int{7, 12},
-				Format:             option.FIXED,
-				Encoding:           text.UTF8M,
-				LineBreak:          text.LF,
+				Path:      "table6_bom.ltsv",
+				Delimiter: ',',
+				Format:    option.LTSV,
+				Encoding:  text.UTF8M,
+				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("fixed_length_bom.txt")),
+				"T": strings.ToUpper(GetTestFilePath("table6_bom.ltsv")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Single-Line Fixed-Length File",
+		Name: "LoadView FormatSpecifiedFunction From LTSV File Arguments Length Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("s[1,5]"),
-						Path:          parser.Identifier{Literal: "fixed_length_sl.txt", Quoted: true},
+						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
+						Path: parser.Identifier{Literal: "table6"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("UTF8"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("extra"),
+						},
+					},
+					Alias: parser.Identifier{Literal: "t"},
+				},
+			},
+		},
+		Error: "table object ltsv takes exactly 3 arguments",
+	},
+	{
+		Name: "LoadView FormatSpecifiedFunction From XLSX File",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "workbook.xlsx"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("sales"),
+							parser.NewStringValue("b3:d5"),
+						},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"c1", "c2"}),
+			Header: NewHeader("t", []string{"item_id", "sold_at", "quantity"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("1"),
-					value.NewString("str1"),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("2"),
-					value.NewString("str2"),
+					value.NewInteger(1),
+					value.NewDatetime(time.Date(2024, 1, 1, 12, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(10),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("3"),
-					value.NewString("str3"),
+					value.NewInteger(3),
+					value.NewDatetime(time.Date(2024, 1, 2, 0, 0, 0, 0, GetTestLocation())),
+					value.NewInteger(4),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:               "fixed_length_sl.txt",
-				Delimiter:          ',',
-				DelimiterPositions: []int{1, 5},
-				Format:             option.FIXED,
-				Encoding:           text.UTF8,
-				LineBreak:          text.LF,
-				SingleLine:         true,
+				Path:      "workbook.xlsx",
+				Delimiter: ',',
+				Format:    option.XLSX,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				XlsxSheet: "sales",
+				XlsxRange: "B3:D5",
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("fixed_length_sl.txt")),
+				"T": strings.ToUpper(GetTestFilePath("workbook.xlsx")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File FormatElement Is Not Specified",
+		Name: "LoadView FormatSpecifiedFunction From XLSX File Without Header",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						Path: parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "workbook"},
+						Args: []parser.QueryExpression{
+							parser.NewIntegerValue(1),
+							parser.NewStringValue("A3:C4"),
+							parser.NewTernaryValueFromString("true"),
+							parser.NewTernaryValueFromString("true"),
+						},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid argument for fixed: delimiter positions are not specified",
+		Result: &View{
+			Header: NewHeader("t", []string{"c1", "c2", "c3"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("banana"),
+					value.NewFloat(0.25),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(3),
+					value.NewString("cherry"),
+					value.NewString(""),
+				}),
+			},
+			FileInfo: &FileInfo{
+				Path:      "workbook.xlsx",
+				Delimiter: ',',
+				Format:    option.XLSX,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				NoHeader:  true,
+				XlsxSheet: "1",
+				XlsxRange: "A3:C4",
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"T": strings.ToUpper(GetTestFilePath("workbook.xlsx")),
+			}},
+		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File FormatElement is Null",
+		Name: "LoadView FormatSpecifiedFunction From XLSX File Sheet Not Exist Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewNullValue(),
-						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "workbook.xlsx"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("notexist"),
+						},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid delimiter positions: NULL",
+		Error: "xlsx loading error: sheet notexist does not exist",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File Invalid Delimiter Positions",
+		Name: "LoadView FormatSpecifiedFunction From XLSX File Invalid Range Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("invalid"),
-						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "workbook.xlsx"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("Items"),
+							parser.NewStringValue("D1:A4"),
+						},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid delimiter positions: 'invalid'",
+		Error: "xlsx loading error: invalid cell range: D1:A4",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Fixed-Length File Arguments Length Error",
+		Name: "LoadView FormatSpecifiedFunction From XLSX File Arguments Length Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.FIXED, Literal: "fixed"},
-						FormatElement: parser.NewStringValue("spaces"),
-						Path:          parser.Identifier{Literal: "fixed_length.txt", Quoted: true},
+						Type: parser.Token{Token: parser.XLSX, Literal: "xlsx"},
+						Path: parser.Identifier{Literal: "workbook.xlsx"},
 						Args: []parser.QueryExpression{
-							parser.NewStringValue("SJIS"),
+							parser.NewStringValue("Items"),
+							parser.NewStringValue("A1:D4"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewTernaryValueFromString("true"),
 							parser.NewStringValue("extra"),
@@ -1276,373 +2207,321 @@ var loadViewTests = []struct {
 				},
 			},
 		},
-		Error: "table object fixed takes at most 5 arguments",
+		Error: "table object xlsx takes at most 5 arguments",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Json File",
+		Name: "LoadView From Parquet File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table"},
-					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Object: parser.Identifier{Literal: "nested.parquet"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("jt", []string{"item1", "item2"}),
+			Header: NewHeader("nested", []string{"id", "name", "address.city", "address.zip", "created", "birthday", "price", "tags", "score", "legacy_ts"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewFloat(1),
+					value.NewInteger(1),
+					value.NewString("alice"),
+					value.NewString("Tokyo"),
+					value.NewInteger(1000001),
+					value.NewDatetime(time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC).In(GetTestLocation())),
+					value.NewDatetime(time.Date(1990, 5, 6, 0, 0, 0, 0, GetTestLocation())),
+					value.NewFloat(12.34),
+					value.NewString("[\"a\",null,\"b\"]"),
+					value.NewFloat(1.5),
+					value.NewDatetime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).In(GetTestLocation())),
+				}),
+				NewRecord([]value.Primary{
+					value.NewInteger(2),
+					value.NewString("bob"),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewNull(),
+					value.NewString("[]"),
+					value.NewNull(),
+					value.NewNull(),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewFloat(2),
+					value.NewInteger(3),
+					value.NewString("alice"),
+					value.NewNull(),
+					value.NewInteger(5),
+					value.NewDatetime(time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).In(GetTestLocation())),
+					value.NewDatetime(time.Date(1970, 1, 1, 0, 0, 0, 0, GetTestLocation())),
+					value.NewFloat(-0.5),
+					value.NewNull(),
+					value.NewFloat(2.25),
+					value.NewNull(),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table.json",
+				Path:      "nested.parquet",
 				Delimiter: ',',
-				JsonQuery: "{}",
-				Format:    option.JSON,
+				Format:    option.PARQUET,
 				Encoding:  text.UTF8,
 				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table.json")),
+				"NESTED": strings.ToUpper(GetTestFilePath("nested.parquet")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From JsonH File",
+		Name: "LoadView FormatSpecifiedFunction From Parquet File with Column Projection",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table_h"},
+						Type: parser.Token{Token: parser.PARQUET, Literal: "parquet"},
+						Path: parser.Identifier{Literal: "nested"},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
+		Scope: func() *ReferenceScope {
+			scope := NewReferenceScope(TestTx)
+			scope.projection = columnProjection{"ID": true, "ADDRESS.CITY": true}
+			return scope
+		}(),
 		Result: &View{
-			Header: NewHeader("jt", []string{"item1", "item2"}),
+			Header: NewHeader("t", []string{"id", "address.city"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value\"1"),
-					value.NewFloat(1),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewFloat(2),
+					value.NewInteger(1),
+					value.NewString("Tokyo"),
 				}),
-			},
-			FileInfo: &FileInfo{
-				Path:       "table_h.json",
-				Delimiter:  ',',
-				JsonQuery:  "{}",
-				Format:     option.JSON,
-				Encoding:   text.UTF8,
-				LineBreak:  text.LF,
-				JsonEscape: json.HexDigits,
-			},
-		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
-			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table_h.json")),
-			}},
-		}, time.Time{}, nil),
-	},
-	{
-		Name: "LoadView FormatSpecifiedFunction From JsonA File",
-		From: parser.FromClause{
-			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table_a"},
-					},
-					Alias: parser.Identifier{Literal: "jt"},
-				},
-			},
-		},
-		Result: &View{
-			Header: NewHeader("jt", []string{"item1", "item2"}),
-			RecordSet: []Record{
 				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewFloat(1),
+					value.NewInteger(2),
+					value.NewNull(),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value2"),
-					value.NewFloat(2),
+					value.NewInteger(3),
+					value.NewNull(),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:       "table_a.json",
-				Delimiter:  ',',
-				JsonQuery:  "{}",
-				Format:     option.JSON,
-				Encoding:   text.UTF8,
-				LineBreak:  text.LF,
-				JsonEscape: json.AllWithHexDigits,
+				Path:      "nested.parquet",
+				Delimiter: ',',
+				Format:    option.PARQUET,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table_a.json")),
+				"T": strings.ToUpper(GetTestFilePath("nested.parquet")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Json File FormatElement Is Not Specified",
+		Name: "LoadView FormatSpecifiedFunction From Parquet File Arguments Length Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.JSON, Literal: "json"},
-						Path: parser.Identifier{Literal: "table"},
+						Type: parser.Token{Token: parser.PARQUET, Literal: "parquet"},
+						Path: parser.Identifier{Literal: "nested.parquet"},
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("extra"),
+						},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "invalid argument for json: json query is not specified",
+		Error: "table object parquet takes at most 1 arguments",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Json File FormatElement is Null",
+		Name: "LoadView From Parquet File ForUpdate Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewNullValue(),
-						Path:          parser.Identifier{Literal: "table"},
-					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Object: parser.Identifier{Literal: "nested.parquet"},
 				},
 			},
 		},
-		Error: "invalid json query: NULL",
+		ForUpdate: true,
+		Error:     "parquet file nested.parquet cannot be updated",
 	},
 	{
-		Name: "LoadView Table Object From Json File Path Error",
+		Name: "LoadView From Invalid Parquet File Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSON, Literal: "json"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "notexist"},
+						Type: parser.Token{Token: parser.PARQUET, Literal: "parquet"},
+						Path: parser.Identifier{Literal: "table1.csv"},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
 				},
 			},
 		},
-		Error: "file notexist does not exist",
+		Error: "parquet loading error: invalid parquet file",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From Json Lines File",
+		Name: "LoadView From Xml File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.FormatSpecifiedFunction{
-						Type:          parser.Token{Token: parser.JSONL, Literal: "jsonl"},
-						FormatElement: parser.NewStringValue("{}"),
-						Path:          parser.Identifier{Literal: "table7"},
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("items.item"),
+						Path:          parser.Identifier{Literal: "table8"},
 					},
-					Alias: parser.Identifier{Literal: "jt"},
+					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("jt", []string{"item1", "item2"}),
+			Header: NewHeader("t", []string{"id", "name", "tag"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
+					value.NewString("1"),
 					value.NewString("value1"),
-					value.NewFloat(1),
+					value.NewNull(),
 				}),
 				NewRecord([]value.Primary{
+					value.NewString("2"),
 					value.NewString("value2"),
-					value.NewFloat(2),
+					value.NewString("[\"a\",\"b\"]"),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table7.jsonl",
-				Delimiter: ',',
-				JsonQuery: "{}",
-				Format:    option.JSONL,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
+				Path:       "table8.xml",
+				Delimiter:  ',',
+				XmlRowPath: "items.item",
+				Format:     option.XML,
+				Encoding:   text.UTF8,
+				LineBreak:  text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"JT": strings.ToUpper(GetTestFilePath("table7.jsonl")),
+				"T": strings.ToUpper(GetTestFilePath("table8.xml")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From LTSV File",
+		Name: "LoadView From Xml File Row Path Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
+						Type:          parser.Token{Token: parser.XML, Literal: "xml"},
+						FormatElement: parser.NewStringValue("items.1item"),
+						Path:          parser.Identifier{Literal: "table8.xml"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewNull(),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewNull(),
-					value.NewString("value6"),
-				}),
-			},
-			FileInfo: &FileInfo{
-				Path:      "table6.ltsv",
-				Delimiter: ',',
-				Format:    option.LTSV,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
-			},
-		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
-			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
-			}},
-		}, time.Time{}, nil),
+		Error: "xml loading error: row path \"items.1item\" contains invalid name \"1item\"",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From LTSV File Without Null",
+		Name: "LoadView From Xml File Arguments Length Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
+						Type: parser.Token{Token: parser.XML, Literal: "xml"},
+						Path: parser.Identifier{Literal: "table8.xml"},
 						Args: []parser.QueryExpression{
-							parser.NewStringValue("UTF8"),
-							parser.NewTernaryValueFromString("true"),
+							parser.NewStringValue("extra"),
 						},
 					},
-					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
-			RecordSet: []Record{
-				NewRecord([]value.Primary{
-					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewString(""),
-				}),
-				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewString(""),
-					value.NewString("value6"),
-				}),
-			},
-			FileInfo: &FileInfo{
-				Path:      "table6.ltsv",
-				Delimiter: ',',
-				Format:    option.LTSV,
-				Encoding:  text.UTF8,
-				LineBreak: text.LF,
+		Error: "table object xml takes at most 2 arguments",
+	},
+	{
+		Name: "LoadView From Xml File ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Identifier{Literal: "table8.xml"},
+				},
 			},
 		},
-		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
-			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6.ltsv")),
-			}},
-		}, time.Time{}, nil),
+		ForUpdate: true,
+		Error:     "xml file table8.xml cannot be updated",
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From LTSV File with UTF-8 BOM",
+		Name: "LoadView From Yaml File",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6_bom"},
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("items"),
+						Path:          parser.Identifier{Literal: "table9"},
 					},
 					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
 		Result: &View{
-			Header: NewHeader("t", []string{"f1", "f2", "f3", "f4"}),
+			Header: NewHeader("t", []string{"item1", "item2"}),
 			RecordSet: []Record{
 				NewRecord([]value.Primary{
 					value.NewString("value1"),
-					value.NewString("value2"),
-					value.NewString("value3"),
-					value.NewNull(),
+					value.NewInteger(1),
 				}),
 				NewRecord([]value.Primary{
-					value.NewString("value4"),
-					value.NewString("value5"),
-					value.NewNull(),
-					value.NewString("value6"),
+					value.NewString("value2"),
+					value.NewFloat(2.5),
 				}),
 			},
 			FileInfo: &FileInfo{
-				Path:      "table6_bom.ltsv",
+				Path:      "table9.yaml",
 				Delimiter: ',',
-				Format:    option.LTSV,
-				Encoding:  text.UTF8M,
+				JsonQuery: "items",
+				Format:    option.YAML,
+				Encoding:  text.UTF8,
 				LineBreak: text.LF,
 			},
 		},
 		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
 			{scopeNameAliases: {
-				"T": strings.ToUpper(GetTestFilePath("table6_bom.ltsv")),
+				"T": strings.ToUpper(GetTestFilePath("table9.yaml")),
 			}},
 		}, time.Time{}, nil),
 	},
 	{
-		Name: "LoadView FormatSpecifiedFunction From LTSV File Arguments Length Error",
+		Name: "LoadView From Yaml File Not Mapping Error",
 		From: parser.FromClause{
 			Tables: []parser.QueryExpression{
 				parser.Table{
 					Object: parser.FormatSpecifiedFunction{
-						Type: parser.Token{Token: parser.LTSV, Literal: "ltsv"},
-						Path: parser.Identifier{Literal: "table6"},
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("UTF8"),
-							parser.NewTernaryValueFromString("true"),
-							parser.NewStringValue("extra"),
-						},
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("items[].item1"),
+						Path:          parser.Identifier{Literal: "table9.yaml"},
 					},
-					Alias: parser.Identifier{Literal: "t"},
 				},
 			},
 		},
-		Error: "table object ltsv takes exactly 3 arguments",
+		Error: "yaml loading error: rows loaded from yaml must be mappings",
+	},
+	{
+		Name: "LoadView From Yaml File ForUpdate Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.FormatSpecifiedFunction{
+						Type:          parser.Token{Token: parser.YAML, Literal: "yaml"},
+						FormatElement: parser.NewStringValue("items"),
+						Path:          parser.Identifier{Literal: "table9.yaml"},
+					},
+				},
+			},
+		},
+		ForUpdate: true,
+		Error:     "yaml file table9.yaml cannot be updated",
 	},
 	{
 		Name: "LoadView FormatSpecifiedFunction Invalid Object Type",
@@ -2133,6 +3012,55 @@ var loadViewTests = []struct {
 			}},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "Lateral Cross Join with Unnest",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+						},
+						JoinTable: parser.Table{
+							Lateral: parser.Token{Token: parser.LATERAL},
+							Object: parser.Unnest{
+								Expr: parser.ArrayValue{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+										parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+									},
+								},
+							},
+							Alias: parser.Identifier{
+								Literal: "elem",
+							},
+						},
+						JoinType: parser.Token{Token: parser.CROSS, Literal: "cross"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+				{View: "elem", Column: "elem", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1"), value.NewString("1")}),
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("str1"), value.NewString("str1")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2"), value.NewString("2")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str2"), value.NewString("str2")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3"), value.NewString("3")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str3"), value.NewString("str3")}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"TABLE1": strings.ToUpper(GetTestFilePath("table1.csv")),
+			}},
+		}, time.Time{}, nil),
+	},
 	{
 		Name: "Inner Join",
 		From: parser.FromClause{
@@ -2890,6 +3818,95 @@ var loadViewTests = []struct {
 			},
 		}, time.Time{}, nil),
 	},
+	{
+		Name: "LoadView Unnest",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unnest{
+						Expr: parser.ArrayValue{
+							Values: []parser.QueryExpression{
+								parser.NewIntegerValue(1),
+								parser.NewStringValue("a"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("", []string{"unnest"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("a"),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Unnest with Table Name Alias",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unnest{
+						Expr: parser.ArrayValue{
+							Values: []parser.QueryExpression{
+								parser.NewIntegerValue(1),
+							},
+						},
+					},
+					Alias: parser.Identifier{Literal: "n"},
+				},
+			},
+		},
+		Result: &View{
+			Header: NewHeader("n", []string{"n"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewInteger(1),
+				}),
+			},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{
+			{scopeNameAliases: {
+				"N": "",
+			}},
+		}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Unnest Null",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unnest{
+						Expr: parser.NewNullValue(),
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header:    NewHeader("", []string{"unnest"}),
+			RecordSet: []Record{},
+		},
+		ResultScope: GenerateReferenceScope(nil, []map[string]map[string]interface{}{{}}, time.Time{}, nil),
+	},
+	{
+		Name: "LoadView Unnest Not Array Error",
+		From: parser.FromClause{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.Unnest{
+						Expr: parser.NewIntegerValue(1),
+					},
+				},
+			},
+		},
+		Error: "UNNEST value 1 is not an array",
+	},
 	{
 		Name: "LoadView Subquery",
 		From: parser.FromClause{
@@ -3186,6 +4203,56 @@ var loadViewTests = []struct {
 	},
 }
 
+func TestLoadView_ParquetColumnProjection(t *testing.T) {
+	defer func() {
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	ctx := context.Background()
+	_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+
+	tables := []parser.QueryExpression{
+		parser.Table{Object: parser.Identifier{Literal: "nested.parquet"}},
+	}
+
+	projections := []struct {
+		Projection columnProjection
+		Header     []string
+	}{
+		{
+			Projection: columnProjection{"NAME": true},
+			Header:     []string{"name"},
+		},
+		{
+			Projection: columnProjection{"PRICE": true},
+			Header:     []string{"name", "price"},
+		},
+		{
+			Projection: columnProjection{"NAME": true},
+			Header:     []string{"name", "price"},
+		},
+	}
+
+	for _, v := range projections {
+		scope := NewReferenceScope(TestTx)
+		scope.projection = v.Projection
+		view, err := LoadView(ctx, scope.CreateNode(), tables, false, false)
+		if err != nil {
+			t.Fatalf("unexpected error %q", err)
+		}
+
+		header := make([]string, 0, view.FieldLen())
+		for _, h := range view.Header {
+			header = append(header, h.Column)
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("header = %q, want %q for projection %v", header, v.Header, v.Projection)
+		}
+	}
+}
+
 func TestLoadView(t *testing.T) {
 	defer func() {
 		_ = TestTx.ReleaseResources()
@@ -3213,6 +4280,7 @@ func TestLoadView(t *testing.T) {
 		TestTx.Flags.ImportOptions.JsonQuery = v.JsonQuery
 		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
 		TestTx.Flags.ImportOptions.WithoutNull = v.WithoutNull
+		TestTx.Flags.ImportOptions.SourcePathColumn = v.SourcePathColumn
 		if v.Encoding != text.AUTO {
 			TestTx.Flags.ImportOptions.Encoding = v.Encoding
 		} else {
@@ -3277,6 +4345,9 @@ func TestLoadView(t *testing.T) {
 			if view.FileInfo.PrettyPrint != v.Result.FileInfo.PrettyPrint {
 				t.Errorf("%s: FileInfo.PrettyPrint = %t, want %t", v.Name, view.FileInfo.PrettyPrint, v.Result.FileInfo.PrettyPrint)
 			}
+			if view.FileInfo.Compression != v.Result.FileInfo.Compression {
+				t.Errorf("%s: FileInfo.Compression = %s, want %s", v.Name, view.FileInfo.Compression, v.Result.FileInfo.Compression)
+			}
 			if view.FileInfo.ForUpdate != v.Result.FileInfo.ForUpdate {
 				t.Errorf("%s: FileInfo.ForUpdate = %t, want %t", v.Name, view.FileInfo.ForUpdate, v.Result.FileInfo.ForUpdate)
 			}
@@ -142,6 +142,8 @@ func setup() {
 	_ = copyfile(filepath.Join(TestDir, "updated_file_1.csv"), filepath.Join(TestDataDir, "table1.csv"))
 	_ = copyfile(filepath.Join(TestDir, "dup_name.csv"), filepath.Join(TestDataDir, "dup_name.csv"))
 	_ = copyfile(filepath.Join(TestDir, "table_empty.csv"), filepath.Join(TestDataDir, "table_empty.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_typed.csv"), filepath.Join(TestDataDir, "table_typed.csv"))
+	_ = copyfile(filepath.Join(TestDir, "table_typed.csv.schema"), filepath.Join(TestDataDir, "table_typed.csv.schema"))
 
 	_ = copyfile(filepath.Join(TestDir, "table3.tsv"), filepath.Join(TestDataDir, "table3.tsv"))
 	_ = copyfile(filepath.Join(TestDir, "dup_name.tsv"), filepath.Join(TestDataDir, "dup_name.tsv"))
@@ -161,6 +163,29 @@ func setup() {
 
 	_ = copyfile(filepath.Join(TestDir, "autoselect"), filepath.Join(TestDataDir, "autoselect"))
 
+	_ = copyfile(filepath.Join(TestDir, "table_gzip.csv.gz"), filepath.Join(TestDataDir, "table_gzip.csv.gz"))
+	_ = copyfile(filepath.Join(TestDir, "table_bzip2.tsv.bz2"), filepath.Join(TestDataDir, "table_bzip2.tsv.bz2"))
+	_ = copyfile(filepath.Join(TestDir, "table_xz.csv.xz"), filepath.Join(TestDataDir, "table_xz.csv.xz"))
+	_ = copyfile(filepath.Join(TestDir, "table_zstd.csv.zst"), filepath.Join(TestDataDir, "table_zstd.csv.zst"))
+
+	_ = copyfile(filepath.Join(TestDir, "archive_zip.zip"), filepath.Join(TestDataDir, "archive_zip.zip"))
+	_ = copyfile(filepath.Join(TestDir, "archive_tar.tar.gz"), filepath.Join(TestDataDir, "archive_tar.tar.gz"))
+
+	_ = copyfile(filepath.Join(TestDir, "workbook.xlsx"), filepath.Join(TestDataDir, "workbook.xlsx"))
+	_ = copyfile(filepath.Join(TestDir, "nested.parquet"), filepath.Join(TestDataDir, "nested.parquet"))
+	_ = copyfile(filepath.Join(TestDir, "table8.xml"), filepath.Join(TestDataDir, "table8.xml"))
+	_ = copyfile(filepath.Join(TestDir, "table9.yaml"), filepath.Join(TestDataDir, "table9.yaml"))
+
+	for _, fpath := range []string{
+		filepath.Join("logs", "year=2024", "app.csv"),
+		filepath.Join("logs", "year=2025", "app.csv"),
+		filepath.Join("logs", "year=2025", "app.jsonl"),
+		filepath.Join("logs", ".hidden", "app.csv"),
+	} {
+		_ = os.MkdirAll(filepath.Dir(filepath.Join(TestDir, fpath)), 0755)
+		_ = copyfile(filepath.Join(TestDir, fpath), filepath.Join(TestDataDir, fpath))
+	}
+
 	_ = copyfile(filepath.Join(TestDir, "source.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source.sql"))
 	_ = copyfile(filepath.Join(TestDir, "source_syntaxerror.sql"), filepath.Join(filepath.Join(GetWD(), "..", "..", "testdata"), "source_syntaxerror.sql"))
 
@@ -205,6 +230,7 @@ func initFlag(flags *option.Flags) {
 	flags.Quiet = false
 	flags.LimitRecursion = 5
 	flags.CPU = cpu
+	flags.SortMemoryLimit = -1
 	flags.Stats = false
 	flags.SetColor(false)
 	_ = flags.SetLocation(TestLocation)
@@ -0,0 +1,237 @@
+package query
+
+import (
+	"context"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// PivotView rotates the values of the pivot field into fields.
+// Records are grouped by the fields that are neither the pivot field nor referred to in the aggregate expression,
+// and the aggregate expression is evaluated for each pair of a group and a pivot value.
+func PivotView(ctx context.Context, scope *ReferenceScope, view *View, expr parser.Pivot) (*View, error) {
+	if ok, err := HasAggregateFunction(expr.Aggregate, scope); err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, NewPivotAggregateRequiredError(expr.Aggregate)
+	}
+
+	pivotIdx, err := view.FieldIndex(expr.Column)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := SearchFieldReferences(expr.Aggregate)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make([]int, 0, len(refs)+1)
+	excluded = append(excluded, pivotIdx)
+	for _, ref := range refs {
+		idx, err := view.FieldIndex(ref)
+		if err != nil {
+			if _, ok := err.(*FieldNotExistError); ok {
+				// The reference may be resolved in the outer scope.
+				continue
+			}
+			return nil, err
+		}
+		excluded = append(excluded, idx)
+	}
+	keys := pivotKeyIndices(view.Header, excluded)
+
+	columns := make([]string, len(expr.Values))
+	columnIndices := make(map[string][]int, len(expr.Values))
+	for i, v := range expr.Values {
+		field := v.(parser.Field)
+		p, err := Evaluate(ctx, scope, field.Object)
+		if err != nil {
+			return nil, err
+		}
+
+		if field.Alias != nil {
+			columns[i] = field.Alias.(parser.Identifier).Literal
+		} else if s := value.ToString(p); !value.IsNull(s) {
+			columns[i] = s.(*value.String).Raw()
+		} else {
+			columns[i] = field.Object.String()
+		}
+
+		if value.IsNull(p) {
+			continue
+		}
+		key := serializePivotKey([]value.Primary{p}, scope)
+		columnIndices[key] = append(columnIndices[key], i)
+	}
+
+	groupIndices := make(map[string]int, 40)
+	groupRecords := make([]int, 0, 40)
+	groups := make([][][]int, 0, 40)
+	keyValues := make([]value.Primary, len(keys))
+	for i, record := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for j, idx := range keys {
+			keyValues[j] = record[idx][0]
+		}
+		groupKey := serializePivotKey(keyValues, scope)
+		gIdx, ok := groupIndices[groupKey]
+		if !ok {
+			gIdx = len(groups)
+			groupIndices[groupKey] = gIdx
+			groupRecords = append(groupRecords, i)
+			groups = append(groups, make([][]int, len(columns)))
+		}
+
+		if value.IsNull(record[pivotIdx][0]) {
+			continue
+		}
+		for _, cIdx := range columnIndices[serializePivotKey([]value.Primary{record[pivotIdx][0]}, scope)] {
+			groups[gIdx][cIdx] = append(groups[gIdx][cIdx], i)
+		}
+	}
+
+	header := NewEmptyHeader(len(keys) + len(columns))
+	for i, idx := range keys {
+		header[i] = view.Header[idx]
+		header[i].Number = i + 1
+		header[i].IsGroupKey = false
+	}
+	viewName := view.Header[pivotIdx].View
+	for i, c := range columns {
+		header[len(keys)+i] = HeaderField{
+			View:        viewName,
+			Column:      c,
+			Number:      len(keys) + i + 1,
+			IsFromTable: true,
+		}
+	}
+
+	aggView := NewView()
+	aggView.Header = view.Header
+	aggView.RecordSet = make(RecordSet, 1)
+	aggView.isGrouped = true
+	aggScope := scope.CreateScopeForRecordEvaluation(aggView, 0)
+
+	records := make(RecordSet, len(groups))
+	for i := range groups {
+		if ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		record := make(Record, header.Len())
+		for j, idx := range keys {
+			record[j] = NewCell(view.RecordSet[groupRecords[i]][idx][0])
+		}
+		for j := range columns {
+			aggView.RecordSet[0] = newPivotGroupRecord(view.RecordSet, groups[i][j], view.FieldLen())
+			p, err := Evaluate(ctx, aggScope, expr.Aggregate)
+			if err != nil {
+				return nil, err
+			}
+			record[len(keys)+j] = NewCell(p)
+		}
+		records[i] = record
+	}
+
+	pivoted := NewView()
+	pivoted.Header = header
+	pivoted.RecordSet = records
+	return pivoted, nil
+}
+
+// UnpivotView rotates the specified fields into records.
+// Each record is expanded into one record per field that has a non-null value,
+// consisting of the remaining fields, the name of the field and its value.
+func UnpivotView(ctx context.Context, scope *ReferenceScope, view *View, expr parser.Unpivot) (*View, error) {
+	indices, err := view.FieldIndices(expr.Columns)
+	if err != nil {
+		return nil, err
+	}
+	keys := pivotKeyIndices(view.Header, indices)
+
+	header := NewEmptyHeader(len(keys) + 2)
+	for i, idx := range keys {
+		header[i] = view.Header[idx]
+		header[i].Number = i + 1
+		header[i].IsGroupKey = false
+	}
+	viewName := view.Header[indices[0]].View
+	header[len(keys)] = HeaderField{
+		View:        viewName,
+		Column:      expr.Name.Literal,
+		Number:      len(keys) + 1,
+		IsFromTable: true,
+	}
+	header[len(keys)+1] = HeaderField{
+		View:        viewName,
+		Column:      expr.Value.Literal,
+		Number:      len(keys) + 2,
+		IsFromTable: true,
+	}
+
+	names := make([]value.Primary, len(indices))
+	for i, idx := range indices {
+		names[i] = value.NewString(view.Header[idx].Column)
+	}
+
+	records := make(RecordSet, 0, view.RecordLen()*len(indices))
+	for i, record := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, ConvertContextError(ctx.Err())
+		}
+
+		for j, idx := range indices {
+			if value.IsNull(record[idx][0]) {
+				continue
+			}
+
+			r := make(Record, header.Len())
+			for k, keyIdx := range keys {
+				r[k] = record[keyIdx]
+			}
+			r[len(keys)] = NewCell(names[j])
+			r[len(keys)+1] = record[idx]
+			records = append(records, r)
+		}
+	}
+
+	unpivoted := NewView()
+	unpivoted.Header = header
+	unpivoted.RecordSet = records
+	return unpivoted, nil
+}
+
+func pivotKeyIndices(header Header, excluded []int) []int {
+	keys := make([]int, 0, header.Len())
+	for i := range header {
+		if header[i].Column == InternalIdColumn || InIntSlice(i, excluded) {
+			continue
+		}
+		keys = append(keys, i)
+	}
+	return keys
+}
+
+func serializePivotKey(values []value.Primary, scope *ReferenceScope) string {
+	buf := GetComparisonKeysBuf()
+	SerializeComparisonKeys(buf, values, scope.Tx.Flags)
+	key := buf.String()
+	PutComparisonkeysBuf(buf)
+	return key
+}
+
+func newPivotGroupRecord(records RecordSet, indices []int, fieldLen int) Record {
+	record := make(Record, fieldLen)
+	for i := range record {
+		cell := make(Cell, len(indices))
+		for j, idx := range indices {
+			cell[j] = records[idx][i][0]
+		}
+		record[i] = cell
+	}
+	return record
+}
@@ -0,0 +1,210 @@
+package query
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+func pivotTestView() *View {
+	return &View{
+		Header: NewHeader("sales", []string{"region", "month", "amount"}),
+		RecordSet: []Record{
+			NewRecord([]value.Primary{value.NewString("East"), value.NewString("Jan"), value.NewInteger(10)}),
+			NewRecord([]value.Primary{value.NewString("East"), value.NewString("Feb"), value.NewInteger(20)}),
+			NewRecord([]value.Primary{value.NewString("West"), value.NewString("Jan"), value.NewInteger(5)}),
+			NewRecord([]value.Primary{value.NewString("East"), value.NewString("Jan"), value.NewInteger(1)}),
+			NewRecord([]value.Primary{value.NewString("North"), value.NewNull(), value.NewInteger(3)}),
+		},
+	}
+}
+
+var pivotViewTests = []struct {
+	Name   string
+	Expr   parser.Pivot
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Pivot",
+		Expr: parser.Pivot{
+			Aggregate: parser.AggregateFunction{
+				Name: "sum",
+				Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("Jan")},
+				parser.Field{Object: parser.NewStringValue("Feb"), Alias: parser.Identifier{Literal: "february"}},
+				parser.Field{Object: parser.NewIntegerValue(3)},
+			},
+		},
+		Result: &View{
+			Header: Header{
+				{View: "sales", Column: "region", Number: 1, IsFromTable: true},
+				{View: "sales", Column: "Jan", Number: 2, IsFromTable: true},
+				{View: "sales", Column: "february", Number: 3, IsFromTable: true},
+				{View: "sales", Column: "3", Number: 4, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("East"), value.NewFloat(11), value.NewFloat(20), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("West"), value.NewFloat(5), value.NewNull(), value.NewNull()}),
+				NewRecord([]value.Primary{value.NewString("North"), value.NewNull(), value.NewNull(), value.NewNull()}),
+			},
+		},
+	},
+	{
+		Name: "Pivot with Count",
+		Expr: parser.Pivot{
+			Aggregate: parser.AggregateFunction{
+				Name: "count",
+				Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("Jan")},
+			},
+		},
+		Result: &View{
+			Header: Header{
+				{View: "sales", Column: "region", Number: 1, IsFromTable: true},
+				{View: "sales", Column: "Jan", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("East"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewString("West"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("North"), value.NewInteger(0)}),
+			},
+		},
+	},
+	{
+		Name: "Pivot Aggregate Required Error",
+		Expr: parser.Pivot{
+			Aggregate: parser.FieldReference{Column: parser.Identifier{Literal: "amount"}},
+			Column:    parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("Jan")},
+			},
+		},
+		Error: "PIVOT value amount must contain an aggregate function",
+	},
+	{
+		Name: "Pivot Field Not Exist Error",
+		Expr: parser.Pivot{
+			Aggregate: parser.AggregateFunction{
+				Name: "sum",
+				Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "amount"}}},
+			},
+			Column: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			Values: []parser.QueryExpression{
+				parser.Field{Object: parser.NewStringValue("Jan")},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestPivotView(t *testing.T) {
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range pivotViewTests {
+		result, err := PivotView(ctx, scope, pivotTestView(), v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
+
+var unpivotViewTests = []struct {
+	Name   string
+	Expr   parser.Unpivot
+	Result *View
+	Error  string
+}{
+	{
+		Name: "Unpivot",
+		Expr: parser.Unpivot{
+			Value: parser.Identifier{Literal: "value"},
+			Name:  parser.Identifier{Literal: "metric"},
+			Columns: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "month"}},
+				parser.FieldReference{Column: parser.Identifier{Literal: "amount"}},
+			},
+		},
+		Result: &View{
+			Header: Header{
+				{View: "sales", Column: "region", Number: 1, IsFromTable: true},
+				{View: "sales", Column: "metric", Number: 2, IsFromTable: true},
+				{View: "sales", Column: "value", Number: 3, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("month"), value.NewString("Jan")}),
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("amount"), value.NewInteger(10)}),
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("month"), value.NewString("Feb")}),
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("amount"), value.NewInteger(20)}),
+				NewRecord([]value.Primary{value.NewString("West"), value.NewString("month"), value.NewString("Jan")}),
+				NewRecord([]value.Primary{value.NewString("West"), value.NewString("amount"), value.NewInteger(5)}),
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("month"), value.NewString("Jan")}),
+				NewRecord([]value.Primary{value.NewString("East"), value.NewString("amount"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("North"), value.NewString("amount"), value.NewInteger(3)}),
+			},
+		},
+	},
+	{
+		Name: "Unpivot Field Not Exist Error",
+		Expr: parser.Unpivot{
+			Value: parser.Identifier{Literal: "value"},
+			Name:  parser.Identifier{Literal: "metric"},
+			Columns: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
+}
+
+func TestUnpivotView(t *testing.T) {
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range unpivotViewTests {
+		result, err := UnpivotView(ctx, scope, pivotTestView(), v.Expr)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(result.Header, v.Result.Header) {
+			t.Errorf("%s: header = %v, want %v", v.Name, result.Header, v.Result.Header)
+		}
+		if !reflect.DeepEqual(result.RecordSet, v.Result.RecordSet) {
+			t.Errorf("%s: records = %s, want %s", v.Name, result.RecordSet, v.Result.RecordSet)
+		}
+	}
+}
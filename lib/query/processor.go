@@ -194,46 +194,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				proc.measurementStart = time.Now()
 			}
 
-			view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery))
-			if e == nil {
-				var warnmsg string
-
-				proc.Tx.Session.mtx.Lock()
-
-				if proc.storeResults {
-					proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
-				}
-
-				if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
-					exportOptions := proc.Tx.Flags.ExportOptions.Copy()
-
-					var writer io.Writer
-					if proc.Tx.Session.OutFile() != nil {
-						writer = proc.Tx.Session.OutFile()
-					} else {
-						writer = proc.Tx.Session.Stdout()
-					}
-					warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
-
-					if e != nil {
-						if e == EmptyResultSetError {
-							warnmsg = warn
-						} else if e == DataEmpty {
-							// Do Nothing
-						} else {
-							err = e
-						}
-					} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
-						!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == option.FIXED && exportOptions.SingleLine) {
-						_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
-					}
-				}
-
-				proc.Tx.Session.mtx.Unlock()
-
-				if 0 < len(warnmsg) {
-					proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
-				}
+			if streamed, e := proc.streamSelect(ctx, stmt.(parser.SelectQuery)); streamed {
+				err = e
+			} else if view, e := Select(ctx, proc.ReferenceScope, stmt.(parser.SelectQuery)); e == nil {
+				err = proc.writeView(ctx, view)
 			} else {
 				err = e
 			}
@@ -242,90 +206,17 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 				proc.showExecutionTime(ctx)
 			}
 		}
-	case parser.InsertQuery:
+	case parser.InsertQuery, parser.UpdateQuery, parser.ReplaceQuery, parser.MergeQuery, parser.DeleteQuery:
 		if proc.Tx.Flags.Stats {
 			proc.measurementStart = time.Now()
 		}
 
-		fileInfo, cnt, e := Insert(ctx, proc.ReferenceScope, stmt.(parser.InsertQuery))
-		if e == nil {
-			if 0 < cnt {
-				proc.Tx.UncommittedViews.SetForUpdatedView(fileInfo)
-			}
-			proc.Log(fmt.Sprintf("%s inserted on %q.", FormatCount(cnt, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
+		if cnt, view, e := Manipulate(ctx, proc.ReferenceScope, stmt); e == nil {
 			if proc.storeResults {
 				proc.Tx.AffectedRows = cnt
 			}
-		} else {
-			err = e
-		}
-
-		if proc.Tx.Flags.Stats {
-			proc.showExecutionTime(ctx)
-		}
-	case parser.UpdateQuery:
-		if proc.Tx.Flags.Stats {
-			proc.measurementStart = time.Now()
-		}
-
-		infos, cnts, e := Update(ctx, proc.ReferenceScope, stmt.(parser.UpdateQuery))
-		if e == nil {
-			cntTotal := 0
-			for i, info := range infos {
-				if 0 < cnts[i] {
-					proc.Tx.UncommittedViews.SetForUpdatedView(info)
-					cntTotal += cnts[i]
-				}
-				proc.Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), proc.Tx.Flags.Quiet)
-			}
-			if proc.storeResults {
-				proc.Tx.AffectedRows = cntTotal
-			}
-		} else {
-			err = e
-		}
-
-		if proc.Tx.Flags.Stats {
-			proc.showExecutionTime(ctx)
-		}
-	case parser.ReplaceQuery:
-		if proc.Tx.Flags.Stats {
-			proc.measurementStart = time.Now()
-		}
-
-		fileInfo, cnt, e := Replace(ctx, proc.ReferenceScope, stmt.(parser.ReplaceQuery))
-		if e == nil {
-			if 0 < cnt {
-				proc.Tx.UncommittedViews.SetForUpdatedView(fileInfo)
-			}
-			proc.Log(fmt.Sprintf("%s replaced on %q.", FormatCount(cnt, "record"), fileInfo.Path), proc.Tx.Flags.Quiet)
-			if proc.storeResults {
-				proc.Tx.AffectedRows = cnt
-			}
-		} else {
-			err = e
-		}
-
-		if proc.Tx.Flags.Stats {
-			proc.showExecutionTime(ctx)
-		}
-	case parser.DeleteQuery:
-		if proc.Tx.Flags.Stats {
-			proc.measurementStart = time.Now()
-		}
-
-		infos, cnts, e := Delete(ctx, proc.ReferenceScope, stmt.(parser.DeleteQuery))
-		if e == nil {
-			cntTotal := 0
-			for i, info := range infos {
-				if 0 < cnts[i] {
-					proc.Tx.UncommittedViews.SetForUpdatedView(info)
-					cntTotal += cnts[i]
-				}
-				proc.Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), proc.Tx.Flags.Quiet)
-			}
-			if proc.storeResults {
-				proc.Tx.AffectedRows = cntTotal
+			if view != nil {
+				err = proc.writeView(ctx, view)
 			}
 		} else {
 			err = e
@@ -462,6 +353,8 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		flow, err = proc.While(ctx, stmt.(parser.While))
 	case parser.WhileInCursor:
 		flow, err = proc.WhileInCursor(ctx, stmt.(parser.WhileInCursor))
+	case parser.TryCatch:
+		flow, err = proc.TryCatch(ctx, stmt.(parser.TryCatch))
 	case parser.Echo:
 		if printstr, err = Echo(ctx, proc.ReferenceScope, stmt.(parser.Echo)); err == nil {
 			proc.Log(printstr, false)
@@ -506,6 +399,10 @@ func (proc *Processor) ExecuteStatement(ctx context.Context, stmt parser.Stateme
 		if printstr, err = Syntax(ctx, proc.ReferenceScope, stmt.(parser.Syntax)); err == nil {
 			proc.Log(printstr, false)
 		}
+	case parser.Explain:
+		if printstr, err = Explain(ctx, proc.ReferenceScope, stmt.(parser.Explain)); err == nil {
+			proc.Log(printstr, false)
+		}
 	case parser.Trigger:
 		trigger := stmt.(parser.Trigger)
 		switch strings.ToUpper(trigger.Event.Literal) {
@@ -684,6 +581,37 @@ func (proc *Processor) WhileInCursor(ctx context.Context, stmt parser.WhileInCur
 	return Terminate, nil
 }
 
+func (proc *Processor) TryCatch(ctx context.Context, stmt parser.TryCatch) (StatementFlow, error) {
+	flow, err := proc.executeChild(ctx, stmt.Try)
+	if err == nil {
+		return flow, nil
+	}
+
+	caught, ok := err.(Error)
+	if !ok || !isCatchableError(caught) {
+		return flow, err
+	}
+
+	child := proc.NewChildProcessor()
+	child.ReferenceScope.Blocks[0].caughtError = caught
+	flow, err = child.execute(ctx, stmt.Catch)
+	if child.returnVal != nil {
+		proc.returnVal = child.returnVal
+	}
+	child.Close()
+	return flow, err
+}
+
+// isCatchableError reports whether the error can be handled by a CATCH block.
+// Cancellations, fatal errors and exits always abort the execution.
+func isCatchableError(err Error) bool {
+	switch err.(type) {
+	case *ContextCanceled, *ContextDone, *FatalError, *ForcedExit:
+		return false
+	}
+	return true
+}
+
 func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.ExternalCommand) error {
 	splitter := new(excmd.ArgsSplitter).Init(stmt.Command)
 	var argStrs = make([]string, 0, 8)
@@ -725,6 +653,85 @@ func (proc *Processor) ExecExternalCommand(ctx context.Context, stmt parser.Exte
 	return err
 }
 
+func (proc *Processor) streamSelect(ctx context.Context, query parser.SelectQuery) (bool, error) {
+	if proc.storeResults {
+		return false, nil
+	}
+
+	var writer io.Writer
+	if proc.Tx.Session.OutFile() != nil {
+		writer = proc.Tx.Session.OutFile()
+	} else if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok {
+		writer = proc.Tx.Session.Stdout()
+	} else {
+		return false, nil
+	}
+
+	proc.Tx.Session.mtx.Lock()
+	defer proc.Tx.Session.mtx.Unlock()
+
+	exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+	streamed, err := StreamSelect(ctx, proc.ReferenceScope, query, writer, exportOptions, proc.Tx.Palette)
+	if !streamed {
+		return false, nil
+	}
+
+	if err != nil {
+		if err == DataEmpty {
+			err = nil
+		}
+	} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak {
+		_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+	}
+	return true, err
+}
+
+func (proc *Processor) writeView(ctx context.Context, view *View) error {
+	var err error
+	var warnmsg string
+
+	proc.Tx.Session.mtx.Lock()
+
+	if proc.storeResults {
+		proc.Tx.SelectedViews = append(proc.Tx.SelectedViews, view)
+	}
+
+	if _, ok := proc.Tx.Session.Stdout().(*Discard); !ok || proc.Tx.Session.OutFile() != nil {
+		exportOptions := proc.Tx.Flags.ExportOptions.Copy()
+
+		var writer io.Writer
+		if proc.Tx.Session.OutFile() != nil {
+			writer = proc.Tx.Session.OutFile()
+		} else {
+			writer = proc.Tx.Session.Stdout()
+		}
+		warn, e := EncodeView(ctx, writer, view, exportOptions, proc.Tx.Palette)
+
+		if e != nil {
+			if e == EmptyResultSetError {
+				warnmsg = warn
+			} else if e == DataEmpty {
+				// Do Nothing
+			} else {
+				err = e
+			}
+		} else if !proc.Tx.Flags.ExportOptions.StripEndingLineBreak &&
+			exportOptions.Format != option.XLSX &&
+			exportOptions.Format != option.PARQUET &&
+			!(proc.Tx.Session.OutFile() != nil && exportOptions.Format == option.FIXED && exportOptions.SingleLine) {
+			_, err = writer.Write([]byte(proc.Tx.Flags.ExportOptions.LineBreak.Value()))
+		}
+	}
+
+	proc.Tx.Session.mtx.Unlock()
+
+	if 0 < len(warnmsg) {
+		proc.LogWarn(warnmsg, proc.Tx.Flags.Quiet)
+	}
+
+	return err
+}
+
 func (proc *Processor) showExecutionTime(ctx context.Context) {
 	if ctx.Err() != nil {
 		return
@@ -2018,6 +2018,170 @@ func TestProcessor_WhileInCursor(t *testing.T) {
 	}
 }
 
+var processorTryCatchTests = []struct {
+	Name       string
+	Stmt       parser.TryCatch
+	ResultFlow StatementFlow
+	Result     string
+	Error      string
+	ReturnCode int
+}{
+	{
+		Name: "TryCatch Statement",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("try")},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("catch")},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'try'\n",
+	},
+	{
+		Name: "TryCatch Statement Catch Error",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+					Code:    value.NewInteger(200),
+				},
+				parser.Print{Value: parser.NewStringValue("try")},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.Function{Name: "error_code"}},
+				parser.Print{Value: parser.Function{Name: "error_message"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "200\n'user error'\n",
+	},
+	{
+		Name: "TryCatch Statement Nested",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("outer error"),
+					Code:    value.NewInteger(200),
+				},
+			},
+			Catch: []parser.Statement{
+				parser.TryCatch{
+					Try: []parser.Statement{
+						parser.Trigger{
+							Event:   parser.Identifier{Literal: "error"},
+							Message: parser.NewStringValue("inner error"),
+						},
+					},
+					Catch: []parser.Statement{
+						parser.Print{Value: parser.Function{Name: "error_message"}},
+					},
+				},
+				parser.Print{Value: parser.Function{Name: "error_message"}},
+			},
+		},
+		ResultFlow: Terminate,
+		Result:     "'inner error'\n'outer error'\n",
+	},
+	{
+		Name: "TryCatch Statement Flow Control in Catch",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Trigger{
+					Event: parser.Identifier{Literal: "error"},
+				},
+			},
+			Catch: []parser.Statement{
+				parser.FlowControl{Token: parser.CONTINUE},
+				parser.Print{Value: parser.NewStringValue("catch")},
+			},
+		},
+		ResultFlow: Continue,
+		Result:     "",
+	},
+	{
+		Name: "TryCatch Statement Error in Catch",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Trigger{
+					Event:   parser.Identifier{Literal: "error"},
+					Message: parser.NewStringValue("user error"),
+				},
+			},
+			Catch: []parser.Statement{
+				parser.Trigger{
+					Event: parser.Identifier{Literal: "error"},
+					Message: parser.Concat{Items: []parser.QueryExpression{
+						parser.NewStringValue("rethrown: "),
+						parser.Function{Name: "error_message"},
+					}},
+				},
+			},
+		},
+		Error: "rethrown: user error",
+	},
+	{
+		Name: "TryCatch Statement Exit in Try",
+		Stmt: parser.TryCatch{
+			Try: []parser.Statement{
+				parser.Exit{Code: value.NewInteger(3)},
+			},
+			Catch: []parser.Statement{
+				parser.Print{Value: parser.NewStringValue("catch")},
+			},
+		},
+		Error:      ExitMessage,
+		ReturnCode: 3,
+	},
+}
+
+func TestProcessor_TryCatch(t *testing.T) {
+	defer func() {
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(true)
+	tx := TestTx
+	proc := NewProcessor(tx)
+
+	for _, v := range processorTryCatchTests {
+		out := NewOutput()
+		tx.Session.SetStdout(out)
+		flow, err := proc.TryCatch(context.Background(), v.Stmt)
+		log := out.String()
+
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			if 0 < v.ReturnCode && err.(Error).Code() != v.ReturnCode {
+				t.Errorf("%s: error code %d, want error code %d", v.Name, err.(Error).Code(), v.ReturnCode)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if flow != v.ResultFlow {
+			t.Errorf("%s: result flow = %q, want %q", v.Name, flow, v.ResultFlow)
+		}
+		if log != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, log, v.Result)
+		}
+	}
+
+	if err := proc.ReferenceScope.CaughtError(); err != nil {
+		t.Errorf("caught error = %q, want nil after the catch blocks", err)
+	}
+}
+
 var processorExecExternalCommand = []struct {
 	Name  string
 	Stmt  parser.ExternalCommand
@@ -2,12 +2,17 @@ package query
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 
 	"github.com/mithrandie/csvq/lib/option"
 	"github.com/mithrandie/csvq/lib/parser"
 	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
 )
 
 func FetchCursor(ctx context.Context, scope *ReferenceScope, name parser.Identifier, fetchPosition parser.FetchPosition, vars []parser.Variable) (bool, error) {
@@ -58,14 +63,19 @@ func DeclareView(ctx context.Context, scope *ReferenceScope, expr parser.ViewDec
 	var err error
 
 	if expr.Query != nil {
-		view, err = Select(ctx, scope, expr.Query.(parser.SelectQuery))
+		selectQuery, isSelect := expr.Query.(parser.SelectQuery)
+		if isSelect {
+			view, err = Select(ctx, scope, selectQuery)
+		} else {
+			view, err = selectReturning(ctx, scope, expr.Query)
+		}
 		if err != nil {
 			return err
 		}
 
 		if err := view.Header.Update(expr.View.Literal, expr.Fields); err != nil {
-			if _, ok := err.(*FieldLengthNotMatchError); ok {
-				return NewTemporaryTableFieldLengthError(expr.Query.(parser.SelectQuery), expr.View, len(expr.Fields))
+			if _, ok := err.(*FieldLengthNotMatchError); ok && isSelect {
+				return NewTemporaryTableFieldLengthError(selectQuery, expr.View, len(expr.Fields))
 			}
 			return err
 		}
@@ -111,12 +121,18 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	}
 
 	queryScope := scope.CreateNode()
+	queryScope.projection = newColumnProjection(query)
+	queryScope.explain.open()
+	defer queryScope.explain.close()
 
 	if query.WithClause != nil {
+		start := queryScope.explain.now()
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
+		queryScope.explain.record(start, -1, "With", explainInlineTableNames(query.WithClause.(parser.WithClause))...)
+		queryScope.explain.setAside()
 	}
 
 	view, err := selectEntity(
@@ -131,26 +147,39 @@ func Select(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery
 	}
 
 	if query.OrderByClause != nil {
-		if err := view.OrderBy(ctx, queryScope, query.OrderByClause.(parser.OrderByClause)); err != nil {
+		orderByClause := query.OrderByClause.(parser.OrderByClause)
+		start := queryScope.explain.now()
+		if err := view.OrderBy(ctx, queryScope, orderByClause); err != nil {
 			queryScope.CloseCurrentNode()
 			return nil, err
 		}
+		queryScope.explain.record(start, view.RecordLen(), "Sort", orderByClause.String())
+	}
+
+	if err := distinctOn(ctx, queryScope, view, query.SelectEntity); err != nil {
+		queryScope.CloseCurrentNode()
+		return nil, err
 	}
 
 	if query.LimitClause != nil {
 		limitClause := query.LimitClause.(parser.LimitClause)
 		if limitClause.OffsetClause != nil {
+			start := queryScope.explain.now()
 			if err := view.Offset(ctx, queryScope, limitClause.OffsetClause.(parser.OffsetClause)); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
+			queryScope.explain.record(start, view.RecordLen(), "Offset", limitClause.OffsetClause.String())
 		}
 
 		if !limitClause.Type.IsEmpty() {
+			start := queryScope.explain.now()
 			if err := view.Limit(ctx, queryScope, limitClause); err != nil {
 				queryScope.CloseCurrentNode()
 				return nil, err
 			}
+			limitClause.OffsetClause = nil
+			queryScope.explain.record(start, view.RecordLen(), "Limit", limitClause.String())
 		}
 	}
 
@@ -197,39 +226,73 @@ func selectEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryE
 	}
 
 	if entity.WhereClause != nil {
+		start := scope.explain.now()
 		if err := view.Where(ctx, scope, entity.WhereClause.(parser.WhereClause)); err != nil {
 			return nil, err
 		}
+		scope.explain.record(start, view.RecordLen(), "Filter", entity.WhereClause.String())
 	}
 
 	if entity.GroupByClause != nil {
+		start := scope.explain.now()
 		if err := view.GroupBy(ctx, scope, entity.GroupByClause.(parser.GroupByClause)); err != nil {
 			return nil, err
 		}
+		scope.explain.record(start, view.RecordLen(), "Group", entity.GroupByClause.String())
 	}
 
 	if entity.HavingClause != nil {
+		start := scope.explain.now()
 		if err := view.Having(ctx, scope, entity.HavingClause.(parser.HavingClause)); err != nil {
 			return nil, err
 		}
+		scope.explain.record(start, view.RecordLen(), "Having", entity.HavingClause.String())
 	}
 
-	if err := view.Select(ctx, scope, entity.SelectClause.(parser.SelectClause)); err != nil {
+	start := scope.explain.now()
+	if err := view.SelectAndQualify(ctx, scope, entity.SelectClause.(parser.SelectClause), entity.QualifyClause); err != nil {
 		return nil, err
 	}
+	scope.explain.record(start, view.RecordLen(), "Select", entity.SelectClause.String())
 
 	return view, nil
 }
 
+// distinctOn removes duplicate records by the expressions of DISTINCT ON in the select entity.
+// It must be called after the records are sorted because the first record of each set is left.
+func distinctOn(ctx context.Context, scope *ReferenceScope, view *View, expr parser.QueryExpression) error {
+	entity, ok := expr.(parser.SelectEntity)
+	if !ok {
+		return nil
+	}
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if !selectClause.IsDistinctOn() {
+		return nil
+	}
+
+	start := scope.explain.now()
+	if err := view.DistinctOn(ctx, scope, selectClause.DistinctOn); err != nil {
+		return err
+	}
+	scope.explain.record(start, view.RecordLen(), "Distinct On", explainExpressionList(selectClause.DistinctOn))
+	return nil
+}
+
 func selectSetEntity(ctx context.Context, scope *ReferenceScope, expr parser.QueryExpression, forUpdate bool) (*View, error) {
 	if subquery, ok := expr.(parser.Subquery); ok {
 		return Select(ctx, scope, subquery.Query)
 	}
 
+	scope.explain.open()
+	defer scope.explain.close()
+
 	view, err := selectEntity(ctx, scope, expr, forUpdate)
 	if err != nil {
 		return nil, err
 	}
+	if err = distinctOn(ctx, scope, view, expr); err != nil {
+		return nil, err
+	}
 	err = view.Fix(ctx, scope.Tx.Flags)
 	return view, err
 }
@@ -241,11 +304,18 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 	}
 
 	if scope.RecursiveTable != nil {
+		start := scope.explain.now()
 		scope.RecursiveTmpView = nil
+		scope.explain.open()
 		err := selectSetForRecursion(ctx, scope, lview, set, forUpdate)
+		iterations := scope.explain.take()
 		if err != nil {
 			return nil, err
 		}
+		if 0 < len(iterations) {
+			scope.explain.push(iterations[0])
+		}
+		scope.explain.record(start, lview.RecordLen(), explainSetOperation(set, true), "Iterations: "+strconv.Itoa(len(iterations)))
 	} else {
 		queryScope := scope.CreateNode()
 		rview, err := selectSetEntity(ctx, queryScope, set.RHS, forUpdate)
@@ -259,6 +329,7 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 			return nil, NewCombinedSetFieldLengthError(set.RHS, lview.FieldLen())
 		}
 
+		start := scope.explain.now()
 		switch set.Operator.Token {
 		case parser.UNION:
 			if err = lview.Union(ctx, scope.Tx.Flags, rview, !set.All.IsEmpty()); err != nil {
@@ -273,6 +344,7 @@ func selectSet(ctx context.Context, scope *ReferenceScope, set parser.SelectSet,
 				return nil, err
 			}
 		}
+		scope.explain.record(start, lview.RecordLen(), explainSetOperation(set, false))
 	}
 
 	err = lview.SelectAllColumns(ctx, scope)
@@ -342,7 +414,7 @@ func selectSetForRecursion(ctx context.Context, scope *ReferenceScope, view *Vie
 	return selectSetForRecursion(ctx, scope, view, set, forUpdate)
 }
 
-func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, error) {
+func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery) (*FileInfo, int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
@@ -350,7 +422,7 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
 		}
 	}
 
@@ -363,10 +435,10 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 
 	view, err := LoadView(ctx, queryScope, tables, true, false)
 	if err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 	if !view.IsUpdatable() {
-		return nil, insertRecords, NewInlineTableCannotBeUpdatedError(query.Table.Object)
+		return nil, insertRecords, nil, NewInlineTableCannotBeUpdatedError(query.Table.Object)
 	}
 
 	fields := query.Fields
@@ -374,18 +446,30 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		fields = view.Header.TableColumns()
 	}
 
+	recordLen := view.RecordLen()
 	if query.ValuesList != nil {
 		if insertRecords, err = view.InsertValues(ctx, queryScope, fields, query.ValuesList); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
 		}
 	} else {
 		if insertRecords, err = view.InsertFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery)); err != nil {
-			return nil, insertRecords, err
+			return nil, insertRecords, nil, err
+		}
+	}
+
+	var returning *View
+	if query.ReturningClause != nil {
+		indices := make([]int, insertRecords)
+		for i := range indices {
+			indices[i] = recordLen + i
+		}
+		if returning, err = returningView(ctx, queryScope, query.ReturningClause.(parser.ReturningClause), view, indices); err != nil {
+			return nil, insertRecords, nil, err
 		}
 	}
 
 	if err = view.RestoreHeaderReferences(); err != nil {
-		return nil, insertRecords, err
+		return nil, insertRecords, nil, err
 	}
 
 	if view.FileInfo.IsInMemoryTable() {
@@ -394,34 +478,37 @@ func Insert(ctx context.Context, scope *ReferenceScope, query parser.InsertQuery
 		scope.Tx.CachedViews.Set(view)
 	}
 
-	return view.FileInfo, insertRecords, err
+	return view.FileInfo, insertRecords, returning, err
 }
 
-func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, error) {
+func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery) ([]*FileInfo, []int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	if query.FromClause == nil {
 		query.FromClause = parser.FromClause{Tables: query.Tables}
 	}
+	if query.ReturningClause != nil && 1 < len(query.Tables) {
+		return nil, nil, nil, NewReturningMultipleTablesError(query.ReturningClause.(parser.ReturningClause))
+	}
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
 
 	view, err := LoadView(ctx, queryScope, query.FromClause.(parser.FromClause).Tables, true, true)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
 		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -431,18 +518,18 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		table := v.(parser.Table)
 		tableName, err := ParseTableName(ctx, queryScope, table)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		if len(tableName.Literal) < 1 {
-			return nil, nil, NewAliasMustBeSpecifiedForUpdateError(table.Object)
+			return nil, nil, nil, NewAliasMustBeSpecifiedForUpdateError(table.Object)
 		}
 
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		if len(fpath) < 1 {
-			return nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
+			return nil, nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
 		}
 		viewKey := strings.ToUpper(tableName.Literal)
 
@@ -451,11 +538,11 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		} else {
 			viewsToUpdate[viewKey], err = queryScope.Tx.CachedViews.Get(fpath)
 			if err != nil {
-				return nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
+				return nil, nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
 			}
 		}
 		if err = viewsToUpdate[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -469,17 +556,17 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		for _, uset := range query.SetList {
 			val, err := Evaluate(ctx, seqScope, uset.Value)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 
 			viewref, err := view.FieldViewName(uset.Field)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			viewref = strings.ToUpper(viewref)
 
 			if _, ok := viewsToUpdate[viewref]; !ok {
-				return nil, nil, NewUpdateFieldNotExistError(uset.Field)
+				return nil, nil, nil, NewUpdateFieldNotExistError(uset.Field)
 			}
 
 			var internalId int
@@ -488,7 +575,7 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			} else {
 				id, err := view.InternalRecordId(viewref, i)
 				if err != nil {
-					return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+					return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 				}
 
 				internalId = id
@@ -496,6 +583,9 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 			}
 
 			fieldIdx, _ := viewsToUpdate[viewref].Header.SearchIndex(uset.Field)
+			if val, err = conformValue(val, viewsToUpdate[viewref].Header[fieldIdx], queryScope.Tx.Flags, internalId+1, uset.Field); err != nil {
+				return nil, nil, nil, err
+			}
 			if _, ok := updatesList[viewref]; !ok {
 				updatesList[viewref] = make(map[int]*UintPool)
 			}
@@ -504,18 +594,32 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 				updatedCount[viewref]++
 			}
 			if updatesList[viewref][internalId].Exists(uint(fieldIdx)) {
-				return nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				return nil, nil, nil, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
 			}
 			updatesList[viewref][internalId].Add(uint(fieldIdx))
 			viewsToUpdate[viewref].RecordSet[internalId][fieldIdx] = NewCell(val)
 		}
 	}
 
+	var returning *View
+	if query.ReturningClause != nil {
+		for k, v := range viewsToUpdate {
+			indices := make([]int, 0, len(updatesList[k]))
+			for internalId := range updatesList[k] {
+				indices = append(indices, internalId)
+			}
+			sort.Ints(indices)
+			if returning, err = returningView(ctx, queryScope, query.ReturningClause.(parser.ReturningClause), v, indices); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	updateRecords := make([]int, 0)
 	for k, v := range viewsToUpdate {
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if v.FileInfo.IsInMemoryTable() {
@@ -528,10 +632,10 @@ func Update(ctx context.Context, scope *ReferenceScope, query parser.UpdateQuery
 		updateRecords = append(updateRecords, updatedCount[k])
 	}
 
-	return fileInfos, updateRecords, nil
+	return fileInfos, updateRecords, returning, nil
 }
 
-func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQuery) (*FileInfo, int, error) {
+func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQuery) (*FileInfo, int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
@@ -539,7 +643,7 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, replaceRecords, err
+			return nil, replaceRecords, nil, err
 		}
 	}
 
@@ -552,10 +656,10 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 
 	view, err := LoadView(ctx, queryScope, tables, true, false)
 	if err != nil {
-		return nil, replaceRecords, err
+		return nil, replaceRecords, nil, err
 	}
 	if !view.IsUpdatable() {
-		return nil, replaceRecords, NewInlineTableCannotBeUpdatedError(query.Table.Object)
+		return nil, replaceRecords, nil, NewInlineTableCannotBeUpdatedError(query.Table.Object)
 	}
 
 	fields := query.Fields
@@ -563,18 +667,27 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		fields = view.Header.TableColumns()
 	}
 
+	var indices []int
 	if query.ValuesList != nil {
-		if replaceRecords, err = view.ReplaceValues(ctx, queryScope, fields, query.ValuesList, query.Keys); err != nil {
-			return nil, replaceRecords, err
+		if indices, err = view.ReplaceValues(ctx, queryScope, fields, query.ValuesList, query.Keys); err != nil {
+			return nil, replaceRecords, nil, err
 		}
 	} else {
-		if replaceRecords, err = view.ReplaceFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery), query.Keys); err != nil {
-			return nil, replaceRecords, err
+		if indices, err = view.ReplaceFromQuery(ctx, queryScope, fields, query.Query.(parser.SelectQuery), query.Keys); err != nil {
+			return nil, replaceRecords, nil, err
+		}
+	}
+	replaceRecords = len(indices)
+
+	var returning *View
+	if query.ReturningClause != nil {
+		if returning, err = returningView(ctx, queryScope, query.ReturningClause.(parser.ReturningClause), view, indices); err != nil {
+			return nil, replaceRecords, nil, err
 		}
 	}
 
 	if err = view.RestoreHeaderReferences(); err != nil {
-		return nil, replaceRecords, err
+		return nil, replaceRecords, nil, err
 	}
 
 	if view.FileInfo.IsInMemoryTable() {
@@ -583,38 +696,248 @@ func Replace(ctx context.Context, scope *ReferenceScope, query parser.ReplaceQue
 		scope.Tx.CachedViews.Set(view)
 	}
 
-	return view.FileInfo, replaceRecords, err
+	return view.FileInfo, replaceRecords, returning, err
+}
+
+func Merge(ctx context.Context, scope *ReferenceScope, query parser.MergeQuery) (*FileInfo, int, error) {
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	if query.WithClause != nil {
+		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	queryScope.Tx.operationMutex.Lock()
+	defer queryScope.Tx.operationMutex.Unlock()
+
+	view, err := LoadView(ctx, queryScope, []parser.QueryExpression{query.Table}, true, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tableName, err := ParseTableName(ctx, queryScope, query.Table)
+	if err != nil {
+		return nil, 0, err
+	}
+	fpath, err := queryScope.GetAlias(tableName)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(fpath) < 1 {
+		return nil, 0, NewInlineTableCannotBeUpdatedError(query.Table.Object)
+	}
+
+	var target *View
+	if queryScope.TemporaryTableExists(fpath) {
+		target, _ = queryScope.GetTemporaryTable(parser.Identifier{Literal: fpath})
+	} else {
+		target, err = queryScope.Tx.CachedViews.Get(fpath)
+		if err != nil {
+			return nil, 0, NewInlineTableCannotBeUpdatedError(query.Table.Object)
+		}
+	}
+	if err = target.Header.Update(tableName.Literal, nil); err != nil {
+		return nil, 0, err
+	}
+
+	sourceView, err := LoadView(ctx, queryScope, []parser.QueryExpression{query.Source}, false, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// A hidden column is appended to the source records so that the records
+	// that exist only in the target can be distinguished after the full outer join.
+	sourceFieldLen := sourceView.FieldLen()
+	sourceView.Header = append(sourceView.Header, HeaderField{Column: InternalIdColumn})
+	for i, record := range sourceView.RecordSet {
+		r := make(Record, sourceFieldLen+1)
+		copy(r, record)
+		r[sourceFieldLen] = NewCell(value.NewInteger(int64(i)))
+		sourceView.RecordSet[i] = r
+	}
+	markerIdx := view.FieldLen() + sourceFieldLen
+
+	if err = OuterJoin(ctx, queryScope, view, sourceView, query.Condition, parser.FULL); err != nil {
+		return nil, 0, err
+	}
+
+	matchedIds := make(map[int]bool)
+	updatedIds := make(map[int]bool)
+	deletedIds := make(map[int]bool)
+	insertRecords := make(RecordSet, 0)
+
+	seqScope := queryScope.CreateScopeForSequentialEvaluation(view)
+	for i := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return nil, 0, ConvertContextError(ctx.Err())
+		}
+		seqScope.Records[0].recordIndex = i
+
+		internalId, idErr := view.InternalRecordId(tableName.Literal, i)
+		existsInTarget := idErr == nil
+		existsInSource := !value.IsNull(view.RecordSet[i][markerIdx][0])
+
+		var clause *parser.MergeWhen
+		for j := range query.WhenClauses {
+			w := query.WhenClauses[j]
+			switch {
+			case w.Matched:
+				if !existsInTarget || !existsInSource {
+					continue
+				}
+			case w.BySource:
+				if !existsInTarget || existsInSource {
+					continue
+				}
+			default:
+				if existsInTarget {
+					continue
+				}
+			}
+
+			if w.Condition != nil {
+				p, err := Evaluate(ctx, seqScope, w.Condition)
+				if err != nil {
+					return nil, 0, err
+				}
+				if p.Ternary() != ternary.TRUE {
+					continue
+				}
+			}
+			clause = &query.WhenClauses[j]
+			break
+		}
+		if clause == nil {
+			continue
+		}
+
+		if existsInTarget {
+			if matchedIds[internalId] {
+				return nil, 0, NewMergeMultipleMatchesError(query.Table.Object)
+			}
+			matchedIds[internalId] = true
+		}
+
+		switch clause.Action.Token {
+		case parser.UPDATE:
+			assigned := make(map[int]bool, len(clause.SetList))
+			for _, uset := range clause.SetList {
+				val, err := Evaluate(ctx, seqScope, uset.Value)
+				if err != nil {
+					return nil, 0, err
+				}
+
+				fieldIdx, err := target.Header.SearchIndex(uset.Field)
+				if err != nil {
+					if err == errFieldAmbiguous {
+						return nil, 0, NewFieldAmbiguousError(uset.Field)
+					}
+					return nil, 0, NewUpdateFieldNotExistError(uset.Field)
+				}
+				if assigned[fieldIdx] {
+					return nil, 0, NewUpdateValueAmbiguousError(uset.Field, uset.Value)
+				}
+				assigned[fieldIdx] = true
+				if val, err = conformValue(val, target.Header[fieldIdx], queryScope.Tx.Flags, internalId+1, uset.Field); err != nil {
+					return nil, 0, err
+				}
+				target.RecordSet[internalId][fieldIdx] = NewCell(val)
+			}
+			updatedIds[internalId] = true
+		case parser.DELETE:
+			deletedIds[internalId] = true
+		default:
+			fields := clause.Fields
+			if fields == nil {
+				fields = target.Header.TableColumns()
+			}
+
+			rv := clause.Values.(parser.RowValue)
+			values, err := EvalRowValue(ctx, seqScope, rv)
+			if err != nil {
+				return nil, 0, err
+			}
+			if len(fields) != len(values) {
+				return nil, 0, NewInsertRowValueLengthError(rv, len(fields))
+			}
+			fieldIndices, err := target.constrainedFieldIndices(fields)
+			if err != nil {
+				return nil, 0, err
+			}
+			if fieldIndices != nil {
+				if err = target.conformValues(fieldIndices, values, queryScope.Tx.Flags, len(insertRecords)+1, rv); err != nil {
+					return nil, 0, err
+				}
+			}
+
+			records, err := target.convertRecordValuesToRecordSet(ctx, fields, [][]value.Primary{values})
+			if err != nil {
+				return nil, 0, err
+			}
+			insertRecords = append(insertRecords, records...)
+		}
+	}
+
+	if 0 < len(deletedIds) {
+		records := make(RecordSet, 0, target.RecordLen()-len(deletedIds))
+		for i, record := range target.RecordSet {
+			if !deletedIds[i] {
+				records = append(records, record)
+			}
+		}
+		target.RecordSet = records
+	}
+	if 0 < len(insertRecords) {
+		target.RecordSet = target.RecordSet.Merge(insertRecords)
+	}
+
+	if err = target.RestoreHeaderReferences(); err != nil {
+		return nil, 0, err
+	}
+
+	if target.FileInfo.IsInMemoryTable() {
+		scope.ReplaceTemporaryTable(target)
+	} else if target.FileInfo.IsFile() {
+		scope.Tx.CachedViews.Set(target)
+	}
+
+	return target.FileInfo, len(updatedIds) + len(deletedIds) + len(insertRecords), nil
 }
 
-func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, error) {
+func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery) ([]*FileInfo, []int, *View, error) {
 	queryScope := scope.CreateNode()
 	defer queryScope.CloseCurrentNode()
 
 	if query.WithClause != nil {
 		if err := queryScope.LoadInlineTable(ctx, query.WithClause.(parser.WithClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
 	tables := query.FromClause.Tables
 	if query.Tables == nil {
 		if 1 < len(tables) {
-			return nil, nil, NewDeleteTableNotSpecifiedError(query)
+			return nil, nil, nil, NewDeleteTableNotSpecifiedError(query)
 		}
 		query.Tables = tables
 	}
+	if query.ReturningClause != nil && 1 < len(query.Tables) {
+		return nil, nil, nil, NewReturningMultipleTablesError(query.ReturningClause.(parser.ReturningClause))
+	}
 
 	queryScope.Tx.operationMutex.Lock()
 	defer queryScope.Tx.operationMutex.Unlock()
 
 	view, err := LoadView(ctx, queryScope, tables, true, true)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if query.WhereClause != nil {
 		if err := view.Where(ctx, queryScope, query.WhereClause.(parser.WhereClause)); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -624,18 +947,18 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		table := v.(parser.Table)
 		tableName, err := ParseTableName(ctx, queryScope, table)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		if len(tableName.Literal) < 1 {
-			return nil, nil, NewAliasMustBeSpecifiedForUpdateError(table.Object)
+			return nil, nil, nil, NewAliasMustBeSpecifiedForUpdateError(table.Object)
 		}
 
 		fpath, err := queryScope.GetAlias(tableName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		if len(fpath) < 1 {
-			return nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
+			return nil, nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
 		}
 		viewKey := strings.ToUpper(tableName.Literal)
 
@@ -644,18 +967,18 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		} else {
 			viewsToDelete[viewKey], err = queryScope.Tx.CachedViews.Get(fpath)
 			if err != nil {
-				return nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
+				return nil, nil, nil, NewInlineTableCannotBeUpdatedError(table.Object)
 			}
 		}
 		if err = viewsToDelete[viewKey].Header.Update(tableName.Literal, nil); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		deletedIndices[viewKey] = make(map[int]bool)
 	}
 
 	for i := range view.RecordSet {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		for viewref := range viewsToDelete {
@@ -669,11 +992,25 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		}
 	}
 
+	var returning *View
+	if query.ReturningClause != nil {
+		for k, v := range viewsToDelete {
+			indices := make([]int, 0, len(deletedIndices[k]))
+			for internalId := range deletedIndices[k] {
+				indices = append(indices, internalId)
+			}
+			sort.Ints(indices)
+			if returning, err = returningView(ctx, queryScope, query.ReturningClause.(parser.ReturningClause), v, indices); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
 	fileInfos := make([]*FileInfo, 0)
 	deletedCounts := make([]int, 0)
 	for k, v := range viewsToDelete {
 		if ctx.Err() != nil {
-			return nil, nil, ConvertContextError(ctx.Err())
+			return nil, nil, nil, ConvertContextError(ctx.Err())
 		}
 
 		records := make(RecordSet, 0, v.RecordLen()-len(deletedIndices[k]))
@@ -685,7 +1022,7 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		v.RecordSet = records
 
 		if err = v.RestoreHeaderReferences(); err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		if v.FileInfo.IsInMemoryTable() {
@@ -698,7 +1035,107 @@ func Delete(ctx context.Context, scope *ReferenceScope, query parser.DeleteQuery
 		deletedCounts = append(deletedCounts, len(deletedIndices[k]))
 	}
 
-	return fileInfos, deletedCounts, nil
+	return fileInfos, deletedCounts, returning, nil
+}
+
+// returningView returns the result set of the RETURNING clause evaluated on the records of the view at the indices.
+func returningView(ctx context.Context, scope *ReferenceScope, clause parser.ReturningClause, view *View, indices []int) (*View, error) {
+	records := make(RecordSet, len(indices))
+	for i, idx := range indices {
+		records[i] = view.RecordSet[idx].Copy()
+	}
+
+	returning := NewView()
+	returning.Header = view.Header.Copy()
+	returning.RecordSet = records
+
+	if err := returning.Select(ctx, scope, parser.SelectClause{BaseExpr: clause.BaseExpr, Fields: clause.Fields}); err != nil {
+		return nil, err
+	}
+	if err := returning.Fix(ctx, scope.Tx.Flags); err != nil {
+		return nil, err
+	}
+	return returning, nil
+}
+
+// Manipulate executes a data manipulation query, and stages the modified tables to be written on commit.
+// The number of affected records and the result set of the RETURNING clause are returned.
+func Manipulate(ctx context.Context, scope *ReferenceScope, stmt parser.Statement) (int, *View, error) {
+	var cnt int
+	var returning *View
+
+	switch stmt.(type) {
+	case parser.InsertQuery:
+		fileInfo, n, view, err := Insert(ctx, scope, stmt.(parser.InsertQuery))
+		if err != nil {
+			return 0, nil, err
+		}
+		if 0 < n {
+			scope.Tx.UncommittedViews.SetForUpdatedView(fileInfo)
+		}
+		scope.Tx.Log(fmt.Sprintf("%s inserted on %q.", FormatCount(n, "record"), fileInfo.Path), scope.Tx.Flags.Quiet)
+		cnt, returning = n, view
+	case parser.UpdateQuery:
+		infos, cnts, view, err := Update(ctx, scope, stmt.(parser.UpdateQuery))
+		if err != nil {
+			return 0, nil, err
+		}
+		for i, info := range infos {
+			if 0 < cnts[i] {
+				scope.Tx.UncommittedViews.SetForUpdatedView(info)
+				cnt += cnts[i]
+			}
+			scope.Tx.Log(fmt.Sprintf("%s updated on %q.", FormatCount(cnts[i], "record"), info.Path), scope.Tx.Flags.Quiet)
+		}
+		returning = view
+	case parser.ReplaceQuery:
+		fileInfo, n, view, err := Replace(ctx, scope, stmt.(parser.ReplaceQuery))
+		if err != nil {
+			return 0, nil, err
+		}
+		if 0 < n {
+			scope.Tx.UncommittedViews.SetForUpdatedView(fileInfo)
+		}
+		scope.Tx.Log(fmt.Sprintf("%s replaced on %q.", FormatCount(n, "record"), fileInfo.Path), scope.Tx.Flags.Quiet)
+		cnt, returning = n, view
+	case parser.MergeQuery:
+		fileInfo, n, err := Merge(ctx, scope, stmt.(parser.MergeQuery))
+		if err != nil {
+			return 0, nil, err
+		}
+		if 0 < n {
+			scope.Tx.UncommittedViews.SetForUpdatedView(fileInfo)
+		}
+		scope.Tx.Log(fmt.Sprintf("%s merged on %q.", FormatCount(n, "record"), fileInfo.Path), scope.Tx.Flags.Quiet)
+		cnt = n
+	case parser.DeleteQuery:
+		infos, cnts, view, err := Delete(ctx, scope, stmt.(parser.DeleteQuery))
+		if err != nil {
+			return 0, nil, err
+		}
+		for i, info := range infos {
+			if 0 < cnts[i] {
+				scope.Tx.UncommittedViews.SetForUpdatedView(info)
+				cnt += cnts[i]
+			}
+			scope.Tx.Log(fmt.Sprintf("%s deleted on %q.", FormatCount(cnts[i], "record"), info.Path), scope.Tx.Flags.Quiet)
+		}
+		returning = view
+	}
+
+	return cnt, returning, nil
+}
+
+// selectReturning executes a data manipulation query and returns the result set of its RETURNING clause.
+func selectReturning(ctx context.Context, scope *ReferenceScope, query parser.QueryExpression) (*View, error) {
+	_, view, err := Manipulate(ctx, scope, query)
+	if err != nil {
+		return nil, err
+	}
+	if view == nil {
+		return nil, NewReturningClauseRequiredError(query)
+	}
+	return view, nil
 }
 
 func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.CreateTable) (*FileInfo, error) {
@@ -725,38 +1162,58 @@ func CreateTable(ctx context.Context, scope *ReferenceScope, query parser.Create
 	fileInfo.PrettyPrint = flags.ExportOptions.PrettyPrint
 	fileInfo.ForUpdate = true
 
+	var fields []parser.QueryExpression
+	defs := make([]parser.ColumnDefinition, 0, len(query.Fields))
+	if query.Fields != nil {
+		fields = make([]parser.QueryExpression, len(query.Fields))
+		for i := range query.Fields {
+			ident, def := columnDefinition(query.Fields[i])
+			fields[i] = ident
+			defs = append(defs, def)
+		}
+	}
+
 	if query.Query != nil {
 		view, err = Select(ctx, queryScope, query.Query.(parser.SelectQuery))
 		if err != nil {
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
 
-		if err = view.Header.Update(FormatTableName(fileInfo.Path), query.Fields); err != nil {
+		if err = view.Header.Update(FormatTableName(fileInfo.Path), fields); err != nil {
 			if _, ok := err.(*FieldLengthNotMatchError); ok {
 				err = NewTableFieldLengthError(query.Query.(parser.SelectQuery), query.Table, len(query.Fields))
 			}
 			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 		}
 	} else {
-		fields := make([]string, len(query.Fields))
-		fieldsMap := make(map[string]bool, len(query.Fields))
-		for i := range query.Fields {
-			lit := query.Fields[i].(parser.Identifier).Literal
+		columns := make([]string, len(fields))
+		columnsMap := make(map[string]bool, len(fields))
+		for i := range fields {
+			lit := fields[i].(parser.Identifier).Literal
 			ulit := strings.ToUpper(lit)
-			if _, ok := fieldsMap[ulit]; ok {
-				err = NewDuplicateFieldNameError(query.Fields[i].(parser.Identifier))
+			if _, ok := columnsMap[ulit]; ok {
+				err = NewDuplicateFieldNameError(fields[i].(parser.Identifier))
 				return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
 			}
-			fields[i] = lit
-			fieldsMap[ulit] = true
+			columns[i] = lit
+			columnsMap[ulit] = true
 		}
-		header := NewHeader(FormatTableName(fileInfo.Path), fields)
+		header := NewHeader(FormatTableName(fileInfo.Path), columns)
 		view = &View{
 			Header:    header,
 			RecordSet: RecordSet{},
 		}
 	}
 
+	if 0 < len(defs) {
+		if err = setColumnDefinitions(view.Header, defs); err == nil {
+			err = view.conformRecordSet(ctx, flags, query.Query)
+		}
+		if err != nil {
+			return nil, appendCompositeError(err, queryScope.Tx.FileContainer.Close(fileInfo.Handler))
+		}
+	}
+
 	view.FileInfo = fileInfo
 
 	scope.Tx.CachedViews.Set(view)
@@ -813,18 +1270,24 @@ func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColu
 	}
 
 	fields := make([]string, len(query.Columns))
+	defs := make([]parser.ColumnDefinition, len(query.Columns))
 	defaults := make([]parser.QueryExpression, len(query.Columns))
 	for i, coldef := range query.Columns {
-		ulit := strings.ToUpper(coldef.Column.Literal)
+		column, def := columnDefinition(coldef.Column)
+		ulit := strings.ToUpper(column.Literal)
 		if _, ok := columnNamesMap[ulit]; ok {
-			return nil, 0, NewDuplicateFieldNameError(coldef.Column)
+			return nil, 0, NewDuplicateFieldNameError(column)
 		}
-		fields[i] = coldef.Column.Literal
+		fields[i] = column.Literal
+		defs[i] = def
 		defaults[i] = coldef.Value
 		columnNamesMap[ulit] = true
 	}
 
 	addHeader := NewHeader(FormatTableName(view.FileInfo.Path), fields)
+	if err = setColumnDefinitions(addHeader, defs); err != nil {
+		return nil, 0, err
+	}
 	header := make(Header, newFieldLen)
 	for i, v := range view.Header {
 		var idx int
@@ -866,6 +1329,9 @@ func AddColumns(ctx context.Context, scope *ReferenceScope, query parser.AddColu
 			if e != nil {
 				return e
 			}
+			if val, e = conformValue(val, header[i+insertPos], seqScope.Tx.Flags, rIdx+1, query.Columns[i].Column); e != nil {
+				return e
+			}
 			record[i+insertPos] = NewCell(val)
 		}
 		records[rIdx] = record
@@ -1003,6 +1469,8 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	fileInfo := view.FileInfo
 	attr := strings.ToUpper(query.Attribute.Literal)
 	switch attr {
+	case TableSchema:
+		err = setTableSchema(ctx, scope.Tx.Flags, view, p)
 	case TableDelimiter, TableDelimiterPositions, TableFormat, TableEncoding, TableLineBreak, TableJsonEscape:
 		s := value.ToString(p)
 		if value.IsNull(s) {
@@ -1053,6 +1521,9 @@ func SetTableAttribute(ctx context.Context, scope *ReferenceScope, query parser.
 	w.NewLine()
 	writeTableAttribute(w, scope.Tx.Flags, fileInfo)
 	w.NewLine()
+	if attr == TableSchema {
+		writeFieldList(w, view.Header.TableColumnDefinitions())
+	}
 
 	w.Title1 = "Attributes Updated in"
 	if i, ok := query.Table.(parser.Identifier); ok {
@@ -507,6 +507,72 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Select Rollup with Empty Records",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "sum", Args: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}}}},
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.NewTernaryValueFromString("false"),
+				},
+				GroupByClause: parser.GroupByClause{
+					Items: []parser.QueryExpression{
+						parser.GroupingSets{
+							Type: parser.Token{Token: parser.ROLLUP, Literal: "rollup"},
+							Items: []parser.QueryExpression{
+								parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: []HeaderField{
+				{
+					View:        "group_table",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+				},
+				{
+					Column:      "SUM(column2)",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{
+					Column:      "COUNT(*)",
+					Number:      3,
+					IsFromTable: true,
+				},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewNull(),
+					value.NewNull(),
+					value.NewInteger(0),
+				}),
+			},
+		},
+	},
 	{
 		Name: "Select Replace Fields",
 		Query: parser.SelectQuery{
@@ -545,6 +611,153 @@ var selectTests = []struct {
 			},
 		},
 	},
+	{
+		Name: "Select Distinct On",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Distinct: parser.Token{Token: parser.DISTINCT, Literal: "distinct"},
+					DistinctOn: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					parser.OrderItem{
+						Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+						Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+					},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: NewHeader("group_table", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str5"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select Qualify",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				QualifyClause: parser.QualifyClause{
+					Filter: parser.Comparison{
+						LHS: parser.AnalyticFunction{
+							Name: "row_number",
+							AnalyticClause: parser.AnalyticClause{
+								PartitionClause: parser.PartitionClause{
+									Values: []parser.QueryExpression{
+										parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+									},
+								},
+								OrderByClause: parser.OrderByClause{
+									Items: []parser.QueryExpression{
+										parser.OrderItem{
+											Value:     parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+											Direction: parser.Token{Token: parser.DESC, Literal: "desc"},
+										},
+									},
+								},
+							},
+						},
+						RHS:      parser.NewIntegerValueFromString("1"),
+						Operator: parser.Token{Token: '=', Literal: "="},
+					},
+				},
+			},
+			OrderByClause: parser.OrderByClause{
+				Items: []parser.QueryExpression{
+					parser.OrderItem{Value: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+				},
+			},
+		},
+		Result: &View{
+			FileInfo: &FileInfo{
+				Path:      GetTestFilePath("group_table.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+			},
+			Header: NewHeader("group_table", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{
+					value.NewString("1"),
+					value.NewString("str2"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("2"),
+					value.NewString("str4"),
+				}),
+				NewRecord([]value.Primary{
+					value.NewString("3"),
+					value.NewString("str5"),
+				}),
+			},
+		},
+	},
+	{
+		Name: "Select Qualify Field Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "group_table"}},
+					},
+				},
+				QualifyClause: parser.QualifyClause{
+					Filter: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
 	{
 		Name: "Union",
 		Query: parser.SelectQuery{
@@ -1438,6 +1651,7 @@ var insertTests = []struct {
 	Query        parser.InsertQuery
 	ResultFile   *FileInfo
 	UpdateCount  int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -1641,6 +1855,55 @@ var insertTests = []struct {
 		},
 		UpdateCount: 2,
 	},
+	{
+		Name: "Insert Query Returning",
+		Query: parser.InsertQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("4"),
+							parser.NewStringValue("str4"),
+						},
+					},
+				},
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("5"),
+							parser.NewStringValue("str5"),
+						},
+					},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2"}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 2,
+		Returning: &View{
+			Header: Header{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "c2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewInteger(4), value.NewString("str4")}),
+				NewRecord([]value.Primary{value.NewInteger(5), value.NewString("str5")}),
+			},
+		},
+	},
 	{
 		Name: "Insert Query Inline Table Cannot Be Updated Error",
 		Query: parser.InsertQuery{
@@ -1852,7 +2115,7 @@ func TestInsert(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range insertTests {
 		_ = TestTx.ReleaseResources()
-		result, cnt, err := Insert(ctx, scope, v.Query)
+		result, cnt, returning, err := Insert(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -1886,6 +2149,10 @@ func TestInsert(t *testing.T) {
 			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.CachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.CachedViews, v.ViewCache)
@@ -1904,6 +2171,7 @@ var updateTests = []struct {
 	Query        parser.UpdateQuery
 	ResultFiles  []*FileInfo
 	UpdateCounts []int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -2098,31 +2366,113 @@ var updateTests = []struct {
 		UpdateCounts: []int{2},
 	},
 	{
-		Name: "Update Query Alias Must Be Specified Error",
+		Name: "Update Query Returning",
 		Query: parser.UpdateQuery{
 			Tables: []parser.QueryExpression{
-				parser.Table{
-					Object: parser.TableFunction{
-						Name: "data",
-						Args: []parser.QueryExpression{
-							parser.NewStringValue("c1,c2\n1,a\n2,b"),
-						},
-					},
-				},
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
 			},
 			SetList: []parser.UpdateSet{
 				{
-					Field: parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
-					Value: parser.NewStringValue("update1"),
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
 				},
-				{
-					Field: parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
-					Value: parser.NewStringValue("update2"),
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: parser.Token{Token: '=', Literal: "="},
+							},
+						},
+					}},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
 				},
 			},
 		},
-		Error: "alias to table identification function or URL must be specified for update",
-	},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{2},
+		Returning: &View{
+			Header: Header{
+				{View: "t1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "t1", Column: "column2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str22")}),
+				NewRecord([]value.Primary{value.NewString("3"), value.NewString("str33")}),
+			},
+		},
+	},
+	{
+		Name: "Update Query Returning Multiple Tables Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+				parser.Table{Object: parser.Identifier{Literal: "t2"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}},
+					Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
+		},
+		Error: "RETURNING clause cannot be used to modify multiple tables",
+	},
+	{
+		Name: "Update Query Alias Must Be Specified Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{
+					Object: parser.TableFunction{
+						Name: "data",
+						Args: []parser.QueryExpression{
+							parser.NewStringValue("c1,c2\n1,a\n2,b"),
+						},
+					},
+				},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
+					Value: parser.NewStringValue("update1"),
+				},
+				{
+					Field: parser.FieldReference{Column: parser.Identifier{Literal: "c1"}},
+					Value: parser.NewStringValue("update2"),
+				},
+			},
+		},
+		Error: "alias to table identification function or URL must be specified for update",
+	},
 	{
 		Name: "Update Query Inline Table Cannot Be Updated Error",
 		Query: parser.UpdateQuery{
@@ -2342,6 +2692,28 @@ var updateTests = []struct {
 		},
 		Error: "value column4 to set in the field column2 is ambiguous",
 	},
+	{
+		Name: "Update Query Column Type Conversion Error",
+		Query: parser.UpdateQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "table_typed"}},
+			},
+			SetList: []parser.UpdateSet{
+				{
+					Field: parser.FieldReference{BaseExpr: parser.NewBaseExpr(parser.Token{Line: 1, Char: 23}), Column: parser.Identifier{Literal: "amount"}},
+					Value: parser.NewStringValue("12a"),
+				},
+			},
+			WhereClause: parser.WhereClause{
+				Filter: parser.Comparison{
+					LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+					RHS:      parser.NewIntegerValueFromString("2"),
+					Operator: parser.Token{Token: '=', Literal: "="},
+				},
+			},
+		},
+		Error: "[L:1 C:23] value '12a' in row 2 cannot be converted to FLOAT for column amount",
+	},
 }
 
 func TestUpdate(t *testing.T) {
@@ -2382,7 +2754,7 @@ func TestUpdate(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range updateTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Update(ctx, scope, v.Query)
+		files, cnt, returning, err := Update(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2416,6 +2788,10 @@ func TestUpdate(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.CachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.CachedViews, v.ViewCache)
@@ -2434,6 +2810,7 @@ var replaceTests = []struct {
 	Query        parser.ReplaceQuery
 	ResultFile   *FileInfo
 	UpdateCount  int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -2690,6 +3067,57 @@ var replaceTests = []struct {
 		},
 		UpdateCount: 2,
 	},
+	{
+		Name: "Replace Query Returning",
+		Query: parser.ReplaceQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Keys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			ValuesList: []parser.QueryExpression{
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("2"),
+							parser.NewStringValue("str22"),
+						},
+					},
+				},
+				parser.RowValue{
+					Value: parser.ValueList{
+						Values: []parser.QueryExpression{
+							parser.NewIntegerValueFromString("5"),
+							parser.NewStringValue("str5"),
+						},
+					},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.AllColumns{}},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 2,
+		Returning: &View{
+			Header: Header{
+				{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+				{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("2"), value.NewString("str22")}),
+				NewRecord([]value.Primary{value.NewInteger(5), value.NewString("str5")}),
+			},
+		},
+	},
 	{
 		Name: "Replace Query Inline Table Cannot Be Updated Error",
 		Query: parser.ReplaceQuery{
@@ -2850,36 +3278,328 @@ var replaceTests = []struct {
 		UpdateCount: 3,
 	},
 	{
-		Name: "Replace Select Query Field Does Not Exist Error",
-		Query: parser.ReplaceQuery{
-			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
-			Fields: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-			},
-			Keys: []parser.QueryExpression{
-				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
-			},
-			Query: parser.SelectQuery{
-				SelectEntity: parser.SelectEntity{
-					SelectClause: parser.SelectClause{
-						Fields: []parser.QueryExpression{
-							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
-							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+		Name: "Replace Select Query Field Does Not Exist Error",
+		Query: parser.ReplaceQuery{
+			Table: parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Fields: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Keys: []parser.QueryExpression{
+				parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+							parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+						},
+					},
+					FromClause: parser.FromClause{
+						Tables: []parser.QueryExpression{
+							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+						},
+					},
+				},
+			},
+		},
+		Error: "select query should return exactly 1 field",
+	},
+}
+
+func TestReplace(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+	TestTx.Flags.Quiet = false
+
+	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
+		{
+			scopeNameTempTables: {
+				"TMPVIEW": &View{
+					Header: NewHeader("tmpview", []string{"column1", "column2"}),
+					RecordSet: []Record{
+						NewRecord([]value.Primary{
+							value.NewString("1"),
+							value.NewString("str1"),
+						}),
+						NewRecord([]value.Primary{
+							value.NewString("2"),
+							value.NewString("str2"),
+						}),
+					},
+					FileInfo: &FileInfo{
+						Path:      "tmpview",
+						Delimiter: ',',
+						ViewType:  ViewTypeTemporaryTable,
+					},
+				},
+			},
+		},
+	}, nil, time.Time{}, nil)
+
+	ctx := context.Background()
+	for _, v := range replaceTests {
+		_ = TestTx.ReleaseResources()
+		result, cnt, returning, err := Replace(ctx, scope, v.Query)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+
+		TestTx.CachedViews.Range(func(key, value interface{}) bool {
+			view := value.(*View)
+			if view.FileInfo.Handler != nil {
+				if view.FileInfo.Path != view.FileInfo.Handler.Path() {
+					t.Errorf("file pointer = %q, want %q for %q", view.FileInfo.Handler.Path(), view.FileInfo.Path, v.Name)
+				}
+				_ = TestTx.FileContainer.Close(view.FileInfo.Handler)
+				view.FileInfo.Handler = nil
+			}
+			return true
+		})
+
+		if !reflect.DeepEqual(result, v.ResultFile) {
+			t.Errorf("%s: fileinfo = %v, want %v", v.Name, result, v.ResultFile)
+		}
+
+		if !reflect.DeepEqual(cnt, v.UpdateCount) {
+			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
+		}
+
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
+		if v.ViewCache.SyncMap != nil {
+			if !SyncMapEqual(TestTx.CachedViews, v.ViewCache) {
+				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.CachedViews, v.ViewCache)
+			}
+		}
+		if v.ResultScopes != nil {
+			if !SyncMapEqual(scope.Blocks[0].TemporaryTables, v.ResultScopes.Blocks[0].TemporaryTables) {
+				t.Errorf("%s: temporary views list = %v, want %v", v.Name, scope.Blocks[0].TemporaryTables, v.ResultScopes.Blocks[0].TemporaryTables)
+			}
+		}
+	}
+}
+
+var mergeTests = []struct {
+	Name        string
+	Query       parser.MergeQuery
+	ResultFile  *FileInfo
+	UpdateCount int
+	ViewCache   ViewMap
+	Error       string
+}{
+	{
+		Name: "Merge Query",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhen{
+				{
+					Matched: true,
+					Condition: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewStringValue("2"),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+					},
+					Action: parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Value: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+					},
+				},
+				{
+					Matched: true,
+					Action:  parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+				{
+					BySource: true,
+					Action:   parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{Field: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}, Value: parser.NewStringValue("gone")},
+					},
+				},
+				{
+					Action: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Fields: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}, parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}, parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 4,
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: NewHeader("table1", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("gone"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table2.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+				},
+				Header: NewHeader("table2", []string{"column3", "column4"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str22"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str33"),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("4"),
+						value.NewString("str44"),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Merge Query Multiple Matches Error",
+		Query: parser.MergeQuery{
+			Table:     parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source:    parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.NewTernaryValueFromString("true"),
+			WhenClauses: []parser.MergeWhen{
+				{
+					Matched: true,
+					Action:  parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
+			},
+		},
+		Error: "record in table1 is matched by more than one record of the merge source",
+	},
+	{
+		Name: "Merge Query Update Field Not Exist Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhen{
+				{
+					Matched: true,
+					Action:  parser.Token{Token: parser.UPDATE, Literal: "update"},
+					SetList: []parser.UpdateSet{
+						{Field: parser.FieldReference{Column: parser.Identifier{Literal: "column4"}}, Value: parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
+					},
+				},
+			},
+		},
+		Error: "field column4 does not exist in the tables to update",
+	},
+	{
+		Name: "Merge Query Insert Row Value Length Error",
+		Query: parser.MergeQuery{
+			Table:  parser.Table{Object: parser.Identifier{Literal: "table1"}},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			Condition: parser.Comparison{
+				LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+				RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+				Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: "="},
+			},
+			WhenClauses: []parser.MergeWhen{
+				{
+					Action: parser.Token{Token: parser.INSERT, Literal: "insert"},
+					Values: parser.RowValue{
+						Value: parser.ValueList{
+							Values: []parser.QueryExpression{parser.FieldReference{Column: parser.Identifier{Literal: "column3"}}},
 						},
 					},
-					FromClause: parser.FromClause{
-						Tables: []parser.QueryExpression{
-							parser.Table{Object: parser.Identifier{Literal: "table2"}},
+				},
+			},
+		},
+		Error: "row value should contain exactly 2 values",
+	},
+	{
+		Name: "Merge Query Inline Table Error",
+		Query: parser.MergeQuery{
+			Table: parser.Table{
+				Object: parser.Subquery{
+					Query: parser.SelectQuery{
+						SelectEntity: parser.SelectEntity{
+							SelectClause: parser.SelectClause{
+								Fields: []parser.QueryExpression{
+									parser.Field{Object: parser.NewIntegerValueFromString("1")},
+								},
+							},
 						},
 					},
 				},
+				Alias: parser.Identifier{Literal: "t"},
+			},
+			Source: parser.Table{Object: parser.Identifier{Literal: "table2"}},
+			WhenClauses: []parser.MergeWhen{
+				{
+					Matched: true,
+					Action:  parser.Token{Token: parser.DELETE, Literal: "delete"},
+				},
 			},
 		},
-		Error: "select query should return exactly 1 field",
+		Error: "inline table cannot be updated",
 	},
 }
 
-func TestReplace(t *testing.T) {
+func TestMerge(t *testing.T) {
 	defer func() {
 		_ = TestTx.ReleaseResources()
 		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
@@ -2889,35 +3609,10 @@ func TestReplace(t *testing.T) {
 	TestTx.Flags.Repository = TestDir
 	TestTx.Flags.Quiet = false
 
-	scope := GenerateReferenceScope([]map[string]map[string]interface{}{
-		{
-			scopeNameTempTables: {
-				"TMPVIEW": &View{
-					Header: NewHeader("tmpview", []string{"column1", "column2"}),
-					RecordSet: []Record{
-						NewRecord([]value.Primary{
-							value.NewString("1"),
-							value.NewString("str1"),
-						}),
-						NewRecord([]value.Primary{
-							value.NewString("2"),
-							value.NewString("str2"),
-						}),
-					},
-					FileInfo: &FileInfo{
-						Path:      "tmpview",
-						Delimiter: ',',
-						ViewType:  ViewTypeTemporaryTable,
-					},
-				},
-			},
-		},
-	}, nil, time.Time{}, nil)
-
 	ctx := context.Background()
-	for _, v := range replaceTests {
+	for _, v := range mergeTests {
 		_ = TestTx.ReleaseResources()
-		result, cnt, err := Replace(ctx, scope, v.Query)
+		result, cnt, err := Merge(ctx, NewReferenceScope(TestTx), v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -2956,11 +3651,6 @@ func TestReplace(t *testing.T) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.CachedViews, v.ViewCache)
 			}
 		}
-		if v.ResultScopes != nil {
-			if !SyncMapEqual(scope.Blocks[0].TemporaryTables, v.ResultScopes.Blocks[0].TemporaryTables) {
-				t.Errorf("%s: temporary views list = %v, want %v", v.Name, scope.Blocks[0].TemporaryTables, v.ResultScopes.Blocks[0].TemporaryTables)
-			}
-		}
 	}
 }
 
@@ -2969,6 +3659,7 @@ var deleteTests = []struct {
 	Query        parser.DeleteQuery
 	ResultFiles  []*FileInfo
 	UpdateCounts []int
+	Returning    *View
 	ViewCache    ViewMap
 	ResultScopes *ReferenceScope
 	Error        string
@@ -3148,6 +3839,60 @@ var deleteTests = []struct {
 		},
 		UpdateCounts: []int{2},
 	},
+	{
+		Name: "Delete Query Returning",
+		Query: parser.DeleteQuery{
+			Tables: []parser.QueryExpression{
+				parser.Table{Object: parser.Identifier{Literal: "t1"}},
+			},
+			FromClause: parser.FromClause{
+				Tables: []parser.QueryExpression{
+					parser.Table{Object: parser.Join{
+						Table: parser.Table{
+							Object: parser.Identifier{Literal: "table1"},
+							Alias:  parser.Identifier{Literal: "t1"},
+						},
+						JoinTable: parser.Table{
+							Object: parser.Identifier{Literal: "table2"},
+							Alias:  parser.Identifier{Literal: "t2"},
+						},
+						Condition: parser.JoinCondition{
+							On: parser.Comparison{
+								LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+								RHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column3"}},
+								Operator: parser.Token{Token: '=', Literal: "="},
+							},
+						},
+					}},
+				},
+			},
+			ReturningClause: parser.ReturningClause{
+				Fields: []parser.QueryExpression{
+					parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column2"}}},
+				},
+			},
+		},
+		ResultFiles: []*FileInfo{
+			{
+				Path:      GetTestFilePath("table1.csv"),
+				Delimiter: ',',
+				NoHeader:  false,
+				Encoding:  text.UTF8,
+				LineBreak: text.LF,
+				ForUpdate: true,
+			},
+		},
+		UpdateCounts: []int{2},
+		Returning: &View{
+			Header: Header{
+				{View: "t1", Column: "column2", Number: 1, IsFromTable: true},
+			},
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("str2")}),
+				NewRecord([]value.Primary{value.NewString("str3")}),
+			},
+		},
+	},
 	{
 		Name: "Delete Query Alias to Table Identification Function Is Not Specified Error ",
 		Query: parser.DeleteQuery{
@@ -3348,7 +4093,7 @@ func TestDelete(t *testing.T) {
 	ctx := context.Background()
 	for _, v := range deleteTests {
 		_ = TestTx.ReleaseResources()
-		files, cnt, err := Delete(ctx, scope, v.Query)
+		files, cnt, returning, err := Delete(ctx, scope, v.Query)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3382,6 +4127,10 @@ func TestDelete(t *testing.T) {
 			t.Errorf("%s: update count = %v, want %v", v.Name, cnt, v.UpdateCounts)
 		}
 
+		if !reflect.DeepEqual(returning, v.Returning) {
+			t.Errorf("%s: returning = %v, want %v", v.Name, returning, v.Returning)
+		}
+
 		if v.ViewCache.SyncMap != nil {
 			if !SyncMapEqual(TestTx.CachedViews, v.ViewCache) {
 				t.Errorf("%s: view cache = %v, want %v", v.Name, TestTx.CachedViews, v.ViewCache)
@@ -3481,6 +4230,89 @@ var createTableTests = []struct {
 			},
 		}),
 	},
+	{
+		Name: "Create Table With Column Types",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "string"}, NotNull: true},
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column2"}, Type: parser.Identifier{Literal: "float"}},
+				parser.Identifier{Literal: "column3"},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewIntegerValueFromString("1")},
+							parser.Field{Object: parser.NewStringValue("2.5")},
+							parser.Field{Object: parser.NewIntegerValueFromString("3")},
+						},
+					},
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("create_table_1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("create_table_1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: Header{
+					{View: "create_table_1", Column: "column1", Number: 1, IsFromTable: true, Type: ColumnTypeString, NotNull: true},
+					{View: "create_table_1", Column: "column2", Number: 2, IsFromTable: true, Type: ColumnTypeFloat},
+					{View: "create_table_1", Column: "column3", Number: 3, IsFromTable: true},
+				},
+				RecordSet: RecordSet{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewFloat(2.5),
+						value.NewInteger(3),
+					}),
+				},
+			},
+		}),
+	},
+	{
+		Name: "Create Table Column Type Conversion Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "integer"}},
+			},
+			Query: parser.SelectQuery{
+				SelectEntity: parser.SelectEntity{
+					SelectClause: parser.SelectClause{
+						Fields: []parser.QueryExpression{
+							parser.Field{Object: parser.NewStringValue("12a")},
+						},
+					},
+				},
+			},
+		},
+		Error: "value '12a' in row 1 cannot be converted to INTEGER for column column1",
+	},
+	{
+		Name: "Create Table Invalid Column Type Error",
+		Query: parser.CreateTable{
+			Table: parser.Identifier{Literal: "create_table_1.csv"},
+			Fields: []parser.QueryExpression{
+				parser.ColumnDefinition{Column: parser.Identifier{Literal: "column1"}, Type: parser.Identifier{Literal: "money"}},
+			},
+		},
+		Error: "column type money does not exist",
+	},
 	{
 		Name: "Create Table File Already Exist Error",
 		Query: parser.CreateTable{
@@ -3918,6 +4750,69 @@ var addColumnsTests = []struct {
 			},
 		}),
 	},
+	{
+		Name: "Add Fields With Column Types",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.ColumnDefinition{Column: parser.Identifier{Literal: "column3"}, Type: parser.Identifier{Literal: "integer"}},
+					Value:  parser.NewStringValue("1"),
+				},
+				{
+					Column: parser.ColumnDefinition{Column: parser.Identifier{Literal: "column4"}, Type: parser.Identifier{Literal: "boolean"}, NotNull: true},
+					Value:  parser.NewStringValue("true"),
+				},
+			},
+		},
+		ResultFile: &FileInfo{
+			Path:      GetTestFilePath("table1.csv"),
+			Delimiter: ',',
+			NoHeader:  false,
+			Encoding:  text.UTF8,
+			LineBreak: text.LF,
+			ForUpdate: true,
+		},
+		UpdateCount: 2,
+		ViewCache: GenerateViewMap([]*View{
+			{
+				FileInfo: &FileInfo{
+					Path:      GetTestFilePath("table1.csv"),
+					Delimiter: ',',
+					NoHeader:  false,
+					Encoding:  text.UTF8,
+					LineBreak: text.LF,
+					ForUpdate: true,
+				},
+				Header: Header{
+					{View: "table1", Column: "column1", Number: 1, IsFromTable: true},
+					{View: "table1", Column: "column2", Number: 2, IsFromTable: true},
+					{View: "table1", Column: "column3", Number: 3, IsFromTable: true, Type: ColumnTypeInteger},
+					{View: "table1", Column: "column4", Number: 4, IsFromTable: true, Type: ColumnTypeBoolean, NotNull: true},
+				},
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("str1"),
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("2"),
+						value.NewString("str2"),
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+					NewRecord([]value.Primary{
+						value.NewString("3"),
+						value.NewString("str3"),
+						value.NewInteger(1),
+						value.NewBoolean(true),
+					}),
+				},
+			},
+		}),
+	},
 	{
 		Name: "Add Fields Load Error",
 		Query: parser.AddColumns{
@@ -3985,6 +4880,31 @@ var addColumnsTests = []struct {
 		},
 		Error: "field notexist does not exist",
 	},
+	{
+		Name: "Add Fields Not Null Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.ColumnDefinition{Column: parser.Identifier{Literal: "column3"}, NotNull: true},
+				},
+			},
+		},
+		Error: "column column3 cannot be null in row 1",
+	},
+	{
+		Name: "Add Fields Column Type Conversion Error",
+		Query: parser.AddColumns{
+			Table: parser.Identifier{Literal: "table1.csv"},
+			Columns: []parser.ColumnDefault{
+				{
+					Column: parser.ColumnDefinition{Column: parser.Identifier{Literal: "column3"}, Type: parser.Identifier{Literal: "datetime"}},
+					Value:  parser.NewStringValue("abc"),
+				},
+			},
+		},
+		Error: "value 'abc' in row 1 cannot be converted to DATETIME for column column3",
+	},
 }
 
 func TestAddColumns(t *testing.T) {
@@ -4623,7 +5543,7 @@ var setTableAttributeTests = []struct {
 			Attribute: parser.Identifier{Literal: "format"},
 			Value:     parser.NewStringValue("invalid"),
 		},
-		Error: "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|GFM|ORG|BOX|TEXT",
+		Error: "format must be one of CSV|TSV|FIXED|JSON|JSONL|LTSV|XLSX|PARQUET|XML|YAML|SQL|HTML|GFM|ORG|BOX|TEXT|VERTICAL",
 	},
 	{
 		Name: "Set Encoding to SJIS",
@@ -4823,6 +5743,24 @@ var setTableAttributeTests = []struct {
 		},
 		Error: "table attribute notexist does not exist",
 	},
+	{
+		Name: "Set Schema Column Type Conversion Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "schema"},
+			Value:     parser.NewStringValue("column1 INTEGER NOT NULL, column2 INTEGER"),
+		},
+		Error: "value 'str1' in row 1 cannot be converted to INTEGER for column column2",
+	},
+	{
+		Name: "Set Schema Invalid Column Type Error",
+		Query: parser.SetTableAttribute{
+			Table:     parser.Identifier{Literal: "table1.csv"},
+			Attribute: parser.Identifier{Literal: "schema"},
+			Value:     parser.NewStringValue("column1 NUMBER"),
+		},
+		Error: "column type NUMBER does not exist",
+	},
 }
 
 func TestSetTableAttribute(t *testing.T) {
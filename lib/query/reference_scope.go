@@ -29,6 +29,7 @@ func GetBlockScope() BlockScope {
 
 func PutBlockScope(scope BlockScope) {
 	scope.Clear()
+	scope.caughtError = nil
 	blockScopePool.Put(scope)
 }
 
@@ -53,6 +54,8 @@ type BlockScope struct {
 	TemporaryTables ViewMap
 	Cursors         CursorMap
 	Functions       UserDefinedFunctionMap
+
+	caughtError Error
 }
 
 func NewBlockScope() BlockScope {
@@ -169,6 +172,9 @@ type ReferenceScope struct {
 	RecursiveTable   *parser.InlineTable
 	RecursiveTmpView *View
 	RecursiveCount   *int64
+
+	explain    *explainTracer
+	projection columnProjection
 }
 
 func NewReferenceScope(tx *Transaction) *ReferenceScope {
@@ -216,6 +222,7 @@ func (rs *ReferenceScope) createScope(referenceRecords []ReferenceRecord) *Refer
 		RecursiveTable:   rs.RecursiveTable,
 		RecursiveTmpView: rs.RecursiveTmpView,
 		RecursiveCount:   rs.RecursiveCount,
+		projection:       rs.projection,
 	}
 }
 
@@ -255,6 +262,8 @@ func (rs *ReferenceScope) CreateNode() *ReferenceScope {
 		RecursiveTable:   rs.RecursiveTable,
 		RecursiveTmpView: rs.RecursiveTmpView,
 		RecursiveCount:   rs.RecursiveCount,
+		explain:          rs.explain,
+		projection:       rs.projection,
 	}
 
 	if node.cachedFilePath == nil {
@@ -326,6 +335,16 @@ func (rs *ReferenceScope) Now() time.Time {
 	return rs.now
 }
 
+// CaughtError returns the error being handled by the innermost CATCH block, or nil outside of CATCH blocks.
+func (rs *ReferenceScope) CaughtError() Error {
+	for i := range rs.Blocks {
+		if rs.Blocks[i].caughtError != nil {
+			return rs.Blocks[i].caughtError
+		}
+	}
+	return nil
+}
+
 func (rs *ReferenceScope) DeclareVariable(ctx context.Context, expr parser.VariableDeclaration) error {
 	return rs.Blocks[0].Variables.Declare(ctx, rs, expr)
 }
@@ -701,10 +720,16 @@ func (rs *ReferenceScope) InlineTableExists(name parser.Identifier) bool {
 func (rs *ReferenceScope) LoadInlineTable(ctx context.Context, clause parser.WithClause) error {
 	for _, v := range clause.InlineTables {
 		inlineTable := v.(parser.InlineTable)
+
+		start := rs.explain.now()
+		rs.explain.open()
 		err := rs.SetInlineTable(ctx, inlineTable)
 		if err != nil {
+			rs.explain.close()
 			return err
 		}
+		rs.explain.record(start, -1, "Inline Table", explainInlineTableName(inlineTable))
+		rs.explain.close()
 	}
 
 	return nil
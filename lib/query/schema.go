@@ -0,0 +1,418 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+// SchemaFileExtension is appended to the path of a file to get the path of its schema file.
+const SchemaFileExtension = ".schema"
+
+type ColumnType int
+
+const (
+	ColumnTypeAny ColumnType = iota
+	ColumnTypeInteger
+	ColumnTypeFloat
+	ColumnTypeBoolean
+	ColumnTypeDatetime
+	ColumnTypeString
+	ColumnTypeDecimal
+)
+
+var columnTypeLiterals = map[ColumnType]string{
+	ColumnTypeInteger:  "INTEGER",
+	ColumnTypeFloat:    "FLOAT",
+	ColumnTypeDecimal:  "DECIMAL",
+	ColumnTypeBoolean:  "BOOLEAN",
+	ColumnTypeDatetime: "DATETIME",
+	ColumnTypeString:   "STRING",
+}
+
+func (t ColumnType) String() string {
+	return columnTypeLiterals[t]
+}
+
+func ParseColumnType(s string) (ColumnType, error) {
+	s = strings.ToUpper(s)
+	for t, lit := range columnTypeLiterals {
+		if s == lit {
+			return t, nil
+		}
+	}
+	return ColumnTypeAny, errors.New("column type does not exist")
+}
+
+// Convert converts the value to the column type.
+// Empty strings are treated as nulls except for strings.
+// The second returned value is false if a value that is not null cannot be converted.
+func (t ColumnType) Convert(p value.Primary, flags *option.Flags) (value.Primary, bool) {
+	if value.IsNull(p) {
+		return p, true
+	}
+	if s, ok := p.(*value.String); ok && t != ColumnTypeString && t != ColumnTypeAny && len(option.TrimSpace(s.Raw())) < 1 {
+		return value.NewNull(), true
+	}
+
+	var converted value.Primary
+	switch t {
+	case ColumnTypeInteger:
+		if f, ok := p.(*value.Float); ok && f.Raw() == math.Trunc(f.Raw()) && !math.IsInf(f.Raw(), 0) {
+			converted = value.NewInteger(int64(f.Raw()))
+		} else if d, ok := p.(*value.Decimal); ok && d.Normalize().Scale() == 0 {
+			converted = value.ToInteger(d)
+		} else {
+			converted = value.ToIntegerStrictly(p)
+		}
+	case ColumnTypeFloat:
+		converted = value.ToFloat(p)
+	case ColumnTypeDecimal:
+		converted = value.ToDecimal(p)
+	case ColumnTypeBoolean:
+		converted = value.ToBoolean(p)
+	case ColumnTypeDatetime:
+		converted = value.ToDatetime(p, flags.DatetimeFormat, flags.GetTimeLocation())
+	case ColumnTypeString:
+		if _, ok := p.(*value.String); ok {
+			return p, true
+		}
+		s, _, _ := ConvertFieldContents(p, false, false)
+		converted = value.NewString(s)
+	default:
+		return p, true
+	}
+	return converted, !value.IsNull(converted)
+}
+
+// conformValue converts the value to the declared type of the field, and checks the not-null constraint.
+func conformValue(p value.Primary, field HeaderField, flags *option.Flags, row int, expr parser.Expression) (value.Primary, error) {
+	converted, ok := field.Type.Convert(p, flags)
+	if !ok {
+		return nil, NewColumnTypeConversionError(expr, p, field, row)
+	}
+	if field.NotNull && value.IsNull(converted) {
+		return nil, NewColumnNotNullError(expr, field, row)
+	}
+	return converted, nil
+}
+
+// HasConstraint returns true if any of the fields has a declared type or a constraint.
+func (h Header) HasConstraint() bool {
+	for i := range h {
+		if h[i].HasConstraint() {
+			return true
+		}
+	}
+	return false
+}
+
+// constrainedFieldIndices returns the indices of the fields to which values are set
+// if the view has any constraints. Otherwise, it returns nil.
+func (view *View) constrainedFieldIndices(fields []parser.QueryExpression) ([]int, error) {
+	if !view.Header.HasConstraint() {
+		return nil, nil
+	}
+	return view.FieldIndices(fields)
+}
+
+// conformValues converts the values to be set to the fields specified by the indices.
+// The fields not specified must accept nulls.
+func (view *View) conformValues(fieldIndices []int, values []value.Primary, flags *option.Flags, row int, expr parser.Expression) error {
+	for i, idx := range fieldIndices {
+		p, err := conformValue(values[i], view.Header[idx], flags, row, expr)
+		if err != nil {
+			return err
+		}
+		values[i] = p
+	}
+
+	for i := range view.Header {
+		if view.Header[i].NotNull && !InIntSlice(i, fieldIndices) {
+			return NewColumnNotNullError(expr, view.Header[i], row)
+		}
+	}
+	return nil
+}
+
+// conformRecordSet converts the values in all the records to the declared types of the fields.
+func (view *View) conformRecordSet(ctx context.Context, flags *option.Flags, expr parser.Expression) error {
+	indices := make([]int, 0, view.FieldLen())
+	for i := range view.Header {
+		if view.Header[i].HasConstraint() {
+			indices = append(indices, i)
+		}
+	}
+	if len(indices) < 1 {
+		return nil
+	}
+
+	for i, record := range view.RecordSet {
+		if i&15 == 0 && ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for _, idx := range indices {
+			p, err := conformValue(record[idx][0], view.Header[idx], flags, i+1, expr)
+			if err != nil {
+				return err
+			}
+			record[idx] = NewCell(p)
+		}
+	}
+	return nil
+}
+
+// setColumnDefinitions declares the types of the fields.
+// Fields not in the definitions are left untyped.
+func setColumnDefinitions(header Header, defs []parser.ColumnDefinition) error {
+	types := make([]ColumnType, header.Len())
+	notNulls := make([]bool, header.Len())
+	declared := make(map[int]bool, len(defs))
+	for _, def := range defs {
+		idx, err := header.SearchIndex(parser.FieldReference{BaseExpr: def.BaseExpr, Column: def.Column})
+		if err != nil {
+			return NewFieldNotExistError(def.Column)
+		}
+		if declared[idx] {
+			return NewDuplicateFieldNameError(def.Column)
+		}
+		declared[idx] = true
+
+		if 0 < len(def.Type.Literal) {
+			t, err := ParseColumnType(def.Type.Literal)
+			if err != nil {
+				return NewInvalidColumnTypeError(def.Type)
+			}
+			types[idx] = t
+		}
+		notNulls[idx] = def.NotNull
+	}
+
+	for i := range header {
+		header[i].Type = types[i]
+		header[i].NotNull = notNulls[i]
+	}
+	return nil
+}
+
+// columnDefinition returns the name and the definition of a column declared in CREATE TABLE or ALTER TABLE ADD.
+func columnDefinition(expr parser.QueryExpression) (parser.Identifier, parser.ColumnDefinition) {
+	if def, ok := expr.(parser.ColumnDefinition); ok {
+		return def.Column, def
+	}
+	ident := expr.(parser.Identifier)
+	return ident, parser.ColumnDefinition{BaseExpr: ident.BaseExpr, Column: ident}
+}
+
+// TableColumnDefinitions returns the column names of the table with their declared types and constraints.
+func (h Header) TableColumnDefinitions() []string {
+	defs := make([]string, 0, h.Len())
+	for _, f := range h {
+		if !f.IsFromTable {
+			continue
+		}
+		if f.HasConstraint() {
+			def := f.ColumnDefinition()
+			def.Column.Quoted = false
+			defs = append(defs, def.String())
+		} else {
+			defs = append(defs, f.Column)
+		}
+	}
+	return defs
+}
+
+// ColumnDefinitions returns the definitions of the fields that have declared types or constraints.
+func (h Header) ColumnDefinitions() []parser.ColumnDefinition {
+	defs := make([]parser.ColumnDefinition, 0, h.Len())
+	for _, f := range h {
+		if !f.IsFromTable || !f.HasConstraint() {
+			continue
+		}
+		defs = append(defs, f.ColumnDefinition())
+	}
+	return defs
+}
+
+// FormatColumnDefinitions returns the definitions in the format of schema files.
+func FormatColumnDefinitions(defs []parser.ColumnDefinition) string {
+	list := make([]string, len(defs))
+	for i, def := range defs {
+		list[i] = def.String()
+	}
+	return strings.Join(list, ",\n")
+}
+
+// ParseColumnDefinitions parses column definitions separated by commas.
+// A definition consists of a column name, an optional type name and an optional NOT NULL.
+func ParseColumnDefinitions(s string) ([]parser.ColumnDefinition, error) {
+	defs := make([]parser.ColumnDefinition, 0, 10)
+	words := make([]parser.Identifier, 0, 4)
+
+	var appendDefinition = func() error {
+		if len(words) < 1 {
+			return errors.New("column name is empty")
+		}
+
+		def := parser.ColumnDefinition{Column: words[0]}
+		rest := words[1:]
+		if 0 < len(rest) && !strings.EqualFold(rest[0].Literal, "NOT") {
+			def.Type = rest[0]
+			rest = rest[1:]
+		}
+		if 0 < len(rest) {
+			if len(rest) != 2 || !strings.EqualFold(rest[0].Literal, "NOT") || !strings.EqualFold(rest[1].Literal, "NULL") {
+				return fmt.Errorf("invalid definition of column %s", def.Column)
+			}
+			def.NotNull = true
+		}
+		defs = append(defs, def)
+		words = words[:0]
+		return nil
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case r == ',':
+			if err := appendDefinition(); err != nil {
+				return nil, err
+			}
+		case r == '`':
+			var buf bytes.Buffer
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					buf.WriteRune(runes[i])
+					i++
+				} else if runes[i] == '`' {
+					closed = true
+					break
+				}
+				buf.WriteRune(runes[i])
+			}
+			if !closed {
+				return nil, errors.New("identifier is not terminated")
+			}
+			words = append(words, parser.Identifier{Literal: option.UnescapeIdentifier(buf.String(), '`'), Quoted: true})
+		default:
+			start := i
+			for i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) && runes[i+1] != ',' && runes[i+1] != '`' {
+				i++
+			}
+			words = append(words, parser.Identifier{Literal: string(runes[start : i+1])})
+		}
+	}
+	if 0 < len(words) || 0 < len(defs) {
+		if err := appendDefinition(); err != nil {
+			return nil, err
+		}
+	}
+	return defs, nil
+}
+
+// setTableSchema replaces the declared types of the fields in the view with the definitions in the string,
+// and converts the values in the records.
+// Null or an empty string removes all the declared types.
+func setTableSchema(ctx context.Context, flags *option.Flags, view *View, p value.Primary) error {
+	var defs []parser.ColumnDefinition
+	if s := value.ToString(p); !value.IsNull(s) {
+		var err error
+		if defs, err = ParseColumnDefinitions(s.(*value.String).Raw()); err != nil {
+			return err
+		}
+	} else if !value.IsNull(p) {
+		return errors.New("schema must be a string")
+	}
+
+	current := view.Header.ColumnDefinitions()
+	header := view.Header.Copy()
+	if err := setColumnDefinitions(header, defs); err != nil {
+		return err
+	}
+	if equivalentColumnDefinitions(current, header.ColumnDefinitions()) {
+		return NewTableAttributeUnchangedError(view.FileInfo.Path)
+	}
+
+	view.Header = header
+	return view.conformRecordSet(ctx, flags, nil)
+}
+
+func SchemaFilePath(fpath string) string {
+	return fpath + SchemaFileExtension
+}
+
+// loadSchemaFile declares the types of the fields in the view if the schema file exists.
+func loadSchemaFile(ctx context.Context, flags *option.Flags, view *View, expr parser.QueryExpression) error {
+	fpath := SchemaFilePath(view.FileInfo.Path)
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return NewIOError(expr, err.Error())
+	}
+
+	defs, err := ParseColumnDefinitions(string(b))
+	if err != nil {
+		return NewSchemaParsingError(expr, fpath, err.Error())
+	}
+	if err = setColumnDefinitions(view.Header, defs); err != nil {
+		return NewSchemaParsingError(expr, fpath, err.Error())
+	}
+	return view.conformRecordSet(ctx, flags, expr)
+}
+
+// writeSchemaFile writes the declared types of the fields to the schema file of the view.
+// The schema file is removed if no types are declared.
+func writeSchemaFile(view *View) error {
+	fpath := SchemaFilePath(view.FileInfo.Path)
+	defs := view.Header.ColumnDefinitions()
+
+	b, err := os.ReadFile(fpath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	exists := err == nil
+
+	if len(defs) < 1 {
+		if exists {
+			return os.Remove(fpath)
+		}
+		return nil
+	}
+
+	if exists {
+		if current, err := ParseColumnDefinitions(string(b)); err == nil && equivalentColumnDefinitions(current, defs) {
+			return nil
+		}
+	}
+	return os.WriteFile(fpath, []byte(FormatColumnDefinitions(defs)+"\n"), 0664)
+}
+
+func equivalentColumnDefinitions(defs1 []parser.ColumnDefinition, defs2 []parser.ColumnDefinition) bool {
+	if len(defs1) != len(defs2) {
+		return false
+	}
+	for i := range defs1 {
+		t1, _ := ParseColumnType(defs1[i].Type.Literal)
+		t2, _ := ParseColumnType(defs2[i].Type.Literal)
+		if defs1[i].Column.Literal != defs2[i].Column.Literal || t1 != t2 || defs1[i].NotNull != defs2[i].NotNull {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,336 @@
+package query
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var columnTypeConvertTests = []struct {
+	Type   ColumnType
+	Value  value.Primary
+	Result value.Primary
+	OK     bool
+}{
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewString(" 12 "),
+		Result: value.NewInteger(12),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewFloat(3),
+		Result: value.NewInteger(3),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewFloat(3.5),
+		Result: value.NewNull(),
+		OK:     false,
+	},
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewString("12a"),
+		Result: value.NewNull(),
+		OK:     false,
+	},
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewString(""),
+		Result: value.NewNull(),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeFloat,
+		Value:  value.NewString("1.5"),
+		Result: value.NewFloat(1.5),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeDecimal,
+		Value:  value.NewString("0.10"),
+		Result: value.NewDecimalFromString("0.10"),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeDecimal,
+		Value:  value.NewString("1.2.3"),
+		Result: value.NewNull(),
+		OK:     false,
+	},
+	{
+		Type:   ColumnTypeInteger,
+		Value:  value.NewDecimalFromString("4.00"),
+		Result: value.NewInteger(4),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeBoolean,
+		Value:  value.NewString("false"),
+		Result: value.NewBoolean(false),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeDatetime,
+		Value:  value.NewString("2012-02-03 09:18:15"),
+		Result: value.NewDatetime(time.Date(2012, 2, 3, 9, 18, 15, 0, GetTestLocation())),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeString,
+		Value:  value.NewBoolean(true),
+		Result: value.NewString("true"),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeString,
+		Value:  value.NewString(""),
+		Result: value.NewString(""),
+		OK:     true,
+	},
+	{
+		Type:   ColumnTypeAny,
+		Value:  value.NewString("12a"),
+		Result: value.NewString("12a"),
+		OK:     true,
+	},
+}
+
+func TestColumnType_Convert(t *testing.T) {
+	for _, v := range columnTypeConvertTests {
+		result, ok := v.Type.Convert(v.Value, TestTx.Flags)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %s to %s", ok, v.OK, v.Value, v.Type)
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %s, want %s for %s to %s", result, v.Result, v.Value, v.Type)
+		}
+	}
+}
+
+var parseColumnDefinitionsTests = []struct {
+	Input  string
+	Result []parser.ColumnDefinition
+	Error  string
+}{
+	{
+		Input: "id integer not null,\n`amount, total` FLOAT,\nname,\nnote NOT NULL\n",
+		Result: []parser.ColumnDefinition{
+			{Column: parser.Identifier{Literal: "id"}, Type: parser.Identifier{Literal: "integer"}, NotNull: true},
+			{Column: parser.Identifier{Literal: "amount, total", Quoted: true}, Type: parser.Identifier{Literal: "FLOAT"}},
+			{Column: parser.Identifier{Literal: "name"}},
+			{Column: parser.Identifier{Literal: "note"}, NotNull: true},
+		},
+	},
+	{
+		Input:  "",
+		Result: []parser.ColumnDefinition{},
+	},
+	{
+		Input: "id integer,",
+		Error: "column name is empty",
+	},
+	{
+		Input: "id integer null",
+		Error: "invalid definition of column id",
+	},
+	{
+		Input: "`id integer",
+		Error: "identifier is not terminated",
+	},
+}
+
+func TestParseColumnDefinitions(t *testing.T) {
+	for _, v := range parseColumnDefinitionsTests {
+		result, err := ParseColumnDefinitions(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %v, want %v for %q", result, v.Result, v.Input)
+		}
+	}
+}
+
+func typedTestView() *View {
+	return &View{
+		Header: Header{
+			{View: "typed", Column: "id", Number: 1, IsFromTable: true, Type: ColumnTypeInteger, NotNull: true},
+			{View: "typed", Column: "amount", Number: 2, IsFromTable: true, Type: ColumnTypeFloat},
+			{View: "typed", Column: "note", Number: 3, IsFromTable: true},
+		},
+		RecordSet: RecordSet{},
+	}
+}
+
+var viewInsertValuesWithTypesTests = []struct {
+	Name   string
+	Fields []parser.QueryExpression
+	Values []parser.QueryExpression
+	Result RecordSet
+	Error  string
+}{
+	{
+		Name: "Insert Values With Types",
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+			parser.FieldReference{Column: parser.Identifier{Literal: "amount"}},
+		},
+		Values: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewStringValue("1"), parser.NewStringValue("1.5")}}},
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(2), parser.NewStringValue("")}}},
+		},
+		Result: RecordSet{
+			NewRecord([]value.Primary{value.NewInteger(1), value.NewFloat(1.5), value.NewNull()}),
+			NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewNull()}),
+		},
+	},
+	{
+		Name: "Insert Values Column Type Conversion Error",
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "id"}},
+			parser.FieldReference{Column: parser.Identifier{Literal: "amount"}},
+		},
+		Values: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewStringValue("1"), parser.NewStringValue("1.5")}}},
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewIntegerValue(2), parser.NewStringValue("12a")}}},
+		},
+		Error: "value '12a' in row 2 cannot be converted to FLOAT for column amount",
+	},
+	{
+		Name: "Insert Values Not Null Error",
+		Fields: []parser.QueryExpression{
+			parser.FieldReference{Column: parser.Identifier{Literal: "note"}},
+		},
+		Values: []parser.QueryExpression{
+			parser.RowValue{Value: parser.ValueList{Values: []parser.QueryExpression{parser.NewStringValue("a")}}},
+		},
+		Error: "column id cannot be null in row 1",
+	},
+}
+
+func TestView_InsertValuesWithTypes(t *testing.T) {
+	ctx := context.Background()
+	scope := NewReferenceScope(TestTx)
+
+	for _, v := range viewInsertValuesWithTypesTests {
+		view := typedTestView()
+		_, err := view.InsertValues(ctx, scope, v.Fields, v.Values)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(view.RecordSet, v.Result) {
+			t.Errorf("%s: records = %s, want %s", v.Name, view.RecordSet, v.Result)
+		}
+	}
+}
+
+func TestSchemaFile(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := os.MkdirTemp("", "csvq_schema_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	fpath := filepath.Join(dir, "typed.csv")
+	newView := func() *View {
+		return &View{
+			Header: NewHeader("typed", []string{"id", "amount", "note"}),
+			RecordSet: RecordSet{
+				NewRecord([]value.Primary{value.NewString("1"), value.NewString("2.5"), value.NewString("a")}),
+				NewRecord([]value.Primary{value.NewString("2"), value.NewNull(), value.NewString("b")}),
+			},
+			FileInfo: &FileInfo{Path: fpath},
+		}
+	}
+
+	view := newView()
+	if err = loadSchemaFile(ctx, TestTx.Flags, view, parser.Identifier{Literal: "typed"}); err != nil {
+		t.Fatalf("unexpected error %q without a schema file", err)
+	}
+	if view.Header.HasConstraint() {
+		t.Errorf("header = %v, want no declared types without a schema file", view.Header)
+	}
+
+	if err = os.WriteFile(SchemaFilePath(fpath), []byte("id INTEGER NOT NULL, amount FLOAT\n"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	view = newView()
+	if err = loadSchemaFile(ctx, TestTx.Flags, view, parser.Identifier{Literal: "typed"}); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	expectRecords := RecordSet{
+		NewRecord([]value.Primary{value.NewInteger(1), value.NewFloat(2.5), value.NewString("a")}),
+		NewRecord([]value.Primary{value.NewInteger(2), value.NewNull(), value.NewString("b")}),
+	}
+	if !reflect.DeepEqual(view.RecordSet, expectRecords) {
+		t.Errorf("records = %s, want %s", view.RecordSet, expectRecords)
+	}
+
+	view.Header[2].Type = ColumnTypeString
+	if err = writeSchemaFile(view); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	b, _ := os.ReadFile(SchemaFilePath(fpath))
+	expectSchema := "id INTEGER NOT NULL,\namount FLOAT,\nnote STRING\n"
+	if string(b) != expectSchema {
+		t.Errorf("schema file = %q, want %q", string(b), expectSchema)
+	}
+
+	if err = os.WriteFile(SchemaFilePath(fpath), []byte("note INTEGER"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	expectError := "value 'a' in row 1 cannot be converted to INTEGER for column note"
+	if err = loadSchemaFile(ctx, TestTx.Flags, newView(), parser.Identifier{Literal: "typed"}); err == nil || err.Error() != expectError {
+		t.Errorf("error = %v, want error %q", err, expectError)
+	}
+
+	if err = os.WriteFile(SchemaFilePath(fpath), []byte("notexist INTEGER"), 0664); err != nil {
+		t.Fatal(err)
+	}
+	expectError = "schema parse error in " + SchemaFilePath(fpath) + ": field notexist does not exist"
+	if err = loadSchemaFile(ctx, TestTx.Flags, newView(), parser.Identifier{Literal: "typed"}); err == nil || err.Error() != expectError {
+		t.Errorf("error = %v, want error %q", err, expectError)
+	}
+
+	for i := range view.Header {
+		view.Header[i].Type = ColumnTypeAny
+		view.Header[i].NotNull = false
+	}
+	if err = writeSchemaFile(view); err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if _, err = os.Stat(SchemaFilePath(fpath)); !os.IsNotExist(err) {
+		t.Errorf("schema file is not removed when no types are declared")
+	}
+}
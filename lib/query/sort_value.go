@@ -3,6 +3,7 @@ package query
 import (
 	"bytes"
 	"math"
+	"math/big"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/option"
@@ -18,7 +19,9 @@ const (
 	NullType SortValueType = iota
 	IntegerType
 	FloatType
+	DecimalType
 	DatetimeType
+	IntervalType
 	BooleanType
 	StringType
 )
@@ -85,8 +88,12 @@ func (values SortValues) Serialize(buf *bytes.Buffer) {
 			serializeInteger(buf, value.Int64ToStr(val.Integer))
 		case FloatType:
 			serializeFloat(buf, value.Float64ToStr(val.Float, false))
+		case DecimalType:
+			serializeFloat(buf, val.Decimal.Normalize().String())
 		case DatetimeType:
 			serializeDatetimeFromUnixNano(buf, val.Datetime)
+		case IntervalType:
+			serializeInterval(buf, val.Interval.TotalNanoseconds().String())
 		case StringType:
 			serializeString(buf, val.String)
 		}
@@ -100,7 +107,9 @@ type SortValue struct {
 
 	Integer  int64
 	Float    float64
+	Decimal  *value.Decimal
 	Datetime int64
+	Interval *value.Interval
 	String   string
 }
 
@@ -109,6 +118,20 @@ func NewSortValue(val value.Primary, flags *option.Flags) *SortValue {
 
 	if value.IsNull(val) {
 		sortValue.Type = NullType
+	} else if d, ok := val.(*value.Decimal); ok {
+		sortValue.Type = DecimalType
+		sortValue.Decimal = d
+		sortValue.Float = d.Float64()
+		sortValue.String = d.String()
+	} else if iv, ok := val.(*value.Interval); ok {
+		sortValue.Type = IntervalType
+		sortValue.Interval = iv
+	} else if a, ok := val.(*value.Array); ok {
+		sortValue.Type = StringType
+		sortValue.String = strings.ToUpper(a.Encode())
+	} else if m, ok := val.(*value.Map); ok {
+		sortValue.Type = StringType
+		sortValue.String = strings.ToUpper(m.Encode())
 	} else if i := value.ToIntegerStrictly(val); !value.IsNull(i) {
 		s := value.ToString(val)
 		sortValue.Type = IntegerType
@@ -172,30 +195,26 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			return ternary.ConvertFromBool(v.Integer < compareValue.Integer)
 		case FloatType:
 			return ternary.ConvertFromBool(v.Float < compareValue.Float)
+		case DecimalType:
+			return lessDecimal(value.NewDecimal(big.NewInt(v.Integer), 0), compareValue.Decimal)
 		case StringType:
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
 	case FloatType:
 		switch compareValue.Type {
-		case IntegerType, FloatType:
-			if math.IsNaN(v.Float) || math.IsNaN(compareValue.Float) {
-				if math.IsNaN(v.Float) && math.IsNaN(compareValue.Float) {
-					return ternary.UNKNOWN
-				}
-
-				if math.IsNaN(v.Float) {
-					return ternary.FALSE
-				}
-
-				// math.IsNaN(compareValue.Float)
-				return ternary.TRUE
-			}
-
-			if v.Float == compareValue.Float {
-				return ternary.UNKNOWN
-			}
-
-			return ternary.ConvertFromBool(v.Float < compareValue.Float)
+		case IntegerType, FloatType, DecimalType:
+			return lessFloat(v.Float, compareValue.Float)
+		case StringType:
+			return ternary.ConvertFromBool(v.String < compareValue.String)
+		}
+	case DecimalType:
+		switch compareValue.Type {
+		case IntegerType:
+			return lessDecimal(v.Decimal, value.NewDecimal(big.NewInt(compareValue.Integer), 0))
+		case FloatType:
+			return lessFloat(v.Float, compareValue.Float)
+		case DecimalType:
+			return lessDecimal(v.Decimal, compareValue.Decimal)
 		case StringType:
 			return ternary.ConvertFromBool(v.String < compareValue.String)
 		}
@@ -207,9 +226,18 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 			}
 			return ternary.ConvertFromBool(v.Datetime < compareValue.Datetime)
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			c := v.Interval.Cmp(compareValue.Interval)
+			if c == 0 {
+				return ternary.UNKNOWN
+			}
+			return ternary.ConvertFromBool(c < 0)
+		}
 	case StringType:
 		switch compareValue.Type {
-		case IntegerType, FloatType, StringType:
+		case IntegerType, FloatType, DecimalType, StringType:
 			if v.String == compareValue.String {
 				return ternary.UNKNOWN
 			}
@@ -220,6 +248,35 @@ func (v *SortValue) Less(compareValue *SortValue) ternary.Value {
 	return ternary.UNKNOWN
 }
 
+func lessFloat(f1 float64, f2 float64) ternary.Value {
+	if math.IsNaN(f1) || math.IsNaN(f2) {
+		if math.IsNaN(f1) && math.IsNaN(f2) {
+			return ternary.UNKNOWN
+		}
+
+		if math.IsNaN(f1) {
+			return ternary.FALSE
+		}
+
+		// math.IsNaN(f2)
+		return ternary.TRUE
+	}
+
+	if f1 == f2 {
+		return ternary.UNKNOWN
+	}
+
+	return ternary.ConvertFromBool(f1 < f2)
+}
+
+func lessDecimal(d1 *value.Decimal, d2 *value.Decimal) ternary.Value {
+	c := d1.Cmp(d2)
+	if c == 0 {
+		return ternary.UNKNOWN
+	}
+	return ternary.ConvertFromBool(c < 0)
+}
+
 func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 	if v.SerializedKey != nil {
 		return bytes.Equal(v.SerializedKey.Bytes(), compareValue.SerializedKey.Bytes())
@@ -239,11 +296,21 @@ func (v *SortValue) EquivalentTo(compareValue *SortValue) bool {
 			}
 			return v.Float == compareValue.Float
 		}
+	case DecimalType:
+		switch compareValue.Type {
+		case DecimalType:
+			return v.Decimal.Cmp(compareValue.Decimal) == 0
+		}
 	case DatetimeType:
 		switch compareValue.Type {
 		case DatetimeType:
 			return v.Datetime == compareValue.Datetime
 		}
+	case IntervalType:
+		switch compareValue.Type {
+		case IntervalType:
+			return v.Interval.Cmp(compareValue.Interval) == 0
+		}
 	case BooleanType:
 		switch compareValue.Type {
 		case BooleanType, IntegerType:
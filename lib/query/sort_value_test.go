@@ -25,8 +25,10 @@ func TestSortValues_Serialize(t *testing.T) {
 		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00", TestTx.Flags.DatetimeFormat, TestTx.Flags.GetTimeLocation()), TestTx.Flags),
 		NewSortValue(value.NewBoolean(false), TestTx.Flags),
 		NewSortValue(value.NewString("str"), TestTx.Flags),
+		NewSortValue(value.NewDecimalFromString("1.2340"), TestTx.Flags),
+		NewSortValue(value.NewInterval(1, 2, 3), TestTx.Flags),
 	}
-	expect := "[N]:[I]1:[F]1.234:[D]1328289495000000000:[D]1328289495123000000:[D]1328289495123456789:[I]0:[S]STR"
+	expect := "[N]:[I]1:[F]1.234:[D]1328289495000000000:[D]1328289495123000000:[D]1328289495123456789:[I]0:[S]STR:[F]1.234:[V]2764800000000003"
 
 	buf := &bytes.Buffer{}
 	values.Serialize(buf)
@@ -46,8 +48,10 @@ func TestSortValues_Serialize(t *testing.T) {
 		NewSortValue(value.NewDatetimeFromString("2012-02-03T09:18:15.123456789-08:00", TestTx.Flags.DatetimeFormat, TestTx.Flags.GetTimeLocation()), TestTx.Flags),
 		NewSortValue(value.NewBoolean(false), TestTx.Flags),
 		NewSortValue(value.NewString("str"), TestTx.Flags),
+		NewSortValue(value.NewDecimalFromString("1.2340"), TestTx.Flags),
+		NewSortValue(value.NewInterval(1, 2, 3), TestTx.Flags),
 	}
-	expect = "[N]:[I]1:[F]1.234:[D]1328289495000000000:[D]1328289495123000000:[D]1328289495123456789:[B]F:[S]str"
+	expect = "[N]:[I]1:[F]1.234:[D]1328289495000000000:[D]1328289495123000000:[D]1328289495123456789:[B]F:[S]str:[M]1.234:[V]1,2,3"
 
 	buf.Reset()
 	values.Serialize(buf)
@@ -274,6 +278,48 @@ var sortValueLessTests = []struct {
 		StrictEqual:  true,
 		Result:       ternary.TRUE,
 	},
+	{
+		Name:         "Decimal is less than Decimal beyond float precision",
+		SortValue:    value.NewDecimalFromString("0.1000000000000000001"),
+		CompareValue: value.NewDecimalFromString("0.1000000000000000002"),
+		StrictEqual:  false,
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "Same Decimal with different scales",
+		SortValue:    value.NewDecimalFromString("1.50"),
+		CompareValue: value.NewDecimalFromString("1.5"),
+		StrictEqual:  false,
+		Result:       ternary.UNKNOWN,
+	},
+	{
+		Name:         "Integer is less than Decimal",
+		SortValue:    value.NewInteger(2),
+		CompareValue: value.NewDecimalFromString("2.01"),
+		StrictEqual:  false,
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "Decimal is greater than Float",
+		SortValue:    value.NewDecimalFromString("2.5"),
+		CompareValue: value.NewFloat(1.5),
+		StrictEqual:  false,
+		Result:       ternary.FALSE,
+	},
+	{
+		Name:         "Interval is less than Interval",
+		SortValue:    value.NewInterval(0, 29, 0),
+		CompareValue: value.NewInterval(1, 0, 0),
+		StrictEqual:  false,
+		Result:       ternary.TRUE,
+	},
+	{
+		Name:         "Interval and Datetime cannot be compared",
+		SortValue:    value.NewInterval(0, 1, 0),
+		CompareValue: value.NewDatetime(time.Date(2012, 2, 3, 0, 0, 0, 0, GetTestLocation())),
+		StrictEqual:  false,
+		Result:       ternary.UNKNOWN,
+	},
 }
 
 func TestSortValue_Less(t *testing.T) {
@@ -411,6 +457,34 @@ var sortValueEquivalentToTests = []struct {
 		StrictEqual:  false,
 		Result:       true,
 	},
+	{
+		Name:         "Decimals with different scales are equivalent",
+		SortValue:    value.NewDecimalFromString("1.50"),
+		CompareValue: value.NewDecimalFromString("1.5"),
+		StrictEqual:  false,
+		Result:       true,
+	},
+	{
+		Name:         "Decimals with different scales are equivalent when StrictEqual is true",
+		SortValue:    value.NewDecimalFromString("1.50"),
+		CompareValue: value.NewDecimalFromString("1.5"),
+		StrictEqual:  true,
+		Result:       true,
+	},
+	{
+		Name:         "Intervals with the same length are equivalent",
+		SortValue:    value.NewInterval(1, 0, 0),
+		CompareValue: value.NewInterval(0, 30, 0),
+		StrictEqual:  false,
+		Result:       true,
+	},
+	{
+		Name:         "Intervals with the same length are not equivalent when StrictEqual is true",
+		SortValue:    value.NewInterval(1, 0, 0),
+		CompareValue: value.NewInterval(0, 30, 0),
+		StrictEqual:  true,
+		Result:       false,
+	},
 }
 
 func TestSortValue_EquivalentTo(t *testing.T) {
@@ -0,0 +1,364 @@
+package query
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/go-text"
+	"github.com/mithrandie/go-text/color"
+)
+
+const streamingSelectChunkSize = 1000
+
+type streamingSelectPlan struct {
+	table      parser.Table
+	tableName  parser.Identifier
+	fileInfo   *FileInfo
+	options    option.ImportOptions
+	where      parser.QueryExpression
+	selectExpr parser.SelectClause
+	offset     int
+	limitation int
+}
+
+// StreamSelect executes a simple select query by reading, filtering and encoding records chunk by chunk
+// without loading the whole table.
+// It returns false without any side effects if the query or the output format cannot be processed
+// in this way, then the query should be executed with Select.
+func StreamSelect(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery, writer io.Writer, exportOptions option.ExportOptions, palette *color.Palette) (bool, error) {
+	if !isStreamableExportOptions(exportOptions) {
+		return false, nil
+	}
+
+	queryScope := scope.CreateNode()
+	defer queryScope.CloseCurrentNode()
+
+	plan, err := newStreamingSelectPlan(ctx, queryScope, query)
+	if err != nil || plan == nil {
+		return plan != nil, err
+	}
+
+	return true, plan.execute(ctx, queryScope, writer, exportOptions, palette)
+}
+
+func isStreamableExportOptions(options option.ExportOptions) bool {
+	switch options.Format {
+	case option.CSV, option.TSV, option.JSONL, option.LTSV:
+	default:
+		return false
+	}
+
+	switch options.Encoding {
+	case text.UTF8, text.SJIS:
+		return true
+	}
+	return false
+}
+
+func newStreamingSelectPlan(ctx context.Context, scope *ReferenceScope, query parser.SelectQuery) (*streamingSelectPlan, error) {
+	if query.WithClause != nil || query.OrderByClause != nil || query.IsForUpdate() {
+		return nil, nil
+	}
+
+	entity, ok := query.SelectEntity.(parser.SelectEntity)
+	if !ok || entity.IntoClause != nil || entity.GroupByClause != nil || entity.HavingClause != nil || entity.QualifyClause != nil || entity.FromClause == nil {
+		return nil, nil
+	}
+
+	tables := entity.FromClause.(parser.FromClause).Tables
+	if len(tables) != 1 {
+		return nil, nil
+	}
+	table, ok := tables[0].(parser.Table)
+	if !ok || !table.Lateral.IsEmpty() {
+		return nil, nil
+	}
+	fileIdentifier, ok := table.Object.(parser.Identifier)
+	if !ok || isTableObjectAsURL(fileIdentifier) {
+		return nil, nil
+	}
+
+	selectClause := entity.SelectClause.(parser.SelectClause)
+	if selectClause.IsDistinct() {
+		return nil, nil
+	}
+	fieldObjects := make([]parser.QueryExpression, 0, len(selectClause.Fields))
+	for _, f := range selectClause.Fields {
+		fieldObjects = append(fieldObjects, f.(parser.Field).Object)
+	}
+	if hasAggregateFunction, err := HasAggregateFunctionInList(fieldObjects, scope); err != nil || hasAggregateFunction {
+		return nil, nil
+	}
+	if analyticFunctions, err := SearchAnalyticFunctionsInList(fieldObjects); err != nil || analyticFunctions != nil {
+		return nil, nil
+	}
+
+	var limitClause parser.LimitClause
+	if query.LimitClause != nil {
+		limitClause = query.LimitClause.(parser.LimitClause)
+		if limitClause.Percentage() {
+			return nil, nil
+		}
+	}
+
+	if (scope.RecursiveTable != nil && strings.EqualFold(fileIdentifier.Literal, scope.RecursiveTable.Name.Literal)) ||
+		scope.InlineTableExists(fileIdentifier) ||
+		scope.TemporaryTableExists(fileIdentifier.Literal) {
+		return nil, nil
+	}
+
+	options := scope.Tx.Flags.ImportOptions.Copy()
+	options.Format = option.AutoSelect
+	if options.AllowUnevenFields {
+		return nil, nil
+	}
+
+	// Tables already loaded in the transaction may have uncommitted changes.
+	if _, ok := scope.LoadFilePath(fileIdentifier.Literal); ok {
+		return nil, nil
+	}
+	if p, err := CreateFilePath(fileIdentifier, scope.Tx.Flags.Repository); err != nil || scope.Tx.CachedViews.Exists(strings.ToUpper(p)) {
+		return nil, nil
+	}
+
+	fileInfo, err := NewFileInfo(fileIdentifier, scope.Tx.Flags.Repository, options, scope.Tx.Flags.ImportOptions.Format)
+	if err != nil || scope.Tx.CachedViews.Exists(strings.ToUpper(fileInfo.Path)) {
+		return nil, nil
+	}
+	switch fileInfo.Format {
+	case option.CSV, option.TSV:
+	default:
+		return nil, nil
+	}
+	// Values in tables with schema files must be converted to the declared types.
+	if _, err := os.Stat(SchemaFilePath(fileInfo.Path)); !os.IsNotExist(err) {
+		return nil, nil
+	}
+	fileInfo.SetDefaultFileInfoAttributes(options, scope.Tx.Flags.ExportOptions)
+
+	plan := &streamingSelectPlan{
+		table:      table,
+		fileInfo:   fileInfo,
+		options:    options,
+		selectExpr: selectClause,
+		limitation: -1,
+	}
+	if entity.WhereClause != nil {
+		plan.where = entity.WhereClause.(parser.WhereClause).Filter
+	}
+
+	if plan.tableName, err = ParseTableName(ctx, scope, table); err != nil {
+		return plan, err
+	}
+
+	if limitClause.OffsetClause != nil {
+		offsetClause := limitClause.OffsetClause.(parser.OffsetClause)
+		val, err := Evaluate(ctx, scope, offsetClause.Value)
+		if err != nil {
+			return plan, err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return plan, NewInvalidOffsetNumberError(offsetClause)
+		}
+		plan.offset = int(number.(*value.Integer).Raw())
+		value.Discard(number)
+
+		if plan.offset < 0 {
+			plan.offset = 0
+		}
+	}
+
+	if !limitClause.Type.IsEmpty() {
+		val, err := Evaluate(ctx, scope, limitClause.Value)
+		if err != nil {
+			return plan, err
+		}
+		number := value.ToInteger(val)
+		if value.IsNull(number) {
+			return plan, NewInvalidLimitNumberError(limitClause)
+		}
+		plan.limitation = int(number.(*value.Integer).Raw())
+		value.Discard(number)
+
+		if plan.limitation < 0 {
+			plan.limitation = 0
+		}
+	}
+
+	return plan, nil
+}
+
+func (plan *streamingSelectPlan) execute(ctx context.Context, scope *ReferenceScope, writer io.Writer, exportOptions option.ExportOptions, palette *color.Palette) (err error) {
+	fileIdentifier := plan.table.Object.(parser.Identifier)
+
+	h, err := scope.Tx.FileContainer.CreateHandlerForRead(ctx, plan.fileInfo.Path, scope.Tx.WaitTimeout, scope.Tx.RetryDelay)
+	if err != nil {
+		fileIdentifier.Literal = plan.fileInfo.Path
+		return ConvertFileHandlerError(err, fileIdentifier)
+	}
+	defer func() {
+		err = appendCompositeError(err, scope.Tx.FileContainer.Close(h))
+	}()
+
+	fileReader, err := newFileReader(h.File(), plan.fileInfo, fileIdentifier)
+	if err != nil {
+		return err
+	}
+
+	reader, headerLabels, err := newCSVReader(fileReader, plan.fileInfo, false, plan.options.WithoutNull, fileIdentifier)
+	if err != nil {
+		if _, ok := err.(Error); !ok {
+			err = NewDataParsingError(fileIdentifier, plan.fileInfo.Path, err.Error())
+		}
+		return err
+	}
+
+	if 0 < len(plan.tableName.Literal) {
+		if err = scope.AddAlias(plan.tableName, plan.fileInfo.Path); err != nil {
+			return err
+		}
+	}
+
+	chunk := make(RecordSet, 0, streamingSelectChunkSize)
+	eof := false
+
+	var readRecord = func() error {
+		row, e := reader.Read()
+		if e == io.EOF {
+			eof = true
+		} else if e != nil {
+			return NewDataParsingError(fileIdentifier, plan.fileInfo.Path, e.Error())
+		} else {
+			chunk = append(chunk, newRecordFromRawText(row))
+		}
+		return nil
+	}
+
+	if headerLabels == nil {
+		// The number of fields is determined by the first record.
+		if err = readRecord(); err != nil {
+			return err
+		}
+		headerLabels = make([]string, reader.FieldsPerRecord)
+		for i := 0; i < reader.FieldsPerRecord; i++ {
+			headerLabels[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	header := NewHeader(FormatTableName(plan.fileInfo.Path), headerLabels)
+	if !strings.EqualFold(FormatTableName(plan.fileInfo.Path), plan.tableName.Literal) {
+		if err = header.Update(plan.tableName.Literal, nil); err != nil {
+			return err
+		}
+	}
+
+	encoder := &streamingEncoder{
+		writer:  writer,
+		options: exportOptions,
+		palette: palette,
+	}
+
+	offset := plan.offset
+	limitation := plan.limitation
+
+	for {
+		if ctx.Err() != nil {
+			return ConvertContextError(ctx.Err())
+		}
+
+		for !eof && len(chunk) < streamingSelectChunkSize && limitation != 0 {
+			if err = readRecord(); err != nil {
+				return err
+			}
+		}
+
+		view := NewView()
+		view.Header = header.Copy()
+		view.RecordSet = chunk
+		view.FileInfo = plan.fileInfo
+
+		if plan.where != nil && 0 < view.RecordLen() {
+			if err = view.filter(ctx, scope, plan.where); err != nil {
+				return err
+			}
+		}
+		if err = view.Select(ctx, scope, plan.selectExpr); err != nil {
+			return err
+		}
+		if err = view.Fix(ctx, scope.Tx.Flags); err != nil {
+			return err
+		}
+
+		if 0 < offset {
+			if view.RecordLen() <= offset {
+				offset = offset - view.RecordLen()
+				view.RecordSet = view.RecordSet[:0]
+			} else {
+				view.RecordSet = view.RecordSet[offset:]
+				offset = 0
+			}
+		}
+		if -1 < limitation {
+			if limitation < view.RecordLen() {
+				view.RecordSet = view.RecordSet[:limitation]
+			}
+			limitation = limitation - view.RecordLen()
+		}
+
+		if err = encoder.encode(ctx, view); err != nil {
+			return err
+		}
+
+		if eof || limitation == 0 {
+			break
+		}
+		chunk = make(RecordSet, 0, streamingSelectChunkSize)
+	}
+
+	if !encoder.written {
+		return DataEmpty
+	}
+	return nil
+}
+
+type streamingEncoder struct {
+	writer  io.Writer
+	options option.ExportOptions
+	palette *color.Palette
+
+	encoded bool
+	written bool
+}
+
+func (e *streamingEncoder) encode(ctx context.Context, view *View) error {
+	if e.encoded && view.RecordLen() < 1 {
+		return nil
+	}
+
+	if e.written && e.options.Format != option.JSONL {
+		if _, err := e.writer.Write([]byte(e.options.LineBreak.Value())); err != nil {
+			return NewSystemError(err.Error())
+		}
+	}
+
+	_, err := EncodeView(ctx, e.writer, view, e.options, e.palette)
+	e.encoded = true
+	if err != nil {
+		if err == DataEmpty {
+			return nil
+		}
+		return err
+	}
+
+	e.written = true
+	e.options.WithoutHeader = true
+	return nil
+}
@@ -0,0 +1,217 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/parser"
+)
+
+var streamSelectTests = []struct {
+	Name     string
+	Query    parser.SelectQuery
+	Format   option.Format
+	NoHeader bool
+	Streamed bool
+	Result   string
+	Error    string
+}{
+	{
+		Name: "Stream Select",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{View: parser.Identifier{Literal: "t"}, Column: parser.Identifier{Literal: "column2"}}, Alias: parser.Identifier{Literal: "c2"}},
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "column1"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}, Alias: parser.Identifier{Literal: "t"}},
+					},
+				},
+				WhereClause: parser.WhereClause{
+					Filter: parser.Comparison{
+						LHS:      parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+						RHS:      parser.NewIntegerValue(1),
+						Operator: parser.Token{Token: parser.COMPARISON_OP, Literal: ">"},
+					},
+				},
+			},
+		},
+		Format:   option.CSV,
+		Streamed: true,
+		Result: "c2,column1\n" +
+			"str2,2\n" +
+			"str3,3",
+	},
+	{
+		Name: "Stream Select with Limit and Offset",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:         parser.Token{Token: parser.LIMIT},
+				Value:        parser.NewIntegerValue(1),
+				OffsetClause: parser.OffsetClause{Value: parser.NewIntegerValue(1)},
+			},
+		},
+		Format:   option.JSONL,
+		Streamed: true,
+		Result:   "{\"column1\":\"2\",\"column2\":\"str2\"}\n",
+	},
+	{
+		Name: "Stream Select No Header",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "c2"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+			LimitClause: parser.LimitClause{
+				Type:  parser.Token{Token: parser.LIMIT},
+				Value: parser.NewIntegerValue(0),
+			},
+		},
+		Format:   option.CSV,
+		NoHeader: true,
+		Streamed: true,
+		Result:   "c2",
+	},
+	{
+		Name: "Stream Select Field Not Exist Error",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format:   option.CSV,
+		Streamed: true,
+		Error:    "field notexist does not exist",
+	},
+	{
+		Name: "Stream Select Not Streamable Query",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AggregateFunction{Name: "count", Args: []parser.QueryExpression{parser.AllColumns{}}}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format:   option.CSV,
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Not Streamable Format",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table1"}},
+					},
+				},
+			},
+		},
+		Format:   option.TEXT,
+		Streamed: false,
+	},
+	{
+		Name: "Stream Select Not Streamable Table with Schema File",
+		Query: parser.SelectQuery{
+			SelectEntity: parser.SelectEntity{
+				SelectClause: parser.SelectClause{
+					Fields: []parser.QueryExpression{
+						parser.Field{Object: parser.AllColumns{}},
+					},
+				},
+				FromClause: parser.FromClause{
+					Tables: []parser.QueryExpression{
+						parser.Table{Object: parser.Identifier{Literal: "table_typed"}},
+					},
+				},
+			},
+		},
+		Format:   option.CSV,
+		Streamed: false,
+	},
+}
+
+func TestStreamSelect(t *testing.T) {
+	defer func() {
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.Repository = TestDir
+
+	for _, v := range streamSelectTests {
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		TestTx.Flags.ImportOptions.NoHeader = v.NoHeader
+
+		options := TestTx.Flags.ExportOptions.Copy()
+		options.Format = v.Format
+
+		buf := &bytes.Buffer{}
+		streamed, err := StreamSelect(context.Background(), NewReferenceScope(TestTx), v.Query, buf, options, TestTx.Palette)
+		if streamed != v.Streamed {
+			t.Errorf("%s: streamed = %t, want %t", v.Name, streamed, v.Streamed)
+			continue
+		}
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %q, want %q", v.Name, buf.String(), v.Result)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package query
 
 import (
 	"context"
+	"io/fs"
 	"net/url"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/mithrandie/csvq/lib/parser"
@@ -28,6 +31,176 @@ func (o HttpObject) String() string {
 	return o.URL
 }
 
+type ArchiveObject struct {
+	*parser.BaseExpr
+	Path   string
+	Member string
+}
+
+func (o ArchiveObject) String() string {
+	return o.Member + " in " + o.Path
+}
+
+// FileSetObject represents files to be loaded as one table.
+// Files are matched by a glob pattern, or searched recursively in a directory by a pattern for the file names.
+type FileSetObject struct {
+	*parser.BaseExpr
+	Directory string
+	Pattern   string
+	Recursive bool
+}
+
+func (o FileSetObject) String() string {
+	if o.Recursive {
+		return "DIRECTORY::(" + o.Directory + ", " + o.Pattern + ")"
+	}
+	return filepath.Join(o.Directory, o.Pattern)
+}
+
+// Name returns the default table name.
+func (o FileSetObject) Name() string {
+	if o.Recursive {
+		return FormatTableName(o.Directory)
+	}
+	return FormatTableName(o.Pattern)
+}
+
+// SearchFiles returns the absolute path of the base directory and the paths of the files in lexical order.
+func (o FileSetObject) SearchFiles(repository string) (string, []string, error) {
+	if _, err := filepath.Match(o.Pattern, ""); err != nil {
+		return "", nil, NewInvalidFilePatternError(o, o.Pattern)
+	}
+
+	dir := o.Directory
+	if !filepath.IsAbs(dir) {
+		if len(repository) < 1 {
+			repository, _ = os.Getwd()
+		}
+		dir = filepath.Join(repository, dir)
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", nil, NewIOError(o, err.Error())
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", nil, NewDirectoryNotExistError(o, dir)
+	}
+
+	var files []string
+
+	if o.Recursive {
+		err = filepath.WalkDir(dir, func(fpath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(d.Name(), ".") && fpath != dir {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Type().IsRegular() {
+				if ok, _ := filepath.Match(o.Pattern, d.Name()); ok {
+					files = append(files, fpath)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return "", nil, NewIOError(o, err.Error())
+		}
+	} else {
+		matches, _ := filepath.Glob(filepath.Join(dir, o.Pattern))
+		for _, fpath := range matches {
+			if isHiddenMatch(dir, o.Pattern, fpath) {
+				continue
+			}
+			if info, err := os.Stat(fpath); err == nil && info.Mode().IsRegular() {
+				files = append(files, fpath)
+			}
+		}
+		sort.Strings(files)
+	}
+
+	return dir, files, nil
+}
+
+// isHiddenMatch reports whether a wildcard in the pattern matched a name starting with a period.
+func isHiddenMatch(dir string, pattern string, fpath string) bool {
+	rel, err := filepath.Rel(dir, fpath)
+	if err != nil {
+		return false
+	}
+
+	patternElems := strings.Split(filepath.ToSlash(pattern), "/")
+	for i, elem := range strings.Split(filepath.ToSlash(rel), "/") {
+		if strings.HasPrefix(elem, ".") && i < len(patternElems) && !strings.HasPrefix(patternElems[i], ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGlobPattern reports whether the path contains any of the special characters of glob patterns.
+func IsGlobPattern(fpath string) bool {
+	return strings.ContainsAny(fpath, "*?[")
+}
+
+// NewGlobObject splits the path into the leading directory without any special characters and the rest of the pattern.
+func NewGlobObject(identifier parser.Identifier) FileSetObject {
+	elems := strings.Split(filepath.ToSlash(identifier.Literal), "/")
+
+	i := 0
+	for i < len(elems)-1 && !IsGlobPattern(elems[i]) {
+		i++
+	}
+
+	dir := strings.Join(elems[:i], "/")
+	if len(dir) < 1 {
+		dir = "."
+		if 1 < len(elems) && i == 1 {
+			dir = "/"
+		}
+	}
+
+	return FileSetObject{
+		BaseExpr:  identifier.GetBaseExpr(),
+		Directory: filepath.FromSlash(dir),
+		Pattern:   filepath.FromSlash(strings.Join(elems[i:], "/")),
+	}
+}
+
+// PartitionValue is a key-value pair represented by a directory name such as "year=2024".
+type PartitionValue struct {
+	Key   string
+	Value string
+}
+
+// HivePartitionNullValue is the directory value that represents NULL in Hive-style partitioning.
+const HivePartitionNullValue = "__HIVE_DEFAULT_PARTITION__"
+
+// ParsePartitionValues returns the key-value pairs in the directory names of the path relative to the base directory.
+func ParsePartitionValues(dir string, fpath string) []PartitionValue {
+	rel, err := filepath.Rel(dir, filepath.Dir(fpath))
+	if err != nil || rel == "." {
+		return nil
+	}
+
+	var values []PartitionValue
+	for _, elem := range strings.Split(filepath.ToSlash(rel), "/") {
+		i := strings.IndexByte(elem, '=')
+		if i < 1 {
+			continue
+		}
+		v := elem[i+1:]
+		if s, err := url.PathUnescape(v); err == nil {
+			v = s
+		}
+		values = append(values, PartitionValue{Key: elem[:i], Value: v})
+	}
+	return values
+}
+
 func ParseTableName(ctx context.Context, scope *ReferenceScope, table parser.Table) (parser.Identifier, error) {
 	if table.Alias != nil {
 		return table.Alias.(parser.Identifier), nil
@@ -47,6 +220,10 @@ func ParseTableName(ctx context.Context, scope *ReferenceScope, table parser.Tab
 		name.Literal = FormatTableName(obj.Literal)
 	case parser.Stdin:
 		name.Literal = obj.String()
+	case ArchiveObject:
+		name.Literal = FormatTableName(obj.Member)
+	case FileSetObject:
+		name.Literal = obj.Name()
 	case parser.FormatSpecifiedFunction:
 		return ParseTableName(ctx, scope, parser.Table{Object: obj.Path})
 	default:
@@ -64,6 +241,10 @@ func NormalizeTableObject(ctx context.Context, scope *ReferenceScope, tableObjec
 		tableObject = p
 	}
 
+	if archiveMember, ok := tableObject.(parser.ArchiveMember); ok {
+		tableObject = ArchiveObject{BaseExpr: archiveMember.GetBaseExpr(), Path: archiveMember.Archive.Literal, Member: archiveMember.Member.Literal}
+	}
+
 	if urlExpr, ok := tableObject.(parser.Url); ok {
 		p, err := ConvertUrlExpr(urlExpr)
 		if err != nil {
@@ -72,6 +253,10 @@ func NormalizeTableObject(ctx context.Context, scope *ReferenceScope, tableObjec
 		tableObject = p
 	}
 
+	if identifier, ok := tableObject.(parser.Identifier); ok && IsGlobPattern(identifier.Literal) {
+		tableObject = NewGlobObject(identifier)
+	}
+
 	return tableObject, nil
 }
 
@@ -83,6 +268,14 @@ func ConvertTableFunction(ctx context.Context, scope *ReferenceScope, tableFunct
 		if len(tableFunction.Args) != 1 {
 			return nil, NewFunctionArgumentLengthError(tableFunction, strings.ToUpper(tableFunction.Name), []int{1})
 		}
+	case "ARCHIVE":
+		if len(tableFunction.Args) != 2 {
+			return nil, NewFunctionArgumentLengthError(tableFunction, strings.ToUpper(tableFunction.Name), []int{2})
+		}
+	case "DIRECTORY":
+		if len(tableFunction.Args) < 1 || 2 < len(tableFunction.Args) {
+			return nil, NewFunctionArgumentLengthError(tableFunction, strings.ToUpper(tableFunction.Name), []int{1, 2})
+		}
 	default:
 		return nil, NewFunctionNotExistError(tableFunction, strings.ToUpper(tableFunction.Name))
 	}
@@ -117,6 +310,30 @@ func ConvertTableFunction(ctx context.Context, scope *ReferenceScope, tableFunct
 			return nil, NewFunctionInvalidArgumentError(tableFunction, strings.ToUpper(tableFunction.Name), "the first argument must be a string")
 		}
 		expr = DataObject{BaseExpr: tableFunction.GetBaseExpr(), Raw: p.(*value.String).Raw()}
+	case "ARCHIVE":
+		p := value.ToString(args[0])
+		if value.IsNull(p) {
+			return nil, NewFunctionInvalidArgumentError(tableFunction, strings.ToUpper(tableFunction.Name), "the first argument must be a string")
+		}
+		m := value.ToString(args[1])
+		if value.IsNull(m) {
+			return nil, NewFunctionInvalidArgumentError(tableFunction, strings.ToUpper(tableFunction.Name), "the second argument must be a string")
+		}
+		expr = ArchiveObject{BaseExpr: tableFunction.GetBaseExpr(), Path: p.(*value.String).Raw(), Member: m.(*value.String).Raw()}
+	case "DIRECTORY":
+		p := value.ToString(args[0])
+		if value.IsNull(p) {
+			return nil, NewFunctionInvalidArgumentError(tableFunction, strings.ToUpper(tableFunction.Name), "the first argument must be a string")
+		}
+		pattern := "*"
+		if 1 < len(args) {
+			m := value.ToString(args[1])
+			if value.IsNull(m) {
+				return nil, NewFunctionInvalidArgumentError(tableFunction, strings.ToUpper(tableFunction.Name), "the second argument must be a string")
+			}
+			pattern = m.(*value.String).Raw()
+		}
+		expr = FileSetObject{BaseExpr: tableFunction.GetBaseExpr(), Directory: p.(*value.String).Raw(), Pattern: pattern, Recursive: true}
 	}
 	return expr, nil
 }
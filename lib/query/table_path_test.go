@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -72,6 +73,21 @@ var parseTableNameTests = []struct {
 		},
 		Result: "table",
 	},
+	{
+		Table: parser.Table{
+			Object: parser.Identifier{Literal: "logs/*/app.csv"},
+		},
+		Result: "app",
+	},
+	{
+		Table: parser.Table{
+			Object: parser.TableFunction{
+				Name: "directory",
+				Args: []parser.QueryExpression{parser.NewStringValue("path/to/logs"), parser.NewStringValue("*.csv")},
+			},
+		},
+		Result: "logs",
+	},
 	{
 		Table: parser.Table{
 			Object: parser.TableFunction{
@@ -203,6 +219,53 @@ var convertTableFunctionTests = []struct {
 		},
 		Error: "the first argument must be a string for function DATA",
 	},
+	{
+		Name: "Convert DIRECTORY function",
+		TableFunction: parser.TableFunction{
+			Name: "directory",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("logs"),
+				parser.NewStringValue("*.csv"),
+			},
+		},
+		Result: FileSetObject{
+			Directory: "logs",
+			Pattern:   "*.csv",
+			Recursive: true,
+		},
+	},
+	{
+		Name: "Convert DIRECTORY function with Default Pattern",
+		TableFunction: parser.TableFunction{
+			Name: "directory",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("logs"),
+			},
+		},
+		Result: FileSetObject{
+			Directory: "logs",
+			Pattern:   "*",
+			Recursive: true,
+		},
+	},
+	{
+		Name: "Argument Length Error in DIRECTORY function",
+		TableFunction: parser.TableFunction{
+			Name: "directory",
+		},
+		Error: "function DIRECTORY takes 1 or 2 arguments",
+	},
+	{
+		Name: "Second argument must be a string in DIRECTORY function",
+		TableFunction: parser.TableFunction{
+			Name: "directory",
+			Args: []parser.QueryExpression{
+				parser.NewStringValue("logs"),
+				parser.NewNullValue(),
+			},
+		},
+		Error: "the second argument must be a string for function DIRECTORY",
+	},
 	{
 		Name: "Invalid Function Name Error",
 		TableFunction: parser.TableFunction{
@@ -314,3 +377,74 @@ func TestConvertUrlExpr(t *testing.T) {
 		}
 	}
 }
+
+var newGlobObjectTests = []struct {
+	Path   string
+	Result FileSetObject
+}{
+	{
+		Path:   "logs/2024-*.csv",
+		Result: FileSetObject{Directory: "logs", Pattern: "2024-*.csv"},
+	},
+	{
+		Path:   "*.csv",
+		Result: FileSetObject{Directory: ".", Pattern: "*.csv"},
+	},
+	{
+		Path:   "/var/log/app/*/[ab].csv",
+		Result: FileSetObject{Directory: filepath.FromSlash("/var/log/app"), Pattern: filepath.FromSlash("*/[ab].csv")},
+	},
+	{
+		Path:   "/*.csv",
+		Result: FileSetObject{Directory: filepath.FromSlash("/"), Pattern: "*.csv"},
+	},
+}
+
+func TestNewGlobObject(t *testing.T) {
+	for _, v := range newGlobObjectTests {
+		result := NewGlobObject(parser.Identifier{Literal: v.Path})
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %#v, want %#v", v.Path, result, v.Result)
+		}
+	}
+}
+
+var parsePartitionValuesTests = []struct {
+	Dir    string
+	Path   string
+	Result []PartitionValue
+}{
+	{
+		Dir:  "/logs",
+		Path: "/logs/year=2024/month=01/app.csv",
+		Result: []PartitionValue{
+			{Key: "year", Value: "2024"},
+			{Key: "month", Value: "01"},
+		},
+	},
+	{
+		Dir:  "/logs",
+		Path: "/logs/archive/region=us%2Feast/app.csv",
+		Result: []PartitionValue{
+			{Key: "region", Value: "us/east"},
+		},
+	},
+	{
+		Dir:    "/logs",
+		Path:   "/logs/app.csv",
+		Result: nil,
+	},
+	{
+		Dir:  "/year=2024",
+		Path: "/year=2024/=invalid/app.csv",
+	},
+}
+
+func TestParsePartitionValues(t *testing.T) {
+	for _, v := range parsePartitionValuesTests {
+		result := ParsePartitionValues(filepath.FromSlash(v.Dir), filepath.FromSlash(v.Path))
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("%s: result = %v, want %v", v.Path, result, v.Result)
+		}
+	}
+}
@@ -153,16 +153,10 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileInfo.ExportOptions(tx), tx.Palette); err != nil {
+			if err := tx.writeFile(ctx, fp, view, fileInfo); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileInfo.Format == option.FIXED && fileInfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
-			}
-
 			createFileInfo = append(createFileInfo, view.FileInfo)
 		}
 	}
@@ -179,16 +173,10 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 				return NewSystemError(err.Error())
 			}
 
-			if _, err := EncodeView(ctx, fp, view, fileInfo.ExportOptions(tx), tx.Palette); err != nil {
+			if err := tx.writeFile(ctx, fp, view, fileInfo); err != nil {
 				return NewCommitError(expr, err.Error())
 			}
 
-			if !tx.Flags.ExportOptions.StripEndingLineBreak && !(fileInfo.Format == option.FIXED && fileInfo.SingleLine) {
-				if _, err := fp.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
-					return NewCommitError(expr, err.Error())
-				}
-			}
-
 			updateFileInfo = append(updateFileInfo, view.FileInfo)
 		}
 	}
@@ -197,6 +185,11 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if view, ok := tx.CachedViews.Load(f.IdentifiedPath()); ok {
+			if err := writeSchemaFile(view); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+		}
 		tx.UncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is created.", f.Path), tx.Flags.Quiet)
 	}
@@ -204,6 +197,11 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 		if err := tx.FileContainer.Commit(f.Handler); err != nil {
 			return NewCommitError(expr, err.Error())
 		}
+		if view, ok := tx.CachedViews.Load(f.IdentifiedPath()); ok {
+			if err := writeSchemaFile(view); err != nil {
+				return NewCommitError(expr, err.Error())
+			}
+		}
 		tx.UncommittedViews.Unset(f)
 		tx.LogNotice(fmt.Sprintf("Commit: file %q is updated.", f.Path), tx.Flags.Quiet)
 	}
@@ -220,6 +218,25 @@ func (tx *Transaction) Commit(ctx context.Context, scope *ReferenceScope, expr p
 	return nil
 }
 
+func (tx *Transaction) writeFile(ctx context.Context, fp io.Writer, view *View, fileInfo *FileInfo) error {
+	w, err := file.NewCompressor(fp, fileInfo.Compression)
+	if err != nil {
+		return err
+	}
+
+	if _, err = EncodeView(ctx, w, view, fileInfo.ExportOptions(tx), tx.Palette); err != nil {
+		return err
+	}
+
+	if !tx.Flags.ExportOptions.StripEndingLineBreak && fileInfo.Format != option.XLSX && fileInfo.Format != option.PARQUET && !(fileInfo.Format == option.FIXED && fileInfo.SingleLine) {
+		if _, err = w.Write([]byte(tx.Flags.ExportOptions.LineBreak.Value())); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
 func (tx *Transaction) Rollback(scope *ReferenceScope, expr parser.Expression) error {
 	tx.operationMutex.Lock()
 	defer tx.operationMutex.Unlock()
@@ -455,6 +472,30 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case option.XlsxSheetFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetXlsxSheet(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.XlsxRangeFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetXlsxRange(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.XmlRowPathFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetXmlRowPath(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.SourcePathColumnFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetSourcePathColumn(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case option.EncodingFlag:
 		if s, ok := value.(string); ok {
 			err = tx.Flags.SetEncoding(s)
@@ -521,6 +562,48 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case option.SqlDialectFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetSqlDialect(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.SqlTableNameFlag:
+		if s, ok := value.(string); ok {
+			tx.Flags.SetSqlTableName(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.SqlBatchSizeFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetSqlBatchSize(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.HtmlDocumentFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetHtmlDocument(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.XmlRootElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRootElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.XmlRowElementFlag:
+		if s, ok := value.(string); ok {
+			err = tx.Flags.SetXmlRowElement(s)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
+	case option.XmlAttributesFlag:
+		if b, ok := value.(bool); ok {
+			tx.Flags.SetXmlAttributes(b)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case option.PrettyPrintFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetPrettyPrint(b)
@@ -581,6 +664,12 @@ func (tx *Transaction) setFlag(key string, value interface{}, outFile string) er
 		} else {
 			err = errNotAllowdFlagFormat
 		}
+	case option.SortMemoryLimitFlag:
+		if i, ok := value.(int64); ok {
+			tx.Flags.SetSortMemoryLimit(i)
+		} else {
+			err = errNotAllowdFlagFormat
+		}
 	case option.StatsFlag:
 		if b, ok := value.(bool); ok {
 			tx.Flags.SetStats(b)
@@ -636,6 +725,14 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewString(s)
 	case option.JsonQueryFlag:
 		val = value.NewString(tx.Flags.ImportOptions.JsonQuery)
+	case option.XlsxSheetFlag:
+		val = value.NewString(tx.Flags.ImportOptions.XlsxSheet)
+	case option.XlsxRangeFlag:
+		val = value.NewString(tx.Flags.ImportOptions.XlsxRange)
+	case option.XmlRowPathFlag:
+		val = value.NewString(tx.Flags.ImportOptions.XmlRowPath)
+	case option.SourcePathColumnFlag:
+		val = value.NewString(tx.Flags.ImportOptions.SourcePathColumn)
 	case option.EncodingFlag:
 		val = value.NewString(tx.Flags.ImportOptions.Encoding.String())
 	case option.NoHeaderFlag:
@@ -662,6 +759,20 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewBoolean(tx.Flags.ExportOptions.EncloseAll)
 	case option.JsonEscapeFlag:
 		val = value.NewString(option.JsonEscapeTypeToString(tx.Flags.ExportOptions.JsonEscape))
+	case option.SqlDialectFlag:
+		val = value.NewString(tx.Flags.ExportOptions.SqlDialect.String())
+	case option.SqlTableNameFlag:
+		val = value.NewString(tx.Flags.ExportOptions.SqlTableName)
+	case option.SqlBatchSizeFlag:
+		val = value.NewInteger(int64(tx.Flags.ExportOptions.SqlBatchSize))
+	case option.HtmlDocumentFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.HtmlDocument)
+	case option.XmlRootElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRootElement)
+	case option.XmlRowElementFlag:
+		val = value.NewString(tx.Flags.ExportOptions.XmlRowElement)
+	case option.XmlAttributesFlag:
+		val = value.NewBoolean(tx.Flags.ExportOptions.XmlAttributes)
 	case option.PrettyPrintFlag:
 		val = value.NewBoolean(tx.Flags.ExportOptions.PrettyPrint)
 	case option.ScientificNotationFlag:
@@ -682,6 +793,8 @@ func (tx *Transaction) GetFlag(key string) (value.Primary, bool) {
 		val = value.NewInteger(tx.Flags.LimitRecursion)
 	case option.CPUFlag:
 		val = value.NewInteger(int64(tx.Flags.CPU))
+	case option.SortMemoryLimitFlag:
+		val = value.NewInteger(tx.Flags.SortMemoryLimit)
 	case option.StatsFlag:
 		val = value.NewBoolean(tx.Flags.Stats)
 	default:
@@ -1,13 +1,16 @@
 package query
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
 	"testing"
 
+	"github.com/mithrandie/csvq/lib/file"
 	"github.com/mithrandie/csvq/lib/option"
 
 	"github.com/mithrandie/go-text"
@@ -225,6 +228,97 @@ func TestTransaction_Commit(t *testing.T) {
 	}
 }
 
+func TestTransaction_CommitCompressedFile(t *testing.T) {
+	defer func() {
+		_ = TestTx.ReleaseResources()
+		_ = TestTx.CachedViews.Clean(TestTx.FileContainer)
+		TestTx.Session.SetStdout(NewDiscard())
+		initFlag(TestTx.Flags)
+	}()
+
+	TestTx.Flags.SetQuiet(true)
+	ctx := context.Background()
+
+	for _, v := range []struct {
+		Compression file.Compression
+		Ext         string
+	}{
+		{Compression: file.Gzip, Ext: file.GzipExt},
+		{Compression: file.Bzip2, Ext: file.Bzip2Ext},
+		{Compression: file.Xz, Ext: file.XzExt},
+		{Compression: file.Zstd, Ext: file.ZstdExt},
+	} {
+		c := v.Compression
+		fpath := GetTestFilePath("updated_compressed.csv" + v.Ext)
+
+		buf := new(bytes.Buffer)
+		w, _ := file.NewCompressor(buf, c)
+		_, _ = w.Write([]byte("column1,column2\n1,str1\n"))
+		_ = w.Close()
+		if err := os.WriteFile(fpath, buf.Bytes(), 0664); err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+
+		h, err := TestTx.FileContainer.CreateHandlerForUpdate(ctx, fpath, TestTx.WaitTimeout, TestTx.RetryDelay)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+		fileInfo := &FileInfo{
+			Path:        fpath,
+			Handler:     h,
+			Encoding:    text.UTF8,
+			Format:      option.CSV,
+			Delimiter:   ',',
+			LineBreak:   text.LF,
+			Compression: c,
+		}
+
+		TestTx.CachedViews = GenerateViewMap([]*View{
+			{
+				Header: NewHeader("updated_compressed", []string{"column1", "column2"}),
+				RecordSet: []Record{
+					NewRecord([]value.Primary{
+						value.NewString("1"),
+						value.NewString("update1"),
+					}),
+				},
+				FileInfo: fileInfo,
+			},
+		})
+		TestTx.UncommittedViews = UncommittedViews{
+			mtx:     &sync.RWMutex{},
+			Created: map[string]*FileInfo{},
+			Updated: map[string]*FileInfo{
+				strings.ToUpper(fpath): fileInfo,
+			},
+		}
+
+		if err = TestTx.Commit(ctx, NewReferenceScope(TestTx), parser.TransactionControl{Token: parser.COMMIT}); err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+
+		contents, err := os.ReadFile(fpath)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+		if detected := file.DetectCompression(contents); detected != c {
+			t.Errorf("%s: compression of the updated file = %s, want %s", c, detected, c)
+			continue
+		}
+		r, err := file.NewDecompressor(bytes.NewReader(contents), c)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+		decompressed, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %q", c, err.Error())
+		}
+		if expect := "column1,column2\n1,update1\n"; string(decompressed) != expect {
+			t.Errorf("%s: updated contents = %q, want %q", c, string(decompressed), expect)
+		}
+	}
+}
+
 func TestTransaction_Rollback(t *testing.T) {
 	defer func() {
 		_ = TestTx.ReleaseResources()
@@ -120,12 +120,15 @@ func (m UserDefinedFunctionMap) parseParameters(parameters []parser.VariableAssi
 func (m UserDefinedFunctionMap) CheckDuplicate(name parser.Identifier) error {
 	uname := strings.ToUpper(name.Literal)
 
-	if _, ok := Functions[uname]; ok || uname == "CALL" || uname == "NOW" || uname == "JSON_OBJECT" {
+	if _, ok := Functions[uname]; ok || uname == "CALL" || uname == "NOW" || uname == "ERROR_CODE" || uname == "ERROR_MESSAGE" || uname == "JSON_OBJECT" {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
 	if _, ok := AggregateFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
+	if _, ok := OrderedSetAggregateFunctions[uname]; ok {
+		return NewBuiltInFunctionDeclaredError(name)
+	}
 	if _, ok := AnalyticFunctions[uname]; ok {
 		return NewBuiltInFunctionDeclaredError(name)
 	}
@@ -71,6 +71,15 @@ func InStrSliceWithCaseInsensitive(s string, list []string) bool {
 	return false
 }
 
+func InIntSlice(i int, list []int) bool {
+	for _, v := range list {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
 func Distinguish(list []value.Primary, flags *option.Flags) []value.Primary {
 	values := make(map[string]int, 40)
 	valueKeys := make([]string, 0, 40)
@@ -142,6 +151,14 @@ func SerializeComparisonKeys(buf *bytes.Buffer, values []value.Primary, flags *o
 func SerializeKey(buf *bytes.Buffer, val value.Primary, flags *option.Flags) {
 	if value.IsNull(val) {
 		serializeNull(buf)
+	} else if d, ok := val.(*value.Decimal); ok {
+		serializeFloat(buf, d.Normalize().String())
+	} else if iv, ok := val.(*value.Interval); ok {
+		serializeInterval(buf, iv.TotalNanoseconds().String())
+	} else if a, ok := val.(*value.Array); ok {
+		serializeString(buf, a.Encode())
+	} else if m, ok := val.(*value.Map); ok {
+		serializeString(buf, m.Encode())
 	} else if in := value.ToIntegerStrictly(val); !value.IsNull(in) {
 		serializeInteger(buf, in.(*value.Integer).String())
 		value.Discard(in)
@@ -172,12 +189,21 @@ func SerializeIdenticalKey(buf *bytes.Buffer, val value.Primary) {
 		serializeInteger(buf, val.(*value.Integer).String())
 	case *value.Float:
 		serializeFloat(buf, val.(*value.Float).String())
+	case *value.Decimal:
+		serializeDecimal(buf, val.(*value.Decimal).Normalize().String())
 	case *value.Boolean:
 		serializeBoolean(buf, val.(*value.Boolean).Raw())
 	case *value.Ternary:
 		serializeTernary(buf, val.(*value.Ternary).Ternary())
 	case *value.Datetime:
 		serializeDatetime(buf, val.(*value.Datetime).Raw())
+	case *value.Interval:
+		iv := val.(*value.Interval)
+		serializeInterval(buf, strings.Join([]string{value.Int64ToStr(iv.Months()), value.Int64ToStr(iv.Days()), value.Int64ToStr(iv.Nanoseconds())}, ","))
+	case *value.Array:
+		serializeStructure(buf, val.(*value.Array).Encode())
+	case *value.Map:
+		serializeStructure(buf, val.(*value.Map).Encode())
 	default:
 		serializeNull(buf)
 	}
@@ -197,6 +223,11 @@ func serializeFloat(buf *bytes.Buffer, s string) {
 	buf.WriteString(s)
 }
 
+func serializeDecimal(buf *bytes.Buffer, s string) {
+	buf.Write([]byte{91, 77, 93})
+	buf.WriteString(s)
+}
+
 func serializeDatetime(buf *bytes.Buffer, t time.Time) {
 	serializeDatetimeFromUnixNano(buf, t.UnixNano())
 }
@@ -206,6 +237,16 @@ func serializeDatetimeFromUnixNano(buf *bytes.Buffer, t int64) {
 	buf.WriteString(value.Int64ToStr(t))
 }
 
+func serializeInterval(buf *bytes.Buffer, s string) {
+	buf.Write([]byte{91, 86, 93})
+	buf.WriteString(s)
+}
+
+func serializeStructure(buf *bytes.Buffer, s string) {
+	buf.Write([]byte{91, 65, 93})
+	buf.WriteString(s)
+}
+
 func serializeString(buf *bytes.Buffer, s string) {
 	buf.Write([]byte{91, 83, 93})
 	buf.WriteString(strings.ToUpper(option.TrimSpace(s)))
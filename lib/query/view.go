@@ -22,6 +22,7 @@ type View struct {
 	selectFields []int
 	selectLabels []string
 	isGrouped    bool
+	grouping     *groupingSets
 
 	comparisonKeysInEachRecord []string
 	sortValuesInEachCell       [][]*SortValue
@@ -48,6 +49,10 @@ func NewViewFromGroupedRecord(ctx context.Context, flags *option.Flags, referenc
 	view.Header = referenceRecord.view.Header
 	record := referenceRecord.view.RecordSet[referenceRecord.recordIndex]
 
+	if referenceRecord.view.grouping != nil {
+		record = referenceRecord.view.grouping.restore(record)
+	}
+
 	view.RecordSet = make(RecordSet, record.GroupLen())
 
 	if err := NewGoroutineTaskManager(record.GroupLen(), -1, flags.CPU).Run(ctx, func(index int) error {
@@ -113,7 +118,123 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 	if items == nil {
 		return view.groupAll(ctx, scope.Tx.Flags)
 	}
+	if HasGroupingSets(items) {
+		return view.groupBySets(ctx, scope, items)
+	}
+
+	records, err := view.groupRecords(ctx, scope, items)
+	if err != nil {
+		return err
+	}
+
+	view.RecordSet = records
+	view.isGrouped = true
+	for _, item := range items {
+		switch item.(type) {
+		case parser.FieldReference, parser.ColumnNumber:
+			idx, _ := view.Header.SearchIndex(item)
+			view.Header[idx].IsGroupKey = true
+		}
+	}
+	return nil
+}
+
+// groupBySets groups records by each of the grouping sets and concatenates the results.
+// In the records of a grouping set, the group keys not included in the set are replaced with nulls,
+// and the original values are kept in hidden fields to be used by aggregate functions.
+func (view *View) groupBySets(ctx context.Context, scope *ReferenceScope, items []parser.QueryExpression) error {
+	sets := ExpandGroupingSets(items)
+
+	keys := make([]int, 0, 8)
+	setKeys := make([][]int, len(sets))
+	for i, set := range sets {
+		setKeys[i] = make([]int, 0, len(set))
+		for _, item := range set {
+			switch item.(type) {
+			case parser.FieldReference, parser.ColumnNumber:
+				idx, err := view.Header.SearchIndex(item)
+				if err != nil {
+					if err == errFieldAmbiguous {
+						return NewFieldAmbiguousError(item)
+					}
+					return NewFieldNotExistError(item)
+				}
+				setKeys[i] = append(setKeys[i], idx)
+				if !InIntSlice(idx, keys) {
+					keys = append(keys, idx)
+				}
+			}
+		}
+	}
+	if MaxGroupingKeys < len(keys) {
+		return NewTooManyGroupingKeysError(items[0])
+	}
+
+	fieldLen := view.FieldLen()
+	grouping := &groupingSets{
+		keys:      keys,
+		originals: make([]int, len(keys)),
+		idIndex:   fieldLen + len(keys),
+	}
+	for i := range keys {
+		grouping.originals[i] = fieldLen + i
+	}
+
+	records := make(RecordSet, 0, view.RecordLen())
+	for i, set := range sets {
+		setRecords, err := view.groupRecords(ctx, scope, set)
+		if err != nil {
+			return err
+		}
+		if len(set) < 1 && len(setRecords) < 1 {
+			// The empty grouping set makes the grand total even if there are no records.
+			record := make(Record, fieldLen)
+			for j := range record {
+				record[j] = make(Cell, 0)
+			}
+			setRecords = RecordSet{record}
+		}
+
+		var bits int64
+		for j, idx := range keys {
+			if !InIntSlice(idx, setKeys[i]) {
+				bits = bits | 1<<uint(j)
+			}
+		}
+
+		for _, record := range setRecords {
+			record = append(record, make(Record, len(keys)+1)...)
+			for j, idx := range keys {
+				record[grouping.originals[j]] = record[idx]
+				if bits&(1<<uint(j)) != 0 {
+					// The grand total of no records has one null as the value of the group key.
+					nulls := make(Cell, int(math.Max(float64(len(record[idx])), 1)))
+					for k := range nulls {
+						nulls[k] = value.NewNull()
+					}
+					record[idx] = nulls
+				}
+			}
+			record[grouping.idIndex] = NewCell(value.NewInteger(bits))
+			records = append(records, record)
+		}
+	}
+
+	for range keys {
+		view.Header, _ = AddHeaderField(view.Header, "", "", "")
+	}
+	view.Header, _ = AddHeaderField(view.Header, "", "", "")
+	for _, idx := range keys {
+		view.Header[idx].IsGroupKey = true
+	}
 
+	view.RecordSet = records
+	view.isGrouped = true
+	view.grouping = grouping
+	return nil
+}
+
+func (view *View) groupRecords(ctx context.Context, scope *ReferenceScope, items []parser.QueryExpression) (RecordSet, error) {
 	gm := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU)
 	groupsList := make([]map[string][]int, gm.Number)
 	groupKeyCnt := make(map[string]int, 40)
@@ -190,10 +311,10 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 	}
 
 	if gm.HasError() {
-		return gm.Err()
+		return nil, gm.Err()
 	}
 	if ctx.Err() != nil {
-		return ConvertContextError(ctx.Err())
+		return nil, ConvertContextError(ctx.Err())
 	}
 
 	for i := range groupsList {
@@ -228,19 +349,10 @@ func (view *View) group(ctx context.Context, scope *ReferenceScope, items []pars
 		records[gIdx] = record
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	view.RecordSet = records
-	view.isGrouped = true
-	for _, item := range items {
-		switch item.(type) {
-		case parser.FieldReference, parser.ColumnNumber:
-			idx, _ := view.Header.SearchIndex(item)
-			view.Header[idx].IsGroupKey = true
-		}
-	}
-	return nil
+	return records, nil
 }
 
 func (view *View) groupAll(ctx context.Context, flags *option.Flags) error {
@@ -289,6 +401,13 @@ func (view *View) Having(ctx context.Context, scope *ReferenceScope, clause pars
 }
 
 func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause parser.SelectClause) error {
+	return view.SelectAndQualify(ctx, scope, clause, nil)
+}
+
+// SelectAndQualify evaluates the fields in the select clause, and then filters records by the qualify clause
+// before duplicate records are removed.
+// Analytic functions in the qualify clause are evaluated in the same way as the ones in the select clause.
+func (view *View) SelectAndQualify(ctx context.Context, scope *ReferenceScope, clause parser.SelectClause, qualify parser.QueryExpression) error {
 	var parseWildcard = func(fields []parser.QueryExpression) []parser.Field {
 		list := make([]parser.Field, 0, len(fields))
 
@@ -364,9 +483,11 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 		}
 
 		if hasAggregateFunction {
+			start := scope.explain.now()
 			if err = view.group(ctx, scope, nil); err != nil {
 				return err
 			}
+			scope.explain.record(start, view.RecordLen(), "Group", explainGroupAll)
 
 			if view.RecordLen() < 1 {
 				record := make(Record, view.FieldLen())
@@ -378,20 +499,27 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 		}
 	}
 
-	analyticFunctions, err := SearchAnalyticFunctionsInList(fieldObjects)
+	analyticTargets := fieldObjects
+	if qualify != nil {
+		analyticTargets = append(analyticTargets[:len(analyticTargets):len(analyticTargets)], qualify.(parser.QualifyClause).Filter)
+	}
+
+	analyticFunctions, err := SearchAnalyticFunctionsInList(analyticTargets)
 	if err != nil {
 		return err
 	}
 
-	if err := view.ExtendRecordCapacity(ctx, scope, fieldObjects, analyticFunctions); err != nil {
+	if err := view.ExtendRecordCapacity(ctx, scope, analyticTargets, analyticFunctions); err != nil {
 		return err
 	}
 
 	for _, fn := range analyticFunctions {
+		start := scope.explain.now()
 		err = view.evalAnalyticFunction(ctx, scope, fn)
 		if err != nil {
 			return err
 		}
+		scope.explain.record(start, view.RecordLen(), "Analytic Function", fn.String())
 	}
 
 	err = evalFields(fields)
@@ -399,7 +527,16 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 		return err
 	}
 
-	if clause.IsDistinct() {
+	if qualify != nil {
+		start := scope.explain.now()
+		if err = view.filter(ctx, scope, qualify.(parser.QualifyClause).Filter); err != nil {
+			return err
+		}
+		view.sortValuesInEachCell = nil
+		scope.explain.record(start, view.RecordLen(), "Qualify", qualify.String())
+	}
+
+	if clause.IsDistinct() && !clause.IsDistinctOn() {
 		if err = view.GenerateComparisonKeys(ctx, scope.Tx.Flags); err != nil {
 			return err
 		}
@@ -432,6 +569,52 @@ func (view *View) Select(ctx context.Context, scope *ReferenceScope, clause pars
 	return nil
 }
 
+// DistinctOn leaves only the first record of each set of records that have the same values of the expressions.
+func (view *View) DistinctOn(ctx context.Context, scope *ReferenceScope, exprs []parser.QueryExpression) error {
+	indices := make([]int, len(exprs))
+	for i, expr := range exprs {
+		idx, err := view.evalColumn(ctx, scope, expr, "")
+		if err != nil {
+			return err
+		}
+		indices[i] = idx
+	}
+
+	keys := make(map[string]bool, 40)
+	primaries := make([]value.Primary, len(indices))
+	buf := GetComparisonKeysBuf()
+
+	newIdx := 0
+	for i, record := range view.RecordSet {
+		for j, idx := range indices {
+			primaries[j] = record[idx][0]
+		}
+		buf.Reset()
+		SerializeComparisonKeys(buf, primaries, scope.Tx.Flags)
+		key := buf.String()
+		if keys[key] {
+			continue
+		}
+		keys[key] = true
+
+		if i != newIdx {
+			view.RecordSet[newIdx] = view.RecordSet[i]
+			if view.sortValuesInEachRecord != nil {
+				view.sortValuesInEachRecord[newIdx] = view.sortValuesInEachRecord[i]
+			}
+		}
+		newIdx++
+	}
+	PutComparisonkeysBuf(buf)
+
+	view.RecordSet = view.RecordSet[:newIdx]
+	if view.sortValuesInEachRecord != nil {
+		view.sortValuesInEachRecord = view.sortValuesInEachRecord[:newIdx]
+	}
+	view.sortValuesInEachCell = nil
+	return nil
+}
+
 func (view *View) GenerateComparisonKeys(ctx context.Context, flags *option.Flags) error {
 	view.comparisonKeysInEachRecord = make([]string, view.RecordLen())
 
@@ -488,10 +671,12 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 	}
 
 	for _, fn := range analyticFunctions {
+		start := scope.explain.now()
 		err = view.evalAnalyticFunction(ctx, scope, fn)
 		if err != nil {
 			return err
 		}
+		scope.explain.record(start, view.RecordLen(), "Analytic Function", fn.String())
 	}
 
 	sortIndices := make([]int, len(clause.Items))
@@ -528,6 +713,12 @@ func (view *View) OrderBy(ctx context.Context, scope *ReferenceScope, clause par
 		}
 	}
 
+	if -1 < scope.Tx.Flags.SortMemoryLimit {
+		if sorted, err := view.externalSort(ctx, scope, sortIndices, scope.Tx.Flags.SortMemoryLimit*1024*1024); sorted || err != nil {
+			return err
+		}
+	}
+
 	if err := NewGoroutineTaskManager(view.RecordLen(), -1, scope.Tx.Flags.CPU).Run(ctx, func(index int) error {
 		if view.sortValuesInEachCell != nil && view.sortValuesInEachCell[index] == nil {
 			view.sortValuesInEachCell[index] = make([]*SortValue, cap(view.RecordSet[index]))
@@ -801,23 +992,32 @@ func (view *View) InsertFromQuery(ctx context.Context, scope *ReferenceScope, fi
 	return view.insert(ctx, fields, recordValues)
 }
 
-func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression, keys []parser.QueryExpression) (int, error) {
+// ReplaceValues updates the records that have the same keys as the values, and inserts the rest of the values.
+// The indices of the updated and inserted records are returned.
+func (view *View) ReplaceValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression, keys []parser.QueryExpression) ([]int, error) {
 	recordValues, err := view.convertListToRecordValues(ctx, scope, fields, list)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
 }
 
-func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery, keys []parser.QueryExpression) (int, error) {
+// ReplaceFromQuery updates the records that have the same keys as the query results, and inserts the rest of the results.
+// The indices of the updated and inserted records are returned.
+func (view *View) ReplaceFromQuery(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, query parser.SelectQuery, keys []parser.QueryExpression) ([]int, error) {
 	recordValues, err := view.convertResultSetToRecordValues(ctx, scope, fields, query)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	return view.replace(ctx, scope.Tx.Flags, fields, recordValues, keys)
 }
 
 func (view *View) convertListToRecordValues(ctx context.Context, scope *ReferenceScope, fields []parser.QueryExpression, list []parser.QueryExpression) ([][]value.Primary, error) {
+	fieldIndices, err := view.constrainedFieldIndices(fields)
+	if err != nil {
+		return nil, err
+	}
+
 	recordValues := make([][]value.Primary, len(list))
 	for i, item := range list {
 		if ctx.Err() != nil {
@@ -832,6 +1032,11 @@ func (view *View) convertListToRecordValues(ctx context.Context, scope *Referenc
 		if len(fields) != len(values) {
 			return recordValues, NewInsertRowValueLengthError(rv, len(fields))
 		}
+		if fieldIndices != nil {
+			if err = view.conformValues(fieldIndices, values, scope.Tx.Flags, i+1, rv); err != nil {
+				return recordValues, err
+			}
+		}
 
 		recordValues[i] = values
 	}
@@ -846,6 +1051,10 @@ func (view *View) convertResultSetToRecordValues(ctx context.Context, scope *Ref
 	if len(fields) != selectedView.FieldLen() {
 		return nil, NewInsertSelectFieldLengthError(query, len(fields))
 	}
+	fieldIndices, err := view.constrainedFieldIndices(fields)
+	if err != nil {
+		return nil, err
+	}
 
 	recordValues := make([][]value.Primary, selectedView.RecordLen())
 	for i, record := range selectedView.RecordSet {
@@ -857,6 +1066,11 @@ func (view *View) convertResultSetToRecordValues(ctx context.Context, scope *Ref
 		for j, cell := range record {
 			values[j] = cell[0]
 		}
+		if fieldIndices != nil {
+			if err = view.conformValues(fieldIndices, values, scope.Tx.Flags, i+1, query); err != nil {
+				return nil, err
+			}
+		}
 		recordValues[i] = values
 	}
 	return recordValues, nil
@@ -911,10 +1125,10 @@ func (view *View) insert(ctx context.Context, fields []parser.QueryExpression, r
 	return len(recordValues), nil
 }
 
-func (view *View) replace(ctx context.Context, flags *option.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) (int, error) {
+func (view *View) replace(ctx context.Context, flags *option.Flags, fields []parser.QueryExpression, recordValues [][]value.Primary, keys []parser.QueryExpression) ([]int, error) {
 	fieldIndices, err := view.FieldIndices(fields)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	fieldIndicesMap := make(map[uint]bool, len(fieldIndices))
 	for _, v := range fieldIndices {
@@ -923,7 +1137,7 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 
 	keyIndices, err := view.FieldIndices(keys)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	keyIndicesMap := make(map[uint]bool, len(keyIndices))
 	for _, v := range keyIndices {
@@ -932,7 +1146,7 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 
 	for idx, i := range keyIndices {
 		if _, ok := fieldIndicesMap[uint(i)]; !ok {
-			return 0, NewReplaceKeyNotSetError(keys[idx])
+			return nil, NewReplaceKeyNotSetError(keys[idx])
 		}
 	}
 	updateIndices := make([]int, 0, len(fieldIndices)-len(keyIndices))
@@ -944,7 +1158,7 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 
 	records, err := view.convertRecordValuesToRecordSet(ctx, fields, recordValues)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	sortValuesInEachRecord := make([]SortValues, view.RecordLen())
@@ -956,7 +1170,7 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 		sortValuesInEachRecord[index] = sortValues
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	sortValuesInInsertRecords := make([]SortValues, len(records))
@@ -968,19 +1182,19 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 		sortValuesInInsertRecords[index] = sortValues
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	replacedRecord := make(map[int]bool, len(records))
-	replacedCount := 0
+	replacedIndices := make([]int, 0, len(records))
 	for i := range records {
 		replacedRecord[i] = false
 	}
 	replaceMtx := &sync.Mutex{}
-	var replaced = func(idx int) {
+	var replaced = func(idx int, recordIdx int) {
 		replaceMtx.Lock()
 		replacedRecord[idx] = true
-		replacedCount++
+		replacedIndices = append(replacedIndices, recordIdx)
 		replaceMtx.Unlock()
 	}
 	if err := NewGoroutineTaskManager(view.RecordLen(), -1, flags.CPU).Run(ctx, func(index int) error {
@@ -989,13 +1203,13 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 				for _, fidx := range updateIndices {
 					view.RecordSet[index][fidx] = records[j][fidx]
 				}
-				replaced(j)
+				replaced(j, index)
 				break
 			}
 		}
 		return nil
 	}); err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	insertRecords := make(RecordSet, 0, len(records))
@@ -1004,8 +1218,14 @@ func (view *View) replace(ctx context.Context, flags *option.Flags, fields []par
 			insertRecords = append(insertRecords, records[i])
 		}
 	}
+	indices := make([]int, 0, len(replacedIndices)+len(insertRecords))
+	sort.Ints(replacedIndices)
+	indices = append(indices, replacedIndices...)
+	for i := range insertRecords {
+		indices = append(indices, view.RecordLen()+i)
+	}
 	view.RecordSet = view.RecordSet.Merge(insertRecords)
-	return len(insertRecords) + replacedCount, nil
+	return indices, nil
 }
 
 func (view *View) Fix(ctx context.Context, flags *option.Flags) error {
@@ -1066,6 +1286,7 @@ func (view *View) Fix(ctx context.Context, flags *option.Flags) error {
 	view.selectFields = nil
 	view.selectLabels = nil
 	view.isGrouped = false
+	view.grouping = nil
 	view.comparisonKeysInEachRecord = nil
 	view.sortValuesInEachCell = nil
 	view.sortValuesInEachRecord = nil
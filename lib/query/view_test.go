@@ -447,6 +447,92 @@ var viewGroupByTests = []struct {
 			isGrouped: true,
 		},
 	},
+	{
+		Name: "Group By Rollup",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(2)}),
+				NewRecord([]value.Primary{value.NewString("b"), value.NewInteger(3)}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.Token{Token: parser.ROLLUP, Literal: "rollup"},
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "column1"}},
+					},
+				},
+			},
+		},
+		Result: &View{
+			Header: []HeaderField{
+				{
+					View:        "table1",
+					Column:      "column1",
+					Number:      1,
+					IsFromTable: true,
+					IsGroupKey:  true,
+				},
+				{
+					View:        "table1",
+					Column:      "column2",
+					Number:      2,
+					IsFromTable: true,
+				},
+				{},
+				{},
+			},
+			RecordSet: []Record{
+				{
+					NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("a")}),
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2)}),
+					NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("a")}),
+					NewCell(value.NewInteger(0)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewString("b")}),
+					NewGroupCell([]value.Primary{value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("b")}),
+					NewCell(value.NewInteger(0)),
+				},
+				{
+					NewGroupCell([]value.Primary{value.NewNull(), value.NewNull(), value.NewNull()}),
+					NewGroupCell([]value.Primary{value.NewInteger(1), value.NewInteger(2), value.NewInteger(3)}),
+					NewGroupCell([]value.Primary{value.NewString("a"), value.NewString("a"), value.NewString("b")}),
+					NewCell(value.NewInteger(1)),
+				},
+			},
+			isGrouped: true,
+			grouping: &groupingSets{
+				keys:      []int{0},
+				originals: []int{2},
+				idIndex:   3,
+			},
+		},
+	},
+	{
+		Name: "Group By Rollup Field Not Exist Error",
+		View: &View{
+			Header: NewHeader("table1", []string{"column1", "column2"}),
+			RecordSet: []Record{
+				NewRecord([]value.Primary{value.NewString("a"), value.NewInteger(1)}),
+			},
+		},
+		GroupBy: parser.GroupByClause{
+			Items: []parser.QueryExpression{
+				parser.GroupingSets{
+					Type: parser.Token{Token: parser.ROLLUP, Literal: "rollup"},
+					Items: []parser.QueryExpression{
+						parser.FieldReference{Column: parser.Identifier{Literal: "notexist"}},
+					},
+				},
+			},
+		},
+		Error: "field notexist does not exist",
+	},
 }
 
 func TestView_GroupBy(t *testing.T) {
@@ -3201,7 +3287,7 @@ func TestView_ReplaceValues(t *testing.T) {
 	scope := NewReferenceScope(TestTx)
 	ctx := context.Background()
 	for _, v := range viewReplaceValuesTests {
-		cnt, err := view.ReplaceValues(ctx, scope, v.Fields, v.ValuesList, v.Keys)
+		indices, err := view.ReplaceValues(ctx, scope, v.Fields, v.ValuesList, v.Keys)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3217,8 +3303,8 @@ func TestView_ReplaceValues(t *testing.T) {
 		if !reflect.DeepEqual(view, v.Result) {
 			t.Errorf("%s: result = %v, want %v", v.Name, view, v.Result)
 		}
-		if cnt != v.UpdateCount {
-			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
+		if len(indices) != v.UpdateCount {
+			t.Errorf("%s: update count = %d, want %d", v.Name, len(indices), v.UpdateCount)
 		}
 
 	}
@@ -3304,7 +3390,7 @@ func TestView_ReplaceFromQuery(t *testing.T) {
 	scope := NewReferenceScope(TestTx)
 	ctx := context.Background()
 	for _, v := range viewReplaceFromQueryTests {
-		cnt, err := view.ReplaceFromQuery(ctx, scope, v.Fields, v.Query, v.Keys)
+		indices, err := view.ReplaceFromQuery(ctx, scope, v.Fields, v.Query, v.Keys)
 		if err != nil {
 			if len(v.Error) < 1 {
 				t.Errorf("%s: unexpected error %q", v.Name, err)
@@ -3320,8 +3406,8 @@ func TestView_ReplaceFromQuery(t *testing.T) {
 		if !reflect.DeepEqual(view, v.Result) {
 			t.Errorf("%s: result = %v, want %v", v.Name, view, v.Result)
 		}
-		if cnt != v.UpdateCount {
-			t.Errorf("%s: update count = %d, want %d", v.Name, cnt, v.UpdateCount)
+		if len(indices) != v.UpdateCount {
+			t.Errorf("%s: update count = %d, want %d", v.Name, len(indices), v.UpdateCount)
 		}
 	}
 }
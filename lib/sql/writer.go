@@ -0,0 +1,373 @@
+package sql
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const datetimeFormat = "2006-01-02 15:04:05.999999"
+
+type columnKind int
+
+const (
+	kindNull columnKind = iota
+	kindInteger
+	kindFloat
+	kindDecimal
+	kindBoolean
+	kindDatetime
+	kindString
+)
+
+type column struct {
+	kind columnKind
+
+	// Maximum number of digits in the integer part and in the fractional part of decimal columns.
+	integerDigits int
+	scale         int
+
+	// Maximum number of characters of string columns.
+	length int
+}
+
+// Writer writes records as a CREATE TABLE statement followed by INSERT statements.
+// Records are buffered to determine the types of the columns, and the statements are written by Close.
+//
+// Integers, floats, decimals, booleans and datetimes are declared as the corresponding types of the dialect.
+// If a column contains values of different types, the column is declared as a character string type
+// and all the values in the column are written as string literals.
+type Writer struct {
+	w         io.Writer
+	tableName string
+	header    []string
+	dialect   option.SqlDialect
+	records   [][]value.Primary
+
+	BatchSize          int
+	LineBreak          string
+	WithoutCreateTable bool
+}
+
+func NewWriter(w io.Writer, tableName string, header []string, dialect option.SqlDialect) *Writer {
+	return &Writer{
+		w:         w,
+		tableName: tableName,
+		header:    header,
+		dialect:   dialect,
+		BatchSize: option.DefaultSqlBatchSize,
+		LineBreak: "\n",
+	}
+}
+
+// Write buffers a record. The values are copied, so the caller can reuse the slice.
+func (w *Writer) Write(record []value.Primary) error {
+	r := make([]value.Primary, len(w.header))
+	copy(r, record)
+	w.records = append(w.records, r)
+	return nil
+}
+
+func (w *Writer) Close() error {
+	columns := make([]column, len(w.header))
+	for i := range w.header {
+		columns[i] = w.column(i)
+	}
+
+	bw := bufio.NewWriter(w.w)
+	tableName := w.quoteIdentifier(w.tableName)
+	written := false
+
+	if !w.WithoutCreateTable {
+		bw.WriteString("CREATE TABLE " + tableName + " (" + w.LineBreak)
+		for i := range w.header {
+			bw.WriteString("  " + w.quoteIdentifier(w.header[i]) + " " + w.typeName(columns[i]))
+			if i < len(w.header)-1 {
+				bw.WriteByte(',')
+			}
+			bw.WriteString(w.LineBreak)
+		}
+		bw.WriteString(");")
+		written = true
+	}
+
+	columnNames := make([]string, len(w.header))
+	for i := range w.header {
+		columnNames[i] = w.quoteIdentifier(w.header[i])
+	}
+	insert := "INSERT INTO " + tableName + " (" + strings.Join(columnNames, ", ") + ") VALUES" + w.LineBreak
+
+	batchSize := w.BatchSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for start := 0; start < len(w.records); start += batchSize {
+		end := start + batchSize
+		if len(w.records) < end {
+			end = len(w.records)
+		}
+
+		if written {
+			bw.WriteString(w.LineBreak)
+		}
+		bw.WriteString(insert)
+		for i, r := range w.records[start:end] {
+			bw.WriteString("  (")
+			for j := range r {
+				if 0 < j {
+					bw.WriteString(", ")
+				}
+				bw.WriteString(w.literal(r[j], columns[j]))
+			}
+			bw.WriteByte(')')
+			if i < end-start-1 {
+				bw.WriteByte(',')
+				bw.WriteString(w.LineBreak)
+			}
+		}
+		bw.WriteByte(';')
+		written = true
+	}
+
+	return bw.Flush()
+}
+
+func valueKind(p value.Primary) columnKind {
+	switch p.(type) {
+	case *value.Integer:
+		return kindInteger
+	case *value.Float:
+		return kindFloat
+	case *value.Decimal:
+		return kindDecimal
+	case *value.Boolean:
+		return kindBoolean
+	case *value.Ternary:
+		if p.(*value.Ternary).Ternary() == ternary.UNKNOWN {
+			return kindNull
+		}
+		return kindBoolean
+	case *value.Datetime:
+		return kindDatetime
+	case *value.String, *value.Interval, *value.Array, *value.Map:
+		return kindString
+	}
+	return kindNull
+}
+
+func mergeKind(kind columnKind, k columnKind) columnKind {
+	switch {
+	case k == kindNull || k == kind:
+		return kind
+	case kind == kindNull:
+		return k
+	case (kind == kindInteger && k == kindFloat) || (kind == kindFloat && k == kindInteger):
+		return kindFloat
+	case (kind == kindInteger && k == kindDecimal) || (kind == kindDecimal && k == kindInteger):
+		return kindDecimal
+	case (kind == kindDecimal && k == kindFloat) || (kind == kindFloat && k == kindDecimal):
+		return kindFloat
+	}
+	return kindString
+}
+
+func (w *Writer) column(idx int) column {
+	c := column{kind: kindNull}
+	for _, r := range w.records {
+		c.kind = mergeKind(c.kind, valueKind(r[idx]))
+	}
+
+	for _, r := range w.records {
+		switch c.kind {
+		case kindDecimal:
+			var s string
+			switch v := r[idx].(type) {
+			case *value.Integer:
+				s = strconv.FormatInt(v.Raw(), 10)
+			case *value.Decimal:
+				s = v.String()
+			default:
+				continue
+			}
+
+			s = strings.TrimPrefix(s, "-")
+			intPart, fracPart := s, ""
+			if i := strings.IndexByte(s, '.'); -1 < i {
+				intPart, fracPart = s[:i], s[i+1:]
+			}
+			if c.integerDigits < len(intPart) {
+				c.integerDigits = len(intPart)
+			}
+			if c.scale < len(fracPart) {
+				c.scale = len(fracPart)
+			}
+		case kindString, kindNull:
+			if valueKind(r[idx]) == kindNull {
+				continue
+			}
+			if l := utf8.RuneCountInString(stringValue(r[idx])); c.length < l {
+				c.length = l
+			}
+		}
+	}
+	return c
+}
+
+func (w *Writer) typeName(c column) string {
+	switch c.kind {
+	case kindInteger:
+		if w.dialect == option.SQLITE {
+			return "INTEGER"
+		}
+		return "BIGINT"
+	case kindFloat:
+		switch w.dialect {
+		case option.MYSQL:
+			return "DOUBLE"
+		case option.SQLITE:
+			return "REAL"
+		}
+		return "DOUBLE PRECISION"
+	case kindDecimal:
+		switch w.dialect {
+		case option.POSTGRESQL, option.SQLITE:
+			return "NUMERIC"
+		}
+		precision := c.integerDigits + c.scale
+		if precision < 1 {
+			precision = 1
+		}
+		return "DECIMAL(" + strconv.Itoa(precision) + "," + strconv.Itoa(c.scale) + ")"
+	case kindBoolean:
+		if w.dialect == option.SQLITE {
+			return "INTEGER"
+		}
+		return "BOOLEAN"
+	case kindDatetime:
+		switch w.dialect {
+		case option.MYSQL:
+			return "DATETIME(6)"
+		case option.SQLITE:
+			return "TEXT"
+		}
+		return "TIMESTAMP"
+	}
+
+	if w.dialect == option.ANSI {
+		length := c.length
+		if length < 1 {
+			length = 1
+		}
+		return "VARCHAR(" + strconv.Itoa(length) + ")"
+	}
+	return "TEXT"
+}
+
+func (w *Writer) literal(p value.Primary, c column) string {
+	if valueKind(p) == kindNull {
+		return "NULL"
+	}
+
+	switch c.kind {
+	case kindInteger, kindFloat, kindDecimal:
+		switch v := p.(type) {
+		case *value.Integer:
+			return strconv.FormatInt(v.Raw(), 10)
+		case *value.Decimal:
+			return v.String()
+		case *value.Float:
+			f := v.Raw()
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				if w.dialect != option.POSTGRESQL {
+					return "NULL"
+				}
+				switch {
+				case math.IsNaN(f):
+					return "'NaN'"
+				case 0 < f:
+					return "'Infinity'"
+				default:
+					return "'-Infinity'"
+				}
+			}
+			return value.Float64ToStr(f, false)
+		}
+	case kindBoolean:
+		var b bool
+		switch v := p.(type) {
+		case *value.Boolean:
+			b = v.Raw()
+		case *value.Ternary:
+			b = v.Ternary().ParseBool()
+		}
+		if w.dialect == option.SQLITE {
+			if b {
+				return "1"
+			}
+			return "0"
+		}
+		if b {
+			return "TRUE"
+		}
+		return "FALSE"
+	case kindDatetime:
+		s := w.quoteString(p.(*value.Datetime).Format(datetimeFormat))
+		switch w.dialect {
+		case option.ANSI, option.POSTGRESQL:
+			return "TIMESTAMP " + s
+		}
+		return s
+	}
+
+	return w.quoteString(stringValue(p))
+}
+
+func (w *Writer) quoteIdentifier(s string) string {
+	if w.dialect == option.MYSQL {
+		return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+	}
+	return "\"" + strings.ReplaceAll(s, "\"", "\"\"") + "\""
+}
+
+func (w *Writer) quoteString(s string) string {
+	if w.dialect == option.MYSQL {
+		s = strings.ReplaceAll(s, "\\", "\\\\")
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func stringValue(p value.Primary) string {
+	switch p.(type) {
+	case *value.String:
+		return p.(*value.String).Raw()
+	case *value.Integer:
+		return strconv.FormatInt(p.(*value.Integer).Raw(), 10)
+	case *value.Float:
+		return value.Float64ToStr(p.(*value.Float).Raw(), false)
+	case *value.Decimal:
+		return p.(*value.Decimal).String()
+	case *value.Boolean:
+		return strconv.FormatBool(p.(*value.Boolean).Raw())
+	case *value.Ternary:
+		return strconv.FormatBool(p.(*value.Ternary).Ternary().ParseBool())
+	case *value.Datetime:
+		return p.(*value.Datetime).Format(time.RFC3339Nano)
+	case *value.Interval:
+		return p.(*value.Interval).Format()
+	case *value.Array:
+		return p.(*value.Array).Encode()
+	case *value.Map:
+		return p.(*value.Map).Encode()
+	}
+	return ""
+}
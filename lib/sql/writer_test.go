@@ -0,0 +1,93 @@
+package sql
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var writerTests = []struct {
+	Name      string
+	Dialect   option.SqlDialect
+	Header    []string
+	Records   [][]value.Primary
+	BatchSize int
+	Result    string
+}{
+	{
+		Name:    "Decimal Columns",
+		Dialect: option.ANSI,
+		Header:  []string{"c1", "c2"},
+		Records: [][]value.Primary{
+			{value.NewDecimalFromString("-123.45"), value.NewDecimalFromString("0.5")},
+			{value.NewInteger(10000), value.NewFloat(1.25)},
+		},
+		Result: "CREATE TABLE \"tbl\" (\n" +
+			"  \"c1\" DECIMAL(7,2),\n" +
+			"  \"c2\" DOUBLE PRECISION\n" +
+			");\n" +
+			"INSERT INTO \"tbl\" (\"c1\", \"c2\") VALUES\n" +
+			"  (-123.45, 0.5),\n" +
+			"  (10000, 1.25);",
+	},
+	{
+		Name:    "PostgreSQL Non-Finite Floats",
+		Dialect: option.POSTGRESQL,
+		Header:  []string{"c1", "c2"},
+		Records: [][]value.Primary{
+			{value.NewFloat(math.NaN()), value.NewDecimalFromString("1.5")},
+			{value.NewFloat(math.Inf(-1)), value.NewNull()},
+		},
+		BatchSize: 1,
+		Result: "CREATE TABLE \"tbl\" (\n" +
+			"  \"c1\" DOUBLE PRECISION,\n" +
+			"  \"c2\" NUMERIC\n" +
+			");\n" +
+			"INSERT INTO \"tbl\" (\"c1\", \"c2\") VALUES\n" +
+			"  ('NaN', 1.5);\n" +
+			"INSERT INTO \"tbl\" (\"c1\", \"c2\") VALUES\n" +
+			"  ('-Infinity', NULL);",
+	},
+	{
+		Name:    "SQLite Mixed Types",
+		Dialect: option.SQLITE,
+		Header:  []string{"c1"},
+		Records: [][]value.Primary{
+			{value.NewFloat(math.Inf(1))},
+			{value.NewString("abc")},
+			{value.NewBoolean(true)},
+		},
+		Result: "CREATE TABLE \"tbl\" (\n" +
+			"  \"c1\" TEXT\n" +
+			");\n" +
+			"INSERT INTO \"tbl\" (\"c1\") VALUES\n" +
+			"  ('+Inf'),\n" +
+			"  ('abc'),\n" +
+			"  ('true');",
+	},
+}
+
+func TestWriter(t *testing.T) {
+	for _, v := range writerTests {
+		buf := &bytes.Buffer{}
+		w := NewWriter(buf, "tbl", v.Header, v.Dialect)
+		if 0 < v.BatchSize {
+			w.BatchSize = v.BatchSize
+		}
+		for _, r := range v.Records {
+			if err := w.Write(r); err != nil {
+				t.Fatalf("%s: unexpected error %q", v.Name, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("%s: unexpected error %q", v.Name, err)
+		}
+
+		if buf.String() != v.Result {
+			t.Errorf("%s: result = %s, want %s", v.Name, buf.String(), v.Result)
+		}
+	}
+}
@@ -22,7 +22,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "select_entity",
 				Group: []Grammar{
-					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}},
+					{Link("select_clause"), Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}, Option{Link("qualify_clause")}},
 					{Link("select_set_entity"), Link("Set Operators"), Option{Keyword("ALL")}, Link("select_set_entity")},
 				},
 			},
@@ -59,6 +59,12 @@ var CsvqSyntax = []Expression{
 						Name: "select_clause",
 						Group: []Grammar{
 							{Keyword("SELECT"), Option{Keyword("DISTINCT")}, ContinuousOption{Link("field")}},
+							{Keyword("SELECT"), Keyword("DISTINCT"), Keyword("ON"), Parentheses{ContinuousOption{Link("value")}}, ContinuousOption{Link("field")}},
+						},
+						Description: Description{
+							Template: "If %s is specified, only the first record of each set of records that have the same %s is retrieved. " +
+								"The first record is determined by the %s.",
+							Values: []Element{Keyword("DISTINCT ON"), Link("value"), Link("order_by_clause")},
 						},
 					},
 					{
@@ -91,6 +97,9 @@ var CsvqSyntax = []Expression{
 							{Keyword("DUAL")},
 							{Link("laterable_table")},
 							{Parentheses{Link("table")}},
+							{Link("pivot_table")},
+							{Link("pivot_table"), Identifier("alias")},
+							{Link("pivot_table"), Keyword("AS"), Identifier("alias")},
 						},
 					},
 					{
@@ -104,6 +113,7 @@ var CsvqSyntax = []Expression{
 						Name: "table_identifier",
 						Group: []Grammar{
 							{Identifier("table_name")},
+							{ConnectedGroup{Identifier("archive_name"), Token("."), Identifier("table_name")}},
 							{Identifier("url")},
 							{Link("table_identification_function")},
 							{Keyword("STDIN")},
@@ -115,6 +125,20 @@ var CsvqSyntax = []Expression{
 							{Link("subquery")},
 							{Link("subquery"), Identifier("alias")},
 							{Link("subquery"), Keyword("AS"), Identifier("alias")},
+							{Link("unnest")},
+							{Link("unnest"), Identifier("alias")},
+							{Link("unnest"), Keyword("AS"), Identifier("alias")},
+						},
+					},
+					{
+						Name: "unnest",
+						Group: []Grammar{
+							{Function{Name: "UNNEST", Args: []Element{Link("array")}}},
+						},
+						Description: Description{
+							Template: "Expands %s into records. " +
+								"The table has only one column named by %s, or \"unnest\" if %s is omitted.",
+							Values: []Element{Link("array"), Identifier("alias"), Identifier("alias")},
 						},
 					},
 					{
@@ -145,6 +169,25 @@ var CsvqSyntax = []Expression{
 							{Keyword("USING"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 						},
 					},
+					{
+						Name: "pivot_table",
+						Group: []Grammar{
+							{Link("table"), Keyword("PIVOT"), Parentheses{Identifier("aggregate"), Keyword("FOR"), Identifier("column_name"), Keyword("IN"), Parentheses{ContinuousOption{Link("pivot_value")}}}},
+							{Link("table"), Keyword("UNPIVOT"), Parentheses{Identifier("value_column"), Keyword("FOR"), Identifier("name_column"), Keyword("IN"), Parentheses{ContinuousOption{Identifier("column_name")}}}},
+						},
+						Description: Description{
+							Template: "%s generates a column for each %s with the result of %s, grouping records by the other columns. " +
+								"%s expands each record into records of %s and %s for each of the columns, excluding null values.",
+							Values: []Element{Keyword("PIVOT"), Link("pivot_value"), Identifier("aggregate"), Keyword("UNPIVOT"), Identifier("name_column"), Identifier("value_column")},
+						},
+					},
+					{
+						Name: "pivot_value",
+						Group: []Grammar{
+							{Link("value")},
+							{Link("value"), Keyword("AS"), Identifier("column_name")},
+						},
+					},
 					{
 						Name: "table_identification_function",
 						Group: []Grammar{
@@ -152,6 +195,8 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "INLINE::", Args: []Element{String("file_path")}}},
 							{Function{Name: "URL::", Args: []Element{String("url")}}},
 							{Function{Name: "DATA::", Args: []Element{String("data")}}},
+							{Function{Name: "ARCHIVE::", Args: []Element{String("archive_path"), String("member_path")}}},
+							{Function{Name: "DIRECTORY::", Args: []Element{String("directory_path"), Option{String("pattern")}}}},
 						},
 					},
 					{
@@ -162,6 +207,12 @@ var CsvqSyntax = []Expression{
 							{Function{Name: "JSON", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "JSONL", Args: []Element{String("json_query"), Link("table_identifier")}}},
 							{Function{Name: "LTSV", Args: []Element{Link("table_identifier"), Option{String("encoding"), Boolean("without_null")}}}},
+							{Function{Name: "XLSX", Args: []Element{Link("table_identifier"), Option{String("sheet"), String("range"), Boolean("no_header"), Boolean("without_null")}}}},
+							{Function{Name: "PARQUET", Args: []Element{Link("table_identifier")}}},
+							{Function{Name: "XML", Args: []Element{Link("table_identifier")}}},
+							{Function{Name: "XML", Args: []Element{String("row_path"), Link("table_identifier")}}},
+							{Function{Name: "YAML", Args: []Element{Link("table_identifier")}}},
+							{Function{Name: "YAML", Args: []Element{String("json_query"), Link("table_identifier")}}},
 						},
 					},
 				},
@@ -189,7 +240,30 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "group_by_clause",
 						Group: []Grammar{
-							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("field")}},
+							{Keyword("GROUP"), Keyword("BY"), ContinuousOption{Link("grouping_element")}},
+						},
+					},
+					{
+						Name: "grouping_element",
+						Group: []Grammar{
+							{Link("field")},
+							{Function{Name: "ROLLUP", Args: []Element{ContinuousOption{Link("field")}}}},
+							{Function{Name: "CUBE", Args: []Element{ContinuousOption{Link("field")}}}},
+							{Keyword("GROUPING"), Keyword("SETS"), Parentheses{ContinuousOption{Link("grouping_set")}}},
+						},
+						Description: Description{
+							Template: "%s and %s generate grouping sets from the list of fields, and the records are grouped by each of the grouping sets. " +
+								"In a grouping set, group keys not included in the set are replaced with %s. " +
+								"A list of fields enclosed in parentheses is treated as one element.",
+							Values: []Element{Keyword("ROLLUP"), Keyword("CUBE"), Null("NULL")},
+						},
+					},
+					{
+						Name: "grouping_set",
+						Group: []Grammar{
+							{Link("grouping_element")},
+							{Parentheses{ContinuousOption{Link("field")}}},
+							{Parentheses{}},
 						},
 					},
 				},
@@ -205,6 +279,22 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "QUALIFY Clause",
+				Grammar: []Definition{
+					{
+						Name: "qualify_clause",
+						Group: []Grammar{
+							{Keyword("QUALIFY"), Link("condition")},
+						},
+						Description: Description{
+							Template: "Records are filtered by %s after analytic functions are calculated. " +
+								"Analytic functions and aliases of fields in the %s can be used in %s.",
+							Values: []Element{Link("condition"), Link("select_clause"), Link("condition")},
+						},
+					},
+				},
+			},
 			{
 				Label: "ORDER BY Clause",
 				Grammar: []Definition{
@@ -281,8 +371,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "insert_query",
 				Group: []Grammar{
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}},
-					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query")},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}, Option{Link("returning_clause")}},
+					{Keyword("INSERT"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Link("select_query"), Option{Link("returning_clause")}},
 				},
 			},
 		},
@@ -299,8 +389,8 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "update_query",
 				Group: []Grammar{
-					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}},
-					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("UPDATE"), Identifier("table_name"), Keyword("SET"), ContinuousOption{Link("set_value")}, Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("UPDATE"), ContinuousOption{Identifier("table_alias")}, Keyword("SET"), ContinuousOption{Link("set_value")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
 				},
 			},
 			{
@@ -323,8 +413,39 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "replace_query",
 				Group: []Grammar{
-					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}},
-					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Link("select_query")},
+					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Keyword("VALUES"), ContinuousOption{Link("row_value")}, Option{Link("returning_clause")}},
+					{Keyword("REPLACE"), Keyword("INTO"), Identifier("table_name"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("USING"), Parentheses{ContinuousOption{Identifier("key_column_name")}}, Link("select_query"), Option{Link("returning_clause")}},
+				},
+			},
+		},
+	},
+	{
+		Label: "MERGE Statement",
+		Grammar: []Definition{
+			{
+				Name: "merge_statement",
+				Group: []Grammar{
+					{Option{Link("with_clause")}, Link("merge_query")},
+				},
+			},
+			{
+				Name: "merge_query",
+				Group: []Grammar{
+					{Keyword("MERGE"), Keyword("INTO"), Identifier("table_name"), Option{Keyword("AS"), Identifier("alias")}, Keyword("USING"), Link("table"), Keyword("ON"), Link("condition"), ContinuousOption{Link("merge_when_clause")}},
+				},
+			},
+			{
+				Name: "merge_when_clause",
+				Group: []Grammar{
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("INSERT"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("VALUES"), Link("row_value")},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Keyword("BY"), Keyword("SOURCE"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("UPDATE"), Keyword("SET"), ContinuousOption{Link("set_value")}},
+					{Keyword("WHEN"), Keyword("NOT"), Keyword("MATCHED"), Keyword("BY"), Keyword("SOURCE"), Option{Keyword("AND"), Link("condition")}, Keyword("THEN"), Keyword("DELETE")},
+				},
+				Description: Description{
+					Template: "The first clause whose conditions are satisfied is applied to each record. " +
+						"A record in the target table must not be matched by more than one record of the source.",
 				},
 			},
 		},
@@ -341,8 +462,30 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "delete_query",
 				Group: []Grammar{
-					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}},
-					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}},
+					{Keyword("DELETE"), Keyword("FROM"), Identifier("table_name"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+					{Keyword("DELETE"), ContinuousOption{Identifier("table_alias")}, Link("from_clause"), Option{Link("where_clause")}, Option{Link("returning_clause")}},
+				},
+			},
+			{
+				Name: "returning_clause",
+				Group: []Grammar{
+					{Keyword("RETURNING"), ContinuousOption{Link("field")}},
+				},
+				Description: Description{
+					Template: "Returns the affected records as a result set. " +
+						"Inserted, updated and replaced records are returned as modified, and deleted records are returned as they were before deletion. " +
+						"%s cannot be used when the query modifies multiple tables.",
+					Values: []Element{Keyword("RETURNING")},
+				},
+			},
+			{
+				Name: "returning_query",
+				Group: []Grammar{
+					{AnyOne{Link("insert_query"), Link("update_query"), Link("replace_query"), Link("delete_query")}},
+				},
+				Description: Description{
+					Template: "The query must have a %s.",
+					Values:   []Element{Link("returning_clause")},
 				},
 			},
 		},
@@ -353,8 +496,26 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "create_table_statement",
 				Group: []Grammar{
-					{Keyword("CREATE"), Keyword("TABLE"), Option{Keyword("IF NOT EXISTS")}, Identifier("file_path"), Parentheses{ContinuousOption{Identifier("column_name")}}},
-					{Keyword("CREATE"), Keyword("TABLE"), Option{Keyword("IF NOT EXISTS")}, Identifier("file_path"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Option{Keyword("AS")}, Link("select_query")},
+					{Keyword("CREATE"), Keyword("TABLE"), Option{Keyword("IF NOT EXISTS")}, Identifier("file_path"), Parentheses{ContinuousOption{Link("table_column")}}},
+					{Keyword("CREATE"), Keyword("TABLE"), Option{Keyword("IF NOT EXISTS")}, Identifier("file_path"), Option{Parentheses{ContinuousOption{Link("table_column")}}}, Option{Keyword("AS")}, Link("select_query")},
+				},
+			},
+			{
+				Name: "table_column",
+				Group: []Grammar{
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("NOT NULL")}},
+				},
+			},
+			{
+				Name: "column_type",
+				Group: []Grammar{
+					{AnyOne{Keyword("INTEGER"), Keyword("FLOAT"), Keyword("DECIMAL"), Keyword("BOOLEAN"), Keyword("DATETIME"), Keyword("STRING")}},
+				},
+				Description: Description{
+					Template: "" +
+						"Values in the column are converted to the type when the table is loaded, inserted or updated, " +
+						"and an error is returned if a value cannot be converted. " +
+						"Declared types are saved in the schema file, that is the file path with the extension \".schema\".",
 				},
 			},
 		},
@@ -372,7 +533,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "column_definition",
 				Group: []Grammar{
-					{Identifier("column_name"), Option{Keyword("DEFAULT"), Link("value")}},
+					{Identifier("column_name"), Option{Link("column_type")}, Option{Keyword("NOT NULL")}, Option{Keyword("DEFAULT"), Link("value")}},
 				},
 				Description: Description{
 					Template: "%s is the default value.",
@@ -411,7 +572,11 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "table_attribute",
 				Group: []Grammar{
-					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("DELIMITER_POSITIONS"), Keyword("JSON_ESCAPE"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT")}},
+					{AnyOne{Keyword("FORMAT"), Keyword("DELIMITER"), Keyword("DELIMITER_POSITIONS"), Keyword("JSON_ESCAPE"), Keyword("ENCODING"), Keyword("LINE_BREAK"), Keyword("HEADER"), Keyword("ENCLOSE_ALL"), Keyword("PRETTY_PRINT"), Keyword("SCHEMA")}},
+				},
+				Description: Description{
+					Template: "The value of %s is a string of %s separated by commas. %s removes all the declared types.",
+					Values:   []Element{Keyword("SCHEMA"), Link("table_column"), Null("NULL")},
 				},
 			},
 		},
@@ -488,7 +653,7 @@ var CsvqSyntax = []Expression{
 			{
 				Name: "select_into_statement",
 				Group: []Grammar{
-					{Option{Link("with_clause")}, Link("select_clause"), Keyword("INTO"), ContinuousOption{Link("variable")}, Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}, Option{Link("order_by_clause")}, Option{Link("limit_clause")}, Option{Link("offset_clause")}, Option{Keyword("FOR"), Keyword("UPDATE")}},
+					{Option{Link("with_clause")}, Link("select_clause"), Keyword("INTO"), ContinuousOption{Link("variable")}, Option{Link("from_clause")}, Option{Link("where_clause")}, Option{Link("group_by_clause")}, Option{Link("having_clause")}, Option{Link("qualify_clause")}, Option{Link("order_by_clause")}, Option{Link("limit_clause")}, Option{Link("offset_clause")}, Option{Keyword("FOR"), Keyword("UPDATE")}},
 				},
 			},
 			{
@@ -506,6 +671,7 @@ var CsvqSyntax = []Expression{
 				Name: "declare_cursor_statement",
 				Group: []Grammar{
 					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("select_query")},
+					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Link("returning_query")},
 					{Keyword("DECLARE"), Identifier("cursor_name"), Keyword("CURSOR"), Keyword("FOR"), Identifier("prepared_statement_name")},
 				},
 			},
@@ -554,6 +720,7 @@ var CsvqSyntax = []Expression{
 				Group: []Grammar{
 					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Parentheses{ContinuousOption{Identifier("column_name")}}},
 					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("AS"), Link("select_query")},
+					{Keyword("DECLARE"), Identifier("view_name"), Keyword("VIEW"), Option{Parentheses{ContinuousOption{Identifier("column_name")}}}, Keyword("AS"), Link("returning_query")},
 				},
 			},
 			{
@@ -658,6 +825,17 @@ var CsvqSyntax = []Expression{
 					{Keyword("WHILE"), Option{AnyOne{Keyword("DECLARE"), Keyword("VAR")}}, ContinuousOption{Variable("@variable")}, Keyword("IN"), Identifier("cursor_name"), Keyword("DO"), Token("statements"), Keyword("END"), Keyword("WHILE")},
 				},
 			},
+			{
+				Name: "try_catch_statement",
+				Group: []Grammar{
+					{Keyword("BEGIN"), Keyword("TRY"), Token("statements"), Keyword("END"), Keyword("TRY"), Keyword("BEGIN"), Keyword("CATCH"), Token("statements"), Keyword("END"), Keyword("CATCH")},
+				},
+				Description: Description{
+					Template: "If an error occurs in the %s block, then the statements in the %s block are executed. " +
+						"The caught error can be retrieved by %s and %s in the %s block.",
+					Values: []Element{Keyword("TRY"), Keyword("CATCH"), Link("error_code"), Link("error_message"), Keyword("CATCH")},
+				},
+			},
 			{
 				Name: "continue_statement",
 				Group: []Grammar{
@@ -813,6 +991,17 @@ var CsvqSyntax = []Expression{
 					Template: "Print syntax.",
 				},
 			},
+			{
+				Name: "explain",
+				Group: []Grammar{
+					{Keyword("EXPLAIN"), Option{Keyword("ANALYZE")}, Link("select_query")},
+				},
+				Description: Description{
+					Template: "Show the operations to be run for the query as a tree. " +
+						"If %s is specified, the query is executed and each operation is shown with the number of records and the elapsed time.",
+					Values: []Element{Keyword("ANALYZE")},
+				},
+			},
 		},
 	},
 	{
@@ -843,25 +1032,54 @@ var CsvqSyntax = []Expression{
 						"%s\n" +
 						"  > 64-bit floating point numbers.\n" +
 						"%s\n" +
+						"  > Arbitrary-precision decimal numbers.\n" +
+						"%s\n" +
 						"  > Boolean values. true or false.\n" +
 						"%s\n" +
 						"  > Values of three-valued logic. TRUE, UNKNOWN or FALSE.\n" +
 						"%s\n" +
 						"  > Values of Date and time with nano seconds.\n" +
 						"%s\n" +
+						"  > Spans of time consisting of months, days and nano seconds.\n" +
+						"%s\n" +
 						"  > Representations of missing values." +
 						"",
 					Values: []Element{
 						String("String"),
 						Integer("Integer"),
 						Float("Float"),
+						Float("Decimal"),
 						Boolean("Boolean"),
 						Ternary("Ternary"),
 						Datetime("Datetime"),
+						Datetime("Interval"),
 						Null("Null"),
 					},
 				},
 			},
+			{
+				Name: "interval",
+				Group: []Grammar{
+					{Keyword("INTERVAL"), String("interval_string")},
+				},
+				Description: Description{
+					Template: "%s is a sequence of numbers followed by units such as '3 days 4 hours'. " +
+						"Available units are YEAR, MONTH, WEEK, DAY, HOUR, MINUTE, SECOND, MILLISECOND, MICROSECOND and NANOSECOND, " +
+						"and a time part in the form of 'hh:mm:ss' can also be specified.",
+					Values: []Element{String("interval_string")},
+				},
+			},
+			{
+				Name: "array",
+				Group: []Grammar{
+					{ConnectedGroup{Keyword("ARRAY"), Token("["), Option{ContinuousOption{Link("value")}}, Token("]")}},
+				},
+				Description: Description{
+					Template: "Ordered lists of values. " +
+						"Maps that are sets of key-value pairs are returned by JSON_VALUE for JSON objects. " +
+						"Arrays and maps are displayed and converted to strings in JSON notation.",
+				},
+			},
 			{
 				Name: "field_reference",
 				Group: []Grammar{
@@ -1036,7 +1254,15 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Delimiter positions for Fixed-Length Format.\n" +
 				"%s  <type::%s>\n" +
-				"  > Query for JSON data.\n" +
+				"  > Query for JSON and YAML data.\n" +
+				"%s  <type::%s>\n" +
+				"  > Sheet name or position for XLSX.\n" +
+				"%s  <type::%s>\n" +
+				"  > Cell range for XLSX.\n" +
+				"%s  <type::%s>\n" +
+				"  > Path to the elements of records for XML.\n" +
+				"%s  <type::%s>\n" +
+				"  > Column name holding source file paths when loading multiple files.\n" +
 				"%s  <type::%s>\n" +
 				"  > Character %s.\n" +
 				"%s  <type::%s>\n" +
@@ -1062,6 +1288,20 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > %s of query results.\n" +
 				"%s  <type::%s>\n" +
+				"  > %s of SQL statements in query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Table name of SQL statements in query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Number of records in an INSERT statement in query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Write a complete HTML document in query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Name of the root element in XML query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Name of the record elements in XML query results.\n" +
+				"%s  <type::%s>\n" +
+				"  > Write fields as attributes in XML query results.\n" +
+				"%s  <type::%s>\n" +
 				"  > Make JSON output easier to read in query results.\n" +
 				"%s  <type::%s>\n" +
 				"  > Use Scientific Notation for large exponents in output.\n" +
@@ -1078,6 +1318,8 @@ var CsvqSyntax = []Expression{
 				"%s  <type::%s>\n" +
 				"  > Hint for the number of cpu cores to be used.\n" +
 				"%s  <type::%s>\n" +
+				"  > Maximum memory in megabytes of buffers to sort records without temporary files.\n" +
+				"%s  <type::%s>\n" +
 				"  > Show execution time.\n" +
 				"",
 			Values: []Element{
@@ -1092,6 +1334,10 @@ var CsvqSyntax = []Expression{
 				Flag("@@ALLOW_UNEVEN_FIELDS"), String("boolean"),
 				Flag("@@DELIMITER_POSITIONS"), String("string"),
 				Flag("@@JSON_QUERY"), String("string"),
+				Flag("@@XLSX_SHEET"), String("string"),
+				Flag("@@XLSX_RANGE"), String("string"),
+				Flag("@@XML_ROW_PATH"), String("string"),
+				Flag("@@SOURCE_PATH_COLUMN"), String("string"),
 				Flag("@@ENCODING"), String("string"), Link("Encoding"),
 				Flag("@@NO_HEADER"), Boolean("boolean"),
 				Flag("@@WITHOUT_NULL"), Boolean("boolean"),
@@ -1104,6 +1350,13 @@ var CsvqSyntax = []Expression{
 				Flag("@@LINE_BREAK"), String("string"), Link("Line Break"),
 				Flag("@@ENCLOSE_ALL"), Boolean("boolean"),
 				Flag("@@JSON_ESCAPE"), String("string"), Link("Json Escape Type"),
+				Flag("@@SQL_DIALECT"), String("string"), Link("SQL Dialect"),
+				Flag("@@SQL_TABLE_NAME"), String("string"),
+				Flag("@@SQL_BATCH_SIZE"), Integer("integer"),
+				Flag("@@HTML_DOCUMENT"), Boolean("boolean"),
+				Flag("@@XML_ROOT_ELEMENT"), String("string"),
+				Flag("@@XML_ROW_ELEMENT"), String("string"),
+				Flag("@@XML_ATTRIBUTES"), Boolean("boolean"),
 				Flag("@@PRETTY_PRINT"), Boolean("boolean"),
 				Flag("@@SCIENTIFIC_NOTATION"), Boolean("boolean"),
 				Flag("@@EAST_ASIAN_ENCODING"), Boolean("boolean"),
@@ -1112,6 +1365,7 @@ var CsvqSyntax = []Expression{
 				Flag("@@COLOR"), Boolean("boolean"),
 				Flag("@@QUIET"), Boolean("boolean"),
 				Flag("@@CPU"), Integer("integer"),
+				Flag("@@SORT_MEMORY_LIMIT"), Integer("integer"),
 				Flag("@@STATS"), Boolean("boolean"),
 			},
 		},
@@ -1352,7 +1606,10 @@ var CsvqSyntax = []Expression{
 								"  | /        | Division        |\n" +
 								"  | %s        | Modulo          |\n" +
 								"  +----------+-----------------+\n" +
-								"```",
+								"```\n" +
+								"\n" +
+								"An interval can be added to or subtracted from a datetime, and multiplied or divided by a number. " +
+								"Subtracting a datetime from another datetime results in an interval.",
 							Values: []Element{Token("%")},
 						},
 					},
@@ -1747,7 +2004,7 @@ var CsvqSyntax = []Expression{
 						Group: []Grammar{
 							{Function{Name: "ROUND", Args: []Element{Float("number"), ArgWithDefValue{Arg: Integer("place"), Default: Integer("0")}}, Return: Return("float")}},
 						},
-						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place")}},
+						Description: Description{Template: "Rounds %s to %s decimal place. If %s is a negative number, then %s represents the place in the integer part. Decimal values are rounded without loss of precision.", Values: []Element{Float("number"), Integer("place"), Integer("place"), Integer("place")}},
 					},
 					{
 						Name: "sin",
@@ -1972,6 +2229,20 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Returns the week number of the year of %s as an integer.", Values: []Element{Datetime("datetime")}},
 					},
+					{
+						Name: "extract",
+						Group: []Grammar{
+							{Function{Name: "EXTRACT", CustomArgs: []Element{Identifier("unit"), Keyword("FROM"), Datetime("datetime")}, Return: Return("integer")}},
+							{Function{Name: "EXTRACT", CustomArgs: []Element{Identifier("unit"), Keyword("FROM"), Datetime("interval")}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the %s field of %s or %s as an integer. " +
+								"Available units are YEAR, MONTH, DAY, HOUR, MINUTE, SECOND, MILLISECOND, MICROSECOND, NANOSECOND and EPOCH, " +
+								"and WEEKDAY, DAY_OF_YEAR and WEEK_OF_YEAR for datetimes.\n" +
+								"EPOCH returns the number of seconds as a float.",
+							Values: []Element{Identifier("unit"), Datetime("datetime"), Datetime("interval")},
+						},
+					},
 					{
 						Name: "add_year",
 						Group: []Grammar{
@@ -2304,8 +2575,13 @@ var CsvqSyntax = []Expression{
 						Name: "list_elem",
 						Group: []Grammar{
 							{Function{Name: "LIST_ELEM", Args: []Element{String("str"), String("sep"), Integer("index")}, Return: Return("string")}},
+							{Function{Name: "LIST_ELEM", Args: []Element{Link("array"), Integer("index")}, Return: Return("value")}},
+						},
+						Description: Description{
+							Template: "Returns the string at %s in the list generated by splitting with %s from %s, " +
+								"or the element at the zero-based %s in %s.",
+							Values: []Element{Integer("index"), String("sep"), String("str"), Integer("index"), Link("array")},
 						},
-						Description: Description{Template: "Returns the string at %s in the list generated by splitting with %s from %s.", Values: []Element{Integer("index"), String("sep"), String("str")}},
 					},
 					{
 						Name: "replace",
@@ -2331,16 +2607,16 @@ var CsvqSyntax = []Expression{
 					{
 						Name: "regexp_find_submatches",
 						Group: []Grammar{
-							{Function{Name: "REGEXP_FIND_SUBMATCHES", Args: []Element{String("str"), String("regexp"), Option{Link("flags_of_regular_expressions")}}, Return: Return("string")}},
+							{Function{Name: "REGEXP_FIND_SUBMATCHES", Args: []Element{String("str"), String("regexp"), Option{Link("flags_of_regular_expressions")}}, Return: Return("array")}},
 						},
-						Description: Description{Template: "Returns the string representing an array that matches the regular expression %s in %s.", Values: []Element{String("regexp"), String("str")}},
+						Description: Description{Template: "Returns the array of strings that matches the regular expression %s in %s.", Values: []Element{String("regexp"), String("str")}},
 					},
 					{
 						Name: "regexp_find_all",
 						Group: []Grammar{
-							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("regexp"), Option{Link("flags_of_regular_expressions")}}, Return: Return("string")}},
+							{Function{Name: "REGEXP_FIND_ALL", Args: []Element{String("str"), String("regexp"), Option{Link("flags_of_regular_expressions")}}, Return: Return("array")}},
 						},
-						Description: Description{Template: "Returns the string representing a nested array that matches the regular expression %s in %s.", Values: []Element{String("regexp"), String("str")}},
+						Description: Description{Template: "Returns the nested array of strings that matches the regular expression %s in %s.", Values: []Element{String("regexp"), String("str")}},
 					},
 					{
 						Name: "regexp_replace",
@@ -2368,7 +2644,7 @@ var CsvqSyntax = []Expression{
 						Group: []Grammar{
 							{Function{Name: "JSON_VALUE", Args: []Element{String("json_query"), String("json_data")}, Return: Return("value")}},
 						},
-						Description: Description{Template: "Returns a %s in %s.", Values: []Element{Link("value"), String("json_data")}},
+						Description: Description{Template: "Returns a %s in %s. JSON arrays and objects are returned as arrays and maps.", Values: []Element{Link("value"), String("json_data")}},
 					},
 					{
 						Name: "json_object",
@@ -2379,6 +2655,32 @@ var CsvqSyntax = []Expression{
 					},
 				},
 			},
+			{
+				Label: "Array Functions",
+				Grammar: []Definition{
+					{
+						Name: "split",
+						Group: []Grammar{
+							{Function{Name: "SPLIT", Args: []Element{String("str"), String("sep")}, Return: Return("array")}},
+						},
+						Description: Description{Template: "Returns the array of the substrings of %s separated by %s.", Values: []Element{String("str"), String("sep")}},
+					},
+					{
+						Name: "array_length",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_LENGTH", Args: []Element{Link("array")}, Return: Return("integer")}},
+						},
+						Description: Description{Template: "Returns the number of elements of %s.", Values: []Element{Link("array")}},
+					},
+					{
+						Name: "array_contains",
+						Group: []Grammar{
+							{Function{Name: "ARRAY_CONTAINS", Args: []Element{Link("array"), Link("value")}, Return: Return("ternary")}},
+						},
+						Description: Description{Template: "Returns TRUE if any element of %s is equal to %s.", Values: []Element{Link("array"), Link("value")}},
+					},
+				},
+			},
 			{
 				Label: "Cryptographic Hash Functions",
 				Grammar: []Definition{
@@ -2464,6 +2766,13 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{Template: "Converts %s to a float.", Values: []Element{Link("value")}},
 					},
+					{
+						Name: "decimal",
+						Group: []Grammar{
+							{Function{Name: "DECIMAL", Args: []Element{Link("value"), Option{Integer("scale")}}, Return: Return("decimal")}},
+						},
+						Description: Description{Template: "Converts %s to a decimal. If %s is specified, the value is rounded half away from zero to %s digits after the decimal point.", Values: []Element{Link("value"), Integer("scale"), Integer("scale")}},
+					},
 					{
 						Name: "datetime",
 						Group: []Grammar{
@@ -2501,6 +2810,28 @@ var CsvqSyntax = []Expression{
 							Values: []Element{String("command"), String("command")},
 						},
 					},
+					{
+						Name: "error_code",
+						Group: []Grammar{
+							{Function{Name: "ERROR_CODE", Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns the code of the error being handled in a %s block. " +
+								"If called outside of %s blocks, then returns %s.",
+							Values: []Element{Keyword("CATCH"), Keyword("CATCH"), Null("NULL")},
+						},
+					},
+					{
+						Name: "error_message",
+						Group: []Grammar{
+							{Function{Name: "ERROR_MESSAGE", Return: Return("string")}},
+						},
+						Description: Description{
+							Template: "Returns the message of the error being handled in a %s block. " +
+								"If called outside of %s blocks, then returns %s.",
+							Values: []Element{Keyword("CATCH"), Keyword("CATCH"), Null("NULL")},
+						},
+					},
 				},
 			},
 			{
@@ -2515,6 +2846,16 @@ var CsvqSyntax = []Expression{
 					Values: []Element{Keyword("DISTINCT"), Link("Select Clause"), Link("Having Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
+					{
+						Name: "filter_clause",
+						Group: []Grammar{
+							{Link("function"), Keyword("FILTER"), Parentheses{Keyword("WHERE"), Link("condition")}},
+						},
+						Description: Description{
+							Template: "Aggregate functions and user-defined aggregate functions calculate only the values of the records that satisfy %s.",
+							Values:   []Element{Link("condition")},
+						},
+					},
 					{
 						Name: "count",
 						Group: []Grammar{
@@ -2556,6 +2897,7 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{
 							Template: "Returns the sum of float values of %s. " +
+								"If any value is a decimal, then returns the exact sum of decimal values. " +
 								"If all values are null, then returns %s.",
 							Values: []Element{Link("value"), Null("NULL")},
 						},
@@ -2567,6 +2909,7 @@ var CsvqSyntax = []Expression{
 						},
 						Description: Description{
 							Template: "Returns the average of float values of %s. " +
+								"If any value is a decimal, then returns the average of decimal values. " +
 								"If all values are null, then returns %s.",
 							Values: []Element{Link("value"), Null("NULL")},
 						},
@@ -2629,6 +2972,42 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), Link("value"), Keyword("DATETIME")},
 						},
 					},
+					{
+						Name: "mode",
+						Group: []Grammar{
+							{Function{Name: "MODE", Args: []Element{Option{Keyword("DISTINCT")}, Link("value")}, Return: Return("primitive type")}},
+							{Function{Name: "MODE", AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the most frequent value of non-null values of %s or the sort key in %s. " +
+								"If some values are equally frequent, then returns the one that appears first. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Link("value"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_cont",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_CONT", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("float")}},
+						},
+						Description: Description{
+							Template: "Returns the value at %s in the non-null values of the sort key in %s. " +
+								"If there is no value exactly at %s, then the value is interpolated linearly between the adjacent values. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("order_by_clause"), Float("fraction"), Null("NULL")},
+						},
+					},
+					{
+						Name: "percentile_disc",
+						Group: []Grammar{
+							{Function{Name: "PERCENTILE_DISC", Args: []Element{Float("fraction")}, AfterArgs: []Element{Keyword("WITHIN"), Keyword("GROUP"), Parentheses{Link("order_by_clause")}}, Return: Return("primitive type")}},
+						},
+						Description: Description{
+							Template: "Returns the first value in the sort order whose cumulative distribution is greater than or equal to %s in the non-null values of the sort key in %s. " +
+								"If all values are null, then returns %s.",
+							Values: []Element{Float("fraction"), Link("order_by_clause"), Null("NULL")},
+						},
+					},
 					{
 						Name: "listagg",
 						Group: []Grammar{
@@ -2643,6 +3022,17 @@ var CsvqSyntax = []Expression{
 							Values: []Element{Link("value"), Null("NULL"), String("sep"), Link("order_by_clause")},
 						},
 					},
+					{
+						Name: "grouping",
+						Group: []Grammar{
+							{Function{Name: "GROUPING", Args: []Element{ContinuousOption{Link("field")}}, Return: Return("integer")}},
+						},
+						Description: Description{
+							Template: "Returns an integer whose bits indicate whether each %s is aggregated in the grouping set of the current record. " +
+								"The last %s corresponds to the least significant bit.",
+							Values: []Element{Link("field"), Link("field")},
+						},
+					},
 					{
 						Name: "json_agg",
 						Group: []Grammar{
@@ -2659,8 +3049,8 @@ var CsvqSyntax = []Expression{
 			{
 				Label: "Analytic Functions",
 				Description: Description{
-					Template: "Analytic functions calculate values of groups. Analytic Functions can be used only in %s and %s",
-					Values:   []Element{Link("Select Clause"), Link("Order By Clause")},
+					Template: "Analytic functions calculate values of groups. Analytic Functions can be used only in %s, %s and %s",
+					Values:   []Element{Link("Select Clause"), Link("Qualify Clause"), Link("Order By Clause")},
 				},
 				Grammar: []Definition{
 					{
@@ -3085,7 +3475,7 @@ var CsvqSyntax = []Expression{
 						"BETWEEN BREAK BY CASE CHDIR CLOSE COMMIT CONTINUE COUNT CREATE CROSS " +
 						"CSV_INLINE CUME_DIST CURRENT CURSOR DECLARE DEFAULT DELETE DENSE_RANK DESC DISPOSE " +
 						"DISTINCT DO DROP DUAL ECHO ELSE ELSEIF END EXCEPT EXECUTE EXISTS " +
-						"EXIT FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
+						"EXIT EXPLAIN FALSE FETCH FIRST FIRST_VALUE FOLLOWING FOR FROM FULL FUNCTION " +
 						"GROUP HAVING IF IGNORE IN INNER INSERT INTERSECT INTO IS JOIN JSONL " +
 						"JSON_AGG JSON_INLINE JSON_OBJECT JSON_ROW JSON_TABLE LAG LAST LAST_VALUE LATERAL LEAD " +
 						"LEFT LIKE LIMIT LISTAGG MAX MEDIAN MIN NATURAL NEXT NOT NTH_VALUE " +
@@ -3316,20 +3706,27 @@ var CsvqSyntax = []Expression{
 				Description: Description{
 					Template: "" +
 						"```\n" +
-						"+-------+------------------------------------------+\n" +
-						"| Value |                  Format                  |\n" +
-						"+-------+------------------------------------------+\n" +
-						"| CSV   | Character separated values               |\n" +
-						"| TSV   | Tab separated values                     |\n" +
-						"| FIXED | Fixed-Length Format                      |\n" +
-						"| JSON  | JSON Format                              |\n" +
-						"| JSONL | JSON Lines Format                        |\n" +
-						"| LTSV  | Labeled Tab-separated Values             |\n" +
-						"| GFM   | Text Table for GitHub Flavored Markdown  |\n" +
-						"| ORG   | Text Table for Emacs Org-mode            |\n" +
-						"| BOX   | Text Table using Box-drawing characters  |\n" +
-						"| TEXT  | Text Table for console                   |\n" +
-						"+-------+------------------------------------------+\n" +
+						"+----------+------------------------------------------+\n" +
+						"|  Value   |                  Format                  |\n" +
+						"+----------+------------------------------------------+\n" +
+						"| CSV      | Character separated values               |\n" +
+						"| TSV      | Tab separated values                     |\n" +
+						"| FIXED    | Fixed-Length Format                      |\n" +
+						"| JSON     | JSON Format                              |\n" +
+						"| JSONL    | JSON Lines Format                        |\n" +
+						"| LTSV     | Labeled Tab-separated Values             |\n" +
+						"| XLSX     | Excel Workbook                           |\n" +
+						"| PARQUET  | Apache Parquet                           |\n" +
+						"| XML      | XML Document                             |\n" +
+						"| YAML     | YAML Sequence of Mappings                |\n" +
+						"| SQL      | CREATE TABLE and INSERT statements       |\n" +
+						"| HTML     | HTML Table                               |\n" +
+						"| GFM      | Text Table for GitHub Flavored Markdown  |\n" +
+						"| ORG      | Text Table for Emacs Org-mode            |\n" +
+						"| BOX      | Text Table using Box-drawing characters  |\n" +
+						"| TEXT     | Text Table for console                   |\n" +
+						"| VERTICAL | One field per line for each record       |\n" +
+						"+----------+------------------------------------------+\n" +
 						"```",
 				},
 			},
@@ -3368,6 +3765,22 @@ var CsvqSyntax = []Expression{
 						"```",
 				},
 			},
+			{
+				Name: "SQL Dialect",
+				Description: Description{
+					Template: "" +
+						"```\n" +
+						"+------------+----------------------------------------------------+\n" +
+						"|   Value    |                    Description                     |\n" +
+						"+------------+----------------------------------------------------+\n" +
+						"| ANSI       | Standard SQL                                       |\n" +
+						"| MYSQL      | MySQL, identifiers are quoted with backquotes      |\n" +
+						"| POSTGRESQL | PostgreSQL                                         |\n" +
+						"| SQLITE     | SQLite, booleans are written as integers           |\n" +
+						"+------------+----------------------------------------------------+\n" +
+						"```",
+				},
+			},
 			{
 				Name: "Timezone",
 				Description: Description{
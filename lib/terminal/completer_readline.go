@@ -31,6 +31,7 @@ var statementPrefix = []string{
 	"INSERT",
 	"UPDATE",
 	"REPLACE",
+	"MERGE",
 	"DELETE",
 	"CREATE",
 	"ALTER",
@@ -50,6 +51,7 @@ var statementPrefix = []string{
 	"SOURCE",
 	"SYNTAX",
 	"RELOAD",
+	"EXPLAIN",
 }
 
 var singleCommandStatement = []string{
@@ -80,12 +82,21 @@ var joinCandidates = []string{
 	"NATURAL",
 }
 
+var pivotCandidates = []string{
+	"PIVOT",
+	"UNPIVOT",
+}
+
 var tableObjectCandidates = []string{
 	"CSV()",
 	"FIXED()",
 	"JSON()",
 	"JSONL()",
 	"LTSV()",
+	"PARQUET()",
+	"XLSX()",
+	"XML()",
+	"YAML()",
 }
 
 var exportEncodingsCandidates = []string{
@@ -191,15 +202,17 @@ func NewCompleter(scope *query.ReferenceScope) *Completer {
 	sort.Strings(completer.flagList)
 	sort.Strings(completer.runinfoList)
 
-	completer.funcs = make([]string, 0, len(query.Functions)+3)
+	completer.funcs = make([]string, 0, len(query.Functions)+5)
 	for k := range query.Functions {
 		completer.funcs = append(completer.funcs, k)
 	}
 	completer.funcs = append(completer.funcs, "CALL")
 	completer.funcs = append(completer.funcs, "NOW")
+	completer.funcs = append(completer.funcs, "ERROR_CODE")
+	completer.funcs = append(completer.funcs, "ERROR_MESSAGE")
 	completer.funcs = append(completer.funcs, "JSON_OBJECT")
 
-	completer.aggFuncs = make([]string, 0, len(query.AggregateFunctions)+2)
+	completer.aggFuncs = make([]string, 0, len(query.AggregateFunctions)+3)
 	completer.analyticFuncs = make([]string, 0, len(query.AnalyticFunctions)+len(query.AggregateFunctions))
 	for k := range query.AggregateFunctions {
 		completer.aggFuncs = append(completer.aggFuncs, k)
@@ -207,6 +220,7 @@ func NewCompleter(scope *query.ReferenceScope) *Completer {
 	}
 	completer.aggFuncs = append(completer.aggFuncs, "LISTAGG")
 	completer.aggFuncs = append(completer.aggFuncs, "JSON_AGG")
+	completer.aggFuncs = append(completer.aggFuncs, "GROUPING")
 	for k := range query.AnalyticFunctions {
 		completer.analyticFuncs = append(completer.analyticFuncs, k)
 	}
@@ -451,6 +465,8 @@ func (c *Completer) Statements(line string, origLine string, index int) readline
 		return nil
 	case parser.SYNTAX:
 		return nil
+	case parser.EXPLAIN:
+		return c.ExplainArgs(line, origLine, index)
 	case parser.EOF:
 		return c.GetStatementPrefix(line, origLine, index)
 	default:
@@ -495,6 +511,32 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
 			}
 		}
+	case "PARQUET":
+		if commaCnt == 0 && c.tokens[c.lastIdx].Token == '(' {
+			cands = c.SearchAllTables(line, origLine, index)
+		}
+	case "XML", "YAML":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 1:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		}
+	case "XLSX":
+		switch commaCnt {
+		case 0:
+			if c.tokens[c.lastIdx].Token == '(' {
+				cands = c.SearchAllTables(line, origLine, index)
+			}
+		case 3, 4:
+			if c.tokens[c.lastIdx].Token == ',' {
+				cands = c.candidateList([]string{ternary.TRUE.String(), ternary.FALSE.String()}, false)
+			}
+		}
 	default:
 		switch commaCnt {
 		case 0:
@@ -534,6 +576,8 @@ func (c *Completer) TableObjectArgs(line string, origLine string, index int) rea
 func (c *Completer) FunctionArgs(line string, origLine string, index int) readline.CandidateList {
 	if c.tokens[0].Token == parser.SUBSTRING {
 		return c.substringArgs(line, origLine, index)
+	} else if c.tokens[0].Token == parser.EXTRACT {
+		return c.extractArgs(line, origLine, index)
 	} else {
 		return c.functionArgs(line, origLine, index)
 	}
@@ -568,6 +612,31 @@ func (c *Completer) substringArgs(line string, origLine string, index int) readl
 	)
 }
 
+func (c *Completer) extractArgs(line string, origLine string, index int) readline.CandidateList {
+	return c.completeArgs(
+		line,
+		origLine,
+		index,
+		func(i int) (keywords []string, customList readline.CandidateList, breakLoop bool) {
+			switch c.tokens[i].Token {
+			case parser.FROM:
+				customList = append(customList, c.SearchValues(line, origLine, index)...)
+				customList.Sort()
+			case parser.EXTRACT:
+				if i < c.lastIdx-1 {
+					keywords = append(keywords, "FROM")
+				} else {
+					keywords = append(keywords, "YEAR", "MONTH", "DAY", "HOUR", "MINUTE", "SECOND", "MILLISECOND", "MICROSECOND", "NANOSECOND", "EPOCH")
+				}
+			default:
+				return keywords, customList, false
+			}
+
+			return keywords, customList, true
+		},
+	)
+}
+
 func (c *Completer) functionArgs(line string, origLine string, index int) readline.CandidateList {
 	return c.completeArgs(
 		line,
@@ -788,6 +857,7 @@ func (c *Completer) WithArgs(line string, origLine string, index int) readline.C
 								"INSERT",
 								"UPDATE",
 								"REPLACE",
+								"MERGE",
 								"DELETE",
 							)
 						} else {
@@ -954,6 +1024,7 @@ func (c *Completer) fromClause(i int, line string, origLine string, index int) (
 
 	switch c.tokens[c.lastIdx].Token {
 	case parser.LATERAL:
+		customList = append(customList, c.candidate("UNNEST()", false))
 		restrict = true
 	case parser.CROSS, parser.INNER, parser.OUTER:
 		customList = append(customList, c.candidate("JOIN", true))
@@ -1020,6 +1091,7 @@ func (c *Completer) fromClause(i int, line string, origLine string, index int) (
 
 	if c.tokens[c.lastIdx].Token == parser.IDENTIFIER {
 		customList = append(customList, c.candidateList(joinCandidates, true)...)
+		customList = append(customList, c.candidateList(pivotCandidates, true)...)
 
 		if c.tokens[c.lastIdx].Quoted == false {
 			customList = append(customList, c.candidate("AS", true))
@@ -1036,6 +1108,37 @@ func (c *Completer) whereClause(line string, origLine string, index int) readlin
 	return cands
 }
 
+func (c *Completer) ExplainArgs(line string, origLine string, index int) readline.CandidateList {
+	queryStart := 1
+	if queryStart < len(c.tokens) && c.tokens[queryStart].Token == parser.ANALYZE {
+		queryStart++
+	}
+
+	if queryStart <= c.lastIdx {
+		c.tokens = c.tokens[queryStart:]
+		c.SetLastIndex(line)
+
+		switch c.tokens[0].Token {
+		case parser.SELECT:
+			return c.SelectArgs(line, origLine, index)
+		case parser.WITH:
+			return c.WithArgs(line, origLine, index)
+		}
+		return nil
+	}
+
+	keywords := []string{
+		"SELECT",
+		"WITH",
+	}
+	if queryStart == 1 {
+		keywords = append(keywords, "ANALYZE")
+	}
+	cands := c.candidateList(keywords, true)
+	cands.Sort()
+	return cands
+}
+
 func (c *Completer) SelectArgs(line string, origLine string, index int) readline.CandidateList {
 	isSelectInto := false
 	if c.selectIntoEnabled {
@@ -1301,6 +1404,12 @@ func (c *Completer) SelectArgs(line string, origLine string, index int) readline
 						customList = append(customList, c.candidateList([]string{
 							"FOR UPDATE",
 						}, false)...)
+					} else {
+						customList = append(customList, c.candidateList([]string{
+							"CUBE()",
+							"GROUPING SETS ()",
+							"ROLLUP()",
+						}, false)...)
 					}
 					customList.Sort()
 				}
@@ -1918,7 +2027,7 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 					case option.AnsiQuotesFlag, option.StrictEqualFlag, option.AllowUnevenFieldsFlag,
 						option.NoHeaderFlag, option.WithoutNullFlag,
 						option.WithoutHeaderFlag, option.EncloseAllFlag, option.PrettyPrintFlag,
-						option.ScientificNotationFlag,
+						option.ScientificNotationFlag, option.HtmlDocumentFlag, option.XmlAttributesFlag,
 						option.StripEndingLineBreakFlag, option.EastAsianEncodingFlag,
 						option.CountDiacriticalSignFlag, option.CountFormatCodeFlag,
 						option.ColorFlag, option.QuietFlag, option.StatsFlag:
@@ -1929,6 +2038,8 @@ func (c *Completer) SetArgs(line string, origLine string, index int) readline.Ca
 						return nil, c.candidateList(c.lineBreakList(), false), true
 					case option.JsonEscapeFlag:
 						return nil, c.candidateList(c.jsonEscapeTypeList(), false), true
+					case option.SqlDialectFlag:
+						return nil, c.candidateList(c.sqlDialectList(), false), true
 					}
 				}
 				return nil, c.SearchValues(line, origLine, index), true
@@ -2119,7 +2230,7 @@ func (c *Completer) SearchAllTablesWithSpace(line string, origLine string, index
 
 func (c *Completer) SearchAllTables(line string, _ string, _ int) readline.CandidateList {
 	tableKeys := c.scope.Tx.CachedViews.SortedKeys()
-	files := c.ListFiles(line, []string{option.CsvExt, option.TsvExt, option.JsonExt, option.JsonlExt, option.LtsvExt, option.TextExt}, c.scope.Tx.Flags.Repository)
+	files := c.ListFiles(line, []string{option.CsvExt, option.TsvExt, option.JsonExt, option.JsonlExt, option.LtsvExt, option.XlsxExt, option.ParquetExt, option.XmlExt, option.YamlExt, option.YmlExt, option.TextExt}, c.scope.Tx.Flags.Repository)
 
 	defaultDir := c.scope.Tx.Flags.Repository
 	if len(defaultDir) < 1 {
@@ -2755,7 +2866,7 @@ func (c *Completer) combineFunction() {
 
 func (c *Completer) isTableObject(token parser.Token) bool {
 	switch token.Token {
-	case parser.CSV, parser.JSON, parser.JSONL, parser.FIXED, parser.LTSV, parser.JSON_TABLE:
+	case parser.CSV, parser.JSON, parser.JSONL, parser.FIXED, parser.LTSV, parser.XLSX, parser.PARQUET, parser.XML, parser.YAML, parser.JSON_TABLE:
 		return true
 	}
 	return false
@@ -2770,6 +2881,7 @@ func (c *Completer) isFunction(token parser.Token) bool {
 	}
 
 	return token.Token == parser.SUBSTRING ||
+		token.Token == parser.EXTRACT ||
 		token.Token == parser.JSON_OBJECT ||
 		token.Token == parser.IF ||
 		token.Token == parser.AGGREGATE_FUNCTION ||
@@ -2903,6 +3015,15 @@ func (c *Completer) lineBreakList() []string {
 	return list
 }
 
+func (c *Completer) sqlDialectList() []string {
+	list := make([]string, 0, len(option.SqlDialectLiteral))
+	for _, v := range option.SqlDialectLiteral {
+		list = append(list, v)
+	}
+	sort.Strings(list)
+	return list
+}
+
 func (c *Completer) jsonEscapeTypeList() []string {
 	list := make([]string, 0, len(option.JsonEscapeTypeLiteral))
 	for _, v := range option.JsonEscapeTypeLiteral {
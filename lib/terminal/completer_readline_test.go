@@ -136,10 +136,10 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.runinfoList) != len(query.RuntimeInformatinList) || !strings.HasPrefix(c.runinfoList[0], option.RuntimeInformationSign) {
 		t.Error("runtime information are not set correctly")
 	}
-	if len(c.funcs) != len(query.Functions)+3 {
+	if len(c.funcs) != len(query.Functions)+5 {
 		t.Error("functions are not set correctly")
 	}
-	if len(c.aggFuncs) != len(query.AggregateFunctions)+2 {
+	if len(c.aggFuncs) != len(query.AggregateFunctions)+3 {
 		t.Error("aggregate functions are not set correctly")
 	}
 	if len(c.analyticFuncs) != len(query.AnalyticFunctions)+len(query.AggregateFunctions) {
@@ -159,10 +159,10 @@ func TestCompleter_Update(t *testing.T) {
 	if len(c.statementList) != 1 {
 		t.Error("statement list is not set correctly")
 	}
-	if len(c.funcList) != len(query.Functions)+3+1 || !strings.HasSuffix(c.funcList[0], "()") {
+	if len(c.funcList) != len(query.Functions)+5+1 || !strings.HasSuffix(c.funcList[0], "()") {
 		t.Error("function list is not set correctly")
 	}
-	if len(c.aggFuncList) != len(query.AggregateFunctions)+2+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
+	if len(c.aggFuncList) != len(query.AggregateFunctions)+3+1 || !strings.HasSuffix(c.aggFuncList[0], "()") {
 		t.Error("aggregate function list is not set correctly")
 	}
 	if len(c.analyticFuncList) != len(query.AnalyticFunctions)+len(query.AggregateFunctions)+1 || !strings.HasSuffix(c.analyticFuncList[0], "() OVER ()") {
@@ -266,8 +266,10 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("ECHO"), AppendSpace: true},
 			{Name: []rune("EXECUTE"), AppendSpace: true},
 			{Name: []rune("EXIT")},
+			{Name: []rune("EXPLAIN"), AppendSpace: true},
 			{Name: []rune("FETCH"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("OPEN"), AppendSpace: true},
 			{Name: []rune("PREPARE"), AppendSpace: true},
 			{Name: []rune("PRINT"), AppendSpace: true},
@@ -319,6 +321,48 @@ var completerStatementsTests = []completerTest{
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "Statements EXPLAIN",
+		Line:     "",
+		OrigLine: "explain ",
+		Index:    8,
+		Expect: readline.CandidateList{
+			{Name: []rune("ANALYZE"), AppendSpace: true},
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN ANALYZE",
+		Line:     "",
+		OrigLine: "explain analyze ",
+		Index:    16,
+		Expect: readline.CandidateList{
+			{Name: []rune("SELECT"), AppendSpace: true},
+			{Name: []rune("WITH"), AppendSpace: true},
+		},
+	},
+	{
+		Name:     "Statements EXPLAIN SELECT",
+		Line:     "fro",
+		OrigLine: "explain analyze select 1 fro",
+		Index:    28,
+		Expect: readline.CandidateList{
+			{Name: []rune("AS"), AppendSpace: true},
+			{Name: []rune("EXCEPT"), AppendSpace: true},
+			{Name: []rune("FETCH"), AppendSpace: true},
+			{Name: []rune("FOR UPDATE")},
+			{Name: []rune("FROM"), AppendSpace: true},
+			{Name: []rune("GROUP BY"), AppendSpace: true},
+			{Name: []rune("HAVING"), AppendSpace: true},
+			{Name: []rune("INTERSECT"), AppendSpace: true},
+			{Name: []rune("LIMIT"), AppendSpace: true},
+			{Name: []rune("OFFSET"), AppendSpace: true},
+			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("WHERE"), AppendSpace: true},
+		},
+	},
 	{
 		Name:     "Statements INSERT",
 		Line:     "",
@@ -1112,6 +1156,7 @@ var completerWithArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("DELETE"), AppendSpace: true},
 			{Name: []rune("INSERT"), AppendSpace: true},
+			{Name: []rune("MERGE"), AppendSpace: true},
 			{Name: []rune("REPLACE"), AppendSpace: true},
 			{Name: []rune("SELECT"), AppendSpace: true},
 			{Name: []rune("UPDATE"), AppendSpace: true},
@@ -1314,6 +1359,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1345,8 +1394,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1372,8 +1423,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1389,6 +1442,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1420,8 +1477,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1446,8 +1505,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1479,8 +1540,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1536,8 +1599,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("NATURAL"), AppendSpace: true},
 			{Name: []rune("OFFSET"), AppendSpace: true},
 			{Name: []rune("ORDER BY"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
 			{Name: []rune("UNION"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -1574,6 +1639,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1584,6 +1653,15 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("LATERAL"), AppendSpace: true},
 		},
 	},
+	{
+		Name:     "SelectArgs After LATERAL Keyword",
+		Line:     "",
+		OrigLine: "select 1 from t1 cross join lateral ",
+		Index:    36,
+		Expect: readline.CandidateList{
+			{Name: []rune("UNNEST()")},
+		},
+	},
 	{
 		Name:     "SelectArgs After JOIN Keyword",
 		Line:     "",
@@ -1596,6 +1674,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1618,6 +1700,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1639,6 +1725,10 @@ var completerSelectArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -1748,7 +1838,10 @@ var completerSelectArgsTests = []completerTest{
 		Expect: readline.CandidateList{
 			{Name: []rune("@var1")},
 			{Name: []rune("@var2")},
+			{Name: []rune("CUBE()")},
+			{Name: []rune("GROUPING SETS ()")},
 			{Name: []rune("JSON_ROW()")},
+			{Name: []rune("ROLLUP()")},
 		},
 	},
 	{
@@ -2078,6 +2171,10 @@ var completerInsertArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2164,6 +2261,10 @@ var completerUpdateArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2249,6 +2350,10 @@ var completerReplaceArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2360,6 +2465,10 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2390,6 +2499,10 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JSONL()")},
 			{Name: []rune("JSON_TABLE()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -2412,7 +2525,9 @@ var completerDeleteArgsTests = []completerTest{
 			{Name: []rune("JOIN"), AppendSpace: true},
 			{Name: []rune("LEFT"), AppendSpace: true},
 			{Name: []rune("NATURAL"), AppendSpace: true},
+			{Name: []rune("PIVOT"), AppendSpace: true},
 			{Name: []rune("RIGHT"), AppendSpace: true},
+			{Name: []rune("UNPIVOT"), AppendSpace: true},
 			{Name: []rune("WHERE"), AppendSpace: true},
 		},
 	},
@@ -2568,6 +2683,10 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("JSON()"), AppendSpace: true},
 			{Name: []rune("JSONL()"), AppendSpace: true},
 			{Name: []rune("LTSV()"), AppendSpace: true},
+			{Name: []rune("PARQUET()"), AppendSpace: true},
+			{Name: []rune("XLSX()"), AppendSpace: true},
+			{Name: []rune("XML()"), AppendSpace: true},
+			{Name: []rune("YAML()"), AppendSpace: true},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true, AppendSpace: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true, AppendSpace: true},
@@ -2718,6 +2837,7 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("JSON_ESCAPE"), AppendSpace: true},
 			{Name: []rune("LINE_BREAK"), AppendSpace: true},
 			{Name: []rune("PRETTY_PRINT"), AppendSpace: true},
+			{Name: []rune("SCHEMA"), AppendSpace: true},
 		},
 	},
 	{
@@ -2760,12 +2880,19 @@ var completerAlterArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("VERTICAL")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3122,7 +3249,11 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("JSON")},
 			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
+			{Name: []rune("PARQUET")},
 			{Name: []rune("TSV")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3199,12 +3330,19 @@ var completerSetArgsTests = []completerTest{
 			{Name: []rune("CSV")},
 			{Name: []rune("FIXED")},
 			{Name: []rune("GFM")},
+			{Name: []rune("HTML")},
 			{Name: []rune("JSON")},
 			{Name: []rune("JSONL")},
 			{Name: []rune("LTSV")},
 			{Name: []rune("ORG")},
+			{Name: []rune("PARQUET")},
+			{Name: []rune("SQL")},
 			{Name: []rune("TEXT")},
 			{Name: []rune("TSV")},
+			{Name: []rune("VERTICAL")},
+			{Name: []rune("XLSX")},
+			{Name: []rune("XML")},
+			{Name: []rune("YAML")},
 		},
 	},
 	{
@@ -3537,6 +3675,10 @@ var completerShowArgsTests = []completerTest{
 			{Name: []rune("JSON()")},
 			{Name: []rune("JSONL()")},
 			{Name: []rune("LTSV()")},
+			{Name: []rune("PARQUET()")},
+			{Name: []rune("XLSX()")},
+			{Name: []rune("XML()")},
+			{Name: []rune("YAML()")},
 			{Name: []rune(filepath.Join(CompletionTestDir, "sub", "table2.csv")), FormatAsIdentifier: true},
 			{Name: []rune("newtable.csv"), FormatAsIdentifier: true},
 			{Name: []rune("tempview"), FormatAsIdentifier: true},
@@ -56,6 +56,7 @@ func initFlag(flags *option.Flags) {
 	flags.Quiet = false
 	flags.LimitRecursion = 5
 	flags.CPU = cpu
+	flags.SortMemoryLimit = -1
 	flags.Stats = false
 	flags.SetColor(false)
 	_ = flags.SetLocation(TestLocation)
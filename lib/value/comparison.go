@@ -59,11 +59,97 @@ func compareFloat(v1 float64, v2 float64) ComparisonResult {
 	return IsGreater
 }
 
+func isDecimal(p Primary) bool {
+	_, ok := p.(*Decimal)
+	return ok
+}
+
+func isInterval(p Primary) bool {
+	_, ok := p.(*Interval)
+	return ok
+}
+
+func isStructure(p Primary) bool {
+	switch p.(type) {
+	case *Array, *Map:
+		return true
+	}
+	return false
+}
+
+// compareStructures compares arrays or maps element by element.
+// Structures are either equal or not equal because they have no order.
+func compareStructures(p1 Primary, p2 Primary, datetimeFormats []string, location *time.Location) ComparisonResult {
+	isEqual := func(v1 Primary, v2 Primary) bool {
+		r := CompareCombinedly(v1, v2, datetimeFormats, location)
+		return r == IsEqual || r == IsBoolEqual
+	}
+
+	switch v1 := p1.(type) {
+	case *Array:
+		if v2, ok := p2.(*Array); ok {
+			if v1.Len() != v2.Len() {
+				return IsNotEqual
+			}
+			for i := range v1.values {
+				if !isEqual(v1.values[i], v2.values[i]) {
+					return IsNotEqual
+				}
+			}
+			return IsEqual
+		}
+	case *Map:
+		if v2, ok := p2.(*Map); ok {
+			if v1.Len() != v2.Len() {
+				return IsNotEqual
+			}
+			for _, k := range v1.keys {
+				val, ok := v2.Get(k)
+				if !ok || !isEqual(v1.values[k], val) {
+					return IsNotEqual
+				}
+			}
+			return IsEqual
+		}
+	}
+	return IsNotEqual
+}
+
 func CompareCombinedly(p1 Primary, p2 Primary, datetimeFormats []string, location *time.Location) ComparisonResult {
 	if IsNull(p1) || IsNull(p2) {
 		return IsIncommensurable
 	}
 
+	if isDecimal(p1) || isDecimal(p2) {
+		if d1 := ToDecimal(p1); !IsNull(d1) {
+			if d2 := ToDecimal(p2); !IsNull(d2) {
+				return compareInteger(int64(d1.(*Decimal).Cmp(d2.(*Decimal))), 0)
+			}
+		}
+	}
+
+	if isStructure(p1) || isStructure(p2) {
+		if isStructure(p1) && isStructure(p2) {
+			return compareStructures(p1, p2, datetimeFormats, location)
+		}
+		if _, ok := p1.(*String); ok {
+			return CompareCombinedly(p1, ToString(p2), datetimeFormats, location)
+		}
+		if _, ok := p2.(*String); ok {
+			return CompareCombinedly(ToString(p1), p2, datetimeFormats, location)
+		}
+		return IsIncommensurable
+	}
+
+	if isInterval(p1) || isInterval(p2) {
+		if iv1 := ToInterval(p1); !IsNull(iv1) {
+			if iv2 := ToInterval(p2); !IsNull(iv2) {
+				return compareInteger(int64(iv1.(*Interval).Cmp(iv2.(*Interval))), 0)
+			}
+		}
+		return IsIncommensurable
+	}
+
 	if i1 := ToIntegerStrictly(p1); !IsNull(i1) {
 		if i2 := ToIntegerStrictly(p2); !IsNull(i2) {
 			v1 := i1.(*Integer).Raw()
@@ -151,12 +237,52 @@ func Identical(p1 Primary, p2 Primary) ternary.Value {
 		}
 	}
 
+	if v1, ok := p1.(*Decimal); ok {
+		if v2, ok := p2.(*Decimal); ok {
+			return ternary.ConvertFromBool(v1.Cmp(v2) == 0)
+		}
+	}
+
 	if v1, ok := p1.(*Datetime); ok {
 		if v2, ok := p2.(*Datetime); ok {
 			return ternary.ConvertFromBool(v1.value.Equal(v2.value))
 		}
 	}
 
+	if v1, ok := p1.(*Interval); ok {
+		if v2, ok := p2.(*Interval); ok {
+			return ternary.ConvertFromBool(*v1 == *v2)
+		}
+	}
+
+	if v1, ok := p1.(*Array); ok {
+		if v2, ok := p2.(*Array); ok {
+			if v1.Len() != v2.Len() {
+				return ternary.FALSE
+			}
+			for i := range v1.values {
+				if Identical(v1.values[i], v2.values[i]) != ternary.TRUE {
+					return ternary.FALSE
+				}
+			}
+			return ternary.TRUE
+		}
+	}
+
+	if v1, ok := p1.(*Map); ok {
+		if v2, ok := p2.(*Map); ok {
+			if v1.Len() != v2.Len() {
+				return ternary.FALSE
+			}
+			for i, k := range v1.keys {
+				if k != v2.keys[i] || Identical(v1.values[k], v2.values[k]) != ternary.TRUE {
+					return ternary.FALSE
+				}
+			}
+			return ternary.TRUE
+		}
+	}
+
 	if v1, ok := p1.(*Boolean); ok {
 		if v2, ok := p2.(*Boolean); ok {
 			return ternary.ConvertFromBool(v1.value == v2.value)
@@ -171,6 +171,61 @@ var compareCombinedlyTests = []struct {
 		RHS:    NewFloat(math.NaN()),
 		Result: IsNotEqual,
 	},
+	{
+		LHS:    NewDecimalFromString("0.30"),
+		RHS:    NewDecimalFromString("0.3"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewDecimalFromString("0.1000000000000000001"),
+		RHS:    NewFloat(0.1),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewInteger(2),
+		RHS:    NewDecimalFromString("2.01"),
+		Result: IsLess,
+	},
+	{
+		LHS:    NewDecimalFromString("1"),
+		RHS:    NewString("A"),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewInterval(1, 0, 0),
+		RHS:    NewInterval(0, 30, 0),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewString("23 hours"),
+		Result: IsGreater,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewFloat(1), NewString("A")}),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1)}),
+		Result: IsNotEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewString("[1,\"a\"]"),
+		Result: IsEqual,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1)}),
+		RHS:    NewInteger(1),
+		Result: IsIncommensurable,
+	},
 }
 
 func TestCompareCombinedly(t *testing.T) {
@@ -247,6 +302,36 @@ var identicalTests = []struct {
 		RHS:    NewFloat(1),
 		Result: ternary.FALSE,
 	},
+	{
+		LHS:    NewDecimalFromString("1.50"),
+		RHS:    NewDecimalFromString("1.5"),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewDecimalFromString("1"),
+		RHS:    NewInteger(1),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewInterval(0, 1, 0),
+		RHS:    NewInterval(0, 1, 0),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewInterval(1, 0, 0),
+		RHS:    NewInterval(0, 30, 0),
+		Result: ternary.FALSE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		Result: ternary.TRUE,
+	},
+	{
+		LHS:    NewArray([]Primary{NewInteger(1), NewString("a")}),
+		RHS:    NewArray([]Primary{NewFloat(1), NewString("a")}),
+		Result: ternary.FALSE,
+	},
 }
 
 func TestIdentical(t *testing.T) {
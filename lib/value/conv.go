@@ -3,6 +3,7 @@ package value
 import (
 	"bytes"
 	"math"
+	"math/big"
 	"strconv"
 	"strings"
 	"sync"
@@ -10,11 +11,15 @@ import (
 
 	"github.com/mithrandie/csvq/lib/option"
 
+	txjson "github.com/mithrandie/go-text/json"
 	"github.com/mithrandie/ternary"
 )
 
 var DatetimeFormats = NewDatetimeFormatMap()
 
+// MaxDecimalScale is the upper limit of exponents and scales accepted for decimal values.
+const MaxDecimalScale = 1000
+
 type DatetimeFormatMap struct {
 	m   *sync.Map
 	mtx *sync.Mutex
@@ -256,6 +261,157 @@ func Float64ToStr(f float64, useScientificNotation bool) string {
 	return strconv.FormatFloat(f, 'f', -1, 64)
 }
 
+// StrToDecimal parses a string in plain or exponential notation as a decimal value.
+func StrToDecimal(s string) (*Decimal, bool) {
+	s = option.TrimSpace(s)
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); -1 < i {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -MaxDecimalScale || MaxDecimalScale < e {
+			return nil, false
+		}
+		exp = e
+		s = s[:i]
+	}
+
+	digits := s
+	if 0 < len(digits) && (digits[0] == '+' || digits[0] == '-') {
+		digits = digits[1:]
+	}
+
+	scale := 0
+	if i := strings.IndexByte(digits, '.'); -1 < i {
+		scale = len(digits) - i - 1
+		digits = digits[:i] + digits[i+1:]
+	}
+	if len(digits) < 1 {
+		return nil, false
+	}
+	for _, c := range digits {
+		if c < '0' || '9' < c {
+			return nil, false
+		}
+	}
+
+	v, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, false
+	}
+	if s[0] == '-' {
+		v.Neg(v)
+	}
+	return NewDecimal(v, scale-exp), true
+}
+
+var intervalUnitNanoseconds = map[string]int64{
+	"HOUR":        int64(time.Hour),
+	"MINUTE":      int64(time.Minute),
+	"SECOND":      int64(time.Second),
+	"MILLISECOND": int64(time.Millisecond),
+	"MICROSECOND": int64(time.Microsecond),
+	"NANOSECOND":  1,
+}
+
+// StrToInterval parses a string such as "1 year 2 months 3 days 04:05:06.789" or "3 days 4 hours" as an interval.
+// Each number followed by a unit can be signed and fractional.
+func StrToInterval(s string) (*Interval, bool) {
+	words := strings.Fields(s)
+	if len(words) < 1 {
+		return nil, false
+	}
+
+	iv := NewInterval(0, 0, 0)
+	for i := 0; i < len(words); i++ {
+		if strings.Contains(words[i], ":") {
+			n, ok := parseIntervalTime(words[i])
+			if !ok || !iv.addNanoseconds(new(big.Rat).SetInt64(n)) {
+				return nil, false
+			}
+			continue
+		}
+
+		if len(words) <= i+1 {
+			return nil, false
+		}
+		d, ok := StrToDecimal(words[i])
+		if !ok {
+			return nil, false
+		}
+		i++
+
+		unit := strings.ToUpper(words[i])
+		if 1 < len(unit) {
+			unit = strings.TrimSuffix(unit, "S")
+		}
+		r := d.Rat()
+
+		switch unit {
+		case "YEAR":
+			ok = iv.addMonths(r.Mul(r, big.NewRat(12, 1)))
+		case "MONTH":
+			ok = iv.addMonths(r)
+		case "WEEK":
+			ok = iv.addDays(r.Mul(r, big.NewRat(7, 1)))
+		case "DAY":
+			ok = iv.addDays(r)
+		default:
+			var n int64
+			if n, ok = intervalUnitNanoseconds[unit]; ok {
+				ok = iv.addNanoseconds(r.Mul(r, big.NewRat(n, 1)))
+			}
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+	return iv, true
+}
+
+// parseIntervalTime parses a string in the form of "[-]h:mm[:ss[.fraction]]" as nanoseconds.
+func parseIntervalTime(s string) (int64, bool) {
+	neg := false
+	if 0 < len(s) && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	elems := strings.Split(s, ":")
+	if 3 < len(elems) {
+		return 0, false
+	}
+	for _, e := range elems[:len(elems)-1] {
+		if len(e) < 1 || strings.Trim(e, "0123456789") != "" {
+			return 0, false
+		}
+	}
+
+	h, err := strconv.ParseInt(elems[0], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	m, err := strconv.ParseInt(elems[1], 10, 64)
+	if err != nil || (len(elems) == 3 && 59 < m) {
+		return 0, false
+	}
+	n := new(big.Int).Add(new(big.Int).Mul(big.NewInt(h), big.NewInt(int64(time.Hour))), big.NewInt(m*int64(time.Minute)))
+
+	if len(elems) == 3 {
+		sec, ok := StrToDecimal(elems[2])
+		if !ok || len(elems[2]) < 1 || strings.Trim(elems[2], "0123456789.") != "" || 60 <= sec.Float64() {
+			return 0, false
+		}
+		n.Add(n, sec.Rescale(9).Unscaled())
+	}
+	if !n.IsInt64() {
+		return 0, false
+	}
+	if neg {
+		return -n.Int64(), true
+	}
+	return n.Int64(), true
+}
+
 func ToInteger(p Primary) Primary {
 	switch val := p.(type) {
 	case *Integer:
@@ -265,6 +421,10 @@ func ToInteger(p Primary) Primary {
 			return NewNull()
 		}
 		return NewInteger(int64(val.Raw()))
+	case *Decimal:
+		if i := new(big.Int).Quo(val.value, pow10(val.scale)); i.IsInt64() {
+			return NewInteger(i.Int64())
+		}
 	case *String:
 		s := option.TrimSpace(val.Raw())
 		if i, e := strconv.ParseInt(s, 10, 64); e == nil {
@@ -298,6 +458,8 @@ func ToFloat(p Primary) Primary {
 		return NewFloat(float64(p.(*Integer).Raw()))
 	case *Float:
 		return NewFloat(p.(*Float).Raw())
+	case *Decimal:
+		return NewFloat(p.(*Decimal).Float64())
 	case *String:
 		s := option.TrimSpace(p.(*String).Raw())
 		if f, e := strconv.ParseFloat(s, 64); e == nil {
@@ -308,6 +470,50 @@ func ToFloat(p Primary) Primary {
 	return NewNull()
 }
 
+func ToDecimal(p Primary) Primary {
+	switch p.(type) {
+	case *Decimal:
+		d := p.(*Decimal)
+		return NewDecimal(d.value, d.scale)
+	case *Integer:
+		return NewDecimal(big.NewInt(p.(*Integer).Raw()), 0)
+	case *Float:
+		f := p.(*Float).Raw()
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			if d, ok := StrToDecimal(Float64ToStr(f, true)); ok {
+				return d
+			}
+		}
+	case *String:
+		if d, ok := StrToDecimal(p.(*String).Raw()); ok {
+			return d
+		}
+	}
+
+	return NewNull()
+}
+
+func ToInterval(p Primary) Primary {
+	switch p.(type) {
+	case *Interval:
+		iv := p.(*Interval)
+		return NewInterval(iv.months, iv.days, iv.nanoseconds)
+	case *String:
+		if iv, ok := StrToInterval(p.(*String).Raw()); ok {
+			return iv
+		}
+	}
+
+	return NewNull()
+}
+
+func ToArray(p Primary) Primary {
+	if a, ok := p.(*Array); ok {
+		return NewArray(a.values)
+	}
+	return NewNull()
+}
+
 func ToDatetime(p Primary, formats []string, location *time.Location) Primary {
 	switch p.(type) {
 	case *Datetime:
@@ -329,7 +535,7 @@ func ToBoolean(p Primary) Primary {
 	switch p.(type) {
 	case *Boolean:
 		return NewBoolean(p.(*Boolean).Raw())
-	case *String, *Integer, *Float, *Ternary:
+	case *String, *Integer, *Float, *Decimal, *Ternary:
 		if p.Ternary() != ternary.UNKNOWN {
 			return NewBoolean(p.Ternary().ParseBool())
 		}
@@ -345,6 +551,62 @@ func ToString(p Primary) Primary {
 		return NewString(Int64ToStr(p.(*Integer).Raw()))
 	case *Float:
 		return NewString(Float64ToStr(p.(*Float).Raw(), false))
+	case *Decimal:
+		return NewString(p.(*Decimal).String())
+	case *Interval:
+		return NewString(p.(*Interval).Format())
+	case *Array:
+		return NewString(p.(*Array).Encode())
+	case *Map:
+		return NewString(p.(*Map).Encode())
 	}
 	return NewNull()
 }
+
+// ToJsonStructure converts a value to a JSON structure.
+// Arrays and maps are converted recursively to JSON arrays and objects.
+func ToJsonStructure(p Primary) txjson.Structure {
+	var s txjson.Structure
+
+	switch p.(type) {
+	case *String:
+		s = txjson.String(p.(*String).Raw())
+	case *Integer:
+		s = txjson.Integer(p.(*Integer).Raw())
+	case *Float:
+		s = txjson.Float(p.(*Float).Raw())
+	case *Decimal:
+		s = txjson.Float(p.(*Decimal).Float64())
+	case *Boolean:
+		s = txjson.Boolean(p.(*Boolean).Raw())
+	case *Ternary:
+		t := p.(*Ternary)
+		if t.Ternary() == ternary.UNKNOWN {
+			s = txjson.Null{}
+		} else {
+			s = txjson.Boolean(t.Ternary().ParseBool())
+		}
+	case *Datetime:
+		s = txjson.String(p.(*Datetime).Format(time.RFC3339Nano))
+	case *Interval:
+		s = txjson.String(p.(*Interval).Format())
+	case *Array:
+		a := p.(*Array)
+		array := make(txjson.Array, 0, a.Len())
+		for _, v := range a.values {
+			array = append(array, ToJsonStructure(v))
+		}
+		s = array
+	case *Map:
+		m := p.(*Map)
+		obj := txjson.NewObject(m.Len())
+		for _, k := range m.keys {
+			obj.Add(k, ToJsonStructure(m.values[k]))
+		}
+		s = obj
+	case *Null:
+		s = txjson.Null{}
+	}
+
+	return s
+}
@@ -2,6 +2,7 @@ package value
 
 import (
 	"math"
+	"reflect"
 	"testing"
 	"time"
 
@@ -490,6 +491,64 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+var toDecimalTests = []struct {
+	Value  Primary
+	Result string
+}{
+	{Value: NewInteger(-3), Result: "-3"},
+	{Value: NewFloat(0.1), Result: "0.1"},
+	{Value: NewFloat(1e21), Result: "1000000000000000000000"},
+	{Value: NewString(" 12.50 "), Result: "12.50"},
+	{Value: NewDecimalFromString("1.20"), Result: "1.20"},
+	{Value: NewString("1.2.3"), Result: "NULL"},
+	{Value: NewString("e5"), Result: "NULL"},
+	{Value: NewString("-"), Result: "NULL"},
+	{Value: NewFloat(math.NaN()), Result: "NULL"},
+	{Value: NewBoolean(true), Result: "NULL"},
+}
+
+func TestToDecimal(t *testing.T) {
+	for _, v := range toDecimalTests {
+		d := ToDecimal(v.Value)
+		if d.String() != v.Result {
+			t.Errorf("result = %s, want %s for %#v", d, v.Result, v.Value)
+		}
+	}
+}
+
+var strToIntervalTests = []struct {
+	Value  string
+	Result *Interval
+	OK     bool
+}{
+	{Value: "3 days 4 hours", Result: NewInterval(0, 3, 4*3600000000000), OK: true},
+	{Value: "1 Year 2 MONTHS", Result: NewInterval(14, 0, 0), OK: true},
+	{Value: "2 weeks -1 day", Result: NewInterval(0, 13, 0), OK: true},
+	{Value: "1.5 days", Result: NewInterval(0, 1, 12*3600000000000), OK: true},
+	{Value: "0.5 month", Result: NewInterval(0, 15, 0), OK: true},
+	{Value: "250 ms", OK: false},
+	{Value: "250 milliseconds", Result: NewInterval(0, 0, 250000000), OK: true},
+	{Value: "1 day 04:05:06.5", Result: NewInterval(0, 1, 14706500000000), OK: true},
+	{Value: "-01:30", Result: NewInterval(0, 0, -5400000000000), OK: true},
+	{Value: "01:60:00", OK: false},
+	{Value: "3 days 4", OK: false},
+	{Value: "3 fortnights", OK: false},
+	{Value: "", OK: false},
+}
+
+func TestStrToInterval(t *testing.T) {
+	for _, v := range strToIntervalTests {
+		iv, ok := StrToInterval(v.Value)
+		if ok != v.OK {
+			t.Errorf("ok = %t, want %t for %q", ok, v.OK, v.Value)
+			continue
+		}
+		if ok && !reflect.DeepEqual(iv, v.Result) {
+			t.Errorf("result = %#v, want %#v for %q", iv, v.Result, v.Value)
+		}
+	}
+}
+
 func TestToDatetime(t *testing.T) {
 	var p Primary
 	var dt Primary
@@ -1,7 +1,10 @@
 package value
 
 import (
+	"fmt"
+	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mithrandie/csvq/lib/option"
@@ -133,6 +136,145 @@ func (f Float) Ternary() ternary.Value {
 	}
 }
 
+type Decimal struct {
+	value *big.Int
+	scale int
+}
+
+func NewDecimalFromString(s string) *Decimal {
+	if d, ok := StrToDecimal(s); ok {
+		return d
+	}
+	return NewDecimal(new(big.Int), 0)
+}
+
+// NewDecimal returns a decimal value represented as unscaled * 10^-scale.
+func NewDecimal(unscaled *big.Int, scale int) *Decimal {
+	if scale < 0 {
+		unscaled = new(big.Int).Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	return &Decimal{
+		value: new(big.Int).Set(unscaled),
+		scale: scale,
+	}
+}
+
+func (d Decimal) String() string {
+	s := d.value.String()
+	if d.scale < 1 {
+		return s
+	}
+
+	sign := ""
+	if d.value.Sign() < 0 {
+		sign = "-"
+		s = s[1:]
+	}
+	if len(s) <= d.scale {
+		s = strings.Repeat("0", d.scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+}
+
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.value)
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.value.Sign()
+}
+
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.value, pow10(d.scale))
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares two decimal values numerically regardless of their scales.
+func (d Decimal) Cmp(d2 *Decimal) int {
+	v1, v2, _ := AlignDecimals(&d, d2)
+	return v1.Cmp(v2)
+}
+
+// Rescale returns a decimal value with the specified scale.
+// Values are rounded half away from zero when the scale is reduced.
+func (d Decimal) Rescale(scale int) *Decimal {
+	if scale < 0 {
+		scale = 0
+	}
+	if d.scale <= scale {
+		return NewDecimal(new(big.Int).Mul(d.value, pow10(scale-d.scale)), scale)
+	}
+	return NewDecimal(RoundQuotient(d.value, pow10(d.scale-scale)), scale)
+}
+
+// Normalize returns a decimal value without trailing zeros in the fractional part.
+func (d Decimal) Normalize() *Decimal {
+	v := new(big.Int).Set(d.value)
+	scale := d.scale
+	q, r := new(big.Int), new(big.Int)
+	for 0 < scale {
+		q.QuoRem(v, big.NewInt(10), r)
+		if r.Sign() != 0 {
+			break
+		}
+		v.Set(q)
+		scale--
+	}
+	return NewDecimal(v, scale)
+}
+
+func (d Decimal) Ternary() ternary.Value {
+	if d.value.Sign() == 0 {
+		return ternary.FALSE
+	}
+	if d.value.Cmp(pow10(d.scale)) == 0 {
+		return ternary.TRUE
+	}
+	return ternary.UNKNOWN
+}
+
+// AlignDecimals returns the unscaled values of two decimals adjusted to the larger scale.
+func AlignDecimals(d1 *Decimal, d2 *Decimal) (*big.Int, *big.Int, int) {
+	switch {
+	case d1.scale < d2.scale:
+		return new(big.Int).Mul(d1.value, pow10(d2.scale-d1.scale)), new(big.Int).Set(d2.value), d2.scale
+	case d2.scale < d1.scale:
+		return new(big.Int).Set(d1.value), new(big.Int).Mul(d2.value, pow10(d1.scale-d2.scale)), d1.scale
+	default:
+		return new(big.Int).Set(d1.value), new(big.Int).Set(d2.value), d1.scale
+	}
+}
+
+// RoundQuotient returns x / y rounded half away from zero.
+func RoundQuotient(x *big.Int, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if (x.Sign() < 0) != (y.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
 type Boolean struct {
 	value bool
 }
@@ -217,6 +359,274 @@ func (dt Datetime) Format(s string) string {
 	return dt.value.Format(s)
 }
 
+const (
+	nanosecondsPerDay = 24 * int64(time.Hour)
+	daysPerMonth      = 30
+)
+
+// Interval is a span of time consisting of months, days and nanoseconds.
+// Months and days are kept separately because their lengths vary depending on the calendar.
+type Interval struct {
+	months      int64
+	days        int64
+	nanoseconds int64
+}
+
+func NewIntervalFromString(s string) *Interval {
+	if iv, ok := StrToInterval(s); ok {
+		return iv
+	}
+	return NewInterval(0, 0, 0)
+}
+
+func NewInterval(months int64, days int64, nanoseconds int64) *Interval {
+	return &Interval{
+		months:      months,
+		days:        days,
+		nanoseconds: nanoseconds,
+	}
+}
+
+// NewIntervalBetween returns the interval from t2 to t1 in days and nanoseconds.
+func NewIntervalBetween(t1 time.Time, t2 time.Time) *Interval {
+	sec := t1.Unix() - t2.Unix()
+	days := sec / 86400
+	nsec := (sec%86400)*int64(time.Second) + int64(t1.Nanosecond()-t2.Nanosecond())
+
+	if 0 < days && nsec < 0 {
+		days--
+		nsec += nanosecondsPerDay
+	} else if days < 0 && 0 < nsec {
+		days++
+		nsec -= nanosecondsPerDay
+	}
+	return NewInterval(0, days, nsec)
+}
+
+func (iv Interval) String() string {
+	return option.QuoteString(iv.Format())
+}
+
+// Format returns the interval in the notation that can be parsed by StrToInterval.
+func (iv Interval) Format() string {
+	parts := make([]string, 0, 4)
+
+	appendPart := func(n int64, unit string) {
+		if n == 0 {
+			return
+		}
+		s := strconv.FormatInt(n, 10) + " " + unit
+		if n != 1 && n != -1 {
+			s = s + "s"
+		}
+		parts = append(parts, s)
+	}
+	appendPart(iv.months/12, "year")
+	appendPart(iv.months%12, "month")
+	appendPart(iv.days, "day")
+
+	if iv.nanoseconds != 0 || len(parts) < 1 {
+		sign := ""
+		n := iv.nanoseconds
+		if n < 0 {
+			sign = "-"
+			n = -n
+		}
+		d := time.Duration(n)
+		s := fmt.Sprintf("%s%02d:%02d:%02d", sign, int64(d/time.Hour), int64(d%time.Hour/time.Minute), int64(d%time.Minute/time.Second))
+		if ns := int64(d % time.Second); ns != 0 {
+			s = s + "." + strings.TrimRight(fmt.Sprintf("%09d", ns), "0")
+		}
+		parts = append(parts, s)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (iv Interval) Months() int64 {
+	return iv.months
+}
+
+func (iv Interval) Days() int64 {
+	return iv.days
+}
+
+func (iv Interval) Nanoseconds() int64 {
+	return iv.nanoseconds
+}
+
+func (iv Interval) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+// AddTo returns the time t plus the interval.
+func (iv Interval) AddTo(t time.Time) time.Time {
+	return t.AddDate(0, int(iv.months), int(iv.days)).Add(time.Duration(iv.nanoseconds))
+}
+
+func (iv Interval) Negate() *Interval {
+	return NewInterval(-iv.months, -iv.days, -iv.nanoseconds)
+}
+
+func (iv Interval) Add(iv2 *Interval) *Interval {
+	return NewInterval(iv.months+iv2.months, iv.days+iv2.days, iv.nanoseconds+iv2.nanoseconds)
+}
+
+// Multiply returns the interval multiplied by r.
+// Fractional months are carried down to days as 30 days per month, and fractional days to nanoseconds.
+func (iv Interval) Multiply(r *big.Rat) (*Interval, bool) {
+	result := NewInterval(0, 0, 0)
+	ok := result.addMonths(new(big.Rat).Mul(big.NewRat(iv.months, 1), r)) &&
+		result.addDays(new(big.Rat).Mul(big.NewRat(iv.days, 1), r)) &&
+		result.addNanoseconds(new(big.Rat).Mul(big.NewRat(iv.nanoseconds, 1), r))
+	return result, ok
+}
+
+// Cmp compares two intervals by their lengths assuming that a month is 30 days and a day is 24 hours.
+func (iv Interval) Cmp(iv2 *Interval) int {
+	return iv.TotalNanoseconds().Cmp(iv2.TotalNanoseconds())
+}
+
+// TotalSeconds returns the length of the interval in seconds in the same way as Cmp.
+func (iv Interval) TotalSeconds() float64 {
+	f, _ := new(big.Rat).SetFrac(iv.TotalNanoseconds(), big.NewInt(int64(time.Second))).Float64()
+	return f
+}
+
+// TotalNanoseconds returns the length of the interval in nanoseconds assuming that a month is 30 days.
+func (iv Interval) TotalNanoseconds() *big.Int {
+	days := new(big.Int).Add(new(big.Int).Mul(big.NewInt(iv.months), big.NewInt(daysPerMonth)), big.NewInt(iv.days))
+	n := new(big.Int).Mul(days, big.NewInt(nanosecondsPerDay))
+	return n.Add(n, big.NewInt(iv.nanoseconds))
+}
+
+func (iv *Interval) addMonths(r *big.Rat) bool {
+	i, frac := splitRat(r)
+	if !i.IsInt64() {
+		return false
+	}
+	iv.months += i.Int64()
+	return iv.addDays(frac.Mul(frac, big.NewRat(daysPerMonth, 1)))
+}
+
+func (iv *Interval) addDays(r *big.Rat) bool {
+	i, frac := splitRat(r)
+	if !i.IsInt64() {
+		return false
+	}
+	iv.days += i.Int64()
+	return iv.addNanoseconds(frac.Mul(frac, big.NewRat(nanosecondsPerDay, 1)))
+}
+
+func (iv *Interval) addNanoseconds(r *big.Rat) bool {
+	n := RoundQuotient(r.Num(), r.Denom())
+	if !n.IsInt64() {
+		return false
+	}
+	iv.nanoseconds += n.Int64()
+	return true
+}
+
+// splitRat returns the integer part truncated toward zero and the fractional part.
+func splitRat(r *big.Rat) (*big.Int, *big.Rat) {
+	i := new(big.Int).Quo(r.Num(), r.Denom())
+	return i, new(big.Rat).Sub(r, new(big.Rat).SetInt(i))
+}
+
+// Array is an ordered list of values.
+type Array struct {
+	values []Primary
+}
+
+func NewArray(values []Primary) *Array {
+	return &Array{
+		values: values,
+	}
+}
+
+func (a Array) String() string {
+	elems := make([]string, len(a.values))
+	for i, v := range a.values {
+		elems[i] = v.String()
+	}
+	return "ARRAY[" + strings.Join(elems, ", ") + "]"
+}
+
+// Encode returns the array in JSON notation.
+func (a Array) Encode() string {
+	return ToJsonStructure(&a).Encode()
+}
+
+func (a Array) Len() int {
+	return len(a.values)
+}
+
+func (a Array) Values() []Primary {
+	return a.values
+}
+
+// Index returns the value at the zero-based index i, or a null if the index is out of range.
+func (a Array) Index(i int) Primary {
+	if i < 0 || len(a.values) <= i {
+		return NewNull()
+	}
+	return a.values[i]
+}
+
+func (a Array) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
+// Map is a set of key-value pairs that preserves the order in which the keys were added.
+type Map struct {
+	keys   []string
+	values map[string]Primary
+}
+
+func NewMap(capacity int) *Map {
+	return &Map{
+		keys:   make([]string, 0, capacity),
+		values: make(map[string]Primary, capacity),
+	}
+}
+
+func (m Map) String() string {
+	elems := make([]string, len(m.keys))
+	for i, k := range m.keys {
+		elems[i] = option.QuoteString(k) + ": " + m.values[k].String()
+	}
+	return "MAP{" + strings.Join(elems, ", ") + "}"
+}
+
+// Encode returns the map in JSON notation.
+func (m Map) Encode() string {
+	return ToJsonStructure(&m).Encode()
+}
+
+func (m *Map) Set(key string, val Primary) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = val
+}
+
+func (m Map) Get(key string) (Primary, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+func (m Map) Keys() []string {
+	return m.keys
+}
+
+func (m Map) Len() int {
+	return len(m.keys)
+}
+
+func (m Map) Ternary() ternary.Value {
+	return ternary.UNKNOWN
+}
+
 type Null struct{}
 
 func NewNull() *Null {
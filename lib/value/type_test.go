@@ -1,6 +1,8 @@
 package value
 
 import (
+	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
@@ -163,6 +165,147 @@ func TestFloat_Ternary(t *testing.T) {
 	}
 }
 
+func TestDecimal_String(t *testing.T) {
+	for s, expect := range map[string]string{
+		"1.230":   "1.230",
+		"-0.05":   "-0.05",
+		".5":      "0.5",
+		"12":      "12",
+		"1.5e3":   "1500",
+		"-12e-4":  "-0.0012",
+		"+007.10": "7.10",
+	} {
+		p := NewDecimalFromString(s)
+		if p.String() != expect {
+			t.Errorf("string = %q, want %q for %q", p.String(), expect, s)
+		}
+	}
+}
+
+func TestDecimal_Rescale(t *testing.T) {
+	for _, v := range []struct {
+		Value  string
+		Scale  int
+		Expect string
+	}{
+		{Value: "1.005", Scale: 2, Expect: "1.01"},
+		{Value: "-1.005", Scale: 2, Expect: "-1.01"},
+		{Value: "1.004", Scale: 2, Expect: "1.00"},
+		{Value: "1.5", Scale: 3, Expect: "1.500"},
+		{Value: "2.5", Scale: 0, Expect: "3"},
+	} {
+		p := NewDecimalFromString(v.Value).Rescale(v.Scale)
+		if p.String() != v.Expect {
+			t.Errorf("result = %q, want %q for %q with scale %d", p.String(), v.Expect, v.Value, v.Scale)
+		}
+	}
+}
+
+func TestDecimal_Normalize(t *testing.T) {
+	p := NewDecimalFromString("12.3400").Normalize()
+	if p.String() != "12.34" {
+		t.Errorf("result = %q, want %q", p.String(), "12.34")
+	}
+	p = NewDecimalFromString("100.00").Normalize()
+	if p.String() != "100" {
+		t.Errorf("result = %q, want %q", p.String(), "100")
+	}
+}
+
+func TestDecimal_Ternary(t *testing.T) {
+	p := NewDecimalFromString("1.00")
+	if p.Ternary() != ternary.TRUE {
+		t.Errorf("ternary = %s, want %s for %s", p.Ternary(), ternary.TRUE, p)
+	}
+	p = NewDecimalFromString("0.0")
+	if p.Ternary() != ternary.FALSE {
+		t.Errorf("ternary = %s, want %s for %s", p.Ternary(), ternary.FALSE, p)
+	}
+	p = NewDecimalFromString("0.5")
+	if p.Ternary() != ternary.UNKNOWN {
+		t.Errorf("ternary = %s, want %s for %s", p.Ternary(), ternary.UNKNOWN, p)
+	}
+}
+
+func TestInterval_Format(t *testing.T) {
+	for _, v := range []struct {
+		Value  *Interval
+		Expect string
+	}{
+		{Value: NewInterval(14, 3, 14706500000000), Expect: "1 year 2 months 3 days 04:05:06.5"},
+		{Value: NewInterval(0, 1, 0), Expect: "1 day"},
+		{Value: NewInterval(-1, -2, -1000000000), Expect: "-1 month -2 days -00:00:01"},
+		{Value: NewInterval(0, 0, 0), Expect: "00:00:00"},
+	} {
+		if v.Value.Format() != v.Expect {
+			t.Errorf("result = %q, want %q for %#v", v.Value.Format(), v.Expect, v.Value)
+		}
+	}
+}
+
+func TestInterval_Multiply(t *testing.T) {
+	iv, ok := NewInterval(3, 3, 0).Multiply(big.NewRat(1, 2))
+	expect := NewInterval(1, 16, 12*3600000000000)
+	if !ok || !reflect.DeepEqual(iv, expect) {
+		t.Errorf("result = %#v, want %#v", iv, expect)
+	}
+}
+
+func TestNewIntervalBetween(t *testing.T) {
+	t1 := time.Date(2012, 2, 3, 1, 0, 0, 0, time.UTC)
+	t2 := time.Date(2012, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	expect := NewInterval(0, 1, 13*3600000000000)
+	if iv := NewIntervalBetween(t1, t2); !reflect.DeepEqual(iv, expect) {
+		t.Errorf("result = %#v, want %#v", iv, expect)
+	}
+	expect = NewInterval(0, -1, -13*3600000000000)
+	if iv := NewIntervalBetween(t2, t1); !reflect.DeepEqual(iv, expect) {
+		t.Errorf("result = %#v, want %#v", iv, expect)
+	}
+}
+
+func TestArray_String(t *testing.T) {
+	p := NewArray([]Primary{NewString("a"), NewInteger(1), NewNull()})
+	expect := "ARRAY['a', 1, NULL]"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+	expect = "[\"a\",1,null]"
+	if p.Encode() != expect {
+		t.Errorf("encoded string = %q, want %q for %#v", p.Encode(), expect, p)
+	}
+}
+
+func TestArray_Index(t *testing.T) {
+	p := NewArray([]Primary{NewString("a"), NewString("b")})
+	if v := p.Index(1); !reflect.DeepEqual(v, NewString("b")) {
+		t.Errorf("value = %s, want %s for index 1", v, NewString("b"))
+	}
+	if v := p.Index(2); !IsNull(v) {
+		t.Errorf("value = %s, want NULL for index 2", v)
+	}
+	if v := p.Index(-1); !IsNull(v) {
+		t.Errorf("value = %s, want NULL for index -1", v)
+	}
+}
+
+func TestMap_String(t *testing.T) {
+	p := NewMap(2)
+	p.Set("b", NewInteger(1))
+	p.Set("a", NewArray([]Primary{NewBoolean(true)}))
+	p.Set("b", NewInteger(2))
+
+	expect := "MAP{'b': 2, 'a': ARRAY[true]}"
+	if p.String() != expect {
+		t.Errorf("string = %q, want %q for %#v", p.String(), expect, p)
+	}
+	expect = "{\"b\":2,\"a\":[true]}"
+	if p.Encode() != expect {
+		t.Errorf("encoded string = %q, want %q for %#v", p.Encode(), expect, p)
+	}
+}
+
 func TestBoolean_String(t *testing.T) {
 	s := "true"
 	p := NewBoolean(true)
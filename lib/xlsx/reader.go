@@ -0,0 +1,475 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+const (
+	workbookPath = "xl/workbook.xml"
+	relsPath     = "xl/_rels/workbook.xml.rels"
+)
+
+type xlsxWorkbook struct {
+	WorkbookPr struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		Id   string `xml:"id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		Id     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxText struct {
+	T    *string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if t.T != nil {
+		return *t.T
+	}
+
+	var buf strings.Builder
+	for _, r := range t.Runs {
+		buf.WriteString(r.T)
+	}
+	return buf.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxStyleSheet struct {
+	NumFmts []struct {
+		Id         int    `xml:"numFmtId,attr"`
+		FormatCode string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtId int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxRow struct {
+	R     int `xml:"r,attr"`
+	Cells []struct {
+		R  string    `xml:"r,attr"`
+		S  int       `xml:"s,attr"`
+		T  string    `xml:"t,attr"`
+		V  *string   `xml:"v"`
+		Is *xlsxText `xml:"is"`
+	} `xml:"c"`
+}
+
+type sheet struct {
+	Name string
+	Path string
+}
+
+type workbook struct {
+	files         map[string]*zip.File
+	sheets        []sheet
+	sharedStrings []string
+	dateStyles    []bool
+	date1904      bool
+}
+
+func openWorkbook(r io.ReaderAt, size int64) (*workbook, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+	}
+
+	wb := &workbook{
+		files: make(map[string]*zip.File, len(zr.File)),
+	}
+	for _, f := range zr.File {
+		wb.files[strings.TrimPrefix(f.Name, "/")] = f
+	}
+
+	if _, ok := wb.files[workbookPath]; !ok {
+		return nil, errors.New("invalid workbook: workbook part does not exist")
+	}
+	if err = wb.readSheets(); err != nil {
+		return nil, err
+	}
+	if err = wb.readSharedStrings(); err != nil {
+		return nil, err
+	}
+	if err = wb.readStyles(); err != nil {
+		return nil, err
+	}
+	return wb, nil
+}
+
+func (wb *workbook) decode(name string, v interface{}) error {
+	f, ok := wb.files[name]
+	if !ok {
+		return nil
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+	}
+	defer func() { _ = r.Close() }()
+
+	if err = xml.NewDecoder(r).Decode(v); err != nil {
+		return errors.New(fmt.Sprintf("invalid workbook: %s: %s", name, err.Error()))
+	}
+	return nil
+}
+
+func (wb *workbook) readSheets() error {
+	book := xlsxWorkbook{}
+	if err := wb.decode(workbookPath, &book); err != nil {
+		return err
+	}
+	wb.date1904 = book.WorkbookPr.Date1904 == "1" || strings.EqualFold(book.WorkbookPr.Date1904, "true")
+
+	rels := xlsxRelationships{}
+	if err := wb.decode(relsPath, &rels); err != nil {
+		return err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.Id] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.Id] = path.Join(path.Dir(workbookPath), rel.Target)
+		}
+	}
+
+	wb.sheets = make([]sheet, 0, len(book.Sheets))
+	for _, s := range book.Sheets {
+		wb.sheets = append(wb.sheets, sheet{Name: s.Name, Path: targets[s.Id]})
+	}
+	return nil
+}
+
+func (wb *workbook) readSharedStrings() error {
+	sst := xlsxSharedStrings{}
+	if err := wb.decode("xl/sharedStrings.xml", &sst); err != nil {
+		return err
+	}
+
+	wb.sharedStrings = make([]string, len(sst.Items))
+	for i := range sst.Items {
+		wb.sharedStrings[i] = sst.Items[i].String()
+	}
+	return nil
+}
+
+func (wb *workbook) readStyles() error {
+	styles := xlsxStyleSheet{}
+	if err := wb.decode("xl/styles.xml", &styles); err != nil {
+		return err
+	}
+
+	formatCodes := make(map[int]string, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		formatCodes[f.Id] = f.FormatCode
+	}
+
+	wb.dateStyles = make([]bool, len(styles.CellXfs))
+	for i, xf := range styles.CellXfs {
+		if code, ok := formatCodes[xf.NumFmtId]; ok {
+			wb.dateStyles[i] = isDateFormatCode(code)
+		} else {
+			wb.dateStyles[i] = isBuiltInDateFormat(xf.NumFmtId)
+		}
+	}
+	return nil
+}
+
+// lookupSheet returns the index of the sheet.
+// A sheet is searched by its name first, then an integer is treated as the position of the sheet starting with 1.
+// An empty string means the first sheet.
+func (wb *workbook) lookupSheet(name string) (int, error) {
+	if len(wb.sheets) < 1 {
+		return -1, errors.New("workbook has no sheets")
+	}
+
+	if len(name) < 1 {
+		return 0, nil
+	}
+
+	for i := range wb.sheets {
+		if wb.sheets[i].Name == name {
+			return i, nil
+		}
+	}
+	for i := range wb.sheets {
+		if strings.EqualFold(wb.sheets[i].Name, name) {
+			return i, nil
+		}
+	}
+	if n, err := strconv.Atoi(name); err == nil && 0 < n && n <= len(wb.sheets) {
+		return n - 1, nil
+	}
+	return -1, errors.New(fmt.Sprintf("sheet %s does not exist", name))
+}
+
+func (wb *workbook) cellValue(t string, s int, v *string, is *xlsxText, loc *time.Location) (value.Primary, error) {
+	switch t {
+	case "inlineStr":
+		if is == nil {
+			return value.NewNull(), nil
+		}
+		return value.NewString(is.String()), nil
+	}
+
+	if v == nil {
+		return value.NewNull(), nil
+	}
+
+	switch t {
+	case "s":
+		i, err := strconv.Atoi(*v)
+		if err != nil || i < 0 || len(wb.sharedStrings) <= i {
+			return nil, errors.New(fmt.Sprintf("invalid shared string index: %s", *v))
+		}
+		return value.NewString(wb.sharedStrings[i]), nil
+	case "str":
+		return value.NewString(*v), nil
+	case "b":
+		return value.NewBoolean(*v == "1" || strings.EqualFold(*v, "true")), nil
+	case "e":
+		return value.NewNull(), nil
+	case "d":
+		for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05", "2006-01-02", "15:04:05"} {
+			if dt, err := time.ParseInLocation(layout, *v, loc); err == nil {
+				return value.NewDatetime(dt), nil
+			}
+		}
+		return value.NewString(*v), nil
+	}
+
+	if 0 <= s && s < len(wb.dateStyles) && wb.dateStyles[s] {
+		if f, err := strconv.ParseFloat(*v, 64); err == nil {
+			return value.NewDatetime(SerialToTime(f, wb.date1904, loc)), nil
+		}
+	}
+	if i, err := strconv.ParseInt(*v, 10, 64); err == nil {
+		return value.NewInteger(i), nil
+	}
+	if f, err := strconv.ParseFloat(*v, 64); err == nil {
+		return value.NewFloat(f), nil
+	}
+	return value.NewString(*v), nil
+}
+
+type cell struct {
+	Column int
+	Value  value.Primary
+}
+
+type row struct {
+	Number int
+	Cells  []cell
+}
+
+func (wb *workbook) readSheet(index int, rng Range, loc *time.Location) ([]row, error) {
+	f, ok := wb.files[wb.sheets[index].Path]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("invalid workbook: sheet %s does not exist", wb.sheets[index].Name))
+	}
+
+	r, err := f.Open()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+	}
+	defer func() { _ = r.Close() }()
+
+	rows := make([]row, 0, 64)
+	decoder := xml.NewDecoder(r)
+	rowNumber := 0
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		xr := xlsxRow{}
+		if err = decoder.DecodeElement(&xr, &start); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+		}
+
+		if 0 < xr.R {
+			rowNumber = xr.R
+		} else {
+			rowNumber++
+		}
+		if !rng.containsRow(rowNumber) {
+			continue
+		}
+
+		rw := row{Number: rowNumber, Cells: make([]cell, 0, len(xr.Cells))}
+		colNumber := 0
+		for _, c := range xr.Cells {
+			if 0 < len(c.R) {
+				col, _, err := parseCellReference(c.R)
+				if err != nil {
+					return nil, errors.New(fmt.Sprintf("invalid workbook: invalid cell reference %s", c.R))
+				}
+				colNumber = col
+			} else {
+				colNumber++
+			}
+			if !rng.containsColumn(colNumber) {
+				continue
+			}
+
+			val, err := wb.cellValue(c.T, c.S, c.V, c.Is, loc)
+			if err != nil {
+				return nil, errors.New(fmt.Sprintf("invalid workbook: %s", err.Error()))
+			}
+			if value.IsNull(val) {
+				continue
+			}
+			rw.Cells = append(rw.Cells, cell{Column: colNumber, Value: val})
+		}
+		if 0 < len(rw.Cells) {
+			rows = append(rows, rw)
+		}
+	}
+
+	return rows, nil
+}
+
+// LoadTable reads a sheet in a workbook, and returns the header and the records.
+//
+// If rng has unbounded edges, they are determined by the cells that have values.
+// If noHeader is false, the first row in the range is used as the header, and empty header cells
+// are named by their positions such as "c3".
+func LoadTable(r io.ReaderAt, size int64, sheetName string, rng Range, noHeader bool, withoutNull bool, loc *time.Location) ([]string, [][]value.Primary, error) {
+	wb, err := openWorkbook(r, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx, err := wb.lookupSheet(sheetName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := wb.readSheet(idx, rng, loc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	firstColumn, lastColumn := rng.FirstColumn, rng.LastColumn
+	if firstColumn == 0 || lastColumn == 0 {
+		minColumn, maxColumn := maxColumnNumber, 0
+		for i := range rows {
+			for _, c := range rows[i].Cells {
+				if c.Column < minColumn {
+					minColumn = c.Column
+				}
+				if maxColumn < c.Column {
+					maxColumn = c.Column
+				}
+			}
+		}
+		if firstColumn == 0 {
+			firstColumn = minColumn
+			if maxColumn < minColumn {
+				firstColumn = 1
+			}
+		}
+		if lastColumn == 0 {
+			lastColumn = maxColumn
+		}
+	}
+
+	firstRow := rng.FirstRow
+	if firstRow == 0 {
+		firstRow = 1
+		if 0 < len(rows) {
+			firstRow = rows[0].Number
+		}
+	}
+
+	fieldLen := lastColumn - firstColumn + 1
+	if fieldLen < 0 {
+		fieldLen = 0
+	}
+
+	newRecord := func() []value.Primary {
+		record := make([]value.Primary, fieldLen)
+		for i := range record {
+			if withoutNull {
+				record[i] = value.NewString("")
+			} else {
+				record[i] = value.NewNull()
+			}
+		}
+		return record
+	}
+
+	var header []string
+	if !noHeader {
+		header = make([]string, fieldLen)
+		if 0 < len(rows) && rows[0].Number == firstRow {
+			for _, c := range rows[0].Cells {
+				if s := value.ToString(c.Value); !value.IsNull(s) {
+					header[c.Column-firstColumn] = s.(*value.String).Raw()
+				}
+			}
+			rows = rows[1:]
+		}
+		firstRow++
+	} else {
+		header = make([]string, fieldLen)
+	}
+	for i := range header {
+		if len(header[i]) < 1 {
+			header[i] = "c" + strconv.Itoa(i+1)
+		}
+	}
+
+	records := make([][]value.Primary, 0, len(rows))
+	nextRow := firstRow
+	for _, rw := range rows {
+		for ; nextRow < rw.Number; nextRow++ {
+			records = append(records, newRecord())
+		}
+
+		record := newRecord()
+		for _, c := range rw.Cells {
+			record[c.Column-firstColumn] = c.Value
+		}
+		records = append(records, record)
+		nextRow = rw.Number + 1
+	}
+
+	return header, records, nil
+}
@@ -0,0 +1,194 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var testWorkbookParts = map[string]string{
+	"[Content_Types].xml": contentTypesXml,
+	"_rels/.rels":         rootRelsXml,
+	"xl/workbook.xml": xmlHeader +
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sales" sheetId="1" r:id="rId1"/><sheet name="Empty" sheetId="2" r:id="rId2"/></sheets>` +
+		`</workbook>`,
+	"xl/_rels/workbook.xml.rels": xmlHeader +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet2.xml"/>` +
+		`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>` +
+		`</Relationships>`,
+	"xl/sharedStrings.xml": xmlHeader +
+		`<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="5" uniqueCount="5">` +
+		`<si><t>id</t></si>` +
+		`<si><t>name</t></si>` +
+		`<si><t>date</t></si>` +
+		`<si><r><t>str</t></r><r><rPr><b/></rPr><t>1</t></r></si>` +
+		`<si><t>str2</t><rPh><t>phonetic</t></rPh></si>` +
+		`</sst>`,
+	"xl/styles.xml": xmlHeader +
+		`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy/mm/dd hh:mm"/></numFmts>` +
+		`<cellXfs count="4"><xf numFmtId="0"/><xf numFmtId="164"/><xf numFmtId="14"/><xf numFmtId="4"/></cellXfs>` +
+		`</styleSheet>`,
+	"xl/worksheets/sheet1.xml": xmlHeader +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+		`<row r="2"><c r="B2" t="s"><v>0</v></c><c r="C2" t="s"><v>1</v></c><c r="D2" t="s"><v>2</v></c><c r="F2" t="inlineStr"><is><t>flag</t></is></c></row>` +
+		`<row r="3"><c r="B3"><v>1</v></c><c r="C3" t="s"><v>3</v></c><c r="D3" s="1"><v>45292.75</v></c><c r="E3" s="3"><v>1.5</v></c><c r="F3" t="b"><v>1</v></c></row>` +
+		`<row r="5"><c r="B5"><v>2</v></c><c r="C5" t="s"><v>4</v></c><c r="D5" s="2"><v>45293</v></c><c r="E5" t="e"><v>#DIV/0!</v></c><c r="F5" t="b"><v>0</v></c></row>` +
+		`<row><c r="B6"><v>3</v></c><c t="str"><v>formula</v></c></row>` +
+		`</sheetData></worksheet>`,
+	"xl/worksheets/sheet2.xml": xmlHeader +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData/></worksheet>`,
+}
+
+func createTestWorkbook(t *testing.T, parts map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+var loadTableTests = []struct {
+	Name        string
+	Sheet       string
+	Range       Range
+	NoHeader    bool
+	WithoutNull bool
+	Header      []string
+	Records     [][]value.Primary
+	Error       string
+}{
+	{
+		Name:   "LoadTable",
+		Header: []string{"id", "name", "date", "c4", "flag"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("str1"), value.NewDatetime(time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)), value.NewFloat(1.5), value.NewBoolean(true)},
+			{value.NewNull(), value.NewNull(), value.NewNull(), value.NewNull(), value.NewNull()},
+			{value.NewInteger(2), value.NewString("str2"), value.NewDatetime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), value.NewNull(), value.NewBoolean(false)},
+			{value.NewInteger(3), value.NewString("formula"), value.NewNull(), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:   "LoadTable Specify Sheet by Position",
+		Sheet:  "1",
+		Header: []string{"id", "name", "date", "c4", "flag"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("str1"), value.NewDatetime(time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)), value.NewFloat(1.5), value.NewBoolean(true)},
+			{value.NewNull(), value.NewNull(), value.NewNull(), value.NewNull(), value.NewNull()},
+			{value.NewInteger(2), value.NewString("str2"), value.NewDatetime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), value.NewNull(), value.NewBoolean(false)},
+			{value.NewInteger(3), value.NewString("formula"), value.NewNull(), value.NewNull(), value.NewNull()},
+		},
+	},
+	{
+		Name:        "LoadTable with Range",
+		Sheet:       "sales",
+		Range:       Range{FirstColumn: 1, FirstRow: 3, LastColumn: 3, LastRow: 5},
+		NoHeader:    true,
+		WithoutNull: true,
+		Header:      []string{"c1", "c2", "c3"},
+		Records: [][]value.Primary{
+			{value.NewString(""), value.NewInteger(1), value.NewString("str1")},
+			{value.NewString(""), value.NewString(""), value.NewString("")},
+			{value.NewString(""), value.NewInteger(2), value.NewString("str2")},
+		},
+	},
+	{
+		Name:    "LoadTable Empty Sheet",
+		Sheet:   "Empty",
+		Header:  []string{},
+		Records: [][]value.Primary{},
+	},
+	{
+		Name:  "LoadTable Sheet Not Exist Error",
+		Sheet: "notexist",
+		Error: "sheet notexist does not exist",
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	data := createTestWorkbook(t, testWorkbookParts)
+
+	for _, v := range loadTableTests {
+		header, records, err := LoadTable(bytes.NewReader(data), int64(len(data)), v.Sheet, v.Range, v.NoHeader, v.WithoutNull, time.UTC)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %s, want %s", v.Name, records, v.Records)
+		}
+	}
+}
+
+func TestLoadTable_InvalidWorkbook(t *testing.T) {
+	data := []byte("column1,column2\n")
+	_, _, err := LoadTable(bytes.NewReader(data), int64(len(data)), "", Range{}, false, false, time.UTC)
+	if err == nil || err.Error() != "invalid workbook: zip: not a valid zip file" {
+		t.Errorf("error = %v, want %q", err, "invalid workbook: zip: not a valid zip file")
+	}
+}
+
+func TestWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(buf, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := []string{"column1", "column2", "column3", "column4", "column5"}
+	records := [][]value.Primary{
+		{value.NewInteger(1), value.NewString("<str1> & \"str\""), value.NewFloat(1.25), value.NewBoolean(true), value.NewDatetime(time.Date(2024, 1, 1, 18, 30, 15, 0, time.UTC))},
+		{value.NewInteger(-2), value.NewNull(), value.NewFloat(-0.5), value.NewBoolean(false), value.NewNull()},
+	}
+
+	if err = w.WriteHeader(header); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range records {
+		if err = w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	resultHeader, resultRecords, err := LoadTable(bytes.NewReader(buf.Bytes()), int64(buf.Len()), DefaultSheetName, Range{}, false, false, time.UTC)
+	if err != nil {
+		t.Fatalf("unexpected error %q", err)
+	}
+	if !reflect.DeepEqual(resultHeader, header) {
+		t.Errorf("header = %q, want %q", resultHeader, header)
+	}
+	if !reflect.DeepEqual(resultRecords, records) {
+		t.Errorf("records = %s, want %s", resultRecords, records)
+	}
+}
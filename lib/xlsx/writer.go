@@ -0,0 +1,221 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const contentTypesXml = xmlHeader +
+	`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const rootRelsXml = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const workbookRelsXml = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const workbookXml = xmlHeader +
+	`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+// Cell styles defined in stylesXml.
+const (
+	defaultStyle  = 0
+	datetimeStyle = 1
+	headerStyle   = 2
+)
+
+const stylesXml = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+// Writer writes a workbook that has a single sheet.
+// The sheet is written row by row, and the workbook is completed by Close.
+type Writer struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+
+	rowNumber int
+}
+
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	if len(sheetName) < 1 {
+		sheetName = DefaultSheetName
+	}
+
+	zw := zip.NewWriter(w)
+
+	parts := []struct {
+		Name    string
+		Content string
+	}{
+		{Name: "[Content_Types].xml", Content: contentTypesXml},
+		{Name: "_rels/.rels", Content: rootRelsXml},
+		{Name: "xl/workbook.xml", Content: strings.Replace(workbookXml, "%s", escapeString(sheetName), 1)},
+		{Name: "xl/_rels/workbook.xml.rels", Content: workbookRelsXml},
+		{Name: "xl/styles.xml", Content: stylesXml},
+	}
+	for _, part := range parts {
+		pw, err := zw.Create(part.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.WriteString(pw, part.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &Writer{
+		zw:    zw,
+		sheet: bufio.NewWriter(sw),
+	}
+	if _, err = writer.sheet.WriteString(xmlHeader + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// WriteHeader writes a row of column names with the header style.
+func (w *Writer) WriteHeader(fields []string) error {
+	w.startRow()
+	for i := range fields {
+		w.writeInlineString(i, fields[i], headerStyle)
+	}
+	return w.endRow()
+}
+
+// Write writes a row of values.
+// Null values are written as empty cells.
+func (w *Writer) Write(values []value.Primary) error {
+	w.startRow()
+	for i := range values {
+		w.writeValue(i, values[i])
+	}
+	return w.endRow()
+}
+
+func (w *Writer) Close() error {
+	if _, err := w.sheet.WriteString(`</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+func (w *Writer) startRow() {
+	w.rowNumber++
+	_, _ = w.sheet.WriteString(`<row r="` + strconv.Itoa(w.rowNumber) + `">`)
+}
+
+func (w *Writer) endRow() error {
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (w *Writer) cellReference(col int) string {
+	return ColumnName(col+1) + strconv.Itoa(w.rowNumber)
+}
+
+func (w *Writer) writeValue(col int, val value.Primary) {
+	switch v := val.(type) {
+	case *value.String:
+		w.writeInlineString(col, v.Raw(), defaultStyle)
+	case *value.Integer:
+		w.writeNumber(col, strconv.FormatInt(v.Raw(), 10), defaultStyle)
+	case *value.Float:
+		if math.IsNaN(v.Raw()) || math.IsInf(v.Raw(), 0) {
+			w.writeInlineString(col, v.String(), defaultStyle)
+		} else {
+			w.writeNumber(col, strconv.FormatFloat(v.Raw(), 'g', -1, 64), defaultStyle)
+		}
+	case *value.Decimal:
+		w.writeNumber(col, v.String(), defaultStyle)
+	case *value.Interval:
+		w.writeInlineString(col, v.Format(), defaultStyle)
+	case *value.Array:
+		w.writeInlineString(col, v.Encode(), defaultStyle)
+	case *value.Map:
+		w.writeInlineString(col, v.Encode(), defaultStyle)
+	case *value.Datetime:
+		w.writeNumber(col, strconv.FormatFloat(TimeToSerial(v.Raw()), 'f', -1, 64), datetimeStyle)
+	case *value.Boolean:
+		w.writeBoolean(col, v.Raw())
+	case *value.Ternary:
+		if v.Ternary() != ternary.UNKNOWN {
+			w.writeBoolean(col, v.Ternary() == ternary.TRUE)
+		}
+	}
+}
+
+func (w *Writer) writeStyle(style int) {
+	if style != defaultStyle {
+		_, _ = w.sheet.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+}
+
+func (w *Writer) writeInlineString(col int, s string, style int) {
+	_, _ = w.sheet.WriteString(`<c r="` + w.cellReference(col) + `"`)
+	w.writeStyle(style)
+	_, _ = w.sheet.WriteString(` t="inlineStr"><is><t xml:space="preserve">` + escapeString(s) + `</t></is></c>`)
+}
+
+func (w *Writer) writeNumber(col int, s string, style int) {
+	_, _ = w.sheet.WriteString(`<c r="` + w.cellReference(col) + `"`)
+	w.writeStyle(style)
+	_, _ = w.sheet.WriteString(`><v>` + s + `</v></c>`)
+}
+
+func (w *Writer) writeBoolean(col int, b bool) {
+	s := "0"
+	if b {
+		s = "1"
+	}
+	_, _ = w.sheet.WriteString(`<c r="` + w.cellReference(col) + `" t="b"><v>` + s + `</v></c>`)
+}
+
+func escapeString(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
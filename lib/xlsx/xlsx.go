@@ -0,0 +1,180 @@
+package xlsx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultSheetName = "Sheet1"
+
+const (
+	maxColumnNumber = 16384
+	maxRowNumber    = 1048576
+)
+
+const (
+	dayInMicroseconds = 24 * 60 * 60 * 1000000
+
+	// Excel incorrectly treats 1900 as a leap year, so serial numbers before 1900-03-01 are shifted by one day.
+	leapYearBugSerial = 61
+)
+
+var (
+	epoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	epoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// Range represents a rectangular area of cells.
+// Column and row numbers start with 1, and 0 means the range is not bounded in the direction.
+type Range struct {
+	FirstColumn int
+	FirstRow    int
+	LastColumn  int
+	LastRow     int
+}
+
+func (r Range) containsRow(row int) bool {
+	return r.FirstRow <= row && (r.LastRow == 0 || row <= r.LastRow)
+}
+
+func (r Range) containsColumn(col int) bool {
+	return r.FirstColumn <= col && (r.LastColumn == 0 || col <= r.LastColumn)
+}
+
+// ParseRange parses a string of a range of cells in A1 notation such as "A1:F200".
+// Either the column or the row of each cell reference can be omitted, such as "B:D" or "A2:F".
+// A single cell reference means the range starting from the cell.
+// An empty string means the range that is not bounded at all.
+func ParseRange(s string) (Range, error) {
+	rng := Range{}
+
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return rng, nil
+	}
+
+	refs := strings.Split(s, ":")
+	if 2 < len(refs) {
+		return rng, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+	}
+
+	var err error
+	if rng.FirstColumn, rng.FirstRow, err = parseCellReference(refs[0]); err != nil {
+		return rng, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+	}
+	if len(refs) == 2 {
+		if rng.LastColumn, rng.LastRow, err = parseCellReference(refs[1]); err != nil {
+			return rng, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+		}
+	}
+
+	if (0 < rng.LastColumn && rng.LastColumn < rng.FirstColumn) || (0 < rng.LastRow && rng.LastRow < rng.FirstRow) {
+		return rng, errors.New(fmt.Sprintf("invalid cell range: %s", s))
+	}
+	return rng, nil
+}
+
+func parseCellReference(s string) (int, int, error) {
+	s = strings.ToUpper(strings.TrimSpace(strings.ReplaceAll(s, "$", "")))
+
+	i := 0
+	col := 0
+	for ; i < len(s) && 'A' <= s[i] && s[i] <= 'Z'; i++ {
+		col = col*26 + int(s[i]-'A'+1)
+		if maxColumnNumber < col {
+			return 0, 0, errors.New("column number is out of range")
+		}
+	}
+
+	row := 0
+	if i < len(s) {
+		n, err := strconv.Atoi(s[i:])
+		if err != nil || n < 1 || maxRowNumber < n {
+			return 0, 0, errors.New("invalid row number")
+		}
+		row = n
+	}
+
+	if col == 0 && row == 0 {
+		return 0, 0, errors.New("empty cell reference")
+	}
+	return col, row, nil
+}
+
+// ColumnName returns the name of the column in A1 notation.
+func ColumnName(col int) string {
+	var buf []byte
+	for 0 < col {
+		col--
+		buf = append([]byte{byte('A' + col%26)}, buf...)
+		col = col / 26
+	}
+	return string(buf)
+}
+
+// SerialToTime converts a serial number of a date in a workbook to the time in the location.
+func SerialToTime(serial float64, date1904 bool, loc *time.Location) time.Time {
+	epoch := epoch1900
+	if date1904 {
+		epoch = epoch1904
+	} else if serial < leapYearBugSerial {
+		serial = serial + 1
+	}
+
+	days := math.Floor(serial)
+	t := epoch.AddDate(0, 0, int(days)).Add(time.Duration(math.Round((serial-days)*dayInMicroseconds)) * time.Microsecond)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// TimeToSerial converts the wall clock of the time to a serial number of a date in the 1900 date system.
+func TimeToSerial(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	serial := (float64(wall.Unix()-epoch1900.Unix()) + float64(wall.Nanosecond())/1e9) / 86400
+	if serial < leapYearBugSerial {
+		serial = serial - 1
+	}
+	return serial
+}
+
+func isBuiltInDateFormat(numFmtId int) bool {
+	return (14 <= numFmtId && numFmtId <= 22) ||
+		(27 <= numFmtId && numFmtId <= 36) ||
+		(45 <= numFmtId && numFmtId <= 47) ||
+		(50 <= numFmtId && numFmtId <= 58)
+}
+
+// isDateFormatCode returns whether the number format code represents dates or times.
+func isDateFormatCode(code string) bool {
+	if i := strings.IndexByte(code, ';'); -1 < i {
+		code = code[:i]
+	}
+
+	runes := []rune(strings.ToLower(code))
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+			}
+		case '\\', '_', '*':
+			i++
+		case '[':
+			end := i + 1
+			for ; end < len(runes) && runes[end] != ']'; end++ {
+			}
+			if end < len(runes) {
+				switch string(runes[i+1 : end]) {
+				case "h", "hh", "m", "mm", "s", "ss":
+					return true
+				}
+			}
+			i = end
+		case 'y', 'm', 'd', 'h', 's':
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package xlsx
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var parseRangeTests = []struct {
+	Range  string
+	Result Range
+	Error  string
+}{
+	{
+		Range:  "",
+		Result: Range{},
+	},
+	{
+		Range:  "A1:F200",
+		Result: Range{FirstColumn: 1, FirstRow: 1, LastColumn: 6, LastRow: 200},
+	},
+	{
+		Range:  "$b$2:$aa$10",
+		Result: Range{FirstColumn: 2, FirstRow: 2, LastColumn: 27, LastRow: 10},
+	},
+	{
+		Range:  "B:D",
+		Result: Range{FirstColumn: 2, LastColumn: 4},
+	},
+	{
+		Range:  "3:10",
+		Result: Range{FirstRow: 3, LastRow: 10},
+	},
+	{
+		Range:  "C5",
+		Result: Range{FirstColumn: 3, FirstRow: 5},
+	},
+	{
+		Range: "A1:B2:C3",
+		Error: "invalid cell range: A1:B2:C3",
+	},
+	{
+		Range: "F1:A10",
+		Error: "invalid cell range: F1:A10",
+	},
+	{
+		Range: "A0",
+		Error: "invalid cell range: A0",
+	},
+	{
+		Range: "A1:",
+		Error: "invalid cell range: A1:",
+	},
+}
+
+func TestParseRange(t *testing.T) {
+	for _, v := range parseRangeTests {
+		result, err := ParseRange(v.Range)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Range)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Range)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Range)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %#v, want %#v for %q", result, v.Result, v.Range)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for col, expect := range map[int]string{1: "A", 26: "Z", 27: "AA", 52: "AZ", 703: "AAA", 16384: "XFD"} {
+		if result := ColumnName(col); result != expect {
+			t.Errorf("column name = %q, want %q for %d", result, expect, col)
+		}
+	}
+}
+
+var serialTests = []struct {
+	Serial   float64
+	Date1904 bool
+	Time     time.Time
+}{
+	{
+		Serial: 1,
+		Time:   time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial: 59.5,
+		Time:   time.Date(1900, 2, 28, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial: 61,
+		Time:   time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial: 45292.75,
+		Time:   time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC),
+	},
+	{
+		Serial:   43830.25,
+		Date1904: true,
+		Time:     time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestSerialToTime(t *testing.T) {
+	for _, v := range serialTests {
+		result := SerialToTime(v.Serial, v.Date1904, time.UTC)
+		if !result.Equal(v.Time) {
+			t.Errorf("time = %s, want %s for %f", result, v.Time, v.Serial)
+		}
+		if !v.Date1904 {
+			if serial := TimeToSerial(v.Time); serial != v.Serial {
+				t.Errorf("serial = %f, want %f for %s", serial, v.Serial, v.Time)
+			}
+		}
+	}
+}
+
+var isDateFormatCodeTests = []struct {
+	Code   string
+	Result bool
+}{
+	{Code: "General", Result: false},
+	{Code: "#,##0.00", Result: false},
+	{Code: "0.00E+00", Result: false},
+	{Code: `_("$"* #,##0.00_);_("$"* \(#,##0.00\)`, Result: false},
+	{Code: `[$-409]#,##0;[Red]-#,##0`, Result: false},
+	{Code: `"days: "0`, Result: false},
+	{Code: "yyyy-mm-dd", Result: true},
+	{Code: `[$-409]mmm d, yyyy`, Result: true},
+	{Code: "[h]:mm:ss", Result: true},
+	{Code: "h:mm AM/PM", Result: true},
+}
+
+func TestIsDateFormatCode(t *testing.T) {
+	for _, v := range isDateFormatCodeTests {
+		if result := isDateFormatCode(v.Code); result != v.Result {
+			t.Errorf("result = %t, want %t for %q", result, v.Result, v.Code)
+		}
+	}
+}
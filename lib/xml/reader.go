@@ -0,0 +1,222 @@
+package xml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+const wildcard = "*"
+
+// ParseRowPath parses a row path and returns the names of the elements from the child of the root element
+// to the elements of records.
+//
+// A row path is a sequence of element names separated by periods, and names can be enclosed in back quotes.
+// An asterisk matches any element, and an empty row path is the same as "*", that is, the children of the root element.
+// Namespace prefixes in names are ignored.
+func ParseRowPath(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 1 {
+		return []string{wildcard}, nil
+	}
+
+	names := make([]string, 0, 4)
+	var buf strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '`':
+			quoted = !quoted
+		case r == '.' && !quoted:
+			names = append(names, buf.String())
+			buf.Reset()
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, errors.New(fmt.Sprintf("row path %q is not terminated", s))
+	}
+	names = append(names, buf.String())
+
+	for i := range names {
+		names[i] = strings.TrimSpace(names[i])
+		if names[i] == wildcard {
+			continue
+		}
+		if !option.IsXmlName(names[i]) {
+			return nil, errors.New(fmt.Sprintf("row path %q contains invalid name %q", s, names[i]))
+		}
+		names[i] = localName(names[i])
+	}
+	return names, nil
+}
+
+func localName(s string) string {
+	if i := strings.LastIndexByte(s, ':'); -1 < i {
+		return s[i+1:]
+	}
+	return s
+}
+
+// LoadTable reads the elements specified by rowPath as records.
+//
+// Attributes of the elements and character data of the child elements are loaded as fields.
+// If a child element appears more than once in an element, the field is loaded as a JSON array.
+// Fields that do not exist in an element are loaded as nulls.
+func LoadTable(r io.Reader, rowPath string) ([]string, [][]value.Primary, error) {
+	path, err := ParseRowPath(rowPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := make([]string, 0, 16)
+	indices := make(map[string]int, 16)
+	records := make([]map[int][]string, 0, 64)
+
+	columnIndex := func(name string) int {
+		idx, ok := indices[name]
+		if !ok {
+			idx = len(header)
+			indices[name] = idx
+			header = append(header, name)
+		}
+		return idx
+	}
+
+	d := xml.NewDecoder(r)
+	depth := 0
+	matched := 0
+
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth < 2 || matched < depth-2 || len(path) < depth-1 {
+				continue
+			}
+			if path[depth-2] != wildcard && path[depth-2] != t.Name.Local {
+				continue
+			}
+			matched = depth - 1
+			if matched < len(path) {
+				continue
+			}
+
+			record, err := readRecord(d, t, columnIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			records = append(records, record)
+			depth--
+			matched--
+		case xml.EndElement:
+			if depth-1 <= matched {
+				matched = depth - 2
+			}
+			depth--
+		}
+	}
+
+	rows := make([][]value.Primary, len(records))
+	for i, record := range records {
+		row := make([]value.Primary, len(header))
+		for j := range header {
+			switch values := record[j]; len(values) {
+			case 0:
+				row[j] = value.NewNull()
+			case 1:
+				row[j] = value.NewString(values[0])
+			default:
+				elems := make(txjson.Array, len(values))
+				for k := range values {
+					elems[k] = txjson.String(values[k])
+				}
+				row[j] = value.NewString(elems.Encode())
+			}
+		}
+		rows[i] = row
+	}
+	return header, rows, nil
+}
+
+func readRecord(d *xml.Decoder, start xml.StartElement, columnIndex func(string) int) (map[int][]string, error) {
+	record := make(map[int][]string, len(start.Attr)+8)
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || (len(attr.Name.Space) < 1 && attr.Name.Local == "xmlns") {
+			continue
+		}
+		idx := columnIndex(attr.Name.Local)
+		record[idx] = append(record[idx], attr.Value)
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			s, err := readText(d)
+			if err != nil {
+				return nil, err
+			}
+			idx := columnIndex(t.Name.Local)
+			record[idx] = append(record[idx], s)
+		case xml.EndElement:
+			return record, nil
+		}
+	}
+}
+
+// readText returns the character data in the current element.
+// If the element has child elements, the character data in the descendants are concatenated and trimmed.
+func readText(d *xml.Decoder) (string, error) {
+	var buf strings.Builder
+	hasChild := false
+	depth := 1
+
+	for 0 < depth {
+		token, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.CharData:
+			buf.Write(t)
+		case xml.StartElement:
+			hasChild = true
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if hasChild {
+		return strings.TrimSpace(buf.String()), nil
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,147 @@
+package xml
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var parseRowPathTests = []struct {
+	Input  string
+	Result []string
+	Error  string
+}{
+	{
+		Input:  "",
+		Result: []string{"*"},
+	},
+	{
+		Input:  "items.item",
+		Result: []string{"items", "item"},
+	},
+	{
+		Input:  "* . ns:item",
+		Result: []string{"*", "item"},
+	},
+	{
+		Input:  "`items`.`item`",
+		Result: []string{"items", "item"},
+	},
+	{
+		Input: "items..item",
+		Error: "row path \"items..item\" contains invalid name \"\"",
+	},
+	{
+		Input: "`items.item",
+		Error: "row path \"`items.item\" is not terminated",
+	},
+}
+
+func TestParseRowPath(t *testing.T) {
+	for _, v := range parseRowPathTests {
+		result, err := ParseRowPath(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if !reflect.DeepEqual(result, v.Result) {
+			t.Errorf("result = %q, want %q for %q", result, v.Result, v.Input)
+		}
+	}
+}
+
+var loadTableTests = []struct {
+	Name    string
+	Input   string
+	RowPath string
+	Header  []string
+	Records [][]value.Primary
+	Error   string
+}{
+	{
+		Name: "LoadTable",
+		Input: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+			"<records xmlns=\"http://example.com/ns\">\n" +
+			"  <record id=\"1\">\n" +
+			"    <name>str1</name>\n" +
+			"    <tag>a</tag>\n" +
+			"    <tag>b</tag>\n" +
+			"  </record>\n" +
+			"  <record id=\"2\">\n" +
+			"    <name> str2 </name>\n" +
+			"    <note><b>bold</b> text</note>\n" +
+			"  </record>\n" +
+			"</records>\n",
+		Header: []string{"id", "name", "tag", "note"},
+		Records: [][]value.Primary{
+			{value.NewString("1"), value.NewString("str1"), value.NewString("[\"a\",\"b\"]"), value.NewNull()},
+			{value.NewString("2"), value.NewString(" str2 "), value.NewNull(), value.NewString("bold text")},
+		},
+	},
+	{
+		Name: "LoadTable with Row Path",
+		Input: "<catalog>" +
+			"<meta><item>ignored</item></meta>" +
+			"<items><item><id>1</id></item><other/><item><id>2</id></item></items>" +
+			"<items><item><id>3</id><name></name></item></items>" +
+			"</catalog>",
+		RowPath: "items.item",
+		Header:  []string{"id", "name"},
+		Records: [][]value.Primary{
+			{value.NewString("1"), value.NewNull()},
+			{value.NewString("2"), value.NewNull()},
+			{value.NewString("3"), value.NewString("")},
+		},
+	},
+	{
+		Name:    "LoadTable No Elements",
+		Input:   "<records/>",
+		Header:  []string{},
+		Records: [][]value.Primary{},
+	},
+	{
+		Name:    "LoadTable Invalid Row Path Error",
+		Input:   "<records/>",
+		RowPath: "1item",
+		Error:   "row path \"1item\" contains invalid name \"1item\"",
+	},
+	{
+		Name:  "LoadTable Syntax Error",
+		Input: "<records><record><id>1</id></records>",
+		Error: "XML syntax error on line 1: element <record> closed by </records>",
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	for _, v := range loadTableTests {
+		header, records, err := LoadTable(strings.NewReader(v.Input), v.RowPath)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %s, want %s", v.Name, records, v.Records)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package xml
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/option"
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+const Declaration = `<?xml version="1.0" encoding="UTF-8"?>`
+
+// FieldElement is the name of the elements of fields whose names are not valid xml names.
+// The field names are written as the name attribute of the elements.
+const FieldElement = "field"
+
+// Writer writes records as child elements of a root element.
+//
+// Each record is written as a row element, and each field in the record is written as a child element
+// of the row element, or as an attribute of the row element if UseAttributes is true.
+// Null fields are omitted.
+type Writer struct {
+	w           *bufio.Writer
+	rootElement string
+	rowElement  string
+	header      []string
+	started     bool
+
+	startTags []string
+	endTags   []string
+
+	UseAttributes bool
+	LineBreak     string
+}
+
+func NewWriter(w io.Writer, rootElement string, rowElement string, header []string) (*Writer, error) {
+	for _, name := range []string{rootElement, rowElement} {
+		if !option.IsXmlName(name) {
+			return nil, errors.New(fmt.Sprintf("%q is not a valid xml name", name))
+		}
+	}
+
+	startTags := make([]string, len(header))
+	endTags := make([]string, len(header))
+	for i, name := range header {
+		if option.IsXmlName(name) {
+			startTags[i] = "<" + name + ">"
+			endTags[i] = "</" + name + ">"
+		} else {
+			startTags[i] = "<" + FieldElement + " name=\"" + escapeString(name) + "\">"
+			endTags[i] = "</" + FieldElement + ">"
+		}
+	}
+
+	return &Writer{
+		w:           bufio.NewWriter(w),
+		rootElement: rootElement,
+		rowElement:  rowElement,
+		header:      header,
+		startTags:   startTags,
+		endTags:     endTags,
+		LineBreak:   "\n",
+	}, nil
+}
+
+func (w *Writer) start() {
+	if w.started {
+		return
+	}
+	w.w.WriteString(Declaration + w.LineBreak)
+	w.w.WriteString("<" + w.rootElement + ">")
+	w.started = true
+}
+
+func (w *Writer) Write(record []value.Primary) error {
+	w.start()
+
+	w.w.WriteString(w.LineBreak + "  <" + w.rowElement)
+
+	if w.UseAttributes {
+		for i := range w.header {
+			s, ok := stringValue(record[i])
+			if !ok {
+				continue
+			}
+			w.w.WriteString(" " + w.header[i] + "=\"")
+			if err := xml.EscapeText(w.w, []byte(s)); err != nil {
+				return err
+			}
+			w.w.WriteByte('"')
+		}
+		_, err := w.w.WriteString("/>")
+		return err
+	}
+
+	w.w.WriteByte('>')
+	for i := range w.header {
+		s, ok := stringValue(record[i])
+		if !ok {
+			continue
+		}
+		w.w.WriteString(w.LineBreak + "    " + w.startTags[i])
+		if err := xml.EscapeText(w.w, []byte(s)); err != nil {
+			return err
+		}
+		w.w.WriteString(w.endTags[i])
+	}
+	_, err := w.w.WriteString(w.LineBreak + "  </" + w.rowElement + ">")
+	return err
+}
+
+func (w *Writer) Close() error {
+	w.start()
+	w.w.WriteString(w.LineBreak + "</" + w.rootElement + ">")
+	return w.w.Flush()
+}
+
+func escapeString(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// stringValue returns the character data of a value, and false if the value is null.
+func stringValue(p value.Primary) (string, bool) {
+	switch v := p.(type) {
+	case *value.String:
+		return v.Raw(), true
+	case *value.Integer:
+		return strconv.FormatInt(v.Raw(), 10), true
+	case *value.Float:
+		return value.Float64ToStr(v.Raw(), false), true
+	case *value.Decimal:
+		return v.String(), true
+	case *value.Boolean:
+		return strconv.FormatBool(v.Raw()), true
+	case *value.Ternary:
+		if v.Ternary() == ternary.UNKNOWN {
+			return "", false
+		}
+		return strconv.FormatBool(v.Ternary().ParseBool()), true
+	case *value.Datetime:
+		return v.Format(time.RFC3339Nano), true
+	case *value.Interval:
+		return v.Format(), true
+	case *value.Array:
+		return v.Encode(), true
+	case *value.Map:
+		return v.Encode(), true
+	}
+	return "", false
+}
@@ -0,0 +1,1036 @@
+package yaml
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mithrandie/csvq/lib/json"
+	"github.com/mithrandie/csvq/lib/value"
+
+	txjson "github.com/mithrandie/go-text/json"
+)
+
+var (
+	intPattern   = regexp.MustCompile("^[-+]?[0-9]+$")
+	octPattern   = regexp.MustCompile("^0o[0-7]+$")
+	hexPattern   = regexp.MustCompile("^0x[0-9a-fA-F]+$")
+	floatPattern = regexp.MustCompile("^[-+]?(\\.[0-9]+|[0-9]+(\\.[0-9]*)?)([eE][-+]?[0-9]+)?$")
+	infPattern   = regexp.MustCompile("^[-+]?\\.(inf|Inf|INF)$")
+	nanPattern   = regexp.MustCompile("^\\.(nan|NaN|NAN)$")
+)
+
+// LoadTable loads the mappings in the sequence specified by queryString as records.
+//
+// The query is written in the same syntax as JSON queries, and applied to the content of the stream.
+// Integers, floats, booleans and nulls are loaded as the corresponding values.
+func LoadTable(queryString string, src string) ([]string, [][]value.Primary, error) {
+	query, err := json.Query.Parse(queryString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := Decode(src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st, err := json.Extract(query, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	array, ok := st.(txjson.Array)
+	if !ok {
+		return nil, nil, errors.New(fmt.Sprintf("yaml value does not exists for %q", queryString))
+	}
+	for _, elem := range array {
+		if _, ok := elem.(txjson.Object); !ok {
+			return nil, nil, errors.New("rows loaded from yaml must be mappings")
+		}
+	}
+
+	return json.ConvertToTableValue(array)
+}
+
+// Decode parses a YAML stream and returns the content as a JSON structure.
+//
+// Block and flow collections, plain, quoted and block scalars, anchors and aliases are supported.
+// Scalars are resolved with the core schema of YAML 1.2.
+// If the stream contains more than one document, the documents are returned as an array.
+func Decode(src string) (txjson.Structure, error) {
+	src = strings.TrimPrefix(src, "\ufeff")
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+
+	documents := make([][]line, 0, 1)
+	var lines []line
+	started := false
+
+	for i, s := range strings.Split(src, "\n") {
+		if !started && strings.HasPrefix(s, "%") {
+			continue
+		}
+		switch {
+		case s == "---" || strings.HasPrefix(s, "--- ") || strings.HasPrefix(s, "---\t"):
+			if started || !isBlankLines(lines) {
+				documents = append(documents, lines)
+			}
+			lines = nil
+			started = true
+			if rest := strings.TrimLeft(s[3:], " \t"); 0 < len(rest) {
+				lines = append(lines, line{num: i + 1, indent: len(s) - len(rest), text: rest})
+			}
+			continue
+		case s == "..." || strings.HasPrefix(s, "... "):
+			if started || !isBlankLines(lines) {
+				documents = append(documents, lines)
+			}
+			lines = nil
+			started = false
+			continue
+		}
+
+		text := strings.TrimLeft(s, " ")
+		lines = append(lines, line{num: i + 1, indent: len(s) - len(text), text: text})
+	}
+	if started || !isBlankLines(lines) {
+		documents = append(documents, lines)
+	}
+
+	anchors := make(map[string]txjson.Structure)
+	values := make(txjson.Array, 0, len(documents))
+	for _, doc := range documents {
+		d := &decoder{lines: doc, anchors: anchors}
+		v, err := d.parseNode(-1, true)
+		if err != nil {
+			return nil, err
+		}
+		d.skipBlank()
+		if d.pos < len(d.lines) {
+			return nil, d.error("unexpected indentation")
+		}
+		values = append(values, v)
+	}
+
+	switch len(values) {
+	case 0:
+		return txjson.Null{}, nil
+	case 1:
+		return values[0], nil
+	}
+	return values, nil
+}
+
+type line struct {
+	num    int
+	indent int
+	text   string
+}
+
+func isBlankLines(lines []line) bool {
+	for _, l := range lines {
+		if 0 < len(stripComment(l.text)) {
+			return false
+		}
+	}
+	return true
+}
+
+type decoder struct {
+	lines   []line
+	pos     int
+	anchors map[string]txjson.Structure
+}
+
+func (d *decoder) error(message string) error {
+	if d.pos < len(d.lines) {
+		return errors.New(fmt.Sprintf("line %d: %s", d.lines[d.pos].num, message))
+	}
+	return errors.New(message)
+}
+
+func (d *decoder) eof() bool {
+	return len(d.lines) <= d.pos
+}
+
+func (d *decoder) current() line {
+	return d.lines[d.pos]
+}
+
+// consume replaces the current line with the remaining text after n bytes.
+func (d *decoder) consume(n int) {
+	l := d.lines[d.pos]
+	rest := strings.TrimLeft(l.text[n:], " \t")
+	d.lines[d.pos] = line{num: l.num, indent: l.indent + len(l.text) - len(rest), text: rest}
+}
+
+func (d *decoder) skipBlank() {
+	for d.pos < len(d.lines) && len(stripComment(d.lines[d.pos].text)) < 1 {
+		d.pos++
+	}
+}
+
+// parseNode parses the node beginning at the current line.
+// Block collections are parsed only if blockAllowed is true, that is, the node is at the beginning of a line
+// or in a compact sequence entry.
+func (d *decoder) parseNode(parentIndent int, blockAllowed bool) (txjson.Structure, error) {
+	d.skipBlank()
+	if d.eof() || d.current().indent <= parentIndent {
+		return txjson.Null{}, nil
+	}
+	if strings.HasPrefix(d.current().text, "\t") {
+		return nil, d.error("tabs cannot be used for indentation")
+	}
+
+	anchor, tag, n, err := parseProperties(d.current().text)
+	if err != nil {
+		return nil, d.error(err.Error())
+	}
+
+	var node txjson.Structure
+	if 0 < n {
+		d.consume(n)
+		if len(stripComment(d.current().text)) < 1 {
+			d.pos++
+			if node, err = d.parseNode(parentIndent, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if node == nil {
+		text := stripComment(d.current().text)
+		switch {
+		case blockAllowed && isSequenceEntry(text):
+			node, err = d.parseSequence(d.current().indent)
+		case blockAllowed && isMappingEntry(d.current().text):
+			node, err = d.parseMapping(d.current().indent)
+		default:
+			node, err = d.parseScalar(parentIndent, tag == "!!str")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if tag == "!!str" {
+		node = toStringStructure(node)
+	}
+	if 0 < len(anchor) {
+		d.anchors[anchor] = node
+	}
+	return node, nil
+}
+
+func (d *decoder) parseSequence(indent int) (txjson.Structure, error) {
+	array := make(txjson.Array, 0, 8)
+
+	for {
+		d.skipBlank()
+		if d.eof() || d.current().indent != indent || !isSequenceEntry(stripComment(d.current().text)) {
+			break
+		}
+
+		d.consume(1)
+		if len(stripComment(d.current().text)) < 1 {
+			d.pos++
+		}
+		v, err := d.parseNode(indent, true)
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, v)
+	}
+
+	return array, nil
+}
+
+func (d *decoder) parseMapping(indent int) (txjson.Structure, error) {
+	obj := txjson.NewObject(8)
+	merged := make([]txjson.Object, 0, 1)
+
+	for {
+		d.skipBlank()
+		if d.eof() || d.current().indent != indent {
+			break
+		}
+
+		key, n, ok, err := splitMappingKey(d.current().text)
+		if err != nil {
+			return nil, d.error(err.Error())
+		}
+		if !ok {
+			break
+		}
+		if obj.Exists(key) {
+			return nil, d.error(fmt.Sprintf("duplicate key %q", key))
+		}
+
+		d.consume(n)
+		var v txjson.Structure
+		if len(stripComment(d.current().text)) < 1 {
+			d.pos++
+			d.skipBlank()
+			if !d.eof() && d.current().indent == indent && isSequenceEntry(stripComment(d.current().text)) {
+				v, err = d.parseSequence(indent)
+			} else {
+				v, err = d.parseNode(indent, true)
+			}
+		} else {
+			v, err = d.parseNode(indent, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if key == "<<" {
+			switch m := v.(type) {
+			case txjson.Object:
+				merged = append(merged, m)
+				continue
+			case txjson.Array:
+				for _, e := range m {
+					if o, ok := e.(txjson.Object); ok {
+						merged = append(merged, o)
+					}
+				}
+				continue
+			}
+		}
+		obj.Add(key, v)
+	}
+
+	for _, m := range merged {
+		for _, member := range m.Members {
+			if !obj.Exists(member.Key) {
+				obj.Add(member.Key, member.Value)
+			}
+		}
+	}
+	return obj, nil
+}
+
+func (d *decoder) parseScalar(parentIndent int, asString bool) (txjson.Structure, error) {
+	l := d.current()
+	text := stripComment(l.text)
+
+	switch text[0] {
+	case '|', '>':
+		return d.parseBlockScalar(parentIndent, text)
+	case '[', '{':
+		return d.parseFlow(parentIndent, asString)
+	case '"', '\'':
+		s, err := d.parseQuotedLines(parentIndent)
+		if err != nil {
+			return nil, err
+		}
+		return txjson.String(s), nil
+	case '*':
+		v, err := d.alias(text[1:])
+		if err != nil {
+			return nil, d.error(err.Error())
+		}
+		d.pos++
+		return v, nil
+	}
+
+	parts := []string{text}
+	d.pos++
+	blanks := 0
+	for !d.eof() {
+		next := d.current()
+		s := stripComment(next.text)
+		if len(s) < 1 {
+			if strings.HasPrefix(strings.TrimLeft(next.text, " \t"), "#") {
+				break
+			}
+			blanks++
+			d.pos++
+			continue
+		}
+		if next.indent <= parentIndent || isMappingEntry(next.text) {
+			break
+		}
+		if 0 < blanks {
+			parts = append(parts, strings.Repeat("\n", blanks))
+		} else {
+			parts = append(parts, " ")
+		}
+		parts = append(parts, s)
+		blanks = 0
+		d.pos++
+	}
+	if 0 < blanks {
+		d.pos -= blanks
+	}
+
+	s := strings.Join(parts, "")
+	if asString || 1 < len(parts) {
+		return txjson.String(s), nil
+	}
+	return resolvePlain(s), nil
+}
+
+func (d *decoder) alias(name string) (txjson.Structure, error) {
+	name = strings.TrimSpace(name)
+	v, ok := d.anchors[name]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("anchor %q is not defined", name))
+	}
+	return v, nil
+}
+
+func (d *decoder) parseBlockScalar(parentIndent int, header string) (txjson.Structure, error) {
+	literal := header[0] == '|'
+	chomping := byte(0)
+	indentIndicator := 0
+	for i := 1; i < len(header); i++ {
+		switch c := header[i]; {
+		case c == '-' || c == '+':
+			chomping = c
+		case '1' <= c && c <= '9':
+			indentIndicator = int(c - '0')
+		default:
+			return nil, d.error(fmt.Sprintf("invalid block scalar header %q", header))
+		}
+	}
+	d.pos++
+
+	contentIndent := -1
+	if 0 < indentIndicator {
+		contentIndent = indentIndicator
+		if 0 < parentIndent {
+			contentIndent = parentIndent + indentIndicator
+		}
+	}
+
+	body := make([]string, 0, 8)
+	for !d.eof() {
+		l := d.current()
+		if len(strings.TrimSpace(l.text)) < 1 {
+			body = append(body, "")
+			d.pos++
+			continue
+		}
+		if contentIndent < 0 {
+			if l.indent <= parentIndent {
+				break
+			}
+			contentIndent = l.indent
+		}
+		if l.indent < contentIndent {
+			break
+		}
+		body = append(body, strings.Repeat(" ", l.indent-contentIndent)+l.text)
+		d.pos++
+	}
+
+	trailing := 0
+	for i := len(body) - 1; 0 <= i && len(body[i]) < 1; i-- {
+		trailing++
+	}
+	body = body[:len(body)-trailing]
+
+	var s string
+	if literal {
+		s = strings.Join(body, "\n")
+	} else {
+		s = foldLines(body)
+	}
+
+	switch {
+	case len(body) < 1:
+		if chomping == '+' {
+			s = strings.Repeat("\n", trailing)
+		}
+	case chomping == '-':
+	case chomping == '+':
+		s = s + "\n" + strings.Repeat("\n", trailing)
+	default:
+		s = s + "\n"
+	}
+	return txjson.String(s), nil
+}
+
+func foldLines(body []string) string {
+	var buf strings.Builder
+	moreIndented := func(s string) bool {
+		return 0 < len(s) && (s[0] == ' ' || s[0] == '\t')
+	}
+
+	prev := ""
+	blanks := 0
+	for i, s := range body {
+		if len(s) < 1 {
+			blanks++
+			continue
+		}
+		if 0 < i {
+			switch {
+			case blanks == i:
+				buf.WriteString(strings.Repeat("\n", blanks))
+			case 0 < blanks:
+				if moreIndented(prev) || moreIndented(s) {
+					blanks++
+				}
+				buf.WriteString(strings.Repeat("\n", blanks))
+			case moreIndented(prev) || moreIndented(s):
+				buf.WriteByte('\n')
+			default:
+				buf.WriteByte(' ')
+			}
+		}
+		buf.WriteString(s)
+		prev = s
+		blanks = 0
+	}
+	return buf.String()
+}
+
+// parseQuotedLines parses a quoted scalar that may continue over the following lines.
+func (d *decoder) parseQuotedLines(parentIndent int) (string, error) {
+	startPos := d.pos
+	src := d.current().text
+
+	for {
+		s, n, closed, err := unquote(src)
+		if err != nil {
+			d.pos = startPos
+			return "", d.error(err.Error())
+		}
+		if closed {
+			if 0 < len(stripComment(src[n:])) {
+				d.pos = startPos
+				return "", d.error(fmt.Sprintf("unexpected characters after the quoted scalar: %s", stripComment(src[n:])))
+			}
+			d.pos++
+			return s, nil
+		}
+
+		d.pos++
+		if d.eof() || (0 < len(strings.TrimSpace(d.current().text)) && d.current().indent <= parentIndent) {
+			d.pos = startPos
+			return "", d.error("quoted scalar is not closed")
+		}
+		src = src + "\n" + d.current().text
+	}
+}
+
+func (d *decoder) parseFlow(parentIndent int, asString bool) (txjson.Structure, error) {
+	startPos := d.pos
+	src := stripComment(d.current().text)
+	for !isFlowClosed(src) {
+		d.pos++
+		if d.eof() {
+			d.pos = startPos
+			return nil, d.error("flow collection is not closed")
+		}
+		src = src + " " + stripComment(d.current().text)
+	}
+	d.pos++
+
+	p := &flowParser{src: src, decoder: d, asString: asString}
+	v, err := p.parseNode()
+	if err == nil {
+		p.skipSpaces()
+		if p.pos < len(p.src) {
+			err = errors.New(fmt.Sprintf("unexpected characters after the flow collection: %s", p.src[p.pos:]))
+		}
+	}
+	if err != nil {
+		d.pos = startPos
+		return nil, d.error(err.Error())
+	}
+	return v, nil
+}
+
+func isFlowClosed(s string) bool {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case '"', '\'':
+			if 0 < i && strings.IndexByte(" \t[{,:", s[i-1]) < 0 {
+				continue
+			}
+			_, n, closed, _ := unquote(s[i:])
+			if !closed {
+				return false
+			}
+			i += n - 1
+		}
+	}
+	return depth <= 0
+}
+
+type flowParser struct {
+	src      string
+	pos      int
+	decoder  *decoder
+	asString bool
+}
+
+func (p *flowParser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t' || p.src[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+func (p *flowParser) parseNode() (txjson.Structure, error) {
+	p.skipSpaces()
+	if len(p.src) <= p.pos {
+		return txjson.Null{}, nil
+	}
+
+	anchor, tag, n, err := parseProperties(p.src[p.pos:])
+	if err != nil {
+		return nil, err
+	}
+	p.pos += n
+	p.skipSpaces()
+	asString := p.asString || tag == "!!str"
+
+	var node txjson.Structure
+	if len(p.src) <= p.pos {
+		node = txjson.Null{}
+	} else {
+		switch p.src[p.pos] {
+		case '[':
+			node, err = p.parseSequence()
+		case '{':
+			node, err = p.parseMapping()
+		case '*':
+			p.pos++
+			start := p.pos
+			for p.pos < len(p.src) && strings.IndexByte(" \t,[]{}", p.src[p.pos]) < 0 {
+				p.pos++
+			}
+			node, err = p.decoder.alias(p.src[start:p.pos])
+		default:
+			var s string
+			var quoted bool
+			s, quoted, err = p.parseScalarText()
+			if err == nil {
+				if quoted || asString {
+					node = txjson.String(s)
+				} else {
+					node = resolvePlain(s)
+				}
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if tag == "!!str" {
+		node = toStringStructure(node)
+	}
+	if 0 < len(anchor) {
+		p.decoder.anchors[anchor] = node
+	}
+	return node, nil
+}
+
+func (p *flowParser) parseScalarText() (string, bool, error) {
+	if p.src[p.pos] == '"' || p.src[p.pos] == '\'' {
+		s, n, closed, err := unquote(p.src[p.pos:])
+		if err != nil {
+			return "", true, err
+		}
+		if !closed {
+			return "", true, errors.New("quoted scalar is not closed")
+		}
+		p.pos += n
+		return s, true, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if strings.IndexByte(",[]{}", c) != -1 {
+			break
+		}
+		if c == ':' && (p.pos+1 == len(p.src) || strings.IndexByte(" \t,[]{}", p.src[p.pos+1]) != -1) {
+			break
+		}
+		p.pos++
+	}
+	return strings.TrimSpace(p.src[start:p.pos]), false, nil
+}
+
+func (p *flowParser) parseSequence() (txjson.Structure, error) {
+	p.pos++
+	array := make(txjson.Array, 0, 8)
+
+	for {
+		p.skipSpaces()
+		if len(p.src) <= p.pos {
+			return nil, errors.New("flow sequence is not closed")
+		}
+		if p.src[p.pos] == ']' {
+			p.pos++
+			return array, nil
+		}
+
+		v, err := p.parseNode()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.pos < len(p.src) && p.src[p.pos] == ':' {
+			p.pos++
+			key := scalarKey(v)
+			val, err := p.parseNode()
+			if err != nil {
+				return nil, err
+			}
+			obj := txjson.NewObject(1)
+			obj.Add(key, val)
+			v = obj
+			p.skipSpaces()
+		}
+		array = append(array, v)
+
+		if len(p.src) <= p.pos {
+			return nil, errors.New("flow sequence is not closed")
+		}
+		switch p.src[p.pos] {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return nil, errors.New(fmt.Sprintf("unexpected character %q in flow sequence", p.src[p.pos]))
+		}
+	}
+}
+
+func (p *flowParser) parseMapping() (txjson.Structure, error) {
+	p.pos++
+	obj := txjson.NewObject(8)
+
+	for {
+		p.skipSpaces()
+		if len(p.src) <= p.pos {
+			return nil, errors.New("flow mapping is not closed")
+		}
+		if p.src[p.pos] == '}' {
+			p.pos++
+			return obj, nil
+		}
+
+		key, _, err := p.parseScalarText()
+		if err != nil {
+			return nil, err
+		}
+		if obj.Exists(key) {
+			return nil, errors.New(fmt.Sprintf("duplicate key %q", key))
+		}
+
+		p.skipSpaces()
+		var v txjson.Structure = txjson.Null{}
+		if p.pos < len(p.src) && p.src[p.pos] == ':' {
+			p.pos++
+			p.skipSpaces()
+			if p.pos < len(p.src) && p.src[p.pos] != ',' && p.src[p.pos] != '}' {
+				if v, err = p.parseNode(); err != nil {
+					return nil, err
+				}
+				p.skipSpaces()
+			}
+		}
+		obj.Add(key, v)
+
+		if len(p.src) <= p.pos {
+			return nil, errors.New("flow mapping is not closed")
+		}
+		switch p.src[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return nil, errors.New(fmt.Sprintf("unexpected character %q in flow mapping", p.src[p.pos]))
+		}
+	}
+}
+
+func scalarKey(v txjson.Structure) string {
+	switch s := v.(type) {
+	case txjson.String:
+		return s.Raw()
+	case txjson.Null:
+		return "null"
+	}
+	return v.Encode()
+}
+
+func toStringStructure(v txjson.Structure) txjson.Structure {
+	switch v.(type) {
+	case txjson.String, txjson.Array, txjson.Object:
+		return v
+	}
+	return txjson.String(scalarKey(v))
+}
+
+// parseProperties returns the anchor and the tag at the beginning of s, and the length of them.
+func parseProperties(s string) (string, string, int, error) {
+	var anchor, tag string
+	pos := 0
+
+	for pos < len(s) && (s[pos] == '&' || s[pos] == '!') {
+		end := pos + 1
+		for end < len(s) && s[end] != ' ' && s[end] != '\t' {
+			end++
+		}
+
+		if s[pos] == '&' {
+			if end-pos < 2 {
+				return "", "", 0, errors.New("anchor name is empty")
+			}
+			anchor = s[pos+1 : end]
+		} else {
+			tag = s[pos:end]
+		}
+
+		pos = end
+		for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t') {
+			pos++
+		}
+	}
+	return anchor, tag, pos, nil
+}
+
+func isSequenceEntry(s string) bool {
+	return s == "-" || strings.HasPrefix(s, "- ") || strings.HasPrefix(s, "-\t")
+}
+
+func isMappingEntry(s string) bool {
+	_, _, ok, _ := splitMappingKey(s)
+	return ok
+}
+
+// splitMappingKey returns the key of a mapping entry in s and the position of the value.
+// If s is not a mapping entry, then false is returned.
+func splitMappingKey(s string) (string, int, bool, error) {
+	if len(s) < 1 {
+		return "", 0, false, nil
+	}
+
+	switch s[0] {
+	case '"', '\'':
+		key, n, closed, err := unquote(s)
+		if err != nil || !closed {
+			return "", 0, false, nil
+		}
+		rest := strings.TrimLeft(s[n:], " \t")
+		if !isValueIndicator(rest) {
+			return "", 0, false, nil
+		}
+		return key, len(s) - len(rest) + 1, true, nil
+	case '[', '{', '#', '|', '>', '*', '&', '!', '%', '@', '`':
+		return "", 0, false, nil
+	case '?':
+		if len(s) == 1 || s[1] == ' ' {
+			return "", 0, false, errors.New("complex mapping keys are not supported")
+		}
+	case '-':
+		if isSequenceEntry(s) {
+			return "", 0, false, nil
+		}
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '#':
+			if 0 < i && (s[i-1] == ' ' || s[i-1] == '\t') {
+				return "", 0, false, nil
+			}
+		case ':':
+			if isValueIndicator(s[i:]) {
+				return strings.TrimRight(s[:i], " \t"), i + 1, true, nil
+			}
+		}
+	}
+	return "", 0, false, nil
+}
+
+func isValueIndicator(s string) bool {
+	return 0 < len(s) && s[0] == ':' && (len(s) == 1 || s[1] == ' ' || s[1] == '\t')
+}
+
+// stripComment returns s without the comment and the trailing spaces.
+func stripComment(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '#':
+			if i == 0 || s[i-1] == ' ' || s[i-1] == '\t' {
+				return strings.TrimRight(s[:i], " \t")
+			}
+		case '"', '\'':
+			if 0 < i && strings.IndexByte(" \t[{,:", s[i-1]) < 0 {
+				continue
+			}
+			_, n, closed, _ := unquote(s[i:])
+			if !closed {
+				return strings.TrimRight(s, " \t")
+			}
+			i += n - 1
+		}
+	}
+	return strings.TrimRight(s, " \t")
+}
+
+// unquote returns the content of the quoted scalar at the beginning of s, and the length of the quoted scalar.
+// Line breaks in s are folded.
+func unquote(s string) (string, int, bool, error) {
+	quote := s[0]
+
+	var buf strings.Builder
+	i := 1
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == quote:
+			if quote == '\'' && i+1 < len(s) && s[i+1] == '\'' {
+				buf.WriteByte('\'')
+				i += 2
+				continue
+			}
+			return buf.String(), i + 1, true, nil
+		case c == '\n':
+			content := strings.TrimRight(buf.String(), " \t")
+			buf.Reset()
+			buf.WriteString(content)
+
+			breaks := 0
+			for i < len(s) && (s[i] == '\n' || s[i] == ' ' || s[i] == '\t') {
+				if s[i] == '\n' {
+					breaks++
+				}
+				i++
+			}
+			if breaks == 1 {
+				buf.WriteByte(' ')
+			} else {
+				buf.WriteString(strings.Repeat("\n", breaks-1))
+			}
+			continue
+		case c == '\\' && quote == '"':
+			if len(s) <= i+1 {
+				return "", 0, false, nil
+			}
+			n, err := writeEscapeSequence(&buf, s[i+1:])
+			if err != nil {
+				return "", 0, false, err
+			}
+			i += n + 1
+			continue
+		}
+		buf.WriteByte(c)
+		i++
+	}
+	return "", 0, false, nil
+}
+
+func writeEscapeSequence(buf *strings.Builder, s string) (int, error) {
+	switch s[0] {
+	case '0':
+		buf.WriteByte(0)
+	case 'a':
+		buf.WriteByte('\a')
+	case 'b':
+		buf.WriteByte('\b')
+	case 't', '\t':
+		buf.WriteByte('\t')
+	case 'n':
+		buf.WriteByte('\n')
+	case 'v':
+		buf.WriteByte('\v')
+	case 'f':
+		buf.WriteByte('\f')
+	case 'r':
+		buf.WriteByte('\r')
+	case 'e':
+		buf.WriteByte(0x1b)
+	case ' ', '"', '/', '\\':
+		buf.WriteByte(s[0])
+	case 'N':
+		buf.WriteString("\u0085")
+	case '_':
+		buf.WriteString("\u00a0")
+	case 'L':
+		buf.WriteString("\u2028")
+	case 'P':
+		buf.WriteString("\u2029")
+	case '\n':
+		n := 1
+		for n < len(s) && (s[n] == ' ' || s[n] == '\t') {
+			n++
+		}
+		return n, nil
+	case 'x', 'u', 'U':
+		size := map[byte]int{'x': 2, 'u': 4, 'U': 8}[s[0]]
+		if len(s) < size+1 {
+			return 0, errors.New(fmt.Sprintf("invalid escape sequence \\%s", s))
+		}
+		r, err := strconv.ParseUint(s[1:size+1], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return 0, errors.New(fmt.Sprintf("invalid escape sequence \\%s", s[:size+1]))
+		}
+		buf.WriteRune(rune(r))
+		return size + 1, nil
+	default:
+		return 0, errors.New(fmt.Sprintf("invalid escape sequence \\%c", s[0]))
+	}
+	return 1, nil
+}
+
+// resolvePlain returns the value of a plain scalar.
+func resolvePlain(s string) txjson.Structure {
+	switch s {
+	case "", "~", "null", "Null", "NULL":
+		return txjson.Null{}
+	case "true", "True", "TRUE":
+		return txjson.Boolean(true)
+	case "false", "False", "FALSE":
+		return txjson.Boolean(false)
+	}
+
+	switch {
+	case intPattern.MatchString(s):
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return txjson.Integer(i)
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return txjson.Number(f)
+		}
+	case octPattern.MatchString(s):
+		if i, err := strconv.ParseInt(s[2:], 8, 64); err == nil {
+			return txjson.Integer(i)
+		}
+	case hexPattern.MatchString(s):
+		if i, err := strconv.ParseInt(s[2:], 16, 64); err == nil {
+			return txjson.Integer(i)
+		}
+	case floatPattern.MatchString(s):
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return txjson.Number(f)
+		}
+	case infPattern.MatchString(s):
+		if s[0] == '-' {
+			return txjson.Number(math.Inf(-1))
+		}
+		return txjson.Number(math.Inf(1))
+	case nanPattern.MatchString(s):
+		return txjson.Number(math.NaN())
+	}
+	return txjson.String(s)
+}
@@ -0,0 +1,178 @@
+package yaml
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var decodeTests = []struct {
+	Input  string
+	Expect string
+	Error  string
+}{
+	{
+		Input: "# comment\n" +
+			"- id: 1\n" +
+			"  name: str1  # comment\n" +
+			"- id: 2\n" +
+			"  name: 'it''s'\n",
+		Expect: "[{\"id\":1,\"name\":\"str1\"},{\"id\":2,\"name\":\"it's\"}]",
+	},
+	{
+		Input: "items:\n" +
+			"- {id: 1, tags: [a, b]}\n" +
+			"- id: 0x1F\n" +
+			"  tags: []\n",
+		Expect: "{\"items\":[{\"id\":1,\"tags\":[\"a\",\"b\"]},{\"id\":31,\"tags\":[]}]}",
+	},
+	{
+		Input:  "[null, ~, true, False, 1.5, 0o17, \"a\\tb\", !!str 12]",
+		Expect: "[null,null,true,false,1.5,15,\"a\\tb\",\"12\"]",
+	},
+	{
+		Input: "base: &base\n" +
+			"  a: 1\n" +
+			"  b: 2\n" +
+			"derived:\n" +
+			"  <<: *base\n" +
+			"  b: 3\n",
+		Expect: "{\"base\":{\"a\":1,\"b\":2},\"derived\":{\"b\":3,\"a\":1}}",
+	},
+	{
+		Input: "literal: |\n" +
+			"  line1\n" +
+			"  line2\n" +
+			"folded: >-\n" +
+			"  word1\n" +
+			"  word2\n" +
+			"plain: multi\n" +
+			"  line\n",
+		Expect: "{\"literal\":\"line1\\nline2\\n\",\"folded\":\"word1 word2\",\"plain\":\"multi line\"}",
+	},
+	{
+		Input: "---\n" +
+			"a: 1\n" +
+			"---\n" +
+			"a: 2\n",
+		Expect: "[{\"a\":1},{\"a\":2}]",
+	},
+	{
+		Input:  "",
+		Expect: "null",
+	},
+	{
+		Input: "a: 1\n" +
+			"a: 2\n",
+		Error: "line 2: duplicate key \"a\"",
+	},
+	{
+		Input: "a: *undefined\n",
+		Error: "line 1: anchor \"undefined\" is not defined",
+	},
+	{
+		Input: "a: [1, 2\n",
+		Error: "line 1: flow collection is not closed",
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, v := range decodeTests {
+		result, err := Decode(v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("unexpected error %q for %q", err, v.Input)
+			} else if err.Error() != v.Error {
+				t.Errorf("error %q, want error %q for %q", err.Error(), v.Error, v.Input)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("no error, want error %q for %q", v.Error, v.Input)
+			continue
+		}
+		if result.Encode() != v.Expect {
+			t.Errorf("result = %s, want %s for %q", result.Encode(), v.Expect, v.Input)
+		}
+	}
+}
+
+var loadTableTests = []struct {
+	Name    string
+	Query   string
+	Input   string
+	Header  []string
+	Records [][]value.Primary
+	Error   string
+}{
+	{
+		Name: "LoadTable",
+		Input: "- id: 1\n" +
+			"  name: str1\n" +
+			"  price: 1.5\n" +
+			"- id: 2\n" +
+			"  name: str2\n" +
+			"  flag: true\n" +
+			"  tags: [a, b]\n",
+		Header: []string{"id", "name", "price", "flag", "tags"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1), value.NewString("str1"), value.NewFloat(1.5), value.NewNull(), value.NewNull()},
+			{value.NewInteger(2), value.NewString("str2"), value.NewNull(), value.NewBoolean(true), value.NewString("[\"a\",\"b\"]")},
+		},
+	},
+	{
+		Name:  "LoadTable with Query",
+		Query: "data.items",
+		Input: "data:\n" +
+			"  items:\n" +
+			"    - id: 1\n" +
+			"    - id: ~\n",
+		Header: []string{"id"},
+		Records: [][]value.Primary{
+			{value.NewInteger(1)},
+			{value.NewNull()},
+		},
+	},
+	{
+		Name:  "LoadTable Value Not Exist Error",
+		Query: "notexist",
+		Input: "a: 1\n",
+		Error: "yaml value does not exists for \"notexist\"",
+	},
+	{
+		Name:  "LoadTable Not Mapping Error",
+		Input: "- 1\n- 2\n",
+		Error: "rows loaded from yaml must be mappings",
+	},
+	{
+		Name:  "LoadTable Query Syntax Error",
+		Query: "data.",
+		Input: "a: 1\n",
+		Error: "column 5: unexpected termination",
+	},
+}
+
+func TestLoadTable(t *testing.T) {
+	for _, v := range loadTableTests {
+		header, records, err := LoadTable(v.Query, v.Input)
+		if err != nil {
+			if len(v.Error) < 1 {
+				t.Errorf("%s: unexpected error %q", v.Name, err)
+			} else if err.Error() != v.Error {
+				t.Errorf("%s: error %q, want error %q", v.Name, err.Error(), v.Error)
+			}
+			continue
+		}
+		if 0 < len(v.Error) {
+			t.Errorf("%s: no error, want error %q", v.Name, v.Error)
+			continue
+		}
+		if !reflect.DeepEqual(header, v.Header) {
+			t.Errorf("%s: header = %q, want %q", v.Name, header, v.Header)
+		}
+		if !reflect.DeepEqual(records, v.Records) {
+			t.Errorf("%s: records = %s, want %s", v.Name, records, v.Records)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package yaml
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mithrandie/csvq/lib/value"
+
+	"github.com/mithrandie/ternary"
+)
+
+// Writer writes records as a sequence of mappings.
+//
+// Integers, floats, decimals, booleans, datetimes and nulls are written as the corresponding scalars,
+// and arrays and maps are written as flow collections, so that the types of the values are kept.
+type Writer struct {
+	w       *bufio.Writer
+	header  []string
+	written bool
+
+	LineBreak string
+}
+
+func NewWriter(w io.Writer, header []string) *Writer {
+	keys := make([]string, len(header))
+	for i := range header {
+		keys[i] = Quote(header[i])
+	}
+
+	return &Writer{
+		w:         bufio.NewWriter(w),
+		header:    keys,
+		LineBreak: "\n",
+	}
+}
+
+func (w *Writer) Write(record []value.Primary) error {
+	if w.written {
+		w.w.WriteString(w.LineBreak)
+	}
+
+	if len(w.header) < 1 {
+		w.w.WriteString("- {}")
+	}
+	for i := range w.header {
+		if i == 0 {
+			w.w.WriteString("- ")
+		} else {
+			w.w.WriteString(w.LineBreak + "  ")
+		}
+		w.w.WriteString(w.header[i] + ": " + Scalar(record[i]))
+	}
+
+	w.written = true
+	return nil
+}
+
+func (w *Writer) Close() error {
+	if !w.written {
+		w.w.WriteString("[]")
+	}
+	return w.w.Flush()
+}
+
+// Scalar returns the representation of a value in YAML flow style.
+func Scalar(p value.Primary) string {
+	switch v := p.(type) {
+	case *value.String:
+		return Quote(v.Raw())
+	case *value.Integer:
+		return strconv.FormatInt(v.Raw(), 10)
+	case *value.Float:
+		f := v.Raw()
+		switch {
+		case math.IsNaN(f):
+			return ".nan"
+		case math.IsInf(f, 1):
+			return ".inf"
+		case math.IsInf(f, -1):
+			return "-.inf"
+		}
+		s := value.Float64ToStr(f, false)
+		if !strings.ContainsAny(s, ".eE") {
+			s = s + ".0"
+		}
+		return s
+	case *value.Decimal:
+		return v.String()
+	case *value.Boolean:
+		return strconv.FormatBool(v.Raw())
+	case *value.Ternary:
+		if v.Ternary() == ternary.UNKNOWN {
+			return "null"
+		}
+		return strconv.FormatBool(v.Ternary().ParseBool())
+	case *value.Datetime:
+		return v.Format(time.RFC3339Nano)
+	case *value.Interval:
+		return Quote(v.Format())
+	case *value.Array:
+		elems := make([]string, v.Len())
+		for i, e := range v.Values() {
+			elems[i] = Scalar(e)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case *value.Map:
+		elems := make([]string, v.Len())
+		for i, k := range v.Keys() {
+			e, _ := v.Get(k)
+			elems[i] = Quote(k) + ": " + Scalar(e)
+		}
+		return "{" + strings.Join(elems, ", ") + "}"
+	}
+	return "null"
+}
+
+// Quote returns s as a plain scalar if s is read as the same string, otherwise as a double-quoted scalar.
+func Quote(s string) string {
+	if isPlain(s) {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString("\\\"")
+		case '\\':
+			b.WriteString("\\\\")
+		case '\n':
+			b.WriteString("\\n")
+		case '\r':
+			b.WriteString("\\r")
+		case '\t':
+			b.WriteString("\\t")
+		default:
+			if r < 0x20 || r == 0x7f {
+				b.WriteString("\\x" + strconv.FormatInt(int64(r)+0x100, 16)[1:])
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var reservedWords = []string{"null", "~", "true", "false", "yes", "no", "on", "off", "y", "n"}
+
+func isPlain(s string) bool {
+	if len(s) < 1 || s[0] == ' ' || s[len(s)-1] == ' ' || s[len(s)-1] == ':' {
+		return false
+	}
+	if strings.ContainsRune("-?:,[]{}#&*!|>'\"%@`+.0123456789", rune(s[0])) {
+		return false
+	}
+	if strings.Contains(s, ": ") || strings.Contains(s, " #") || strings.ContainsAny(s, ",[]{}") {
+		return false
+	}
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+	for _, w := range reservedWords {
+		if strings.EqualFold(s, w) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package yaml
+
+import (
+	"math"
+	"testing"
+
+	"github.com/mithrandie/csvq/lib/value"
+)
+
+var quoteTests = []struct {
+	Input  string
+	Expect string
+}{
+	{
+		Input:  "abc",
+		Expect: "abc",
+	},
+	{
+		Input:  "",
+		Expect: "\"\"",
+	},
+	{
+		Input:  "Yes",
+		Expect: "\"Yes\"",
+	},
+	{
+		Input:  "123",
+		Expect: "\"123\"",
+	},
+	{
+		Input:  "key: value",
+		Expect: "\"key: value\"",
+	},
+	{
+		Input:  "a\tb\x01",
+		Expect: "\"a\\tb\\x01\"",
+	},
+}
+
+func TestQuote(t *testing.T) {
+	for _, v := range quoteTests {
+		result := Quote(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %s, want %s for %q", result, v.Expect, v.Input)
+		}
+	}
+}
+
+var scalarTests = []struct {
+	Input  value.Primary
+	Expect string
+}{
+	{
+		Input:  value.NewFloat(math.Inf(-1)),
+		Expect: "-.inf",
+	},
+	{
+		Input:  value.NewFloat(2),
+		Expect: "2.0",
+	},
+	{
+		Input:  value.NewDecimalFromString("1.50"),
+		Expect: "1.50",
+	},
+	{
+		Input:  value.NewNull(),
+		Expect: "null",
+	},
+}
+
+func TestScalar(t *testing.T) {
+	for _, v := range scalarTests {
+		result := Scalar(v.Input)
+		if result != v.Expect {
+			t.Errorf("result = %s, want %s for %s", result, v.Expect, v.Input)
+		}
+	}
+}